@@ -0,0 +1,676 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+)
+
+// ctlTimeout bounds each non-streaming request to the daemon.
+const ctlTimeout = 30 * time.Second
+
+var ctlCmd = &cobra.Command{
+	Use:   "ctl",
+	Short: "Manage workflows on a running daemon",
+	Long: `Control a running "perles daemon" from the command line.
+
+The daemon's address is discovered from ~/.perles/daemon/daemon.json, which
+the daemon writes on startup. TCP daemons are authenticated with the token
+from "perles daemon token".
+
+Every command prints a table or message by default, or JSON with --json.
+
+Example:
+  perles ctl list
+  perles ctl create --template cook --epic perles-abc1 --worktree --start
+  perles ctl create --template research_proposal -a goal="OAuth" --then cook --start
+  perles ctl logs wf-1234 -f
+  perles ctl send wf-1234 "Prioritize the failing tests"
+  perles ctl land wf-1234 --squash --cleanup
+  perles ctl list --state running --json | jq -r '.workflows[].id'`,
+}
+
+var (
+	ctlJSON bool
+
+	ctlListState string
+
+	ctlCreateTemplate   string
+	ctlCreateName       string
+	ctlCreateEpic       string
+	ctlCreateArgs       []string
+	ctlCreateWorktree   bool
+	ctlCreateBaseBranch string
+	ctlCreateBranch     string
+	ctlCreatePriority   int
+	ctlCreateBudget     float64
+	ctlCreateAfter      []string
+	ctlCreateThen       []string
+	ctlCreateStart      bool
+
+	ctlCreateWorkerWorktrees bool
+
+	ctlStopForce  bool
+	ctlStopReason string
+
+	ctlLogsFollow bool
+
+	ctlSendTo        string
+	ctlSendInterrupt bool
+
+	ctlLandSquash  bool
+	ctlLandMessage string
+	ctlLandCleanup bool
+	ctlLandDryRun  bool
+	ctlLandResolve bool
+)
+
+func init() {
+	rootCmd.AddCommand(ctlCmd)
+	ctlCmd.PersistentFlags().BoolVar(&ctlJSON, "json", false, "print JSON instead of tables")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List workflows",
+		Args:  cobra.NoArgs,
+		RunE:  runCtlList,
+	}
+	listCmd.Flags().StringVar(&ctlListState, "state", "", "only list workflows in this state (e.g. running)")
+
+	getCmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runCtlGet,
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a workflow",
+		Args:  cobra.NoArgs,
+		RunE:  runCtlCreate,
+	}
+	createCmd.Flags().StringVarP(&ctlCreateTemplate, "template", "t", "", "workflow template (required)")
+	createCmd.Flags().StringVar(&ctlCreateName, "name", "", "workflow name (defaults to the template name)")
+	createCmd.Flags().StringVar(&ctlCreateEpic, "epic", "", "existing epic for epic-driven templates")
+	createCmd.Flags().StringArrayVarP(&ctlCreateArgs, "arg", "a", nil, "template argument as key=value (repeatable)")
+	createCmd.Flags().BoolVar(&ctlCreateWorktree, "worktree", false, "run the workflow in its own git worktree")
+	createCmd.Flags().StringVar(&ctlCreateBaseBranch, "base-branch", "main", "branch to base the worktree on")
+	createCmd.Flags().StringVar(&ctlCreateBranch, "branch", "", "worktree branch name (auto-generated if empty)")
+	createCmd.Flags().BoolVar(&ctlCreateWorkerWorktrees, "worker-worktrees", false,
+		"give each worker its own worktree branched from the workflow branch (requires --worktree)")
+	createCmd.Flags().IntVar(&ctlCreatePriority, "priority", 0, "start queue priority")
+	createCmd.Flags().Float64Var(&ctlCreateBudget, "budget", 0, "spend budget in USD")
+	createCmd.Flags().StringArrayVar(&ctlCreateAfter, "after", nil, "start once this workflow completes, with its outputs as arguments (repeatable)")
+	createCmd.Flags().StringArrayVar(&ctlCreateThen, "then", nil, "template to run on the results once the workflow completes (repeatable, runs in order)")
+	createCmd.Flags().BoolVar(&ctlCreateStart, "start", false, "start the workflow after creating it")
+	_ = createCmd.MarkFlagRequired("template")
+
+	startCmd := &cobra.Command{
+		Use:   "start <id>",
+		Short: "Start, or queue, a pending workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runCtlAction(args[0], "Started", (*api.Client).StartWorkflow)
+		},
+	}
+
+	pauseCmd := &cobra.Command{
+		Use:   "pause <id>",
+		Short: "Pause a running workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runCtlAction(args[0], "Paused", (*api.Client).PauseWorkflow)
+		},
+	}
+
+	resumeCmd := &cobra.Command{
+		Use:   "resume <id>",
+		Short: "Resume a paused workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runCtlAction(args[0], "Resumed", (*api.Client).ResumeWorkflow)
+		},
+	}
+
+	stopCmd := &cobra.Command{
+		Use:   "stop <id>",
+		Short: "Stop a workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			req := api.StopWorkflowRequest{Force: ctlStopForce, Reason: ctlStopReason}
+			return runCtlAction(args[0], "Stopped", func(c *api.Client, ctx context.Context, id string) error {
+				return c.StopWorkflow(ctx, id, req)
+			})
+		},
+	}
+	stopCmd.Flags().BoolVar(&ctlStopForce, "force", false, "skip graceful shutdown and discard uncommitted worktree changes")
+	stopCmd.Flags().StringVar(&ctlStopReason, "reason", "", "why the workflow is being stopped")
+
+	logsCmd := &cobra.Command{
+		Use:   "logs <id>",
+		Short: "Print a workflow's events until it ends",
+		Long: `Print a workflow's events as they happen. Without --follow, exits when
+the workflow completes, fails or is stopped, so scripts can wait on it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCtlLogs,
+	}
+	logsCmd.Flags().BoolVarP(&ctlLogsFollow, "follow", "f", false, "keep streaming after the workflow ends")
+
+	sendCmd := &cobra.Command{
+		Use:   "send <id> <message>",
+		Short: "Send a message to a workflow's coordinator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			req := api.SendMessageRequest{Content: args[1], ProcessID: ctlSendTo, Interrupt: ctlSendInterrupt}
+			return runCtlAction(args[0], "Sent message to", func(c *api.Client, ctx context.Context, id string) error {
+				return c.SendMessage(ctx, id, req)
+			})
+		},
+	}
+	sendCmd.Flags().StringVar(&ctlSendTo, "to", "", "send to this process instead of the coordinator")
+	sendCmd.Flags().BoolVar(&ctlSendInterrupt, "interrupt", false, "cancel the process's current turn and deliver the message immediately")
+
+	healthCmd := &cobra.Command{
+		Use:   "health",
+		Short: "Show daemon and workflow health",
+		Args:  cobra.NoArgs,
+		RunE:  runCtlHealth,
+	}
+
+	landCmd := &cobra.Command{
+		Use:   "land <id>",
+		Short: "Merge a workflow's worktree branch into its base branch",
+		Long: `Show the diff stat of a workflow's worktree branch against its base branch,
+then merge it, or squash it with --squash. Landing fails when the branch
+conflicts with the base branch; hand the conflicts to the workflow's
+coordinator with --resolve and land again once it reports back.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runCtlLand,
+	}
+	landCmd.Flags().BoolVar(&ctlLandSquash, "squash", false, "commit the branch's changes as a single commit")
+	landCmd.Flags().StringVarP(&ctlLandMessage, "message", "m", "", "commit message (defaults to one naming the workflow)")
+	landCmd.Flags().BoolVar(&ctlLandCleanup, "cleanup", false, "remove the worktree and delete the branch after landing")
+	landCmd.Flags().BoolVar(&ctlLandDryRun, "dry-run", false, "only show the diff stat and conflicts")
+	landCmd.Flags().BoolVar(&ctlLandResolve, "resolve", false, "ask the coordinator to resolve merge conflicts")
+	landCmd.MarkFlagsMutuallyExclusive("dry-run", "resolve")
+
+	ctlCmd.AddCommand(listCmd, getCmd, createCmd, startCmd, pauseCmd, resumeCmd, stopCmd, logsCmd, sendCmd, healthCmd, landCmd)
+}
+
+// newCtlClient discovers the running daemon and returns a client for it.
+func newCtlClient() (*api.Client, error) {
+	info, err := api.ReadDaemonInfo(config.DefaultDaemonInfoPath())
+	if err != nil {
+		if errors.Is(err, api.ErrDaemonNotRunning) {
+			return nil, fmt.Errorf("%w; start it with \"perles daemon\"", err)
+		}
+		return nil, err
+	}
+
+	var token string
+	if info.Network == "tcp" {
+		token, err = api.NewTokenFile(config.DefaultAPITokenPath()).Load()
+		if err != nil {
+			return nil, fmt.Errorf("loading API token: %w", err)
+		}
+	}
+	return api.NewClient(info, token), nil
+}
+
+func runCtlList(_ *cobra.Command, _ []string) error {
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	resp, err := client.ListWorkflows(ctx, ctlListState)
+	if err != nil {
+		return err
+	}
+	if ctlJSON {
+		return printJSON(os.Stdout, resp)
+	}
+	printWorkflowTable(os.Stdout, resp.Workflows, time.Now())
+	return nil
+}
+
+func runCtlGet(_ *cobra.Command, args []string) error {
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	wf, err := client.GetWorkflow(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if ctlJSON {
+		return printJSON(os.Stdout, wf)
+	}
+	printWorkflowDetail(os.Stdout, wf, time.Now())
+	return nil
+}
+
+func runCtlCreate(_ *cobra.Command, _ []string) error {
+	req, err := buildCreateRequest()
+	if err != nil {
+		return err
+	}
+
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	resp, err := client.CreateWorkflow(ctx, req)
+	if err != nil {
+		return err
+	}
+	if ctlCreateStart {
+		if err := client.StartWorkflow(ctx, resp.ID); err != nil {
+			return fmt.Errorf("created %s but failed to start it: %w", resp.ID, err)
+		}
+	}
+
+	if ctlJSON {
+		return printJSON(os.Stdout, resp)
+	}
+	fmt.Println(resp.ID)
+	for _, id := range resp.Chain {
+		fmt.Println(id)
+	}
+	return nil
+}
+
+// buildCreateRequest builds the create request from the create flags.
+func buildCreateRequest() (api.CreateWorkflowRequest, error) {
+	req := api.CreateWorkflowRequest{
+		TemplateID:      ctlCreateTemplate,
+		Name:            ctlCreateName,
+		WorktreeEnabled: ctlCreateWorktree,
+		Priority:        ctlCreatePriority,
+		BudgetUSD:       ctlCreateBudget,
+		After:           ctlCreateAfter,
+	}
+	if len(ctlCreateAfter) > 0 && ctlCreateStart {
+		return req, fmt.Errorf("--start cannot be combined with --after: the workflow starts when its upstream workflows complete")
+	}
+	for _, template := range ctlCreateThen {
+		req.Then = append(req.Then, api.ChainedWorkflowRequest{TemplateID: template})
+	}
+	if ctlCreateWorktree {
+		req.WorktreeBaseBranch = ctlCreateBaseBranch
+		req.BranchName = ctlCreateBranch
+	}
+	if ctlCreateWorkerWorktrees {
+		if !ctlCreateWorktree {
+			return req, fmt.Errorf("--worker-worktrees requires --worktree")
+		}
+		req.WorkerWorktrees = true
+	}
+
+	args, err := parseTemplateArgs(ctlCreateArgs)
+	if err != nil {
+		return req, err
+	}
+	if ctlCreateEpic != "" {
+		if args == nil {
+			args = map[string]string{}
+		}
+		args["epic_id"] = ctlCreateEpic
+	}
+	req.Args = args
+	return req, nil
+}
+
+// parseTemplateArgs parses key=value template arguments.
+func parseTemplateArgs(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	args := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --arg %q: expected key=value", pair)
+		}
+		args[key] = value
+	}
+	return args, nil
+}
+
+// runCtlAction runs a workflow action that returns no body.
+func runCtlAction(id, verb string, action func(*api.Client, context.Context, string) error) error {
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	if err := action(client, ctx, id); err != nil {
+		return err
+	}
+	if ctlJSON {
+		return printJSON(os.Stdout, map[string]string{"id": id, "status": "ok"})
+	}
+	fmt.Printf("%s %s\n", verb, id)
+	return nil
+}
+
+func runCtlLand(_ *cobra.Command, args []string) error {
+	if ctlLandResolve {
+		return runCtlAction(args[0], "Sent merge conflicts to the coordinator of", (*api.Client).ResolveLandConflicts)
+	}
+
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	preview, err := client.PreviewLand(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if ctlLandDryRun {
+		if ctlJSON {
+			return printJSON(os.Stdout, preview)
+		}
+		printLandPreview(os.Stdout, preview)
+		return nil
+	}
+	if len(preview.Conflicts) > 0 {
+		if !ctlJSON {
+			printLandPreview(os.Stdout, preview)
+		}
+		return fmt.Errorf("%s conflicts with %s; run with --resolve to hand the conflicts to the coordinator",
+			preview.Branch, preview.BaseBranch)
+	}
+
+	resp, err := client.LandWorkflow(ctx, args[0], api.LandWorkflowRequest{
+		Squash:  ctlLandSquash,
+		Message: ctlLandMessage,
+		Cleanup: ctlLandCleanup,
+	})
+	if err != nil {
+		return err
+	}
+	if ctlJSON {
+		return printJSON(os.Stdout, resp)
+	}
+	printLandPreview(os.Stdout, preview)
+	printLandResult(os.Stdout, resp)
+	return nil
+}
+
+func runCtlLogs(_ *cobra.Command, args []string) error {
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	errDone := errors.New("workflow ended")
+	err = client.StreamWorkflowEvents(ctx, args[0], func(event api.StreamEvent) error {
+		if event.Type == api.StreamEventConnected {
+			// A workflow that already ended has no events left to wait for
+			var connected api.StreamConnectedData
+			_ = json.Unmarshal(event.Data, &connected)
+			if !ctlLogsFollow && isTerminalState(connected.State) {
+				_, _ = fmt.Fprintf(os.Stderr, "Workflow %s has already %s\n", args[0], connected.State)
+				return errDone
+			}
+			return nil
+		}
+		if ctlJSON {
+			fmt.Println(string(event.Data))
+		} else {
+			printStreamEvent(os.Stdout, event)
+		}
+		if !ctlLogsFollow && isTerminalEvent(event.Type) {
+			return errDone
+		}
+		return nil
+	})
+	if errors.Is(err, errDone) {
+		return nil
+	}
+	return err
+}
+
+func runCtlHealth(_ *cobra.Command, _ []string) error {
+	client, err := newCtlClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+	defer cancel()
+
+	resp, err := client.Health(ctx)
+	if err != nil {
+		return err
+	}
+	if ctlJSON {
+		return printJSON(os.Stdout, resp)
+	}
+	printHealth(os.Stdout, resp, time.Now())
+	return nil
+}
+
+// === Output ===
+
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func printWorkflowTable(w io.Writer, workflows []api.WorkflowResponse, now time.Time) {
+	if len(workflows) == 0 {
+		_, _ = fmt.Fprintln(w, "No workflows")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tNAME\tTEMPLATE\tSTATE\tHEALTH\tSPEND\tAGE")
+	for _, wf := range workflows {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			wf.ID, wf.Name, wf.TemplateID, wf.State, healthLabel(wf.State, wf.IsHealthy),
+			spendLabel(wf.CostUSD, wf.BudgetUSD), formatAge(now.Sub(wf.CreatedAt)))
+	}
+	_ = tw.Flush()
+}
+
+func printWorkflowDetail(w io.Writer, wf *api.WorkflowResponse, now time.Time) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	row := func(label, value string) {
+		if value != "" {
+			_, _ = fmt.Fprintf(tw, "%s:\t%s\n", label, value)
+		}
+	}
+	row("ID", wf.ID)
+	row("Name", wf.Name)
+	row("Template", wf.TemplateID)
+	row("State", wf.State)
+	row("Health", healthLabel(wf.State, wf.IsHealthy))
+	row("Spend", spendLabel(wf.CostUSD, wf.BudgetUSD))
+	row("Created", formatAge(now.Sub(wf.CreatedAt))+" ago")
+	if wf.StartedAt != nil {
+		row("Started", formatAge(now.Sub(*wf.StartedAt))+" ago")
+	}
+	if wf.WorktreePath != "" {
+		row("Worktree", wf.WorktreePath)
+	}
+	row("Labels", joinKeyValues(wf.Labels))
+	row("After", strings.Join(wf.After, ", "))
+	row("Outputs", joinKeyValues(wf.Outputs))
+	_ = tw.Flush()
+}
+
+// joinKeyValues formats a map as sorted key=value pairs.
+func joinKeyValues(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+m[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func printHealth(w io.Writer, resp *api.HealthResponse, now time.Time) {
+	_, _ = fmt.Fprintf(w, "Daemon: %s\n", resp.Status)
+	if len(resp.Workflows) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tNAME\tSTATE\tHEALTH\tLAST PROGRESS\tRECOVERIES")
+	for _, wf := range resp.Workflows {
+		progress := "-"
+		if wf.LastProgressAt != nil {
+			progress = formatAge(now.Sub(*wf.LastProgressAt)) + " ago"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			wf.ID, wf.Name, wf.State, healthLabel(wf.State, wf.IsHealthy), progress, wf.RecoveryCount)
+	}
+	_ = tw.Flush()
+}
+
+func printLandPreview(w io.Writer, preview *api.LandPreviewResponse) {
+	_, _ = fmt.Fprintf(w, "%s -> %s: %d files changed, +%d -%d\n",
+		preview.Branch, preview.BaseBranch, len(preview.Files), preview.Additions, preview.Deletions)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, f := range preview.Files {
+		_, _ = fmt.Fprintf(tw, "  +%d\t-%d\t%s\n", f.Additions, f.Deletions, f.Path)
+	}
+	_ = tw.Flush()
+
+	if len(preview.Conflicts) > 0 {
+		_, _ = fmt.Fprintf(w, "\nConflicts with %s:\n", preview.BaseBranch)
+		for _, path := range preview.Conflicts {
+			_, _ = fmt.Fprintf(w, "  %s\n", path)
+		}
+	}
+}
+
+func printLandResult(w io.Writer, resp *api.LandWorkflowResponse) {
+	verb := "Merged"
+	if resp.Squashed {
+		verb = "Squashed"
+	}
+	_, _ = fmt.Fprintf(w, "%s %s into %s\n", verb, resp.Branch, resp.BaseBranch)
+	if resp.CleanedUp {
+		_, _ = fmt.Fprintln(w, "Removed the worktree and branch")
+	}
+}
+
+// streamEventJSON is the subset of a streamed event printed by `ctl logs`.
+type streamEventJSON struct {
+	ProcessID string    `json:"process_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Payload   struct {
+		Output  string
+		Message string
+	} `json:"payload"`
+}
+
+func printStreamEvent(w io.Writer, event api.StreamEvent) {
+	var data streamEventJSON
+	_ = json.Unmarshal(event.Data, &data)
+
+	ts := data.Timestamp.Local().Format("15:04:05")
+	text := data.Payload.Output
+	if text == "" {
+		text = data.Payload.Message
+	}
+
+	switch {
+	case text != "" && data.ProcessID != "":
+		_, _ = fmt.Fprintf(w, "%s %s: %s\n", ts, data.ProcessID, text)
+	case data.ProcessID != "":
+		_, _ = fmt.Fprintf(w, "%s %s %s\n", ts, event.Type, data.ProcessID)
+	default:
+		_, _ = fmt.Fprintf(w, "%s %s\n", ts, event.Type)
+	}
+}
+
+// isTerminalEvent reports whether an event ends a workflow.
+func isTerminalEvent(eventType string) bool {
+	switch eventType {
+	case "workflow.completed", "workflow.failed", "workflow.stopped", "workflow.deleted":
+		return true
+	}
+	return false
+}
+
+// isTerminalState reports whether a workflow state is final.
+func isTerminalState(state string) bool {
+	return controlplane.WorkflowState(state).IsTerminal()
+}
+
+func healthLabel(state string, healthy bool) string {
+	if state != "running" {
+		return "-"
+	}
+	if healthy {
+		return "healthy"
+	}
+	return "unhealthy"
+}
+
+func spendLabel(cost, budget float64) string {
+	switch {
+	case budget > 0:
+		return fmt.Sprintf("$%.2f/$%.2f", cost, budget)
+	case cost > 0:
+		return fmt.Sprintf("$%.2f", cost)
+	default:
+		return "-"
+	}
+}
+
+// formatAge formats a duration compactly, e.g. "45s", "12m", "3h", "2d".
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+)
+
+func TestParseTemplateArgs(t *testing.T) {
+	args, err := parseTemplateArgs([]string{"goal=Ship it", "notes=a=b"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"goal": "Ship it", "notes": "a=b"}, args)
+
+	args, err = parseTemplateArgs(nil)
+	require.NoError(t, err)
+	require.Nil(t, args)
+
+	_, err = parseTemplateArgs([]string{"missing-equals"})
+	require.Error(t, err)
+}
+
+func TestBuildCreateRequest(t *testing.T) {
+	ctlCreateTemplate, ctlCreateEpic, ctlCreateArgs = "cook", "perles-abc1", []string{"goal=x"}
+	ctlCreateWorktree, ctlCreateBaseBranch, ctlCreateBranch = true, "develop", ""
+	t.Cleanup(func() {
+		ctlCreateTemplate, ctlCreateEpic, ctlCreateArgs = "", "", nil
+		ctlCreateWorktree, ctlCreateBaseBranch = false, "main"
+	})
+
+	req, err := buildCreateRequest()
+
+	require.NoError(t, err)
+	require.Equal(t, "cook", req.TemplateID)
+	require.Equal(t, map[string]string{"goal": "x", "epic_id": "perles-abc1"}, req.Args)
+	require.True(t, req.WorktreeEnabled)
+	require.Equal(t, "develop", req.WorktreeBaseBranch)
+}
+
+func TestBuildCreateRequest_WorkerWorktrees(t *testing.T) {
+	ctlCreateTemplate, ctlCreateWorkerWorktrees = "cook", true
+	t.Cleanup(func() {
+		ctlCreateTemplate, ctlCreateWorkerWorktrees, ctlCreateWorktree = "", false, false
+	})
+
+	_, err := buildCreateRequest()
+	require.ErrorContains(t, err, "--worker-worktrees requires --worktree")
+
+	ctlCreateWorktree = true
+	req, err := buildCreateRequest()
+	require.NoError(t, err)
+	require.True(t, req.WorkerWorktrees)
+}
+
+func TestBuildCreateRequest_Chain(t *testing.T) {
+	ctlCreateTemplate, ctlCreateThen = "research_proposal", []string{"cook", "land"}
+	t.Cleanup(func() {
+		ctlCreateTemplate, ctlCreateThen, ctlCreateAfter, ctlCreateStart = "", nil, nil, false
+	})
+
+	req, err := buildCreateRequest()
+
+	require.NoError(t, err)
+	require.Equal(t, []api.ChainedWorkflowRequest{{TemplateID: "cook"}, {TemplateID: "land"}}, req.Then)
+
+	ctlCreateAfter, ctlCreateStart = []string{"wf-1"}, true
+	_, err = buildCreateRequest()
+	require.ErrorContains(t, err, "--start cannot be combined with --after")
+}
+
+func TestPrintWorkflowTable(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+
+	printWorkflowTable(&buf, []api.WorkflowResponse{
+		{ID: "wf-1", Name: "Auth", TemplateID: "cook", State: "running", IsHealthy: true, CostUSD: 1.5, BudgetUSD: 10, CreatedAt: now.Add(-90 * time.Minute)},
+		{ID: "wf-2", Name: "Docs", TemplateID: "research", State: "pending", CreatedAt: now.Add(-30 * time.Second)},
+	}, now)
+
+	out := buf.String()
+	require.Contains(t, out, "ID    NAME  TEMPLATE  STATE")
+	require.Contains(t, out, "wf-1  Auth  cook      running  healthy  $1.50/$10.00  1h")
+	require.Contains(t, out, "wf-2  Docs  research  pending  -        -             30s")
+
+	buf.Reset()
+	printWorkflowTable(&buf, nil, now)
+	require.Equal(t, "No workflows\n", buf.String())
+}
+
+func TestPrintLandPreview(t *testing.T) {
+	var buf bytes.Buffer
+
+	printLandPreview(&buf, &api.LandPreviewResponse{
+		Branch:     "perles-auth",
+		BaseBranch: "main",
+		Files:      []api.LandFileStatResponse{{Path: "auth.go", Additions: 120, Deletions: 4}, {Path: "go.mod", Additions: 1}},
+		Additions:  121,
+		Deletions:  4,
+		Conflicts:  []string{"go.mod"},
+	})
+
+	require.Equal(t, "perles-auth -> main: 2 files changed, +121 -4\n"+
+		"  +120  -4  auth.go\n"+
+		"  +1    -0  go.mod\n"+
+		"\nConflicts with main:\n"+
+		"  go.mod\n", buf.String())
+}
+
+func TestPrintLandResult(t *testing.T) {
+	var buf bytes.Buffer
+
+	printLandResult(&buf, &api.LandWorkflowResponse{Branch: "perles-auth", BaseBranch: "main", Squashed: true, CleanedUp: true})
+
+	require.Equal(t, "Squashed perles-auth into main\nRemoved the worktree and branch\n", buf.String())
+}
+
+func TestPrintStreamEvent(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 12, 0, 0, 0, time.Local)
+	data, err := json.Marshal(map[string]any{
+		"process_id": "worker-1",
+		"timestamp":  ts,
+		"payload":    map[string]any{"Output": "Running tests"},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	printStreamEvent(&buf, api.StreamEvent{Type: "worker.output", Data: data})
+	require.Equal(t, "12:00:00 worker-1: Running tests\n", buf.String())
+
+	buf.Reset()
+	printStreamEvent(&buf, api.StreamEvent{Type: "workflow.completed", Data: []byte(`{"timestamp":"2026-01-01T12:00:00Z"}`)})
+	require.Contains(t, buf.String(), "workflow.completed")
+}
+
+func TestIsTerminalEvent(t *testing.T) {
+	require.True(t, isTerminalEvent("workflow.completed"))
+	require.True(t, isTerminalEvent("workflow.stopped"))
+	require.False(t, isTerminalEvent("worker.output"))
+}
+
+func TestIsTerminalState(t *testing.T) {
+	require.True(t, isTerminalState("completed"))
+	require.True(t, isTerminalState("failed"))
+	require.False(t, isTerminalState("paused"))
+	require.False(t, isTerminalState(""))
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,10 +13,15 @@ import (
 
 	"github.com/zjrosen/perles/frontend"
 	"github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/cachemanager"
 	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	infragit "github.com/zjrosen/perles/internal/git/infrastructure"
+	"github.com/zjrosen/perles/internal/infrastructure/sqlite"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
@@ -25,6 +31,7 @@ import (
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 	"github.com/zjrosen/perles/internal/sound"
 	"github.com/zjrosen/perles/internal/templates"
+	"github.com/zjrosen/perles/internal/watcher"
 
 	// Register AI client providers (required for AgentProvider to work)
 	_ "github.com/zjrosen/perles/internal/orchestration/client/providers/amp"
@@ -43,24 +50,74 @@ var daemonCmd = &cobra.Command{
 	Long: `Run the control plane as a background daemon that exposes an HTTP API
 for workflow management. Other tools can connect to manage workflows.
 
-The daemon listens on localhost with the specified port and provides REST
-endpoints for creating, starting, stopping, and monitoring workflows.
+By default the daemon listens on a Unix socket at ~/.perles/daemon/perles.sock
+that only the current user can connect to. Pass --port (or set
+orchestration.api_port) to listen on localhost TCP instead. TCP requires
+a bearer token, created with "perles daemon token".
 
 Example:
-  perles daemon                # Start on auto-assigned port
-  perles daemon --port 8080    # Start on port 8080
-  perles daemon -p 19999       # Start on port 19999`,
+  perles daemon                                         # Listen on the default Unix socket
+  perles daemon --socket $XDG_RUNTIME_DIR/perles.sock   # Listen on a custom Unix socket
+  perles daemon token                                   # Create or rotate the API token
+  perles daemon --port 8080                             # Listen on localhost:8080 (token required)
+
+The socket's directory must not be writable by other users, so shared
+directories such as /tmp are rejected.`,
 	RunE: runDaemon,
 }
 
+var daemonTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Create or rotate the daemon API token",
+	Long: `Generate a new bearer token for the daemon API and print it. The token
+is stored at ~/.perles/daemon/token, readable only by the current user, and
+replaces any previous token immediately.
+
+Clients send it as "Authorization: Bearer <token>".
+
+Example:
+  perles daemon token          # Rotate and print the new token
+  perles daemon token --show   # Print the current token`,
+	RunE: runDaemonToken,
+}
+
 var (
-	daemonPort int
+	daemonPort      int
+	daemonSocket    string
+	daemonTokenShow bool
 )
 
 func init() {
 	rootCmd.AddCommand(daemonCmd)
+	daemonCmd.AddCommand(daemonTokenCmd)
+
+	daemonCmd.Flags().IntVarP(&daemonPort, "port", "p", 0, "listen on localhost TCP port (requires an API token, overrides config)")
+	daemonCmd.Flags().StringVar(&daemonSocket, "socket", "", "Unix socket path (default ~/.perles/daemon/perles.sock)")
+	daemonTokenCmd.Flags().BoolVar(&daemonTokenShow, "show", false, "print the current token instead of rotating it")
+}
+
+func runDaemonToken(_ *cobra.Command, _ []string) error {
+	tokens := api.NewTokenFile(config.DefaultAPITokenPath())
+
+	if daemonTokenShow {
+		token, err := tokens.Load()
+		if errors.Is(err, api.ErrNoToken) {
+			return fmt.Errorf("no API token at %s; run \"perles daemon token\" to create one", tokens.Path())
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Println(token)
+		return nil
+	}
 
-	daemonCmd.Flags().IntVarP(&daemonPort, "port", "p", 0, "API server port (0 = auto-assign, overrides config)")
+	token, err := tokens.Rotate()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Wrote new API token to %s\n", tokens.Path())
+	fmt.Println(token)
+	return nil
 }
 
 func runDaemon(_ *cobra.Command, _ []string) error {
@@ -87,22 +144,9 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("getting working directory: %w", err)
 	}
 
-	// Resolution priority for beads directory (same as TUI):
-	// 1. BEADS_DIR environment variable
-	// 2. beads_dir config file setting
-	// 3. Current working directory
-	// Note: daemon doesn't have -b flag; use root command for explicit path
-	var dbPath string
-	if envDir := os.Getenv("BEADS_DIR"); envDir != "" {
-		dbPath = envDir
-	} else if cfg.BeadsDir != "" {
-		dbPath = cfg.BeadsDir
-	} else {
-		dbPath = workDir
-	}
-
-	// Resolve full .beads path (handles redirect for worktrees, normalizes input)
-	cfg.ResolvedBeadsDir = paths.ResolveBeadsDir(dbPath)
+	// Same beads directory resolution as the TUI, except the daemon has no -b
+	// flag; use the root command for an explicit path
+	cfg.ResolvedBeadsDir = paths.BeadsDirFromEnv(cfg.BeadsDir, workDir)
 	log.Info(log.CatConfig, "resolved beads dir", "path", cfg.ResolvedBeadsDir)
 
 	// Create beads executor for workflow creation
@@ -126,32 +170,87 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		workflowCreator = appreg.NewWorkflowCreator(registryService, beadsExec, cfg.Orchestration.Templates)
 	}
 
+	// Open the beads database for schedule target queries, automation rules
+	// and worker autoscaling
+	beadsDB := openDaemonBeads(&cfg)
+	defer beadsDB.Close()
+
+	// Open the sessions database for schedules and durable orchestration state
+	// (nil without session persistence)
+	db, closeDB := openDaemonDatabase(&cfg)
+	defer closeDB()
+
 	// Create control plane
-	cp, err := createDaemonControlPlane(&cfg, workDir)
+	specs := api.NewTemplateSpecBuilder(registryService, workflowCreator)
+	cp, err := createDaemonControlPlane(&cfg, workDir, specs, beadsDB.executor, db)
 	if err != nil {
 		return fmt.Errorf("creating control plane: %w", err)
 	}
 
 	// Determine API server address
-	// Priority: --port flag > config api_port > auto-assign (port 0)
+	// Priority: --port flag > config api_port > Unix socket (--socket or default path)
+	// TCP is reachable by any local user, so it always requires the API token.
+	network, addr := "unix", daemonSocket
+	if addr == "" {
+		addr = config.DefaultDaemonSocketPath()
+	}
 	port := daemonPort
 	if port == 0 {
 		port = cfg.Orchestration.APIPort
 	}
-	addr := fmt.Sprintf("localhost:%d", port)
+	if port != 0 {
+		network, addr = "tcp", fmt.Sprintf("localhost:%d", port)
+	}
+
+	// Socket clients are authenticated by the socket's file permissions
+	var tokens *api.TokenFile
+	if network == "tcp" {
+		tokens = api.NewTokenFile(config.DefaultAPITokenPath())
+		if _, err := tokens.Load(); errors.Is(err, api.ErrNoToken) {
+			return fmt.Errorf("listening on TCP requires an API token; run \"perles daemon token\" to create one")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	// Create cron scheduler for schedules and delayed starts (nil without a database)
+	schedules := createDaemonCronScheduler(db, workDir, cp, specs, beadsDB.executor, beadsExec)
+
+	// Create trigger engine for automation rules (nil without rules or a beads database)
+	triggers := createDaemonTriggerEngine(&cfg, cp, specs, beadsDB.executor, beadsExec)
+	beadsDB.Watch(func() {
+		if triggers != nil {
+			triggers.HandleDBChanged()
+		}
+	})
 
 	// Create API server
 	server, err := api.NewServer(api.ServerConfig{
 		Addr:            addr,
+		Network:         network,
+		Tokens:          tokens,
 		ControlPlane:    cp,
 		WorkflowCreator: workflowCreator,
 		RegistryService: registryService,
+		Schedules:       schedules,
 		FrontendFS:      frontend.DistFS(),
 	})
 	if err != nil {
 		return fmt.Errorf("creating API server: %w", err)
 	}
 
+	// Advertise the address so `perles ctl` can find the daemon
+	infoPath := config.DefaultDaemonInfoPath()
+	if err := api.WriteDaemonInfo(infoPath, api.DaemonInfo{
+		PID:       os.Getpid(),
+		Network:   network,
+		Addr:      server.Addr(),
+		StartedAt: time.Now(),
+	}); err != nil {
+		log.Warn(log.CatOrch, "Failed to write daemon info", "path", infoPath, "error", err)
+	}
+	defer api.RemoveDaemonInfo(infoPath, os.Getpid())
+
 	// Handle shutdown signals
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -165,7 +264,14 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		errCh <- server.Start()
 	}()
 
-	fmt.Printf("Perles daemon started on port %d\n", server.Port())
+	if network == "unix" {
+		fmt.Printf("Perles daemon listening on %s\n", server.Addr())
+	} else {
+		fmt.Printf("Perles daemon started on port %d\n", server.Port())
+	}
+	if tokens != nil {
+		fmt.Printf("API token required (%s)\n", tokens.Path())
+	}
 	fmt.Println("Press Ctrl+C to stop")
 
 	// Wait for shutdown signal or error
@@ -187,6 +293,14 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		log.Error(log.CatOrch, "Error stopping API server", "error", err)
 	}
 
+	// Stop schedules and triggers before the control plane so nothing new is started
+	if schedules != nil {
+		schedules.Stop()
+	}
+	if triggers != nil {
+		triggers.Stop()
+	}
+
 	// Shutdown control plane (stops all workflows)
 	if err := cp.Shutdown(shutdownCtx); err != nil {
 		log.Error(log.CatOrch, "Error shutting down control plane", "error", err)
@@ -196,11 +310,176 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
-func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.ControlPlane, error) {
+// daemonBeads is the daemon's read access to the beads database.
+// The executor is nil when the database cannot be opened.
+type daemonBeads struct {
+	client        *infrabeads.SQLiteClient
+	executor      bql.BQLExecutor
+	bqlCache      cachemanager.CacheManager[string, []beads.Issue]
+	depGraphCache cachemanager.CacheManager[string, *bql.DependencyGraph]
+	watcher       *watcher.Watcher
+}
+
+// openDaemonBeads opens the beads database with a cached BQL executor.
+func openDaemonBeads(cfg *config.Config) *daemonBeads {
+	d := &daemonBeads{}
+	client, err := infrabeads.NewSQLiteClient(cfg.ResolvedBeadsDir)
+	if err != nil {
+		log.Warn(log.CatBeads, "Failed to open beads database, target queries and triggers disabled", "error", err)
+		return d
+	}
+
+	d.client = client
+	d.bqlCache = cachemanager.NewInMemoryCacheManager[string, []beads.Issue](
+		"bql-cache", cachemanager.DefaultExpiration, cachemanager.DefaultCleanupInterval)
+	d.depGraphCache = cachemanager.NewInMemoryCacheManager[string, *bql.DependencyGraph](
+		"bql-dep-cache", cachemanager.DefaultExpiration, cachemanager.DefaultCleanupInterval)
+	d.executor = bql.NewExecutor(client.DB(), d.bqlCache, d.depGraphCache)
+	return d
+}
+
+// Watch flushes the query caches and calls onChange whenever the beads
+// database changes. It does nothing when the database is not open.
+func (d *daemonBeads) Watch(onChange func()) {
+	if d.client == nil {
+		return
+	}
+	w, err := watcher.New(watcher.DefaultConfig(d.client.DBPath()))
+	if err == nil {
+		err = w.Start()
+	}
+	if err != nil {
+		log.Warn(log.CatWatcher, "Failed to watch beads database, triggers only run at startup", "error", err)
+		return
+	}
+	d.watcher = w
+
+	events := w.Broker().Subscribe(context.Background())
+	log.SafeGo("daemon.beadsWatcher", func() {
+		for event := range events {
+			if event.Payload.Type != watcher.DBChanged {
+				continue
+			}
+			if err := d.bqlCache.Flush(context.Background()); err != nil {
+				log.Warn(log.CatCache, "Failed to flush BQL cache on DB change", "error", err)
+			}
+			if err := d.depGraphCache.Flush(context.Background()); err != nil {
+				log.Warn(log.CatCache, "Failed to flush dep graph cache on DB change", "error", err)
+			}
+			onChange()
+		}
+	})
+}
+
+// Close stops watching and closes the database.
+func (d *daemonBeads) Close() {
+	if d.watcher != nil {
+		_ = d.watcher.Stop()
+	}
+	if d.client != nil {
+		_ = d.client.Close()
+	}
+}
+
+// openDaemonDatabase opens the sessions database when session persistence is
+// enabled. Returns nil when it is unavailable. The returned func closes it.
+func openDaemonDatabase(cfg *config.Config) (*sqlite.DB, func()) {
+	noop := func() {}
+	if !cfg.Flags[flags.FlagSessionPersistence] {
+		return nil, noop
+	}
+
+	dbPath := config.DefaultDatabasePath()
+	if dbPath == "" {
+		return nil, noop
+	}
+	db, err := sqlite.NewDB(dbPath)
+	if err != nil {
+		log.Warn(log.CatDB, "Failed to open database, schedules and durable state disabled", "path", dbPath, "error", err)
+		return nil, noop
+	}
+	return db, func() {
+		if err := db.Close(); err != nil {
+			log.Error(log.CatDB, "Error closing database", "error", err)
+		}
+	}
+}
+
+// createDaemonCronScheduler creates and starts the CronScheduler, since
+// schedules are stored in the sessions database. Returns nil without a
+// database or when the scheduler fails to start.
+func createDaemonCronScheduler(
+	db *sqlite.DB,
+	workDir string,
+	cp controlplane.ControlPlane,
+	specs controlplane.SpecBuilder,
+	executor bql.BQLExecutor,
+	deleter controlplane.IssueDeleter,
+) controlplane.CronScheduler {
+	if db == nil {
+		return nil
+	}
+
+	scheduler, err := controlplane.NewCronScheduler(controlplane.CronSchedulerConfig{
+		Project:      session.DeriveApplicationName(workDir, infragit.NewRealExecutor(workDir)),
+		Repository:   db.ScheduleRepository(),
+		ControlPlane: cp,
+		SpecBuilder:  specs,
+		Executor:     executor,
+		Deleter:      deleter,
+	})
+	if err == nil {
+		err = scheduler.Start(context.Background())
+	}
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to start CronScheduler", "error", err)
+		return nil
+	}
+	return scheduler
+}
+
+// createDaemonTriggerEngine creates and starts the TriggerEngine for the
+// configured automation rules. Returns nil when there are no rules or no
+// beads database.
+func createDaemonTriggerEngine(
+	cfg *config.Config,
+	cp controlplane.ControlPlane,
+	specs controlplane.SpecBuilder,
+	executor bql.BQLExecutor,
+	labeler controlplane.IssueLabeler,
+) controlplane.TriggerEngine {
+	triggers := cfg.Orchestration.Triggers
+	if len(triggers.Rules) == 0 || executor == nil {
+		return nil
+	}
+
+	engine, err := controlplane.NewTriggerEngine(controlplane.TriggerEngineConfig{
+		Rules:        controlplane.TriggerRulesFromConfig(triggers),
+		DryRun:       triggers.DryRun,
+		Executor:     executor,
+		Labeler:      labeler,
+		ControlPlane: cp,
+		SpecBuilder:  specs,
+	})
+	if err == nil {
+		err = engine.Start(context.Background())
+	}
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to start TriggerEngine", "error", err)
+		return nil
+	}
+	return engine
+}
+
+func createDaemonControlPlane(cfg *config.Config, _ string, specs controlplane.SpecBuilder, executor bql.BQLExecutor, db *sqlite.DB) (controlplane.ControlPlane, error) {
 	orchConfig := cfg.Orchestration
 
-	// Create workflow registry
-	workflowRegistry := workflow.NewRegistry()
+	// Load workflow templates for per-template health and tool policies
+	workflowRegistry, err := workflow.NewRegistryWithConfig(orchConfig)
+	if err != nil {
+		log.Warn(log.CatConfig, "Failed to load workflow registry", "error", err)
+		workflowRegistry = workflow.NewRegistry()
+	}
 
 	// Create components
 	registry := controlplane.NewInMemoryRegistry()
@@ -213,43 +492,106 @@ func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.Contro
 
 	soundService := sound.NewSystemSoundService(cfg.Sound.Events)
 
-	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
-		AgentProviders:   orchConfig.AgentProviders(),
-		WorkflowRegistry: workflowRegistry,
-		SessionFactory:   sessionFactory,
-		SoundService:     soundService,
-		BeadsDir:         cfg.ResolvedBeadsDir,
-		GitExecutorFactory: func(path string) appgit.GitExecutor {
-			return infragit.NewRealExecutor(path)
+	// Create resource scheduler for global workflow, worker and spend limits
+	limits := orchConfig.Limits
+	scheduler := controlplane.NewResourceScheduler(controlplane.ResourceSchedulerConfig{
+		Limits: controlplane.ResourceLimits{
+			MaxWorkflows:       limits.MaxWorkflows,
+			MaxWorkers:         limits.MaxWorkers,
+			MaxWorkflowTokens:  limits.WorkflowTokens,
+			MaxWorkflowCostUSD: limits.WorkflowCostUSD,
+			MaxTotalTokens:     limits.TotalTokens,
+			MaxTotalCostUSD:    limits.TotalCostUSD,
 		},
+		Policy:   controlplane.QueuePolicy(limits.Queue),
+		EventBus: eventBus.Broker(),
 	})
+
+	gitExecutorFactory := func(path string) appgit.GitExecutor {
+		return infragit.NewRealExecutor(path)
+	}
+	supervisorCfg := controlplane.SupervisorConfig{
+		AgentProviders:          orchConfig.AgentProviders(),
+		WorkflowRegistry:        workflowRegistry,
+		SessionFactory:          sessionFactory,
+		SoundService:            soundService,
+		BeadsDir:                cfg.ResolvedBeadsDir,
+		Scheduler:               scheduler,
+		GitExecutorFactory:      gitExecutorFactory,
+		Policies:                orchConfig.Policies,
+		ContextHandoffThreshold: orchConfig.ContextHandoff.Threshold,
+		Autoscale:               orchConfig.Autoscale,
+		Retry:                   orchConfig.Retry,
+		TaskQuerier:             executor,
+	}
+	// Persist process, task and queue state in the sessions database
+	if db != nil {
+		supervisorCfg.RepositoryStore = db
+	}
+	supervisor, err := controlplane.NewSupervisor(supervisorCfg)
 	if err != nil {
 		return nil, fmt.Errorf("creating supervisor: %w", err)
 	}
 
-	// Create health monitor
+	// Create health monitor. Without an escalation ladder in config the daemon
+	// only reports stuck workflows; stuck workers are still nudged and replaced.
+	healthConfig := cfg.Orchestration.Health
+	healthPolicy, err := controlplane.HealthPolicyFromConfig(controlplane.HealthPolicy{
+		HeartbeatTimeout: 2 * time.Minute,
+		ProgressTimeout:  10 * time.Minute,
+		MaxRecoveries:    3,
+		RecoveryBackoff:  30 * time.Second,
+		WorkerTimeout:    10 * time.Minute,
+		WorkerNudges:     1,
+	}, healthConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid orchestration.health config: %w", err)
+	}
+	checkInterval := healthConfig.CheckInterval
+	if checkInterval == 0 {
+		checkInterval = 30 * time.Second
+	}
+	publishHealth := eventBus.HealthEventPublisher(registry)
+	recoveryExecutor, err := controlplane.NewRecoveryExecutor(controlplane.RecoveryExecutorConfig{
+		WorkflowProvider: registry,
+		Supervisor:       supervisor,
+		OnHealthEvent:    publishHealth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating recovery executor: %w", err)
+	}
 	healthMonitor := controlplane.NewHealthMonitor(controlplane.HealthMonitorConfig{
-		Policy: controlplane.HealthPolicy{
-			HeartbeatTimeout: 2 * time.Minute,
-			ProgressTimeout:  10 * time.Minute,
-			MaxRecoveries:    3,
-			RecoveryBackoff:  30 * time.Second,
-		},
-		CheckInterval: 30 * time.Second,
-		EventBus:      eventBus.Broker(),
+		Policy:           healthPolicy,
+		TemplatePolicies: controlplane.TemplateHealthPolicies(healthPolicy, workflowRegistry.List()),
+		CheckInterval:    checkInterval,
+		EventBus:         eventBus.Broker(),
+		RecoveryExecutor: recoveryExecutor,
+		WorkflowProvider: registry,
+		OnHealthEvent:    publishHealth,
 	})
 
 	// Create control plane
 	cp, err := controlplane.NewControlPlane(controlplane.ControlPlaneConfig{
-		Registry:      registry,
-		Supervisor:    supervisor,
-		EventBus:      eventBus,
-		HealthMonitor: healthMonitor,
+		Registry:         registry,
+		Supervisor:       supervisor,
+		EventBus:         eventBus,
+		HealthMonitor:    healthMonitor,
+		Scheduler:        scheduler,
+		BudgetThresholds: limits.BudgetAlerts,
+		SoundService:     soundService,
+		SpecBuilder:      specs,
+
+		GitExecutorFactory: gitExecutorFactory,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("creating control plane: %w", err)
 	}
 
+	// Start resource scheduler
+	if err := scheduler.Start(context.Background()); err != nil {
+		return nil, fmt.Errorf("starting resource scheduler: %w", err)
+	}
+
 	// Start health monitor
 	if err := healthMonitor.Start(context.Background()); err != nil {
 		return nil, fmt.Errorf("starting health monitor: %w", err)
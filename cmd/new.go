@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/issuetemplate"
+	"github.com/zjrosen/perles/internal/paths"
+)
+
+var (
+	newTemplateID  string
+	newPriority    int
+	newLabels      []string
+	newDescription string
+	newList        bool
+	newProject     string
+)
+
+var newCmd = &cobra.Command{
+	Use:   "new [title]",
+	Short: "Create an issue, optionally from a template",
+	Long: `Create a new beads issue, optionally from a reusable issue template.
+
+Templates are markdown files in .perles/issue-templates/ (project) or
+~/.perles/issue-templates/ (user). The filename is the template name and the
+body becomes the issue description. YAML frontmatter sets defaults:
+
+  ---
+  name: Bug report
+  title: "Bug: "
+  type: bug
+  priority: 1
+  labels: [bug, triage]
+  ---
+
+Project templates override user templates with the same name. Flags override
+template defaults; --label adds to the template's labels.
+
+Examples:
+  # Create a bug from .perles/issue-templates/bug.md
+  perles new --template bug "Crash when saving config"
+
+  # Override the template priority
+  perles new -t bug -p 0 "Data loss on sync"
+
+  # Create a plain task without a template
+  perles new "Write release notes"
+
+  # Create the issue in a configured project (see "projects" in the config)
+  perles new --project web -t bug "Button misaligned"
+
+  # List available templates
+  perles new --list`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runNew,
+}
+
+func init() {
+	newCmd.Flags().StringVarP(&newTemplateID, "template", "t", "", "issue template name")
+	newCmd.Flags().IntVarP(&newPriority, "priority", "p", int(beads.PriorityMedium), "priority 0-4 (overrides template)")
+	newCmd.Flags().StringSliceVarP(&newLabels, "label", "l", nil, "additional label (repeatable)")
+	newCmd.Flags().StringVar(&newDescription, "description", "", "description (overrides template body)")
+	newCmd.Flags().BoolVar(&newList, "list", false, "list available templates and exit")
+	newCmd.Flags().StringVar(&newProject, "project", "", "configured project to create the issue in (default: first project)")
+	rootCmd.AddCommand(newCmd)
+}
+
+func runNew(cmd *cobra.Command, args []string) error {
+	templates, err := issuetemplate.LoadDefault()
+	if err != nil {
+		return err
+	}
+
+	if newList {
+		return printIssueTemplates(templates)
+	}
+
+	title := strings.TrimSpace(strings.Join(args, " "))
+	if title == "" {
+		return fmt.Errorf("a title is required, e.g. perles new --template bug \"Crash on save\"")
+	}
+
+	tmpl := issuetemplate.Template{Type: beads.TypeTask, Priority: beads.PriorityMedium}
+	if newTemplateID != "" {
+		var ok bool
+		tmpl, ok = issuetemplate.Find(templates, newTemplateID)
+		if !ok {
+			return fmt.Errorf("issue template %q not found in %s or %s",
+				newTemplateID, issuetemplate.ProjectDir(), issuetemplate.UserDir())
+		}
+	}
+
+	opts := tmpl.Options(title)
+	if cmd.Flags().Changed("priority") {
+		if newPriority < 0 || newPriority > 4 {
+			return fmt.Errorf("invalid priority %d (use 0-4)", newPriority)
+		}
+		opts.Priority = beads.Priority(newPriority)
+	}
+	if cmd.Flags().Changed("description") {
+		opts.Description = newDescription
+	}
+	opts.Labels = append(opts.Labels, newLabels...)
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+	dbPath, err := newIssueBeadsDir(workDir)
+	if err != nil {
+		return err
+	}
+
+	executor := infrabeads.NewBDExecutor(workDir, paths.ResolveBeadsDir(dbPath))
+	result, err := executor.CreateIssue(opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created %s: %s\n", result.ID, opts.Title)
+	return nil
+}
+
+// newIssueBeadsDir picks the beads directory for the new issue: the --project
+// mount, else BEADS_DIR, the first configured project, beads_dir, or workDir.
+func newIssueBeadsDir(workDir string) (string, error) {
+	if newProject != "" {
+		for _, p := range cfg.Projects {
+			if p.Name == newProject {
+				return p.Path, nil
+			}
+		}
+		return "", fmt.Errorf("project %q is not configured", newProject)
+	}
+	if envDir := os.Getenv("BEADS_DIR"); envDir != "" {
+		return envDir, nil
+	}
+	if len(cfg.Projects) > 0 {
+		return cfg.Projects[0].Path, nil
+	}
+	if cfg.BeadsDir != "" {
+		return cfg.BeadsDir, nil
+	}
+	return workDir, nil
+}
+
+func printIssueTemplates(templates []issuetemplate.Template) error {
+	if len(templates) == 0 {
+		fmt.Printf("No issue templates found in %s or %s\n", issuetemplate.ProjectDir(), issuetemplate.UserDir())
+		return nil
+	}
+	for _, t := range templates {
+		line := fmt.Sprintf("%-16s %-8s P%d", t.ID, t.Type, t.Priority)
+		if t.Description != "" {
+			line += "  " + t.Description
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
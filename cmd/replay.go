@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/mode/replay"
+	"github.com/zjrosen/perles/internal/orchestration/session"
+	v2replay "github.com/zjrosen/perles/internal/orchestration/v2/replay"
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay <session>",
+	Short: "Step through a session's recorded commands",
+	Long: `Replay a workflow session's command log and scrub through the result.
+
+Every command the orchestrator processed is recorded in the session's
+commands.jsonl. Replay feeds them, in order, through a fresh command processor
+backed by mock processes, so no agents are started and the issue tracker is
+not touched. A scrubber then shows the process, task and queue state after any
+command. Commands whose replayed outcome differs from the recording are
+flagged as divergent.
+
+<session> is a session ID, a session directory, or a commands.jsonl file.
+
+Examples:
+  # Replay a session by ID
+  perles replay 4f1c2a9e-7d3b-4e8a-9c51-0b2d6e8f3a17
+
+  # Replay a session directory
+  perles replay ~/.perles/sessions/perles/2026-01-15/4f1c2a9e-7d3b-4e8a-9c51-0b2d6e8f3a17`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReplay,
+}
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+}
+
+func runReplay(cmd *cobra.Command, args []string) error {
+	sessionDir, err := resolveReplaySession(args[0], cfg.Orchestration.SessionStorage.BaseDir)
+	if err != nil {
+		return err
+	}
+
+	commands, err := session.LoadCommandEvents(sessionDir)
+	if err != nil {
+		return fmt.Errorf("loading command log: %w", err)
+	}
+
+	steps, err := v2replay.Run(cmd.Context(), commands)
+	if err != nil {
+		return fmt.Errorf("replaying session: %w", err)
+	}
+
+	model := replay.New(filepath.Base(sessionDir), steps)
+	p := tea.NewProgram(
+		&model,
+		tea.WithAltScreen(),
+	)
+
+	if _, err := p.Run(); err != nil {
+		return fmt.Errorf("running replay: %w", err)
+	}
+	return nil
+}
+
+// resolveReplaySession returns the session directory for a path or session ID.
+// IDs are looked up across every application's sessions under baseDir.
+func resolveReplaySession(arg, baseDir string) (string, error) {
+	if info, err := os.Stat(arg); err == nil {
+		if info.IsDir() {
+			return arg, nil
+		}
+		return filepath.Dir(arg), nil
+	}
+
+	if baseDir == "" {
+		baseDir = session.DefaultBaseDir()
+	}
+	apps, err := session.ListAllApplications(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("listing sessions: %w", err)
+	}
+	for _, app := range apps {
+		summary, err := session.FindSessionByID(session.NewSessionPathBuilder(baseDir, app), arg)
+		if err != nil {
+			return "", fmt.Errorf("finding session %s: %w", arg, err)
+		}
+		if summary != nil {
+			return summary.SessionDir, nil
+		}
+	}
+
+	return "", fmt.Errorf("session %s not found", arg)
+}
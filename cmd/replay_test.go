@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayCommand_Registration(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "replay" {
+			found = true
+			break
+		}
+	}
+	require.True(t, found, "replay command should be registered with rootCmd")
+}
+
+func TestResolveReplaySession_Directory(t *testing.T) {
+	dir := t.TempDir()
+
+	sessionDir, err := resolveReplaySession(dir, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, dir, sessionDir)
+}
+
+func TestResolveReplaySession_CommandLog(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "commands.jsonl")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+
+	sessionDir, err := resolveReplaySession(path, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, dir, sessionDir)
+}
+
+func TestResolveReplaySession_UnknownID(t *testing.T) {
+	_, err := resolveReplaySession("4f1c2a9e-missing", t.TempDir())
+	require.EqualError(t, err, "session 4f1c2a9e-missing not found")
+}
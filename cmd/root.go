@@ -169,6 +169,10 @@ func runApp(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid view configuration: %w", err)
 	}
 
+	if err := config.ValidateProjects(cfg.Projects); err != nil {
+		return fmt.Errorf("invalid projects configuration: %w", err)
+	}
+
 	if err := config.ValidateOrchestration(cfg.Orchestration); err != nil {
 		return fmt.Errorf("invalid orchestration configuration: %w", err)
 	}
@@ -221,27 +225,53 @@ func runApp(cmd *cobra.Command, args []string) error {
 		dbPath = workDir
 	}
 
-	// Resolve full .beads path (handles redirect for worktrees, normalizes input)
-	cfg.ResolvedBeadsDir = paths.ResolveBeadsDir(dbPath)
-	log.Info(log.CatConfig, "resolved beads dir", "path", cfg.ResolvedBeadsDir)
+	// Configured projects mount several databases, unless a single directory
+	// was requested explicitly with -b or BEADS_DIR
+	var mounts []infrabeads.Mount
+	explicitDir := cmd.Flags().Changed("beads-dir") || os.Getenv("BEADS_DIR") != ""
+	if len(cfg.Projects) > 0 && !explicitDir {
+		mounts, err = openProjects(cfg.Projects)
+		if err != nil {
+			return err
+		}
+		cfg.ResolvedBeadsDir = mounts[0].BeadsDir
+	} else {
+		// Resolve full .beads path (handles redirect for worktrees, normalizes input)
+		cfg.ResolvedBeadsDir = paths.ResolveBeadsDir(dbPath)
+	}
+	log.Info(log.CatConfig, "resolved beads dir", "path", cfg.ResolvedBeadsDir, "projects", len(mounts))
 
-	client, err := infrabeads.NewSQLiteClient(cfg.ResolvedBeadsDir)
-	if err != nil {
-		// Show friendly TUI empty state instead of CLI error
-		return runNoBeadsMode()
+	var client *infrabeads.SQLiteClient
+	if len(mounts) > 0 {
+		client = mounts[0].Client
+	} else {
+		client, err = infrabeads.NewSQLiteClient(cfg.ResolvedBeadsDir)
+		if err != nil {
+			// Show friendly TUI empty state instead of CLI error
+			return runNoBeadsMode()
+		}
 	}
 
-	// Version check - query bd_version from database metadata table
-	currentVersion, err := client.Version()
-	if err != nil {
-		// Very old database without bd_version metadata - show outdated view
-		log.Debug(log.CatBeads, "Version check failed", "error", err)
-		return runOutdatedMode("unknown", beads.MinBeadsVersion)
+	// Version check - query bd_version from each database's metadata table
+	// (the first mount's client is already client)
+	clients := []*infrabeads.SQLiteClient{client}
+	if len(mounts) > 1 {
+		for _, mount := range mounts[1:] {
+			clients = append(clients, mount.Client)
+		}
 	}
+	for _, c := range clients {
+		currentVersion, err := c.Version()
+		if err != nil {
+			// Very old database without bd_version metadata - show outdated view
+			log.Debug(log.CatBeads, "Version check failed", "error", err, "path", c.DBPath())
+			return runOutdatedMode("unknown", beads.MinBeadsVersion)
+		}
 
-	log.Debug(log.CatBeads, "Beads Database Version", "version", currentVersion, "minRequiredVersion", beads.MinBeadsVersion)
-	if err := beads.CheckVersion(currentVersion); err != nil {
-		return runOutdatedMode(currentVersion, beads.MinBeadsVersion)
+		log.Debug(log.CatBeads, "Beads Database Version", "version", currentVersion, "minRequiredVersion", beads.MinBeadsVersion, "path", c.DBPath())
+		if err := beads.CheckVersion(currentVersion); err != nil {
+			return runOutdatedMode(currentVersion, beads.MinBeadsVersion)
+		}
 	}
 
 	// Handle --no-auto-refresh flag (negated logic)
@@ -271,6 +301,7 @@ func runApp(cmd *cobra.Command, args []string) error {
 	// Pass config to app with database and config paths (debug for log overlay)
 	model, err := app.NewWithConfig(
 		client,
+		mounts,
 		cfg,
 		bqlCache,
 		depGraphCache,
@@ -326,6 +357,20 @@ func SetVersion(v string) {
 	rootCmd.Version = v
 }
 
+// openProjects opens every configured project database, resolving each path
+// the same way as --beads-dir.
+func openProjects(projects []config.ProjectConfig) ([]infrabeads.Mount, error) {
+	mounts := make([]infrabeads.Mount, 0, len(projects))
+	for _, p := range projects {
+		mount, err := infrabeads.OpenMount(p.Name, paths.ResolveBeadsDir(p.Path), p.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		mounts = append(mounts, mount)
+	}
+	return mounts, nil
+}
+
 // runNoBeadsMode launches the TUI in "no database" mode, showing a friendly
 // empty state view when no .beads directory is found.
 func runNoBeadsMode() error {
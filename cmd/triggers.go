@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/cachemanager"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/paths"
+)
+
+var triggersCmd = &cobra.Command{
+	Use:   "triggers",
+	Short: "Show what automation rules would start (dry run)",
+	Long: `Evaluate the automation rules in orchestration.triggers against the beads
+database and list the workflows they would start. Nothing is started or labeled.
+
+Rules run in the dashboard and in "perles daemon" whenever the database
+changes. Set orchestration.triggers.dry_run to have them log matches instead.`,
+	Args: cobra.NoArgs,
+	RunE: runTriggers,
+}
+
+func init() {
+	rootCmd.AddCommand(triggersCmd)
+}
+
+func runTriggers(_ *cobra.Command, _ []string) error {
+	rules := controlplane.TriggerRulesFromConfig(cfg.Orchestration.Triggers)
+	if len(rules) == 0 {
+		fmt.Println("No automation rules configured (orchestration.triggers.rules)")
+		return nil
+	}
+
+	// Same beads directory resolution as the daemon
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+	client, err := infrabeads.NewSQLiteClient(paths.BeadsDirFromEnv(cfg.BeadsDir, wd))
+	if err != nil {
+		return fmt.Errorf("opening beads database: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	executor := bql.NewExecutor(client.DB(),
+		cachemanager.NewInMemoryCacheManager[string, []beads.Issue](
+			"bql-cache", cachemanager.DefaultExpiration, cachemanager.DefaultCleanupInterval),
+		cachemanager.NewInMemoryCacheManager[string, *bql.DependencyGraph](
+			"bql-dep-cache", cachemanager.DefaultExpiration, cachemanager.DefaultCleanupInterval),
+	)
+
+	matches, matchErr := controlplane.MatchTriggers(rules, executor)
+	if len(matches) == 0 {
+		fmt.Println("No issues would trigger a workflow")
+	} else {
+		fmt.Printf("%d workflow(s) would start:\n", len(matches))
+		for _, m := range matches {
+			fmt.Printf("  %-16s %-20s %s  %s\n", m.Rule, m.TemplateID, m.IssueID, m.Title)
+		}
+	}
+	if cfg.Orchestration.Triggers.DryRun {
+		fmt.Println("\norchestration.triggers.dry_run is set: rules only log matches")
+	}
+	return matchErr
+}
@@ -51,6 +51,10 @@ export default function CommandsPanel({ commands }: Props) {
       'mark_task_failed': 'var(--accent-red)',
       'report_complete': 'var(--accent-green)',
       'notify_user': 'var(--accent-orange)',
+      'require_approval': 'var(--accent-orange)',
+      'resolve_approval': 'var(--accent-green)',
+      'report_policy_violation': 'var(--accent-red)',
+      'submit_handoff': 'var(--accent-purple)',
     }
     return colors[type] || 'var(--text-muted)'
   }
@@ -45,6 +45,10 @@ export default function MetadataPanel({ metadata, session, onNavigateToWorker, o
     { type: 'mark_task_failed', label: 'Task Failed', color: 'var(--accent-red)' },
     { type: 'report_complete', label: 'Report', color: 'var(--accent-green)' },
     { type: 'notify_user', label: 'Notify', color: 'var(--accent-orange)' },
+    { type: 'require_approval', label: 'Approval', color: 'var(--accent-orange)' },
+    { type: 'resolve_approval', label: 'Resolved', color: 'var(--accent-green)' },
+    { type: 'report_policy_violation', label: 'Policy', color: 'var(--accent-red)' },
+    { type: 'submit_handoff', label: 'Handoff', color: 'var(--accent-purple)' },
   ]
   const commandCounts = commandTypes.map(ct => ({
     ...ct,
@@ -12,6 +12,7 @@ import (
 	zone "github.com/lrstanley/bubblezone"
 
 	"github.com/zjrosen/perles/frontend"
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
 	"github.com/zjrosen/perles/internal/bql"
@@ -59,6 +60,12 @@ type Model struct {
 	// ControlPlane for multi-workflow management (lazy initialized on dashboard entry)
 	controlPlane controlplane.ControlPlane
 
+	// CronScheduler for schedules and delayed starts (nil without a database)
+	schedules controlplane.CronScheduler
+
+	// TriggerEngine for automation rules (nil without rules or a beads database)
+	triggers controlplane.TriggerEngine
+
 	// Shared services (passed to mode controllers)
 	services mode.Services
 
@@ -104,12 +111,15 @@ type Model struct {
 	// API server for control plane (started when dashboard mode enters)
 	apiServer     *api.Server
 	apiServerPort int
+	apiToken      string
 
 	// SQLite database for session persistence (owned by app, closed on shutdown)
 	db *sqlite.DB
 }
 
 // NewWithConfig creates a new application model with the provided configuration.
+// mounts are the configured projects (nil for a single database); when set,
+// client is the first mount's client.
 // dbPath is the path to the beads database file for watching changes.
 // configPath is the path to the config file for saving column changes.
 // debugMode enables the log overlay (Ctrl+X toggle).
@@ -118,6 +128,7 @@ type Model struct {
 // Returns an error if database initialization fails (fail-fast behavior).
 func NewWithConfig(
 	client *infrabeads.SQLiteClient,
+	mounts []infrabeads.Mount,
 	cfg config.Config,
 	bqlCache cachemanager.CacheManager[string, []beads.Issue],
 	depGraphCache cachemanager.CacheManager[string, *bql.DependencyGraph],
@@ -127,6 +138,19 @@ func NewWithConfig(
 	debugMode bool,
 	registryService *appreg.RegistryService,
 ) (Model, error) {
+	// Mount configured projects before starting anything that needs cleanup
+	var (
+		projectRouter    *appbeads.Router
+		projectExecutors []*bql.Executor
+	)
+	if len(mounts) > 0 {
+		var err error
+		projectRouter, projectExecutors, err = newProjectRouter(mounts, workDir, bqlCache, depGraphCache)
+		if err != nil {
+			return Model{}, err
+		}
+	}
+
 	// Initialize SQLite database for session persistence (only if feature flag enabled)
 	// Path is ~/.perles/perles.db (or perles-test.db when running tests)
 	var db *sqlite.DB
@@ -152,7 +176,13 @@ func NewWithConfig(
 	)
 
 	if cfg.AutoRefresh && dbPath != "" {
-		w, err := watcher.New(watcher.DefaultConfig(dbPath))
+		watcherCfg := watcher.DefaultConfig(dbPath)
+		for _, mount := range mounts {
+			if mount.Client.DBPath() != dbPath {
+				watcherCfg.AdditionalDBPaths = append(watcherCfg.AdditionalDBPaths, mount.Client.DBPath())
+			}
+		}
+		w, err := watcher.New(watcherCfg)
 		if err == nil {
 			if err := w.Start(); err == nil {
 				watcherHandle = w
@@ -176,7 +206,7 @@ func NewWithConfig(
 
 	flagService := flags.New(cfg.Flags)
 
-	beadsExec := infrabeads.NewBDExecutor(workDir, cfg.ResolvedBeadsDir)
+	var beadsExec appbeads.IssueExecutor = infrabeads.NewBDExecutor(workDir, cfg.ResolvedBeadsDir)
 
 	// Create shared services with session repository from SQLite database
 	var sessionRepo domain.SessionRepository
@@ -186,12 +216,22 @@ func NewWithConfig(
 
 	// Create BQL executor only if client is available (nil when beads DB not present)
 	var bqlExec bql.BQLExecutor
+	var beadsClient mode.BeadsClient
 	if client != nil {
 		bqlExec = bql.NewExecutor(client.DB(), bqlCache, depGraphCache)
+		beadsClient = client
+	}
+
+	// With several projects mounted, queries fan out to every database and
+	// reads and mutations are routed by issue ID prefix
+	if projectRouter != nil {
+		bqlExec = bql.NewMultiExecutor(projectExecutors...)
+		beadsClient = projectRouter
+		beadsExec = projectRouter
 	}
 
 	services := mode.Services{
-		Client:        client,
+		Client:        beadsClient,
 		Config:        &cfg,
 		ConfigPath:    configPath,
 		DBPath:        dbPath,
@@ -275,6 +315,34 @@ func NewWithConfig(
 	}, nil
 }
 
+// newProjectRouter builds the per-project BQL executors and the router that
+// dispatches reads and mutations to each mounted project.
+func newProjectRouter(
+	mounts []infrabeads.Mount,
+	workDir string,
+	bqlCache cachemanager.CacheManager[string, []beads.Issue],
+	depGraphCache cachemanager.CacheManager[string, *bql.DependencyGraph],
+) (*appbeads.Router, []*bql.Executor, error) {
+	projects := make([]appbeads.Project, len(mounts))
+	executors := make([]*bql.Executor, len(mounts))
+	for i, mount := range mounts {
+		projects[i] = appbeads.Project{
+			Name:     mount.Name,
+			Prefix:   mount.Prefix,
+			BeadsDir: mount.BeadsDir,
+			Reader:   mount.Client,
+			Executor: infrabeads.NewBDExecutor(workDir, mount.BeadsDir),
+		}
+		executors[i] = bql.NewExecutor(mount.Client.DB(), bqlCache, depGraphCache).WithProject(mount.Name)
+	}
+
+	router, err := appbeads.NewRouter(projects)
+	if err != nil {
+		return nil, nil, fmt.Errorf("mounting projects: %w", err)
+	}
+	return router, executors, nil
+}
+
 // Init implements tea.Model interface.
 // Defaults the application to Kanban mode and starts the watcher listener
 // if auto-refresh is enabled.
@@ -487,6 +555,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Lazy initialize ControlPlane if needed
 		if m.controlPlane == nil {
 			m.controlPlane = m.createControlPlane()
+			if m.controlPlane != nil {
+				m.schedules = m.createCronScheduler()
+				m.triggers = m.createTriggerEngine()
+			}
 		}
 
 		// Start API server if not already running
@@ -495,11 +567,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			port := m.services.Config.Orchestration.APIPort
 			addr := fmt.Sprintf("localhost:%d", port)
 
+			// TCP requires a token; create one on first use
+			tokens := api.NewTokenFile(config.DefaultAPITokenPath())
+			token, err := tokens.Ensure()
+			if err != nil {
+				log.Error(log.CatOrch, "Failed to load API token", "error", err)
+			}
+			m.apiToken = token
+
 			server, err := api.NewServer(api.ServerConfig{
 				Addr:            addr,
+				Tokens:          tokens,
 				ControlPlane:    m.controlPlane,
 				WorkflowCreator: m.workflowCreator,
 				RegistryService: m.registryService,
+				Schedules:       m.schedules,
 				FrontendFS:      frontend.DistFS(),
 			})
 			if err != nil {
@@ -532,6 +614,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			GitExecutorFactory: m.services.GitExecutorFactory,
 			WorkDir:            m.services.WorkDir,
 			APIPort:            m.apiServerPort,
+			APIToken:           m.apiToken,
 			DebugMode:          m.debugMode,
 			VimMode:            m.services.Config.UI.VimMode,
 			ObserverEnabled:    m.services.Config.Orchestration.IsObserverEnabled(),
@@ -578,6 +661,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				log.Warn(log.CatCache, "Failed to flush dep graph cache on DB change", "error", err)
 			}
 
+			// Re-evaluate automation rules against the fresh data
+			if m.triggers != nil {
+				m.triggers.HandleDBChanged()
+			}
+
 			log.Debug(log.CatMode, "DB changed, refreshing active mode", "mode", m.currentMode)
 			var modeCmd tea.Cmd
 			switch m.currentMode {
@@ -681,7 +769,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case diffviewer.ShowDiffViewerMsg:
 		var cmd tea.Cmd
-		m.diffViewer, cmd = m.diffViewer.ShowAndLoad()
+		if msg.BaseRef != "" {
+			m.diffViewer, cmd = m.diffViewer.ShowBranchDiffAndLoad(msg.WorkDir, msg.BaseRef)
+		} else {
+			m.diffViewer, cmd = m.diffViewer.ShowAndLoad()
+		}
 		m.diffViewer = m.diffViewer.SetSize(m.width, m.height)
 		return m, cmd
 
@@ -1153,6 +1245,14 @@ func (m *Model) Close() error {
 	// Clean up chat panel infrastructure
 	m.chatPanel.Cleanup()
 
+	// Stop schedules and triggers before the control plane so nothing new is started
+	if m.schedules != nil {
+		m.schedules.Stop()
+	}
+	if m.triggers != nil {
+		m.triggers.Stop()
+	}
+
 	// Shutdown ControlPlane (stops all workflows, releases resources)
 	// Must happen before closing DB since it may persist final state
 	if m.controlPlane != nil {
@@ -1232,30 +1332,65 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 		GitExecutor: m.services.GitExecutorFactory(m.services.WorkDir),
 	})
 
-	// Create supervisor with full configuration
-	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
-		AgentProviders:     orchConfig.AgentProviders(),
-		WorkflowRegistry:   m.workflowRegistry,
-		GitExecutorFactory: m.services.GitExecutorFactory,
-		Flags:              m.services.Flags,
-		SessionFactory:     sessionFactory,
-		SoundService:       m.services.Sounds,
-		BeadsDir:           m.services.Config.ResolvedBeadsDir,
+	// Create resource scheduler for global workflow, worker and spend limits
+	limits := orchConfig.Limits
+	scheduler := controlplane.NewResourceScheduler(controlplane.ResourceSchedulerConfig{
+		Limits: controlplane.ResourceLimits{
+			MaxWorkflows:       limits.MaxWorkflows,
+			MaxWorkers:         limits.MaxWorkers,
+			MaxWorkflowTokens:  limits.WorkflowTokens,
+			MaxWorkflowCostUSD: limits.WorkflowCostUSD,
+			MaxTotalTokens:     limits.TotalTokens,
+			MaxTotalCostUSD:    limits.TotalCostUSD,
+		},
+		Policy:   controlplane.QueuePolicy(limits.Queue),
+		EventBus: eventBus.Broker(),
 	})
+	if err := scheduler.Start(context.Background()); err != nil {
+		log.Error(log.CatOrch, "Failed to start ResourceScheduler", "error", err)
+		return nil
+	}
+
+	// Create supervisor with full configuration
+	supervisorCfg := controlplane.SupervisorConfig{
+		AgentProviders:          orchConfig.AgentProviders(),
+		WorkflowRegistry:        m.workflowRegistry,
+		GitExecutorFactory:      m.services.GitExecutorFactory,
+		Flags:                   m.services.Flags,
+		SessionFactory:          sessionFactory,
+		SoundService:            m.services.Sounds,
+		BeadsDir:                m.services.Config.ResolvedBeadsDir,
+		Scheduler:               scheduler,
+		Policies:                orchConfig.Policies,
+		ContextHandoffThreshold: orchConfig.ContextHandoff.Threshold,
+		Autoscale:               orchConfig.Autoscale,
+		Retry:                   orchConfig.Retry,
+		TaskQuerier:             m.services.Executor,
+	}
+	// Persist process, task and queue state alongside the durable registry
+	if m.db != nil {
+		supervisorCfg.RepositoryStore = m.db
+	}
+	supervisor, err := controlplane.NewSupervisor(supervisorCfg)
 	if err != nil {
 		log.Error(log.CatMode, "Failed to create Supervisor", "error", err)
 		return nil
 	}
 
-	// Create recovery executor for automatic recovery actions
+	// Create recovery executor for automatic recovery actions. Health events
+	// are republished on the event bus so the dashboard can surface them.
+	publishHealth := eventBus.HealthEventPublisher(registry)
 	recoveryExecutor, err := controlplane.NewRecoveryExecutor(controlplane.RecoveryExecutorConfig{
 		WorkflowProvider: registry,
+		Supervisor:       supervisor,
 		OnHealthEvent: func(event controlplane.HealthEvent) {
 			log.Debug(log.CatOrch, "Recovery event",
 				"type", event.Type,
 				"workflowID", event.WorkflowID,
+				"processID", event.ProcessID,
 				"action", event.RecoveryAction,
 				"details", event.Details)
+			publishHealth(event)
 		},
 	})
 	if err != nil {
@@ -1263,25 +1398,43 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 		return nil
 	}
 
-	// Create health monitor for workflow health tracking
+	// Create health monitor for workflow health tracking, applying the
+	// configured policy and workflow template overrides over the defaults
+	healthConfig := m.services.Config.Orchestration.Health
+	healthPolicy, err := controlplane.HealthPolicyFromConfig(controlplane.HealthPolicy{
+		HeartbeatTimeout:  2 * time.Minute,
+		ProgressTimeout:   2 * time.Minute,
+		MaxRecoveries:     3,
+		RecoveryBackoff:   2 * time.Minute,
+		EnableAutoNudge:   true,
+		MaxNudges:         3,
+		EnableAutoReplace: false,
+		EnableAutoPause:   false,
+		WorkerTimeout:     10 * time.Minute,
+		WorkerNudges:      1,
+	}, healthConfig)
+	if err != nil {
+		log.Error(log.CatOrch, "Invalid orchestration.health config", "error", err)
+		return nil
+	}
+	var templatePolicies map[string]controlplane.HealthPolicy
+	if m.workflowRegistry != nil {
+		templatePolicies = controlplane.TemplateHealthPolicies(healthPolicy, m.workflowRegistry.List())
+	}
 	healthMonitor := controlplane.NewHealthMonitor(controlplane.HealthMonitorConfig{
-		Policy: controlplane.HealthPolicy{
-			HeartbeatTimeout:  2 * time.Minute,
-			ProgressTimeout:   2 * time.Minute,
-			MaxRecoveries:     3,
-			RecoveryBackoff:   2 * time.Minute,
-			EnableAutoNudge:   true,
-			MaxNudges:         3,
-			EnableAutoReplace: false,
-			EnableAutoPause:   false,
-		},
+		Policy:           healthPolicy,
+		TemplatePolicies: templatePolicies,
+		CheckInterval:    healthConfig.CheckInterval,
 		EventBus:         eventBus.Broker(),
 		RecoveryExecutor: recoveryExecutor,
+		WorkflowProvider: registry,
 		OnHealthEvent: func(event controlplane.HealthEvent) {
 			log.Debug(log.CatOrch, "Health event",
 				"type", event.Type,
 				"workflowID", event.WorkflowID,
+				"processID", event.ProcessID,
 				"details", event.Details)
+			publishHealth(event)
 		},
 	})
 
@@ -1293,10 +1446,16 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 	}()
 
 	cp, err := controlplane.NewControlPlane(controlplane.ControlPlaneConfig{
-		Registry:      registry,
-		Supervisor:    supervisor,
-		EventBus:      eventBus,
-		HealthMonitor: healthMonitor,
+		Registry:         registry,
+		Supervisor:       supervisor,
+		EventBus:         eventBus,
+		HealthMonitor:    healthMonitor,
+		Scheduler:        scheduler,
+		BudgetThresholds: limits.BudgetAlerts,
+		SoundService:     m.services.Sounds,
+		SpecBuilder:      api.NewTemplateSpecBuilder(m.registryService, m.workflowCreator),
+
+		GitExecutorFactory: m.services.GitExecutorFactory,
 	})
 	if err != nil {
 		log.Error(log.CatMode, "Failed to create ControlPlane", "error", err)
@@ -1305,3 +1464,61 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 
 	return cp
 }
+
+// createCronScheduler creates and starts the CronScheduler for the dashboard.
+// Schedules are stored in the SQLite database, so returns nil without one.
+func (m *Model) createCronScheduler() controlplane.CronScheduler {
+	if m.db == nil {
+		return nil
+	}
+
+	project := session.DeriveApplicationName(
+		m.services.WorkDir,
+		m.services.GitExecutorFactory(m.services.WorkDir),
+	)
+
+	scheduler, err := controlplane.NewCronScheduler(controlplane.CronSchedulerConfig{
+		Project:      project,
+		Repository:   m.db.ScheduleRepository(),
+		ControlPlane: m.controlPlane,
+		SpecBuilder:  api.NewTemplateSpecBuilder(m.registryService, m.workflowCreator),
+		Executor:     m.services.Executor,
+		Deleter:      m.services.BeadsExecutor,
+	})
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to create CronScheduler", "error", err)
+		return nil
+	}
+	if err := scheduler.Start(context.Background()); err != nil {
+		log.Error(log.CatOrch, "Failed to start CronScheduler", "error", err)
+		return nil
+	}
+	return scheduler
+}
+
+// createTriggerEngine creates and starts the TriggerEngine for the configured
+// automation rules. Returns nil when there are no rules or no beads database.
+func (m *Model) createTriggerEngine() controlplane.TriggerEngine {
+	triggers := m.services.Config.Orchestration.Triggers
+	if len(triggers.Rules) == 0 || m.services.Executor == nil || m.services.BeadsExecutor == nil {
+		return nil
+	}
+
+	engine, err := controlplane.NewTriggerEngine(controlplane.TriggerEngineConfig{
+		Rules:        controlplane.TriggerRulesFromConfig(triggers),
+		DryRun:       triggers.DryRun,
+		Executor:     m.services.Executor,
+		Labeler:      m.services.BeadsExecutor,
+		ControlPlane: m.controlPlane,
+		SpecBuilder:  api.NewTemplateSpecBuilder(m.registryService, m.workflowCreator),
+	})
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to create TriggerEngine", "error", err)
+		return nil
+	}
+	if err := engine.Start(context.Background()); err != nil {
+		log.Error(log.CatOrch, "Failed to start TriggerEngine", "error", err)
+		return nil
+	}
+	return engine
+}
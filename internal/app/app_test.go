@@ -571,6 +571,7 @@ func TestApp_WorkflowCreatorReceivesConfig(t *testing.T) {
 	require.NoError(t, err)
 
 	model, err := NewWithConfig(
+		nil,
 		nil,
 		cfg,
 		nil,
@@ -595,6 +596,7 @@ func TestApp_EndToEnd_CustomPath(t *testing.T) {
 	require.NoError(t, err)
 
 	model, err := NewWithConfig(
+		nil,
 		nil,
 		cfg,
 		nil,
@@ -638,6 +640,7 @@ func TestApp_EndToEnd_DefaultPath(t *testing.T) {
 	require.NoError(t, err)
 
 	model, err := NewWithConfig(
+		nil,
 		nil,
 		cfg,
 		nil,
@@ -2284,6 +2287,7 @@ func TestApp_InitializesDatabase(t *testing.T) {
 
 	model, err := NewWithConfig(
 		nil, // client - not needed for database tests
+		nil, // mounts
 		cfg,
 		nil, // bqlCache
 		nil, // depGraphCache
@@ -2314,6 +2318,7 @@ func TestApp_Shutdown_ClosesDatabase(t *testing.T) {
 
 	model, err := NewWithConfig(
 		nil, // client
+		nil, // mounts
 		cfg,
 		nil, // bqlCache
 		nil, // depGraphCache
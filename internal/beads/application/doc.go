@@ -22,6 +22,11 @@
 //   - IssueReader: reads issue details
 //   - IssueWriter: mutates issues via CLI
 //
+// # Multiple Projects
+//
+// Router mounts several beads databases as named projects and implements the
+// same ports, routing each call to the project that owns the issue ID prefix.
+//
 // # Infrastructure Adapters
 //
 // SQLiteClient implements the read ports (VersionReader, CommentReader).
@@ -12,6 +12,11 @@ type CommentReader interface {
 	GetComments(issueID string) ([]domain.Comment, error)
 }
 
+// EventReader reads the audit history (beads events table) for issues.
+type EventReader interface {
+	GetEvents(issueID string) ([]domain.Event, error)
+}
+
 // IssueReader reads issue details.
 type IssueReader interface {
 	ShowIssue(issueID string) (*domain.Issue, error)
@@ -28,6 +33,7 @@ type IssueWriter interface {
 	AddComment(issueID, author, text string) error
 	CreateEpic(title, description string, labels []string) (domain.CreateResult, error)
 	CreateTask(title, description, parentID, assignee string, labels []string) (domain.CreateResult, error)
+	CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error)
 	DeleteIssues(issueIDs []string) error
 	AddDependency(taskID, dependsOnID string) error
 }
@@ -0,0 +1,192 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// ProjectReader combines the read ports served by a project's database.
+type ProjectReader interface {
+	VersionReader
+	CommentReader
+	EventReader
+}
+
+// Project is a beads database mounted under a name.
+// Issues whose IDs start with Prefix followed by "-" belong to the project.
+type Project struct {
+	Name     string
+	Prefix   string
+	BeadsDir string
+	Reader   ProjectReader
+	Executor IssueExecutor
+}
+
+// Compile-time check that Router implements the beads ports.
+var (
+	_ IssueExecutor = (*Router)(nil)
+	_ ProjectReader = (*Router)(nil)
+)
+
+// Router dispatches reads and mutations to the project that owns each issue.
+// The first project is the default: it receives new issues without a parent
+// and any issue ID that matches no prefix.
+type Router struct {
+	projects []Project
+}
+
+// NewRouter creates a router over the given projects. At least one project is required.
+func NewRouter(projects []Project) (*Router, error) {
+	if len(projects) == 0 {
+		return nil, errors.New("router requires at least one project")
+	}
+	return &Router{projects: projects}, nil
+}
+
+// Projects returns the mounted projects in configuration order.
+func (r *Router) Projects() []Project {
+	return r.projects
+}
+
+// Default returns the project that receives unrouted operations.
+func (r *Router) Default() Project {
+	return r.projects[0]
+}
+
+// ProjectFor returns the project owning the issue ID, preferring the longest
+// matching prefix so "api-web" wins over "api" for "api-web-12".
+func (r *Router) ProjectFor(issueID string) Project {
+	best := -1
+	for i, p := range r.projects {
+		if p.Prefix == "" || !strings.HasPrefix(issueID, p.Prefix+"-") {
+			continue
+		}
+		if best < 0 || len(p.Prefix) > len(r.projects[best].Prefix) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return r.Default()
+	}
+	return r.projects[best]
+}
+
+// Project returns the project with the given name.
+func (r *Router) Project(name string) (Project, bool) {
+	for _, p := range r.projects {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Project{}, false
+}
+
+// Version returns the default project's beads version.
+func (r *Router) Version() (string, error) {
+	return r.Default().Reader.Version()
+}
+
+// GetComments reads comments from the issue's project.
+func (r *Router) GetComments(issueID string) ([]domain.Comment, error) {
+	return r.ProjectFor(issueID).Reader.GetComments(issueID)
+}
+
+// GetEvents reads history from the issue's project.
+func (r *Router) GetEvents(issueID string) ([]domain.Event, error) {
+	return r.ProjectFor(issueID).Reader.GetEvents(issueID)
+}
+
+// ShowIssue reads the issue from its project.
+func (r *Router) ShowIssue(issueID string) (*domain.Issue, error) {
+	return r.ProjectFor(issueID).Executor.ShowIssue(issueID)
+}
+
+// UpdateStatus routes to the issue's project.
+func (r *Router) UpdateStatus(issueID string, status domain.Status) error {
+	return r.ProjectFor(issueID).Executor.UpdateStatus(issueID, status)
+}
+
+// UpdatePriority routes to the issue's project.
+func (r *Router) UpdatePriority(issueID string, priority domain.Priority) error {
+	return r.ProjectFor(issueID).Executor.UpdatePriority(issueID, priority)
+}
+
+// UpdateType routes to the issue's project.
+func (r *Router) UpdateType(issueID string, issueType domain.IssueType) error {
+	return r.ProjectFor(issueID).Executor.UpdateType(issueID, issueType)
+}
+
+// CloseIssue routes to the issue's project.
+func (r *Router) CloseIssue(issueID, reason string) error {
+	return r.ProjectFor(issueID).Executor.CloseIssue(issueID, reason)
+}
+
+// ReopenIssue routes to the issue's project.
+func (r *Router) ReopenIssue(issueID string) error {
+	return r.ProjectFor(issueID).Executor.ReopenIssue(issueID)
+}
+
+// SetLabels routes to the issue's project.
+func (r *Router) SetLabels(issueID string, labels []string) error {
+	return r.ProjectFor(issueID).Executor.SetLabels(issueID, labels)
+}
+
+// AddComment routes to the issue's project.
+func (r *Router) AddComment(issueID, author, text string) error {
+	return r.ProjectFor(issueID).Executor.AddComment(issueID, author, text)
+}
+
+// CreateEpic creates the epic in the default project.
+func (r *Router) CreateEpic(title, description string, labels []string) (domain.CreateResult, error) {
+	return r.Default().Executor.CreateEpic(title, description, labels)
+}
+
+// CreateTask creates the task in its parent's project.
+func (r *Router) CreateTask(title, description, parentID, assignee string, labels []string) (domain.CreateResult, error) {
+	return r.ProjectFor(parentID).Executor.CreateTask(title, description, parentID, assignee, labels)
+}
+
+// CreateIssue creates the issue in its parent's project, or the default project without a parent.
+func (r *Router) CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+	if opts.ParentID != "" {
+		return r.ProjectFor(opts.ParentID).Executor.CreateIssue(opts)
+	}
+	return r.Default().Executor.CreateIssue(opts)
+}
+
+// DeleteIssues groups the IDs by project and deletes each group in its project.
+func (r *Router) DeleteIssues(issueIDs []string) error {
+	var order []string
+	groups := make(map[string][]string)
+	executors := make(map[string]IssueExecutor)
+	for _, id := range issueIDs {
+		p := r.ProjectFor(id)
+		if _, ok := groups[p.Name]; !ok {
+			order = append(order, p.Name)
+			executors[p.Name] = p.Executor
+		}
+		groups[p.Name] = append(groups[p.Name], id)
+	}
+
+	for _, name := range order {
+		if err := executors[name].DeleteIssues(groups[name]); err != nil {
+			return fmt.Errorf("deleting issues in project %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// AddDependency adds the dependency in the task's project. Dependencies cannot
+// cross projects because each beads database only knows its own issues.
+func (r *Router) AddDependency(taskID, dependsOnID string) error {
+	task := r.ProjectFor(taskID)
+	dependsOn := r.ProjectFor(dependsOnID)
+	if task.Name != dependsOn.Name {
+		return fmt.Errorf("cannot add dependency across projects: %s (%s) depends on %s (%s)",
+			taskID, task.Name, dependsOnID, dependsOn.Name)
+	}
+	return task.Executor.AddDependency(taskID, dependsOnID)
+}
@@ -0,0 +1,109 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func newTestRouter(t *testing.T) (*appbeads.Router, *mocks.MockIssueExecutor, *mocks.MockIssueExecutor, *mocks.MockIssueExecutor) {
+	api := mocks.NewMockIssueExecutor(t)
+	web := mocks.NewMockIssueExecutor(t)
+	apiWeb := mocks.NewMockIssueExecutor(t)
+
+	router, err := appbeads.NewRouter([]appbeads.Project{
+		{Name: "api", Prefix: "api", Executor: api},
+		{Name: "web", Prefix: "web", Executor: web},
+		{Name: "api-web", Prefix: "api-web", Executor: apiWeb},
+	})
+	require.NoError(t, err)
+	return router, api, web, apiWeb
+}
+
+func TestNewRouter_RequiresProject(t *testing.T) {
+	_, err := appbeads.NewRouter(nil)
+	require.Error(t, err)
+}
+
+func TestRouter_ProjectFor(t *testing.T) {
+	router, _, _, _ := newTestRouter(t)
+
+	require.Equal(t, "api", router.ProjectFor("api-12").Name)
+	require.Equal(t, "web", router.ProjectFor("web-abc.1").Name)
+	require.Equal(t, "api-web", router.ProjectFor("api-web-3").Name, "longest prefix wins")
+	require.Equal(t, "api", router.ProjectFor("other-1").Name, "unknown prefix falls back to default")
+	require.Equal(t, "api", router.ProjectFor("apix-1").Name, "prefix must be followed by a dash")
+}
+
+func TestRouter_RoutesMutationsByIssueID(t *testing.T) {
+	router, api, web, _ := newTestRouter(t)
+
+	web.EXPECT().UpdateStatus("web-1", domain.StatusClosed).Return(nil)
+	api.EXPECT().SetLabels("api-2", []string{"x"}).Return(nil)
+
+	require.NoError(t, router.UpdateStatus("web-1", domain.StatusClosed))
+	require.NoError(t, router.SetLabels("api-2", []string{"x"}))
+}
+
+func TestRouter_CreateIssue(t *testing.T) {
+	router, api, web, _ := newTestRouter(t)
+
+	api.EXPECT().CreateIssue(domain.CreateIssueOptions{Title: "top"}).
+		Return(domain.CreateResult{ID: "api-9"}, nil)
+	web.EXPECT().CreateIssue(domain.CreateIssueOptions{Title: "child", ParentID: "web-1"}).
+		Return(domain.CreateResult{ID: "web-1.1"}, nil)
+
+	result, err := router.CreateIssue(domain.CreateIssueOptions{Title: "top"})
+	require.NoError(t, err)
+	require.Equal(t, "api-9", result.ID)
+
+	result, err = router.CreateIssue(domain.CreateIssueOptions{Title: "child", ParentID: "web-1"})
+	require.NoError(t, err)
+	require.Equal(t, "web-1.1", result.ID)
+}
+
+func TestRouter_DeleteIssuesGroupsByProject(t *testing.T) {
+	router, api, web, _ := newTestRouter(t)
+
+	web.EXPECT().DeleteIssues([]string{"web-1", "web-2"}).Return(nil)
+	api.EXPECT().DeleteIssues([]string{"api-1"}).Return(errors.New("bd delete failed"))
+
+	err := router.DeleteIssues([]string{"web-1", "api-1", "web-2"})
+	require.ErrorContains(t, err, "project api")
+}
+
+func TestRouter_AddDependencyRejectsCrossProject(t *testing.T) {
+	router, api, _, _ := newTestRouter(t)
+
+	api.EXPECT().AddDependency("api-1", "api-2").Return(nil)
+	require.NoError(t, router.AddDependency("api-1", "api-2"))
+
+	err := router.AddDependency("api-1", "web-2")
+	require.ErrorContains(t, err, "across projects")
+}
+
+func TestRouter_ReadsFromIssueProject(t *testing.T) {
+	apiReader := mocks.NewMockBeadsClient(t)
+	webReader := mocks.NewMockBeadsClient(t)
+	router, err := appbeads.NewRouter([]appbeads.Project{
+		{Name: "api", Prefix: "api", Reader: apiReader},
+		{Name: "web", Prefix: "web", Reader: webReader},
+	})
+	require.NoError(t, err)
+
+	webReader.EXPECT().GetComments("web-1").Return([]domain.Comment{{ID: 1}}, nil)
+	apiReader.EXPECT().Version().Return("0.30.0", nil)
+
+	comments, err := router.GetComments("web-1")
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+
+	version, err := router.Version()
+	require.NoError(t, err)
+	require.Equal(t, "0.30.0", version)
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EventType identifies the kind of change recorded in the beads events table.
+type EventType string
+
+const (
+	EventCreated           EventType = "created"
+	EventUpdated           EventType = "updated"
+	EventStatusChanged     EventType = "status_changed"
+	EventCommented         EventType = "commented"
+	EventClosed            EventType = "closed"
+	EventReopened          EventType = "reopened"
+	EventDependencyAdded   EventType = "dependency_added"
+	EventDependencyRemoved EventType = "dependency_removed"
+	EventLabelAdded        EventType = "label_added"
+	EventLabelRemoved      EventType = "label_removed"
+	EventCompacted         EventType = "compacted"
+)
+
+// Event is a single audit row from the beads events table.
+// OldValue and NewValue hold JSON snapshots for update events; Comment holds
+// a human-readable note (close reason, "Added label: x", ...).
+type Event struct {
+	ID        int64     `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	Type      EventType `json:"event_type"`
+	Actor     string    `json:"actor"`
+	OldValue  string    `json:"old_value,omitempty"`
+	NewValue  string    `json:"new_value,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FieldChange describes a single field transition parsed from an update event.
+// Old and New are empty for long text fields, which are only reported as edited.
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// trackedFields lists the fields reported by Changes, in display order.
+// Text fields are reported as edited without their values.
+var trackedFields = []struct {
+	key  string
+	name string
+	text bool
+}{
+	{key: "status", name: "status"},
+	{key: "priority", name: "priority"},
+	{key: "assignee", name: "assignee"},
+	{key: "issue_type", name: "type"},
+	{key: "title", name: "title"},
+	{key: "description", name: "description", text: true},
+	{key: "design", name: "design", text: true},
+	{key: "acceptance_criteria", name: "acceptance criteria", text: true},
+	{key: "notes", name: "notes", text: true},
+}
+
+// Changes returns the tracked fields present in NewValue that differ from OldValue.
+// Returns nil when the event carries no parseable JSON snapshot.
+func (e Event) Changes() []FieldChange {
+	var newValues map[string]any
+	if err := json.Unmarshal([]byte(e.NewValue), &newValues); err != nil {
+		return nil
+	}
+	var oldValues map[string]any
+	_ = json.Unmarshal([]byte(e.OldValue), &oldValues)
+
+	var changes []FieldChange
+	for _, f := range trackedFields {
+		newVal, ok := newValues[f.key]
+		if !ok {
+			continue
+		}
+		oldStr := formatEventValue(f.key, oldValues[f.key])
+		newStr := formatEventValue(f.key, newVal)
+		if oldStr == newStr {
+			continue
+		}
+		if f.text {
+			changes = append(changes, FieldChange{Field: f.name})
+			continue
+		}
+		changes = append(changes, FieldChange{Field: f.name, Old: oldStr, New: newStr})
+	}
+	return changes
+}
+
+// Summary returns a one-line description of the event, e.g.
+// "status open → in_progress, priority P2 → P1" or "closed: duplicate".
+func (e Event) Summary() string {
+	switch e.Type {
+	case EventCreated:
+		return "created"
+	case EventClosed:
+		if e.Comment != "" {
+			return "closed: " + e.Comment
+		}
+		return "closed"
+	case EventReopened:
+		return "reopened"
+	}
+
+	if changes := e.Changes(); len(changes) > 0 {
+		parts := make([]string, len(changes))
+		for i, c := range changes {
+			if c.Old == "" && c.New == "" {
+				parts[i] = c.Field + " edited"
+				continue
+			}
+			parts[i] = fmt.Sprintf("%s %s → %s", c.Field, orNone(c.Old), orNone(c.New))
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	if e.Comment != "" {
+		return e.Comment
+	}
+	return strings.ReplaceAll(string(e.Type), "_", " ")
+}
+
+// formatEventValue renders a JSON snapshot value for display.
+func formatEventValue(key string, v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		if key == "priority" {
+			return fmt.Sprintf("P%d", int(val))
+		}
+		return fmt.Sprintf("%g", val)
+	case string:
+		return val
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// orNone substitutes a placeholder for empty values.
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_Changes(t *testing.T) {
+	e := Event{
+		Type:     EventStatusChanged,
+		OldValue: `{"id":"bd-1","status":"open","priority":2,"assignee":"","description":"old"}`,
+		NewValue: `{"status":"in_progress","priority":1,"assignee":"alice","description":"new"}`,
+	}
+
+	require.Equal(t, []FieldChange{
+		{Field: "status", Old: "open", New: "in_progress"},
+		{Field: "priority", Old: "P2", New: "P1"},
+		{Field: "assignee", Old: "", New: "alice"},
+		{Field: "description"},
+	}, e.Changes())
+}
+
+func TestEvent_ChangesSkipsUnchangedAndInvalid(t *testing.T) {
+	unchanged := Event{OldValue: `{"status":"open"}`, NewValue: `{"status":"open"}`}
+	require.Empty(t, unchanged.Changes())
+
+	notJSON := Event{NewValue: "in_progress"}
+	require.Nil(t, notJSON.Changes())
+}
+
+func TestEvent_Summary(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		want  string
+	}{
+		{"created", Event{Type: EventCreated, NewValue: `{"title":"x"}`}, "created"},
+		{"closed with reason", Event{Type: EventClosed, Comment: "duplicate"}, "closed: duplicate"},
+		{"closed", Event{Type: EventClosed}, "closed"},
+		{"reopened", Event{Type: EventReopened}, "reopened"},
+		{
+			"field changes",
+			Event{Type: EventUpdated, OldValue: `{"assignee":"bob","notes":"a"}`, NewValue: `{"assignee":"","notes":"b"}`},
+			"assignee bob → (none), notes edited",
+		},
+		{"label comment", Event{Type: EventLabelAdded, Comment: "Added label: urgent"}, "Added label: urgent"},
+		{"bare type", Event{Type: EventDependencyRemoved}, "dependency removed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.event.Summary())
+		})
+	}
+}
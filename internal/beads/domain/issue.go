@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // Status represents the issue lifecycle state.
 type Status string
@@ -36,6 +39,10 @@ const (
 	TypeAgent    IssueType = "agent"
 )
 
+// LabelApprovalGate marks a workflow task as a human approval gate. Gate tasks
+// are never assigned to workers; the coordinator requests approval for them.
+const LabelApprovalGate = "gate:approval"
+
 // Comment represents a comment on an issue.
 type Comment struct {
 	ID        int       `json:"id"`
@@ -89,6 +96,50 @@ type Issue struct {
 
 	// CommentCount is populated by BQL queries for display without loading full comments
 	CommentCount int `json:"comment_count,omitempty"`
+
+	// Blocked is populated by BQL queries from the blocked issues cache
+	Blocked bool `json:"blocked,omitempty"`
+
+	// Progress is populated by BQL queries with the rolled-up completion of the issue's descendants
+	Progress Progress `json:"progress,omitzero"`
+
+	// Project is populated by BQL queries with the name of the mounted beads
+	// database the issue came from. Empty when a single database is mounted.
+	Project string `json:"project,omitempty"`
+}
+
+// IsApprovalGate reports whether the issue is a human approval gate.
+func (i *Issue) IsApprovalGate() bool {
+	return slices.Contains(i.Labels, LabelApprovalGate)
+}
+
+// Progress summarizes the state of an issue's descendants, following
+// parent-child edges recursively (children, grandchildren, ...).
+type Progress struct {
+	Closed     int `json:"closed"`
+	Total      int `json:"total"`
+	InProgress int `json:"in_progress,omitempty"`
+	Blocked    int `json:"blocked,omitempty"`
+}
+
+// Percent returns the closed percentage (0-100), or 0 when there are no descendants.
+func (p Progress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Closed * 100 / p.Total
+}
+
+// CreateIssueOptions describes a new issue of any type.
+// Empty optional fields are omitted from the create call.
+type CreateIssueOptions struct {
+	Title       string
+	Description string
+	Type        IssueType
+	Priority    Priority
+	Labels      []string
+	ParentID    string
+	Assignee    string
 }
 
 // CreateResult holds the result of a create operation.
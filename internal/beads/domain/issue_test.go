@@ -86,3 +86,9 @@ func TestIssueType_Values(t *testing.T) {
 	require.Equal(t, IssueType("convoy"), TypeConvoy)
 	require.Equal(t, IssueType("agent"), TypeAgent)
 }
+
+func TestIssue_IsApprovalGate(t *testing.T) {
+	require.True(t, (&Issue{Labels: []string{"spec:plan", LabelApprovalGate}}).IsApprovalGate())
+	require.False(t, (&Issue{Labels: []string{"spec:plan"}}).IsApprovalGate())
+	require.False(t, (&Issue{}).IsApprovalGate())
+}
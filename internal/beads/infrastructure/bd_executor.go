@@ -265,6 +265,47 @@ func (e *BDExecutor) CreateTask(title, description, parentID, assignee string, l
 	return result, nil
 }
 
+// CreateIssue creates a new issue of any type via bd CLI.
+func (e *BDExecutor) CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "CreateIssue completed", "title", opts.Title, "type", opts.Type, "duration", time.Since(start))
+	}()
+
+	issueType := opts.Type
+	if issueType == "" {
+		issueType = domain.TypeTask
+	}
+	args := []string{"create", opts.Title, "-t", string(issueType), "--priority", fmt.Sprintf("%d", opts.Priority), "--json"}
+	if opts.Description != "" {
+		args = append(args, "-d", opts.Description)
+	}
+	if opts.ParentID != "" {
+		args = append(args, "--parent", opts.ParentID)
+	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee", opts.Assignee)
+	}
+	for _, l := range opts.Labels {
+		args = append(args, "--label", l)
+	}
+
+	output, err := e.runBeads(args...)
+	if err != nil {
+		log.Error(log.CatBeads, "CreateIssue failed", "title", opts.Title, "error", err)
+		return domain.CreateResult{}, err
+	}
+
+	var result domain.CreateResult
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		err = fmt.Errorf("failed to parse bd create output: %w", err)
+		log.Error(log.CatBeads, "CreateIssue parse failed", "error", err)
+		return domain.CreateResult{}, err
+	}
+
+	return result, nil
+}
+
 // AddDependency adds a dependency between two tasks via bd CLI.
 func (e *BDExecutor) AddDependency(taskID, dependsOnID string) error {
 	start := time.Now()
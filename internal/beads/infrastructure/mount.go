@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// Mount is a beads database opened as a named project.
+type Mount struct {
+	Name     string
+	Prefix   string
+	BeadsDir string
+	Client   *SQLiteClient
+}
+
+// OpenMount opens the database in beadsDir (an already resolved .beads directory)
+// as the named project. An empty prefix falls back to the database's configured
+// issue_prefix, then to the project name.
+func OpenMount(name, beadsDir, prefix string) (Mount, error) {
+	client, err := NewSQLiteClient(beadsDir)
+	if err != nil {
+		return Mount{}, fmt.Errorf("opening project %s (%s): %w", name, beadsDir, err)
+	}
+
+	if prefix == "" {
+		prefix, err = client.IssuePrefix()
+		if err != nil {
+			log.ErrorErr(log.CatDB, "Failed to read issue prefix", err, "project", name)
+		}
+	}
+	if prefix == "" {
+		prefix = name
+	}
+
+	log.Info(log.CatDB, "Mounted project", "name", name, "prefix", prefix, "path", beadsDir)
+	return Mount{Name: name, Prefix: prefix, BeadsDir: beadsDir, Client: client}, nil
+}
@@ -2,8 +2,10 @@ package infrastructure
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	domain "github.com/zjrosen/perles/internal/beads/domain"
@@ -17,6 +19,7 @@ import (
 var (
 	_ appbeads.VersionReader = (*SQLiteClient)(nil)
 	_ appbeads.CommentReader = (*SQLiteClient)(nil)
+	_ appbeads.EventReader   = (*SQLiteClient)(nil)
 )
 
 // SQLiteClient provides read access to the beads SQLite database.
@@ -69,6 +72,20 @@ func (c *SQLiteClient) Version() (string, error) {
 	return version, nil
 }
 
+// IssuePrefix returns the issue ID prefix configured for the database
+// (the beads config table's issue_prefix), or "" when it is not set.
+func (c *SQLiteClient) IssuePrefix() (string, error) {
+	var prefix string
+	err := c.db.QueryRow("SELECT value FROM config WHERE key = 'issue_prefix'").Scan(&prefix)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "no such table") {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading issue_prefix from config: %w", err)
+	}
+	return prefix, nil
+}
+
 // GetComments fetches comments for an issue.
 func (c *SQLiteClient) GetComments(issueID string) ([]domain.Comment, error) {
 	query := `
@@ -95,3 +112,40 @@ func (c *SQLiteClient) GetComments(issueID string) ([]domain.Comment, error) {
 	}
 	return comments, rows.Err()
 }
+
+// GetEvents fetches the audit history for an issue, oldest first.
+// Databases created by beads versions without an events table return no events.
+func (c *SQLiteClient) GetEvents(issueID string) ([]domain.Event, error) {
+	query := `
+		SELECT id, issue_id, event_type, actor,
+			COALESCE(old_value, ''), COALESCE(new_value, ''), COALESCE(comment, ''),
+			created_at
+		FROM events
+		WHERE issue_id = ?
+		ORDER BY created_at ASC, id ASC
+	`
+	rows, err := c.db.Query(query, issueID)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such table") {
+			return nil, nil
+		}
+		log.ErrorErr(log.CatDB, "GetEvents query failed", err, "issueID", issueID)
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var events []domain.Event
+	for rows.Next() {
+		var event domain.Event
+		if err := rows.Scan(
+			&event.ID, &event.IssueID, &event.Type, &event.Actor,
+			&event.OldValue, &event.NewValue, &event.Comment,
+			&event.CreatedAt,
+		); err != nil {
+			log.ErrorErr(log.CatDB, "GetEvents scan failed", err, "issueID", issueID)
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
@@ -25,6 +25,26 @@ func (q *Query) HasExpand() bool {
 	return q.Expand != nil && q.Expand.Type != ExpandNone
 }
 
+// UsesField returns true if the filter references the given field.
+func (q *Query) UsesField(field string) bool {
+	return exprUsesField(q.Filter, field)
+}
+
+// exprUsesField walks an expression looking for a comparison on field.
+func exprUsesField(expr Expr, field string) bool {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return exprUsesField(e.Left, field) || exprUsesField(e.Right, field)
+	case *NotExpr:
+		return exprUsesField(e.Expr, field)
+	case *CompareExpr:
+		return e.Field == field
+	case *InExpr:
+		return e.Field == field
+	}
+	return false
+}
+
 // BinaryExpr represents "expr AND/OR expr".
 type BinaryExpr struct {
 	Left  Expr
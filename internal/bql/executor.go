@@ -26,6 +26,7 @@ type Executor struct {
 	db            *sql.DB
 	cacheManager  cachemanager.CacheManager[string, []beads.Issue]
 	depGraphCache cachemanager.CacheManager[string, *DependencyGraph]
+	project       string // Project name when several databases are mounted
 }
 
 // depGraphCacheKey is the static key for caching the dependency graph.
@@ -44,6 +45,27 @@ func NewExecutor(
 	}
 }
 
+// WithProject names the project this executor's database is mounted as.
+// Issues are tagged with the name, the BQL project field matches against it,
+// and cache keys are namespaced so executors can share cache managers.
+func (e *Executor) WithProject(name string) *Executor {
+	e.project = name
+	return e
+}
+
+// Project returns the project name, or "" for a single unnamed database.
+func (e *Executor) Project() string {
+	return e.project
+}
+
+// cacheKey namespaces a cache key by project.
+func (e *Executor) cacheKey(key string) string {
+	if e.project == "" {
+		return key
+	}
+	return e.project + "\x00" + key
+}
+
 // maxExpandIterations is the safety limit for unlimited depth expansion.
 const maxExpandIterations = 100
 
@@ -59,6 +81,62 @@ type DependencyEdge struct {
 type DependencyGraph struct {
 	Forward map[string][]DependencyEdge // issue_id -> edges pointing to depends_on_id
 	Reverse map[string][]DependencyEdge // depends_on_id -> edges pointing from issue_id
+	States  map[string]IssueState       // issue_id -> status snapshot used for progress rollups
+}
+
+// IssueState is the per-issue status snapshot stored alongside the dependency graph.
+type IssueState struct {
+	Status  beads.Status
+	Blocked bool
+}
+
+// Rollup returns the progress of every descendant of id reachable through
+// parent-child edges, excluding id itself. Cycles are tolerated.
+func (g *DependencyGraph) Rollup(id string) beads.Progress {
+	var p beads.Progress
+	visited := map[string]bool{id: true}
+	stack := []string{id}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, edge := range g.Reverse[current] {
+			if edge.Type != "parent-child" || visited[edge.TargetID] {
+				continue
+			}
+			visited[edge.TargetID] = true
+			stack = append(stack, edge.TargetID)
+
+			state := g.States[edge.TargetID]
+			p.Total++
+			switch state.Status {
+			case beads.StatusClosed:
+				p.Closed++
+			case beads.StatusInProgress:
+				p.InProgress++
+			}
+			if state.Blocked && state.Status != beads.StatusClosed {
+				p.Blocked++
+			}
+		}
+	}
+
+	return p
+}
+
+// Rollups returns the progress of every issue that has at least one descendant.
+func (g *DependencyGraph) Rollups() map[string]beads.Progress {
+	result := make(map[string]beads.Progress)
+	for id, edges := range g.Reverse {
+		for _, edge := range edges {
+			if edge.Type == "parent-child" {
+				result[id] = g.Rollup(id)
+				break
+			}
+		}
+	}
+	return result
 }
 
 // Execute runs a BQL query and returns matching issues.
@@ -104,7 +182,7 @@ func (e *Executor) Execute(input string) ([]beads.Issue, error) {
 		},
 		false,
 	)
-	issues, err := cache.GetWithRefresh(context.Background(), input, query, cachemanager.DefaultExpiration)
+	issues, err := cache.GetWithRefresh(context.Background(), e.cacheKey(input), query, cachemanager.DefaultExpiration)
 	if err != nil {
 		log.ErrorErr(log.CatBQL, "failed to load issues", err, "query", input)
 		return nil, err
@@ -126,14 +204,23 @@ type IssueDeps struct {
 }
 
 // executeBaseQuery runs the main BQL filter query with batch-loaded dependencies.
-// This uses 3 total queries instead of 8N correlated subqueries:
+// This uses 4 total queries instead of 8N correlated subqueries:
 // 1. Main query (no dependency subqueries)
 // 2. Batch load dependencies for all result IDs
 // 3. Batch load labels for all result IDs
 // 4. Batch load comment counts for all result IDs
+// Progress rollups come from the cached dependency graph (no per-query SQL).
 func (e *Executor) executeBaseQuery(query *Query) ([]beads.Issue, error) {
+	graph, err := e.loadDependencyGraph()
+	if err != nil {
+		return nil, fmt.Errorf("load dependency graph: %w", err)
+	}
+
 	// Build SQL
-	builder := NewSQLBuilder(query)
+	builder := NewSQLBuilder(query).WithProject(e.project)
+	if query.UsesField("progress") {
+		builder = builder.WithProgress(graph.Rollups())
+	}
 	whereClause, orderBy, params := builder.Build()
 
 	// Construct main query WITHOUT dependency subqueries
@@ -164,7 +251,8 @@ func (e *Executor) executeBaseQuery(query *Query) ([]beads.Issue, error) {
 			i.last_activity,
 			i.role_type,
 			i.rig,
-			i.mol_type
+			i.mol_type,
+			EXISTS (SELECT 1 FROM blocked_issues_cache b WHERE b.issue_id = i.id) AS blocked
 		FROM issues i
 		WHERE i.status not in ('deleted', 'tombstone')
 	  AND i.deleted_at is null
@@ -239,6 +327,8 @@ func (e *Executor) executeBaseQuery(query *Query) ([]beads.Issue, error) {
 		if c, ok := commentCounts[id]; ok {
 			issues[i].CommentCount = c
 		}
+		issues[i].Progress = graph.Rollup(id)
+		issues[i].Project = e.project
 	}
 
 	return issues, nil
@@ -298,6 +388,7 @@ func (e *Executor) scanIssuesBase(rows *sql.Rows) ([]beads.Issue, error) {
 			&roleType,
 			&rig,
 			&molType,
+			&issue.Blocked,
 		)
 		if err != nil {
 			log.ErrorErr(log.CatDB, "Scan failed", err)
@@ -697,10 +788,11 @@ func (e *Executor) loadDependencyGraph() (*DependencyGraph, error) {
 		},
 		false,
 	)
-	return cache.GetWithRefresh(context.Background(), depGraphCacheKey, struct{}{}, cachemanager.DefaultExpiration)
+	return cache.GetWithRefresh(context.Background(), e.cacheKey(depGraphCacheKey), struct{}{}, cachemanager.DefaultExpiration)
 }
 
-// loadDependencyGraphFromDB loads the full dependency graph from the database in a single query.
+// loadDependencyGraphFromDB loads the full dependency graph from the database:
+// one query for the edges and one for the issue states used by progress rollups.
 func (e *Executor) loadDependencyGraphFromDB() (*DependencyGraph, error) {
 	log.Debug(log.CatBQL, "Loading dependency graph from database")
 
@@ -725,6 +817,7 @@ func (e *Executor) loadDependencyGraphFromDB() (*DependencyGraph, error) {
 	graph := &DependencyGraph{
 		Forward: make(map[string][]DependencyEdge),
 		Reverse: make(map[string][]DependencyEdge),
+		States:  make(map[string]IssueState),
 	}
 
 	for rows.Next() {
@@ -752,9 +845,48 @@ func (e *Executor) loadDependencyGraphFromDB() (*DependencyGraph, error) {
 		return nil, fmt.Errorf("dependency graph rows: %w", err)
 	}
 
+	if err := e.loadIssueStates(graph); err != nil {
+		return nil, err
+	}
+
 	return graph, nil
 }
 
+// loadIssueStates loads the status and blocked flag of every live issue into the graph.
+func (e *Executor) loadIssueStates(graph *DependencyGraph) error {
+	query := `
+		SELECT i.id, i.status,
+			EXISTS (SELECT 1 FROM blocked_issues_cache b WHERE b.issue_id = i.id)
+		FROM issues i
+		WHERE i.status NOT IN ('deleted', 'tombstone')
+		  AND i.deleted_at IS NULL
+	`
+
+	rows, err := e.db.Query(query)
+	if err != nil {
+		log.ErrorErr(log.CatDB, "Failed to load issue states", err)
+		return fmt.Errorf("load issue states: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var id string
+		var state IssueState
+		if err := rows.Scan(&id, &state.Status, &state.Blocked); err != nil {
+			log.ErrorErr(log.CatDB, "Failed to scan issue state row", err)
+			return fmt.Errorf("scan issue state: %w", err)
+		}
+		graph.States[id] = state
+	}
+
+	if err := rows.Err(); err != nil {
+		log.ErrorErr(log.CatDB, "Issue state rows error", err)
+		return fmt.Errorf("issue state rows: %w", err)
+	}
+
+	return nil
+}
+
 // traverseGraph performs BFS traversal from starting IDs, following edges based on expand type.
 // Returns all reachable issue IDs (including the starting IDs).
 func (e *Executor) traverseGraph(graph *DependencyGraph, startIDs []string, expandType ExpandType, depth int) []string {
@@ -362,6 +362,150 @@ func TestExecutor_LoadsChildrenForEpicWithChildren(t *testing.T) {
 	require.Contains(t, issues[0].Children, "test-2", "epic should show child in Blocks")
 }
 
+func TestExecutor_ProgressRollup(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("epic-1", testutil.IssueType("epic")).
+			WithIssue("epic-2", testutil.IssueType("epic")).
+			WithIssue("task-1", testutil.Status("closed")).
+			WithIssue("task-2", testutil.Status("in_progress")).
+			WithIssue("task-3").
+			WithIssue("task-4", testutil.DeletedAt(time.Now())).
+			WithIssue("task-5", testutil.Status("closed")).
+			WithDependency("epic-2", "epic-1", "parent-child").
+			WithDependency("task-1", "epic-1", "parent-child").
+			WithDependency("task-2", "epic-2", "parent-child").
+			WithDependency("task-3", "epic-2", "parent-child").
+			WithDependency("task-4", "epic-1", "parent-child").
+			WithDependency("task-5", "epic-2", "parent-child").
+			WithDependency("task-3", "task-2", "blocks").
+			WithBlockedCache("task-3")
+	})
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db)
+
+	issues, err := executor.Execute("id in (epic-1, epic-2, task-3)")
+	require.NoError(t, err)
+	require.Len(t, issues, 3)
+
+	byID := make(map[string]beads.Issue)
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	// epic-1 rolls up epic-2 and its children; deleted children are excluded
+	require.Equal(t, beads.Progress{Closed: 2, Total: 5, InProgress: 1, Blocked: 1}, byID["epic-1"].Progress)
+	require.Equal(t, 40, byID["epic-1"].Progress.Percent())
+	require.Equal(t, beads.Progress{Closed: 1, Total: 3, InProgress: 1, Blocked: 1}, byID["epic-2"].Progress)
+	require.Zero(t, byID["task-3"].Progress, "leaf issues have no progress")
+}
+
+func TestExecutor_ProgressFilter(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("epic-1", testutil.IssueType("epic")).
+			WithIssue("epic-2", testutil.IssueType("epic")).
+			WithIssue("task-1", testutil.Status("closed")).
+			WithIssue("task-2").
+			WithIssue("task-3", testutil.Status("closed")).
+			WithDependency("task-1", "epic-1", "parent-child").
+			WithDependency("task-2", "epic-1", "parent-child").
+			WithDependency("task-3", "epic-2", "parent-child")
+	})
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db)
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"progress < 60", []string{"epic-1"}},
+		{"progress = 100", []string{"epic-2"}},
+		{"progress >= 50", []string{"epic-1", "epic-2"}},
+		{"progress > 100", nil},
+		{"type = epic and progress != 100", []string{"epic-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			issues, err := executor.Execute(tt.query)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, issue := range issues {
+				ids = append(ids, issue.ID)
+			}
+			require.ElementsMatch(t, tt.expected, ids, "leaf issues never match a progress filter")
+		})
+	}
+}
+
+func TestExecute_ProgressFilterManyMatches(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder { return b })
+	defer func() { _ = db.Close() }()
+
+	// More matching epics than SQLite allows bound variables (32766)
+	_, err := db.Exec(`
+		WITH RECURSIVE n(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM n WHERE x < 33000)
+		INSERT INTO issues (id, title, status, priority, issue_type)
+		SELECT 'epic-' || x, 'Epic', 'open', 2, 'epic' FROM n
+		UNION ALL
+		SELECT 'task-' || x, 'Task', 'open', 2, 'task' FROM n`)
+	require.NoError(t, err)
+	_, err = db.Exec(`
+		INSERT INTO dependencies (issue_id, depends_on_id, type)
+		SELECT 'task-' || substr(id, 6), id, 'parent-child' FROM issues WHERE issue_type = 'epic'`)
+	require.NoError(t, err)
+
+	executor := newTestExecutor(t, db)
+	issues, err := executor.Execute("progress < 100 and id = epic-42")
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "epic-42", issues[0].ID)
+}
+
+func TestDependencyGraph_RollupToleratesCycles(t *testing.T) {
+	graph := &DependencyGraph{
+		Reverse: map[string][]DependencyEdge{
+			"a": {{TargetID: "b", Type: "parent-child"}, {TargetID: "x", Type: "blocks"}},
+			"b": {{TargetID: "a", Type: "parent-child"}, {TargetID: "c", Type: "parent-child"}},
+		},
+		States: map[string]IssueState{
+			"a": {Status: beads.StatusOpen},
+			"b": {Status: beads.StatusOpen, Blocked: true},
+			"c": {Status: beads.StatusClosed, Blocked: true},
+			"x": {Status: beads.StatusClosed},
+		},
+	}
+
+	// "blocks" edges are ignored, the a<->b cycle does not count a twice, and
+	// closed issues are never reported as blocked
+	require.Equal(t, beads.Progress{Closed: 1, Total: 2, Blocked: 1}, graph.Rollup("a"))
+	require.Len(t, graph.Rollups(), 2)
+}
+
+func TestExecutor_LoadsBlockedFlag(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("task-1").
+			WithIssue("task-2").
+			WithBlockedCache("task-2")
+	})
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db)
+
+	issues, err := executor.Execute("id in (task-1, task-2)")
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+
+	for _, issue := range issues {
+		require.Equal(t, issue.ID == "task-2", issue.Blocked, "issue %s", issue.ID)
+	}
+}
+
 func TestExecutor_LoadsRelated(t *testing.T) {
 	db := setupDB(t, (*testutil.Builder).WithDiscoveredFromTestData)
 	defer func() { _ = db.Close() }()
@@ -0,0 +1,128 @@
+package bql
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// Verify MultiExecutor implements BQLExecutor at compile time.
+var _ BQLExecutor = (*MultiExecutor)(nil)
+
+// MultiExecutor runs each query against several project databases and merges
+// the results, so a single column can span projects. Each executor filters its
+// own database (the project field resolves per executor); the merged results
+// are re-sorted by the query's ORDER BY.
+type MultiExecutor struct {
+	executors []*Executor
+}
+
+// NewMultiExecutor creates an executor over the given per-project executors.
+func NewMultiExecutor(executors ...*Executor) *MultiExecutor {
+	return &MultiExecutor{executors: executors}
+}
+
+// Execute runs the query against every project and merges the results.
+func (m *MultiExecutor) Execute(input string) ([]beads.Issue, error) {
+	// Parse once up front so syntax errors are reported once, not per project
+	query, err := NewParser(input).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse error: %w", err)
+	}
+	if err := Validate(query); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	var merged []beads.Issue
+	for _, e := range m.executors {
+		issues, err := e.Execute(input)
+		if err != nil {
+			log.ErrorErr(log.CatBQL, "Project query failed", err, "project", e.Project(), "query", input)
+			return nil, fmt.Errorf("project %s: %w", e.Project(), err)
+		}
+		merged = append(merged, issues...)
+	}
+
+	if len(m.executors) > 1 {
+		SortIssues(merged, query.OrderBy)
+	}
+	return merged, nil
+}
+
+// SortIssues sorts issues in place by ORDER BY terms, matching the SQL
+// ordering used for a single database (updated DESC when no terms are given).
+// Ties keep their existing relative order.
+func SortIssues(issues []beads.Issue, orderBy []OrderTerm) {
+	if len(orderBy) == 0 {
+		orderBy = []OrderTerm{{Field: "updated", Desc: true}}
+	}
+	slices.SortStableFunc(issues, func(a, b beads.Issue) int {
+		for _, term := range orderBy {
+			c := compareIssueField(&a, &b, term.Field)
+			if term.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// compareIssueField compares one sortable field of two issues.
+// Fields without an in-memory value compare equal.
+func compareIssueField(a, b *beads.Issue, field string) int {
+	switch field {
+	case "priority":
+		return cmp.Compare(a.Priority, b.Priority)
+	case "created":
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case "updated":
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	case "last_activity":
+		return a.LastActivity.Compare(b.LastActivity)
+	case "blocked":
+		return compareBool(a.Blocked, b.Blocked)
+	case "project":
+		return strings.Compare(a.Project, b.Project)
+	case "id":
+		return strings.Compare(a.ID, b.ID)
+	case "title":
+		return strings.Compare(a.TitleText, b.TitleText)
+	case "type":
+		return strings.Compare(string(a.Type), string(b.Type))
+	case "status":
+		return strings.Compare(string(a.Status), string(b.Status))
+	case "assignee":
+		return strings.Compare(a.Assignee, b.Assignee)
+	case "sender":
+		return strings.Compare(a.Sender, b.Sender)
+	case "created_by":
+		return strings.Compare(a.CreatedBy, b.CreatedBy)
+	case "agent_state":
+		return strings.Compare(a.AgentState, b.AgentState)
+	case "role_type":
+		return strings.Compare(a.RoleType, b.RoleType)
+	case "rig":
+		return strings.Compare(a.Rig, b.Rig)
+	case "mol_type":
+		return strings.Compare(a.MolType, b.MolType)
+	}
+	return 0
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,119 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/testutil"
+)
+
+// newTestMultiExecutor mounts two databases as the "api" and "web" projects.
+func newTestMultiExecutor(t *testing.T) *MultiExecutor {
+	now := time.Now()
+	apiDB := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("api-1", testutil.Priority(2), testutil.UpdatedAt(now.Add(-3*time.Hour))).
+			WithIssue("api-2", testutil.Priority(0), testutil.IssueType("bug"), testutil.UpdatedAt(now.Add(-1*time.Hour))).
+			WithIssue("api-3", testutil.IssueType("epic"), testutil.UpdatedAt(now)).
+			WithDependency("api-1", "api-3", "parent-child")
+	})
+	webDB := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("web-1", testutil.Priority(1), testutil.IssueType("bug"), testutil.UpdatedAt(now.Add(-2*time.Hour))).
+			WithIssue("web-2", testutil.Priority(3), testutil.UpdatedAt(now.Add(-4*time.Hour)))
+	})
+	t.Cleanup(func() {
+		_ = apiDB.Close()
+		_ = webDB.Close()
+	})
+
+	return NewMultiExecutor(
+		newTestExecutor(t, apiDB).WithProject("api"),
+		newTestExecutor(t, webDB).WithProject("web"),
+	)
+}
+
+func issueIDs(issues []beads.Issue) []string {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	return ids
+}
+
+func TestMultiExecutor_MergesProjects(t *testing.T) {
+	executor := newTestMultiExecutor(t)
+
+	issues, err := executor.Execute("type = bug")
+	require.NoError(t, err)
+	require.Equal(t, []string{"api-2", "web-1"}, issueIDs(issues), "default order is updated desc across projects")
+	require.Equal(t, "api", issues[0].Project)
+	require.Equal(t, "web", issues[1].Project)
+}
+
+func TestMultiExecutor_ProjectField(t *testing.T) {
+	executor := newTestMultiExecutor(t)
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"project = web", []string{"web-1", "web-2"}},
+		{"project != web and type = bug", []string{"api-2"}},
+		{"project ~ WE", []string{"web-1", "web-2"}},
+		{"project in (api, web) and priority = 0", []string{"api-2"}},
+		{"project not in (api)", []string{"web-1", "web-2"}},
+		{"project = mobile", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			issues, err := executor.Execute(tt.query)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expected, issueIDs(issues))
+		})
+	}
+}
+
+func TestMultiExecutor_OrderByAcrossProjects(t *testing.T) {
+	executor := newTestMultiExecutor(t)
+
+	issues, err := executor.Execute("status = open order by priority asc")
+	require.NoError(t, err)
+	require.Equal(t, []string{"api-2", "web-1", "api-1", "api-3", "web-2"}, issueIDs(issues))
+
+	issues, err = executor.Execute("status = open order by project desc, priority asc")
+	require.NoError(t, err)
+	require.Equal(t, []string{"web-1", "web-2", "api-2", "api-1", "api-3"}, issueIDs(issues))
+}
+
+func TestMultiExecutor_ExpandStaysWithinProject(t *testing.T) {
+	executor := newTestMultiExecutor(t)
+
+	issues, err := executor.Execute("id = api-3 expand down")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"api-3", "api-1"}, issueIDs(issues))
+	for _, issue := range issues {
+		require.Equal(t, "api", issue.Project)
+	}
+}
+
+func TestMultiExecutor_ReportsParseErrorsOnce(t *testing.T) {
+	executor := newTestMultiExecutor(t)
+
+	_, err := executor.Execute("project = ")
+	require.ErrorContains(t, err, "parse error")
+	require.NotContains(t, err.Error(), "project api")
+}
+
+func TestExecutor_ProjectFieldWithoutProjectName(t *testing.T) {
+	db := setupDB(t, (*testutil.Builder).WithStandardTestData)
+	defer func() { _ = db.Close() }()
+
+	issues, err := newTestExecutor(t, db).Execute("project = api")
+	require.NoError(t, err)
+	require.Empty(t, issues, "a single unnamed database matches no project")
+}
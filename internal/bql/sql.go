@@ -1,14 +1,20 @@
 package bql
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 )
 
 // SQLBuilder converts a BQL AST to SQL.
 type SQLBuilder struct {
-	query  *Query
-	params []any
+	query    *Query
+	params   []any
+	progress map[string]beads.Progress
+	project  string
 }
 
 // NewSQLBuilder creates a builder for the query.
@@ -16,6 +22,20 @@ func NewSQLBuilder(query *Query) *SQLBuilder {
 	return &SQLBuilder{query: query}
 }
 
+// WithProgress supplies the descendant rollups used to resolve the computed
+// progress field. Only issues present in the map can match a progress filter.
+func (b *SQLBuilder) WithProgress(progress map[string]beads.Progress) *SQLBuilder {
+	b.progress = progress
+	return b
+}
+
+// WithProject sets the name of the project the query runs against. The project
+// field is constant within a database, so comparisons on it resolve to 1 = 1 or 1 = 0.
+func (b *SQLBuilder) WithProject(name string) *SQLBuilder {
+	b.project = name
+	return b
+}
+
 // Build generates the SQL WHERE clause and ORDER BY.
 func (b *SQLBuilder) Build() (whereClause string, orderBy string, params []any) {
 	if b.query.Filter != nil {
@@ -82,6 +102,12 @@ func (b *SQLBuilder) buildCompare(e *CompareExpr) string {
 		}
 		return fmt.Sprintf("%s = 0", column)
 
+	case "progress":
+		return b.buildProgress(e)
+
+	case "project":
+		return sqlBool(matchProject(b.project, e.Op, e.Value.String))
+
 	case "label":
 		// Label check via labels table
 		// Supports exact match (=, !=) and partial match (~, !~)
@@ -148,6 +174,68 @@ func (b *SQLBuilder) buildCompare(e *CompareExpr) string {
 	return fmt.Sprintf("%s %s ?", column, b.opToSQL(e.Op))
 }
 
+// buildProgress resolves a progress comparison against the precomputed rollups
+// and emits an ID list, since progress is not stored in the database. The IDs
+// are bound as a single JSON array so large epics stay under SQLite's limit on
+// bound variables.
+func (b *SQLBuilder) buildProgress(e *CompareExpr) string {
+	var ids []string
+	for id, p := range b.progress {
+		if p.Total > 0 && comparePercent(p.Percent(), e.Op, e.Value.Int) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return "1 = 0"
+	}
+
+	slices.Sort(ids)
+	encoded, _ := json.Marshal(ids) // []string always marshals
+	b.params = append(b.params, string(encoded))
+	return "i.id IN (SELECT value FROM json_each(?))"
+}
+
+// matchProject applies a string comparison to the project name. Contains
+// matching is case-insensitive, like SQLite LIKE.
+func matchProject(project string, op TokenType, value string) bool {
+	switch op {
+	case TokenNeq:
+		return project != value
+	case TokenContains:
+		return strings.Contains(strings.ToLower(project), strings.ToLower(value))
+	case TokenNotContains:
+		return !strings.Contains(strings.ToLower(project), strings.ToLower(value))
+	default:
+		return project == value
+	}
+}
+
+// sqlBool renders a condition resolved in Go as a constant SQL predicate.
+func sqlBool(match bool) string {
+	if match {
+		return "1 = 1"
+	}
+	return "1 = 0"
+}
+
+// comparePercent applies a comparison operator to two integers.
+func comparePercent(left int, op TokenType, right int) bool {
+	switch op {
+	case TokenNeq:
+		return left != right
+	case TokenLt:
+		return left < right
+	case TokenGt:
+		return left > right
+	case TokenLte:
+		return left <= right
+	case TokenGte:
+		return left >= right
+	default:
+		return left == right
+	}
+}
+
 // buildIn builds SQL for an IN expression.
 func (b *SQLBuilder) buildIn(e *InExpr) string {
 	// Handle label field specially
@@ -165,6 +253,11 @@ func (b *SQLBuilder) buildIn(e *InExpr) string {
 		return subquery
 	}
 
+	if e.Field == "project" {
+		match := slices.ContainsFunc(e.Values, func(v Value) bool { return v.String == b.project })
+		return sqlBool(match != e.Not)
+	}
+
 	column := b.fieldToColumn(e.Field)
 	placeholders := make([]string, len(e.Values))
 
@@ -250,9 +343,14 @@ func (b *SQLBuilder) dateToSQL(dateStr string) string {
 }
 
 // buildOrderBy builds the ORDER BY clause.
+// Project terms are skipped: the project is constant within a database and
+// MultiExecutor sorts by it after merging results.
 func (b *SQLBuilder) buildOrderBy() string {
 	var parts []string
 	for _, term := range b.query.OrderBy {
+		if term.Field == "project" {
+			continue
+		}
 		col := b.fieldToColumn(term.Field)
 		dir := "ASC"
 		if term.Desc {
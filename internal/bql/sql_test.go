@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 )
 
 func TestSQLBuilder_SimpleComparison(t *testing.T) {
@@ -98,6 +100,55 @@ func TestSQLBuilder_SpecialFields(t *testing.T) {
 		require.Equal(t, "i.id NOT IN (SELECT issue_id FROM blocked_issues_cache)", where)
 	})
 
+	t.Run("progress uses rollups", func(t *testing.T) {
+		parser := NewParser("progress < 50")
+		query, err := parser.Parse()
+		require.NoError(t, err)
+
+		builder := NewSQLBuilder(query).WithProgress(map[string]beads.Progress{
+			"epic-2": {Closed: 1, Total: 4},
+			"epic-1": {Closed: 0, Total: 2},
+			"epic-3": {Closed: 3, Total: 4},
+		})
+		where, _, params := builder.Build()
+
+		require.Equal(t, "i.id IN (SELECT value FROM json_each(?))", where)
+		require.Equal(t, []any{`["epic-1","epic-2"]`}, params)
+	})
+
+	t.Run("progress without matches", func(t *testing.T) {
+		parser := NewParser("progress = 100")
+		query, err := parser.Parse()
+		require.NoError(t, err)
+
+		builder := NewSQLBuilder(query)
+		where, _, params := builder.Build()
+
+		require.Equal(t, "1 = 0", where)
+		require.Empty(t, params)
+	})
+
+	t.Run("project resolves against builder project", func(t *testing.T) {
+		parser := NewParser("project = api or project in (web, mobile)")
+		query, err := parser.Parse()
+		require.NoError(t, err)
+
+		where, _, params := NewSQLBuilder(query).WithProject("web").Build()
+
+		require.Equal(t, "(1 = 0 OR 1 = 1)", where)
+		require.Empty(t, params)
+	})
+
+	t.Run("order by project is skipped", func(t *testing.T) {
+		parser := NewParser("status = open order by project, priority desc")
+		query, err := parser.Parse()
+		require.NoError(t, err)
+
+		_, orderBy, _ := NewSQLBuilder(query).WithProject("web").Build()
+
+		require.Equal(t, "i.priority DESC", orderBy)
+	})
+
 	t.Run("ready true", func(t *testing.T) {
 		parser := NewParser("ready = true")
 		query, err := parser.Parse()
@@ -32,6 +32,8 @@ var ValidFields = map[string]FieldType{
 	"mol_type":      FieldString,
 	"created":       FieldDate,
 	"updated":       FieldDate,
+	"progress":      FieldPercent,
+	"project":       FieldString,
 }
 
 // FieldType categorizes fields for validation.
@@ -43,6 +45,7 @@ const (
 	FieldPriority
 	FieldBool
 	FieldDate
+	FieldPercent // Computed 0-100 value, e.g. descendant progress rollups
 )
 
 // ValidTypeValues are the valid values for the type field.
@@ -136,7 +139,7 @@ func validateIn(e *InExpr) error {
 	}
 
 	// IN is only valid for enum, string, and priority fields
-	if fieldType == FieldBool || fieldType == FieldDate {
+	if fieldType == FieldBool || fieldType == FieldDate || fieldType == FieldPercent {
 		return fmt.Errorf("operator IN is not valid for field %q", e.Field)
 	}
 
@@ -180,6 +183,12 @@ func validateOperator(field string, fieldType FieldType, op TokenType) error {
 		if op == TokenContains || op == TokenNotContains {
 			return fmt.Errorf("operator %q is not valid for date field %q", op, field)
 		}
+
+	case FieldPercent:
+		// Percent supports comparison operators, but not ~
+		if op == TokenContains || op == TokenNotContains {
+			return fmt.Errorf("operator %q is not valid for numeric field %q", op, field)
+		}
 	}
 
 	return nil
@@ -211,6 +220,14 @@ func validateValue(field string, fieldType FieldType, value Value) error {
 			return fmt.Errorf("field %q requires a date value (today, yesterday, -Nd, or ISO date), got %q", field, value.Raw)
 		}
 
+	case FieldPercent:
+		if value.Type != ValueInt {
+			return fmt.Errorf("field %q requires a number 0-100, got %q", field, value.Raw)
+		}
+		if value.Int < 0 || value.Int > 100 {
+			return fmt.Errorf("field %q requires a number 0-100, got %d", field, value.Int)
+		}
+
 	case FieldEnum:
 		// Validate enum values
 		switch field {
@@ -234,10 +251,14 @@ func validateValue(field string, fieldType FieldType, value Value) error {
 // validateOrderField checks if a field can be used in ORDER BY.
 func validateOrderField(field string) error {
 	// Check field exists
-	_, ok := ValidFields[field]
+	fieldType, ok := ValidFields[field]
 	if !ok {
 		return fmt.Errorf("unknown field in ORDER BY: %q (valid: %s)", field, validFieldNames())
 	}
+	// Computed fields have no SQL column to sort by
+	if fieldType == FieldPercent {
+		return fmt.Errorf("field %q cannot be used in ORDER BY", field)
+	}
 	return nil
 }
 
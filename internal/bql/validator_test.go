@@ -38,6 +38,12 @@ func TestValidate_ValidQueries(t *testing.T) {
 		"created > yesterday",
 		"created > -7d",
 		"updated >= -30d",
+		"progress < 50",
+		"progress = 100",
+		"type = epic and progress >= 0",
+		"project = api",
+		"project in (api, web)",
+		"project ~ web order by project",
 		"type = bug and priority = P0",
 		"type in (bug, task)",
 		"status in (open, in_progress)",
@@ -95,6 +101,7 @@ func TestValidate_InvalidOperator(t *testing.T) {
 		{"enum with less than", "status < open"},
 		{"enum with contains", "type ~ bug"},
 		{"date with contains", "created ~ today"},
+		{"progress with contains", "progress ~ 50"},
 	}
 
 	for _, tc := range tests {
@@ -121,6 +128,8 @@ func TestValidate_InvalidValue(t *testing.T) {
 		{"priority field with string", "priority = high"},
 		{"priority integer too high", "priority = 5"},
 		{"priority integer negative", "priority = -1"},
+		{"progress with string", "progress = half"},
+		{"progress above 100", "progress > 101"},
 	}
 
 	for _, tc := range tests {
@@ -142,6 +151,7 @@ func TestValidate_InvalidIn(t *testing.T) {
 	}{
 		{"in with boolean field", "blocked in (true, false)"},
 		{"in with date field", "created in (today, yesterday)"},
+		{"in with progress field", "progress in (50, 100)"},
 		{"in with invalid type values", "type in (invalid, unknown)"},
 	}
 
@@ -167,6 +177,16 @@ func TestValidate_InvalidOrderBy(t *testing.T) {
 	require.Contains(t, err.Error(), "unknown field in ORDER BY")
 }
 
+func TestValidate_OrderByComputedField(t *testing.T) {
+	parser := NewParser("type = epic order by progress")
+	q, err := parser.Parse()
+	require.NoError(t, err)
+
+	err = Validate(q)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be used in ORDER BY")
+}
+
 func TestValidate_BinaryExprWithInvalidChild(t *testing.T) {
 	// Test that validation propagates through binary expressions
 	parser := NewParser("type = bug and foo = bar")
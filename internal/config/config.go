@@ -23,17 +23,76 @@ type ColumnConfig struct {
 	IssueID  string `mapstructure:"issue_id"`  // Root issue ID (required when type=tree)
 	TreeMode string `mapstructure:"tree_mode"` // "deps" (default) or "child" for tree columns
 	Color    string `mapstructure:"color"`     // hex color e.g. "#10B981"
+
+	// Card overrides the view's card template for this column (bql columns only).
+	Card *CardConfig `mapstructure:"card"`
 }
 
 // ViewConfig defines a named board view with its column configuration.
 type ViewConfig struct {
 	Name    string         `mapstructure:"name"`
 	Columns []ColumnConfig `mapstructure:"columns"`
+
+	// Card is the default card template for every column in the view.
+	Card *CardConfig `mapstructure:"card"`
+}
+
+// Card densities.
+const (
+	CardDensityNormal  = "normal"  // Title line (wraps) plus a metadata line
+	CardDensityCompact = "compact" // Single truncated line with inline metadata
+)
+
+// Card field names accepted in CardConfig.Fields.
+const (
+	CardFieldAssignee     = "assignee"
+	CardFieldLabels       = "labels"
+	CardFieldAge          = "age"
+	CardFieldComments     = "comments"
+	CardFieldProgress     = "progress"
+	CardFieldBlocked      = "blocked"
+	CardFieldAgentState   = "agent_state"
+	CardFieldLastActivity = "last_activity"
+	CardFieldProject      = "project"
+)
+
+// ValidCardFields lists the field names accepted in a card template.
+var ValidCardFields = []string{
+	CardFieldAssignee,
+	CardFieldLabels,
+	CardFieldAge,
+	CardFieldComments,
+	CardFieldProgress,
+	CardFieldBlocked,
+	CardFieldAgentState,
+	CardFieldLastActivity,
+	CardFieldProject,
+}
+
+// CardConfig selects which fields a board card shows and how densely it renders.
+type CardConfig struct {
+	Fields  []string `mapstructure:"fields"`  // Ordered list of card fields (see ValidCardFields)
+	Density string   `mapstructure:"density"` // "normal" (default) or "compact"
+}
+
+// IsCompact returns whether the card renders on a single line.
+func (c CardConfig) IsCompact() bool {
+	return c.Density == CardDensityCompact
+}
+
+// CardFor returns the effective card template for the column at colIndex.
+// A column-level card wins over the view-level card; nil means the built-in layout.
+func (v ViewConfig) CardFor(colIndex int) *CardConfig {
+	if colIndex >= 0 && colIndex < len(v.Columns) && v.Columns[colIndex].Card != nil {
+		return v.Columns[colIndex].Card
+	}
+	return v.Card
 }
 
 // Config holds all configuration options for perles.
 type Config struct {
 	BeadsDir      string              `mapstructure:"beads_dir"`
+	Projects      []ProjectConfig     `mapstructure:"projects"`
 	AutoRefresh   bool                `mapstructure:"auto_refresh"`
 	UI            UIConfig            `mapstructure:"ui"`
 	Theme         ThemeConfig         `mapstructure:"theme"`
@@ -48,6 +107,15 @@ type Config struct {
 	ResolvedBeadsDir string `mapstructure:"-" yaml:"-"`
 }
 
+// ProjectConfig mounts a beads database as a named project. When projects are
+// configured they replace the single beads_dir, and the first project is the
+// default for new issues and agents.
+type ProjectConfig struct {
+	Name   string `mapstructure:"name"`   // Value matched by the BQL project field
+	Path   string `mapstructure:"path"`   // Project directory or .beads directory
+	Prefix string `mapstructure:"prefix"` // Issue ID prefix for routing; defaults to the database's issue_prefix, then Name
+}
+
 // UIConfig holds user interface configuration options.
 type UIConfig struct {
 	ShowCounts    bool              `mapstructure:"show_counts"`
@@ -199,6 +267,176 @@ func DefaultTimeoutsConfig() TimeoutsConfig {
 	}
 }
 
+// LimitsConfig holds global resource limits enforced by the control plane scheduler.
+// Zero values mean unlimited. Token budgets count output tokens.
+type LimitsConfig struct {
+	// MaxWorkflows is the maximum number of concurrently running workflows.
+	// Workflows started over the limit are queued rather than rejected.
+	MaxWorkflows int `mapstructure:"max_workflows"`
+
+	// MaxWorkers is the maximum number of live workers across all workflows.
+	MaxWorkers int `mapstructure:"max_workers"`
+
+	// WorkflowTokens and WorkflowCostUSD cap the spend of a single workflow.
+	WorkflowTokens  int64   `mapstructure:"workflow_tokens"`
+	WorkflowCostUSD float64 `mapstructure:"workflow_cost_usd"`
+
+	// TotalTokens and TotalCostUSD cap the spend of all workflows combined.
+	TotalTokens  int64   `mapstructure:"total_tokens"`
+	TotalCostUSD float64 `mapstructure:"total_cost_usd"`
+
+	// Queue selects the order queued workflows start in: "fifo" (default) or "priority".
+	Queue string `mapstructure:"queue"`
+
+	// BudgetAlerts are the fractions of a workflow's own budget (budget_usd or
+	// budget_tokens) at which the user is notified. Reaching the budget pauses
+	// the workflow. Default: [0.5, 0.8]
+	BudgetAlerts []float64 `mapstructure:"budget_alerts"`
+}
+
+// HealthConfig configures workflow health monitoring and stuck-workflow recovery.
+// Zero values keep the defaults. Workflow templates can override it with a
+// health block in their frontmatter.
+type HealthConfig struct {
+	// HeartbeatTimeout marks a workflow unhealthy when no events arrive for this long.
+	HeartbeatTimeout time.Duration `mapstructure:"heartbeat_timeout" yaml:"heartbeat_timeout"`
+
+	// ProgressTimeout declares a workflow stuck when no worker output arrives for this long.
+	ProgressTimeout time.Duration `mapstructure:"progress_timeout" yaml:"progress_timeout"`
+
+	// RecoveryBackoff is the minimum time between recovery attempts.
+	RecoveryBackoff time.Duration `mapstructure:"recovery_backoff" yaml:"recovery_backoff"`
+
+	// CheckInterval is how often workflows are checked. Only read from the
+	// global config.
+	CheckInterval time.Duration `mapstructure:"check_interval" yaml:"check_interval"`
+
+	// WorkerTimeout declares a single worker stuck when it has been working
+	// without output for this long (default: 10m).
+	WorkerTimeout time.Duration `mapstructure:"worker_timeout" yaml:"worker_timeout"`
+
+	// WorkerNudges is how many times a stuck worker is nudged before it is
+	// replaced and its task reassigned (default: 1). Zero replaces immediately.
+	WorkerNudges *int `mapstructure:"worker_nudges" yaml:"worker_nudges"`
+
+	// Escalation is the recovery ladder for a stuck workflow. Each recovery
+	// attempt takes the next step; once the ladder is exhausted the workflow is
+	// left stuck. Default: nudge the coordinator 3 times.
+	Escalation []EscalationStepConfig `mapstructure:"escalation" yaml:"escalation"`
+}
+
+// EscalationStepConfig is one step of the recovery escalation ladder.
+type EscalationStepConfig struct {
+	// Action is one of nudge, replace_coordinator, replace_worker, pause, notify or fail.
+	Action string `mapstructure:"action" yaml:"action"`
+
+	// Message is sent by nudge (to the coordinator) and notify (to the user)
+	// steps instead of the default message.
+	Message string `mapstructure:"message" yaml:"message"`
+
+	// Repeat is how many times the step is attempted before escalating (default: 1).
+	Repeat int `mapstructure:"repeat" yaml:"repeat"`
+}
+
+// escalationActions are the recovery actions an escalation step can take.
+var escalationActions = []string{"nudge", "replace_coordinator", "replace_worker", "pause", "notify", "fail"}
+
+// PolicyConfig restricts the tools of the agents in one role. Providers enforce
+// what their CLI supports natively; every rule is also stated in the agent's
+// system prompt. Workflow templates can override it per role with a policies
+// block in their frontmatter.
+type PolicyConfig struct {
+	// AllowedCommands are the shell commands agents may run, matched by prefix
+	// (e.g. "go test", "git"). Empty allows any command.
+	AllowedCommands []string `mapstructure:"allowed_commands" yaml:"allowed_commands"`
+
+	// WritablePaths are path globs agents may write. Empty allows any path.
+	WritablePaths []string `mapstructure:"writable_paths" yaml:"writable_paths"`
+
+	// ReadOnly forbids writing files at all.
+	ReadOnly bool `mapstructure:"read_only" yaml:"read_only"`
+
+	// Network is "allow" (default) or "deny".
+	Network string `mapstructure:"network" yaml:"network"`
+
+	// ProtectedPaths are path globs agents must never read or write.
+	ProtectedPaths []string `mapstructure:"protected_paths" yaml:"protected_paths"`
+}
+
+// ToolPolicy converts the policy to its provider-agnostic form.
+func (p PolicyConfig) ToolPolicy() client.ToolPolicy {
+	return client.ToolPolicy{
+		AllowedCommands: p.AllowedCommands,
+		WritablePaths:   p.WritablePaths,
+		ReadOnly:        p.ReadOnly,
+		DenyNetwork:     p.Network == "deny",
+		ProtectedPaths:  p.ProtectedPaths,
+	}
+}
+
+// policyRoles are the roles a policy can be configured for.
+var policyRoles = []string{"coordinator", "worker", "implementer", "reviewer", "researcher", "observer"}
+
+// DefaultPolicies returns the built-in tool policies: reviewers never write files.
+func DefaultPolicies() map[string]PolicyConfig {
+	return map[string]PolicyConfig{
+		"reviewer": {ReadOnly: true},
+	}
+}
+
+// ToolPolicies resolves the tool policies of a workflow. Each layer replaces
+// the policies of the roles it configures: the built-in defaults, then the
+// global orchestration.policies, then the template's policies block.
+func ToolPolicies(global, template map[string]PolicyConfig) client.ToolPolicies {
+	policies := make(client.ToolPolicies)
+	for _, layer := range []map[string]PolicyConfig{DefaultPolicies(), global, template} {
+		for role, policy := range layer {
+			policies[role] = policy.ToolPolicy()
+		}
+	}
+	return policies
+}
+
+// TriggersConfig holds automation rules that start workflows for matching issues.
+type TriggersConfig struct {
+	// DryRun logs the workflows the rules would start instead of starting them.
+	DryRun bool `mapstructure:"dry_run"`
+
+	// Rules are evaluated whenever the beads database changes.
+	Rules []TriggerRuleConfig `mapstructure:"rules"`
+}
+
+// TriggerRuleConfig starts a workflow for every issue matching a BQL query.
+// Each matched issue is labeled so the rule fires once per issue.
+type TriggerRuleConfig struct {
+	// Name identifies the rule in logs and workflow labels (default: the template).
+	Name string `mapstructure:"name"`
+
+	// When is the BQL query selecting the issues to start workflows for.
+	When string `mapstructure:"when"`
+
+	// Template is the workflow template to run. The matched issue is passed
+	// as the epic_id argument.
+	Template string `mapstructure:"template"`
+
+	// Args are additional template argument values.
+	Args map[string]string `mapstructure:"args"`
+
+	// Worktree runs the workflow in a git worktree based on BaseBranch.
+	Worktree   bool   `mapstructure:"worktree"`
+	BaseBranch string `mapstructure:"base_branch"`
+
+	// Priority orders the workflows in the start queue.
+	Priority int `mapstructure:"priority"`
+
+	// BudgetUSD caps each workflow's spend (0 = no budget).
+	BudgetUSD float64 `mapstructure:"budget_usd"`
+
+	// Label is added to matched issues so the rule doesn't fire twice.
+	// Default: "perles-started"
+	Label string `mapstructure:"label"`
+}
+
 // OrchestrationConfig holds orchestration mode configuration.
 type OrchestrationConfig struct {
 	Client            string               `mapstructure:"client"`             // "claude" (default), "amp", "codex", or "gemini" - backward compat
@@ -219,8 +457,91 @@ type OrchestrationConfig struct {
 	SessionStorage    SessionStorageConfig `mapstructure:"session_storage"` // Session storage location configuration
 	Templates         TemplatesConfig      `mapstructure:"templates"`       // Template rendering variables
 	Timeouts          TimeoutsConfig       `mapstructure:"timeouts"`        // Initialization phase timeout configuration
+	Limits            LimitsConfig         `mapstructure:"limits"`          // Global workflow, worker and spend limits
+	Triggers          TriggersConfig       `mapstructure:"triggers"`        // Automation rules that start workflows for matching issues
+
+	// Health configures stuck-workflow detection and the recovery escalation ladder
+	Health HealthConfig `mapstructure:"health"`
+
+	// Policies restricts agent tools per role (coordinator, worker, implementer,
+	// reviewer, researcher, observer)
+	Policies map[string]PolicyConfig `mapstructure:"policies"`
+
+	// ContextHandoff replaces processes with a fresh session before they run
+	// out of context
+	ContextHandoff ContextHandoffConfig `mapstructure:"context_handoff"`
+
+	// Autoscale spawns and retires workers to match the ready tasks of a
+	// workflow's epic
+	Autoscale AutoscaleConfig `mapstructure:"autoscale"`
+
+	// Retry retries failed tasks on a fresh worker, optionally on a different
+	// provider
+	Retry RetryConfig `mapstructure:"retry"`
+}
+
+// ContextHandoffConfig configures automatic context handoffs. When a process's
+// context usage crosses the threshold it is asked for a handoff summary and
+// then replaced by a fresh process seeded with that summary.
+type ContextHandoffConfig struct {
+	// Threshold is the context window usage, in percent, that triggers a
+	// handoff (e.g. 85). Zero disables handoffs (default).
+	Threshold float64 `mapstructure:"threshold" yaml:"threshold"`
+}
+
+// DefaultAutoscaleMaxWorkers is the worker cap used when max_workers is unset.
+const DefaultAutoscaleMaxWorkers = 4
+
+// AutoscaleConfig configures the worker autoscaler. When enabled, workflows
+// with an epic spawn workers while the epic has more ready tasks than
+// unassigned workers and retire workers that stay idle past the cooldown.
+// Zero values keep the defaults.
+type AutoscaleConfig struct {
+	// Enabled turns the autoscaler on (default: false).
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+
+	// MinWorkers is the number of workers never retired (default: 0).
+	MinWorkers int `mapstructure:"min_workers" yaml:"min_workers"`
+
+	// MaxWorkers caps the workers the autoscaler spawns up to (default: 4).
+	MaxWorkers int `mapstructure:"max_workers" yaml:"max_workers"`
+
+	// IdleCooldown is how long a worker must sit idle before it is retired
+	// (default: 5m).
+	IdleCooldown time.Duration `mapstructure:"idle_cooldown" yaml:"idle_cooldown"`
+
+	// Interval is how often the worker pool is evaluated (default: 30s).
+	Interval time.Duration `mapstructure:"interval" yaml:"interval"`
+}
+
+// RetryConfig configures task retries. When max_attempts is above 1, a task
+// marked failed is reopened and handed to a fresh worker after a backoff, and
+// every attempt is recorded as a comment on the task. Zero values keep the
+// defaults.
+type RetryConfig struct {
+	// MaxAttempts is the number of attempts a task gets, including the first.
+	// Zero or 1 disables retries (default: 0).
+	MaxAttempts int `mapstructure:"max_attempts" yaml:"max_attempts"`
+
+	// Backoff is the delay before the first retry, doubled for every further
+	// retry (default: 30s).
+	Backoff time.Duration `mapstructure:"backoff" yaml:"backoff"`
+
+	// Client is the provider retried tasks run on (default: worker client).
+	Client string `mapstructure:"client" yaml:"client"`
+
+	// RetryOn limits retries to these failure kinds: rate_limit,
+	// context_exceeded, tool_error, gave_up (default: all).
+	RetryOn []string `mapstructure:"retry_on" yaml:"retry_on"`
+
+	// RateLimitCooldown is how long a provider gets no new workers after a
+	// rate limit, across all workflows (default: 5m).
+	RateLimitCooldown time.Duration `mapstructure:"rate_limit_cooldown" yaml:"rate_limit_cooldown"`
 }
 
+// retryFailureKinds are the failure kinds retry_on accepts.
+var retryFailureKinds = []string{"rate_limit", "context_exceeded", "tool_error", "gave_up"}
+
 // ClaudeClientConfig holds Claude-specific settings.
 type ClaudeClientConfig struct {
 	Model string            `mapstructure:"model"` // sonnet (default), opus, haiku
@@ -290,7 +611,8 @@ func (o OrchestrationConfig) IsObserverEnabled() bool {
 
 // AgentProviders returns the AgentProviders map for coordinator, worker, and observer roles.
 // This is the preferred way to get AI clients for orchestration.
-// Observer is only included when ObserverEnabled is explicitly set to true.
+// Observer is only included when ObserverEnabled is explicitly set to true, and
+// the retry worker only when retry.client is set.
 func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 	coordType := o.CoordinatorClientType()
 	workerType := o.WorkerClientType()
@@ -305,6 +627,11 @@ func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 		providers[client.RoleObserver] = client.NewAgentProvider(observerType, o.extensionsForObserver(observerType))
 	}
 
+	if o.Retry.Client != "" {
+		retryType := client.ClientType(o.Retry.Client)
+		providers[client.RoleRetryWorker] = client.NewAgentProvider(retryType, o.extensionsForClient(retryType, true))
+	}
+
 	return providers
 }
 
@@ -486,6 +813,51 @@ func DefaultDatabasePath() string {
 	return filepath.Join(home, ".perles", dbName)
 }
 
+// DefaultDaemonDir returns the directory holding the daemon's API token and socket.
+// Returns ~/.perles/daemon in production, or ~/.perles/daemon-test when running
+// under `go test`. Returns empty string if home dir unavailable.
+func DefaultDaemonDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	dirName := "daemon"
+	if testing.Testing() {
+		dirName = "daemon-test"
+	}
+	return filepath.Join(home, ".perles", dirName)
+}
+
+// DefaultAPITokenPath returns the path of the control plane API bearer token.
+// Returns empty string if home dir unavailable.
+func DefaultAPITokenPath() string {
+	dir := DefaultDaemonDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "token")
+}
+
+// DefaultDaemonSocketPath returns the Unix socket the daemon listens on by default.
+// Returns empty string if home dir unavailable.
+func DefaultDaemonSocketPath() string {
+	dir := DefaultDaemonDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "perles.sock")
+}
+
+// DefaultDaemonInfoPath returns the file a running daemon writes its address to,
+// used by `perles ctl` to discover it. Returns empty string if home dir unavailable.
+func DefaultDaemonInfoPath() string {
+	dir := DefaultDaemonDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "daemon.json")
+}
+
 // DefaultColumns returns the default column configuration matching current behavior.
 func DefaultColumns() []ColumnConfig {
 	return []ColumnConfig{
@@ -550,6 +922,34 @@ func ValidateColumns(cols []ColumnConfig) error {
 		default:
 			return fmt.Errorf("column %d (%s): invalid type %q (must be \"bql\" or \"tree\")", i, col.Name, col.Type)
 		}
+
+		if col.Card != nil {
+			if err := ValidateCard(*col.Card); err != nil {
+				return fmt.Errorf("column %d (%s): %w", i, col.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCard checks a card template for unknown fields and densities.
+func ValidateCard(card CardConfig) error {
+	switch card.Density {
+	case "", CardDensityNormal, CardDensityCompact:
+		// Valid
+	default:
+		return fmt.Errorf("card.density must be %q or %q, got %q", CardDensityNormal, CardDensityCompact, card.Density)
+	}
+
+	seen := make(map[string]bool, len(card.Fields))
+	for _, field := range card.Fields {
+		if !slices.Contains(ValidCardFields, field) {
+			return fmt.Errorf("card.fields: unknown field %q (valid: %s)", field, strings.Join(ValidCardFields, ", "))
+		}
+		if seen[field] {
+			return fmt.Errorf("card.fields: duplicate field %q", field)
+		}
+		seen[field] = true
 	}
 	return nil
 }
@@ -565,6 +965,11 @@ func ValidateViews(views []ViewConfig) error {
 		if view.Name == "" {
 			return fmt.Errorf("view %d: name is required", i)
 		}
+		if view.Card != nil {
+			if err := ValidateCard(*view.Card); err != nil {
+				return fmt.Errorf("view %d (%s): %w", i, view.Name, err)
+			}
+		}
 		// Empty columns array is valid - will show empty state UI
 		if err := ValidateColumns(view.Columns); err != nil {
 			return fmt.Errorf("view %d (%s): %w", i, view.Name, err)
@@ -573,6 +978,32 @@ func ValidateViews(views []ViewConfig) error {
 	return nil
 }
 
+// ValidateProjects checks project mounts for errors.
+// Returns nil if projects are valid or empty (single beads_dir mode).
+func ValidateProjects(projects []ProjectConfig) error {
+	names := make(map[string]bool, len(projects))
+	prefixes := make(map[string]bool, len(projects))
+	for i, p := range projects {
+		if p.Name == "" {
+			return fmt.Errorf("project %d: name is required", i)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("project %d: duplicate name %q", i, p.Name)
+		}
+		names[p.Name] = true
+		if p.Path == "" {
+			return fmt.Errorf("project %d (%s): path is required", i, p.Name)
+		}
+		if p.Prefix != "" {
+			if prefixes[p.Prefix] {
+				return fmt.Errorf("project %d (%s): duplicate prefix %q", i, p.Name, p.Prefix)
+			}
+			prefixes[p.Prefix] = true
+		}
+	}
+	return nil
+}
+
 // ValidateOrchestration checks orchestration configuration for errors.
 // Returns nil if the configuration is valid (empty values use defaults).
 // allowedClients is the list of valid AI client types for orchestration.
@@ -634,6 +1065,196 @@ func ValidateOrchestration(orch OrchestrationConfig) error {
 		return err
 	}
 
+	// Validate resource limits
+	if err := ValidateLimits(orch.Limits); err != nil {
+		return err
+	}
+
+	// Validate automation rules
+	if err := ValidateTriggers(orch.Triggers); err != nil {
+		return err
+	}
+
+	// Validate health policy
+	if err := ValidateHealth(orch.Health); err != nil {
+		return err
+	}
+
+	// Validate tool policies
+	if err := ValidatePolicies("orchestration.policies", orch.Policies); err != nil {
+		return err
+	}
+
+	// Validate context handoff threshold
+	if t := orch.ContextHandoff.Threshold; t < 0 || t > 100 {
+		return fmt.Errorf("orchestration.context_handoff.threshold must be between 0 and 100, got %v", t)
+	}
+
+	// Validate autoscaler
+	if err := ValidateAutoscale(orch.Autoscale); err != nil {
+		return err
+	}
+
+	// Validate task retries
+	if err := ValidateRetry(orch.Retry); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateRetry checks the task retry configuration for errors.
+func ValidateRetry(r RetryConfig) error {
+	if r.MaxAttempts < 0 {
+		return fmt.Errorf("orchestration.retry.max_attempts must not be negative, got %d", r.MaxAttempts)
+	}
+	if r.Backoff < 0 {
+		return fmt.Errorf("orchestration.retry.backoff must not be negative, got %v", r.Backoff)
+	}
+	if r.RateLimitCooldown < 0 {
+		return fmt.Errorf("orchestration.retry.rate_limit_cooldown must not be negative, got %v", r.RateLimitCooldown)
+	}
+	if r.Client != "" && !isAllowedClient(r.Client) {
+		return fmt.Errorf("orchestration.retry.client must be one of %v, got %q", allowedClients, r.Client)
+	}
+	for _, kind := range r.RetryOn {
+		if !slices.Contains(retryFailureKinds, kind) {
+			return fmt.Errorf("orchestration.retry.retry_on: unknown failure kind %q, must be one of %v", kind, retryFailureKinds)
+		}
+	}
+	return nil
+}
+
+// ValidateAutoscale checks the worker autoscaler configuration for errors.
+func ValidateAutoscale(as AutoscaleConfig) error {
+	if as.MinWorkers < 0 {
+		return fmt.Errorf("orchestration.autoscale.min_workers must not be negative, got %d", as.MinWorkers)
+	}
+	if as.MaxWorkers < 0 {
+		return fmt.Errorf("orchestration.autoscale.max_workers must not be negative, got %d", as.MaxWorkers)
+	}
+	maxWorkers := as.MaxWorkers
+	if maxWorkers == 0 {
+		maxWorkers = DefaultAutoscaleMaxWorkers
+	}
+	if as.MinWorkers > maxWorkers {
+		return fmt.Errorf("orchestration.autoscale.min_workers (%d) must not exceed max_workers (%d)", as.MinWorkers, maxWorkers)
+	}
+	if as.IdleCooldown < 0 {
+		return fmt.Errorf("orchestration.autoscale.idle_cooldown must not be negative, got %v", as.IdleCooldown)
+	}
+	if as.Interval < 0 {
+		return fmt.Errorf("orchestration.autoscale.interval must not be negative, got %v", as.Interval)
+	}
+	return nil
+}
+
+// ValidatePolicies checks tool policies keyed by role for errors.
+// The path prefixes error messages, e.g. "orchestration.policies".
+func ValidatePolicies(path string, policies map[string]PolicyConfig) error {
+	for role, policy := range policies {
+		if !slices.Contains(policyRoles, role) {
+			return fmt.Errorf("%s: unknown role %q, must be one of %v", path, role, policyRoles)
+		}
+		if policy.Network != "" && policy.Network != "allow" && policy.Network != "deny" {
+			return fmt.Errorf("%s.%s.network must be \"allow\" or \"deny\", got %q", path, role, policy.Network)
+		}
+		if policy.ReadOnly && len(policy.WritablePaths) > 0 {
+			return fmt.Errorf("%s.%s: writable_paths cannot be set on a read_only policy", path, role)
+		}
+		for _, command := range policy.AllowedCommands {
+			if strings.TrimSpace(command) == "" {
+				return fmt.Errorf("%s.%s.allowed_commands must not contain empty commands", path, role)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateHealth checks health monitoring configuration for errors.
+// Returns nil if the configuration is valid (zero durations keep the defaults).
+func ValidateHealth(health HealthConfig) error {
+	if health.HeartbeatTimeout < 0 {
+		return fmt.Errorf("orchestration.health.heartbeat_timeout must not be negative, got %v", health.HeartbeatTimeout)
+	}
+	if health.ProgressTimeout < 0 {
+		return fmt.Errorf("orchestration.health.progress_timeout must not be negative, got %v", health.ProgressTimeout)
+	}
+	if health.RecoveryBackoff < 0 {
+		return fmt.Errorf("orchestration.health.recovery_backoff must not be negative, got %v", health.RecoveryBackoff)
+	}
+	if health.CheckInterval < 0 {
+		return fmt.Errorf("orchestration.health.check_interval must not be negative, got %v", health.CheckInterval)
+	}
+	if health.WorkerTimeout < 0 {
+		return fmt.Errorf("orchestration.health.worker_timeout must not be negative, got %v", health.WorkerTimeout)
+	}
+	if health.WorkerNudges != nil && *health.WorkerNudges < 0 {
+		return fmt.Errorf("orchestration.health.worker_nudges must not be negative, got %d", *health.WorkerNudges)
+	}
+	for i, step := range health.Escalation {
+		if !slices.Contains(escalationActions, step.Action) {
+			return fmt.Errorf("orchestration.health.escalation[%d].action must be one of %v, got %q", i, escalationActions, step.Action)
+		}
+		if step.Repeat < 0 {
+			return fmt.Errorf("orchestration.health.escalation[%d].repeat must not be negative, got %d", i, step.Repeat)
+		}
+	}
+	return nil
+}
+
+// ValidateTriggers checks automation rule configuration for errors.
+// Returns nil if the configuration is valid.
+func ValidateTriggers(triggers TriggersConfig) error {
+	for i, rule := range triggers.Rules {
+		if strings.TrimSpace(rule.When) == "" {
+			return fmt.Errorf("orchestration.triggers.rules[%d].when is required", i)
+		}
+		if rule.Template == "" {
+			return fmt.Errorf("orchestration.triggers.rules[%d].template is required", i)
+		}
+		if rule.Worktree && rule.BaseBranch == "" {
+			return fmt.Errorf("orchestration.triggers.rules[%d].base_branch is required when worktree is enabled", i)
+		}
+		if rule.BudgetUSD < 0 {
+			return fmt.Errorf("orchestration.triggers.rules[%d].budget_usd must not be negative, got %v", i, rule.BudgetUSD)
+		}
+	}
+	return nil
+}
+
+// ValidateLimits checks resource limit configuration for errors.
+// Returns nil if the configuration is valid (zero values mean unlimited).
+func ValidateLimits(limits LimitsConfig) error {
+	if limits.MaxWorkflows < 0 {
+		return fmt.Errorf("orchestration.limits.max_workflows must not be negative, got %d", limits.MaxWorkflows)
+	}
+	if limits.MaxWorkers < 0 {
+		return fmt.Errorf("orchestration.limits.max_workers must not be negative, got %d", limits.MaxWorkers)
+	}
+	if limits.WorkflowTokens < 0 {
+		return fmt.Errorf("orchestration.limits.workflow_tokens must not be negative, got %d", limits.WorkflowTokens)
+	}
+	if limits.WorkflowCostUSD < 0 {
+		return fmt.Errorf("orchestration.limits.workflow_cost_usd must not be negative, got %v", limits.WorkflowCostUSD)
+	}
+	if limits.TotalTokens < 0 {
+		return fmt.Errorf("orchestration.limits.total_tokens must not be negative, got %d", limits.TotalTokens)
+	}
+	if limits.TotalCostUSD < 0 {
+		return fmt.Errorf("orchestration.limits.total_cost_usd must not be negative, got %v", limits.TotalCostUSD)
+	}
+	switch limits.Queue {
+	case "", "fifo", "priority":
+		// Valid
+	default:
+		return fmt.Errorf("orchestration.limits.queue must be \"fifo\" or \"priority\", got %q", limits.Queue)
+	}
+	for i, alert := range limits.BudgetAlerts {
+		if alert <= 0 || alert >= 1 {
+			return fmt.Errorf("orchestration.limits.budget_alerts[%d] must be between 0 and 1 (exclusive), got %v", i, alert)
+		}
+	}
 	return nil
 }
 
@@ -1038,6 +1659,9 @@ func Defaults() Config {
 				"worker_out_of_context":      {Enabled: true},
 				"coordinator_out_of_context": {Enabled: true},
 				"user_notification":          {Enabled: true},
+				"approval_required":          {Enabled: true},
+				"budget_warning":             {Enabled: true},
+				"budget_exceeded":            {Enabled: true},
 			},
 		},
 	}
@@ -1050,6 +1674,16 @@ func DefaultConfigTemplate() string {
 # Path to beads database directory (default: current directory)
 # beads_dir: /path/to/project
 
+# Mount several beads databases at once (replaces beads_dir). Issues from every
+# project share the board; filter with the BQL project field, e.g. "project = api".
+# Mutations go to the project whose prefix matches the issue ID.
+# projects:
+#   - name: api
+#     path: services/api
+#   - name: web
+#     path: apps/web
+#     prefix: web        # Default: the database's issue_prefix
+
 # Auto-refresh when database changes
 auto_refresh: true
 
@@ -1115,6 +1749,7 @@ views:
 # View options:
 #   name: Display name for the view (required)
 #   columns: List of columns for this view (required)
+#   card: Default card template for the view's columns (optional)
 #
 # Column options:
 #   name: Display name (required)
@@ -1123,6 +1758,14 @@ views:
 #   issue_id: Issue Id (required when type is tree)
 #   tree_mode: deps or child (optional when type is tree)
 #   color: Hex color for column header
+#   card: Card template overriding the view's card (bql columns only)
+#
+# Card templates (set on a view or a column):
+#   card:
+#     density: normal     # normal (default) or compact (one line per issue)
+#     fields: [assignee, labels, age, comments, progress, blocked]
+#   Available fields: assignee, labels, age, comments, progress (descendants
+#   closed/total), blocked, agent_state, last_activity, project
 #
 # BQL Query Syntax:
 #   Fields: type, priority, status, blocked, ready, label, title, id, created, updated
@@ -1205,6 +1848,18 @@ orchestration:
       # Plays for general user notifications
       user_notification:
         enabled: true
+
+      # Plays when the coordinator asks for a human approval
+      approval_required:
+        enabled: true
+
+      # Plays when a workflow crosses a soft budget threshold
+      budget_warning:
+        enabled: true
+
+      # Plays when a workflow reaches its budget and is paused
+      budget_exceeded:
+        enabled: true
 `
 }
 
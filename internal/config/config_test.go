@@ -185,6 +185,30 @@ func TestValidateViews_MissingName(t *testing.T) {
 	require.Contains(t, err.Error(), "view 0: name is required")
 }
 
+func TestValidateProjects(t *testing.T) {
+	require.NoError(t, ValidateProjects(nil), "no projects means single beads_dir mode")
+	require.NoError(t, ValidateProjects([]ProjectConfig{
+		{Name: "api", Path: "services/api"},
+		{Name: "web", Path: "apps/web", Prefix: "web"},
+	}))
+
+	tests := []struct {
+		name     string
+		projects []ProjectConfig
+		wantErr  string
+	}{
+		{"missing name", []ProjectConfig{{Path: "a"}}, "project 0: name is required"},
+		{"missing path", []ProjectConfig{{Name: "api"}}, "project 0 (api): path is required"},
+		{"duplicate name", []ProjectConfig{{Name: "api", Path: "a"}, {Name: "api", Path: "b"}}, `duplicate name "api"`},
+		{"duplicate prefix", []ProjectConfig{{Name: "a", Path: "a", Prefix: "x"}, {Name: "b", Path: "b", Prefix: "x"}}, `duplicate prefix "x"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorContains(t, ValidateProjects(tt.projects), tt.wantErr)
+		})
+	}
+}
+
 func TestValidateViews_EmptyColumns(t *testing.T) {
 	// Empty columns array is valid - will show empty state UI
 	views := []ViewConfig{
@@ -349,6 +373,64 @@ func TestValidateColumns_TreeWithMode(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidateColumns_CardValid(t *testing.T) {
+	cols := []ColumnConfig{
+		{Name: "Agents", Query: "type = agent", Card: &CardConfig{
+			Density: CardDensityCompact,
+			Fields:  []string{CardFieldAgentState, CardFieldLastActivity},
+		}},
+	}
+	require.NoError(t, ValidateColumns(cols))
+}
+
+func TestValidateColumns_CardUnknownField(t *testing.T) {
+	cols := []ColumnConfig{
+		{Name: "Bad", Query: "status = open", Card: &CardConfig{Fields: []string{"estimate"}}},
+	}
+	err := ValidateColumns(cols)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown field \"estimate\"")
+}
+
+func TestValidateColumns_CardDuplicateField(t *testing.T) {
+	cols := []ColumnConfig{
+		{Name: "Bad", Query: "status = open", Card: &CardConfig{Fields: []string{"age", "age"}}},
+	}
+	err := ValidateColumns(cols)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicate field \"age\"")
+}
+
+func TestValidateViews_CardInvalidDensity(t *testing.T) {
+	views := []ViewConfig{
+		{Name: "Planning", Card: &CardConfig{Density: "dense"}, Columns: []ColumnConfig{
+			{Name: "Open", Query: "status = open"},
+		}},
+	}
+	err := ValidateViews(views)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "view 0 (Planning)")
+	require.Contains(t, err.Error(), "card.density")
+}
+
+func TestViewConfig_CardFor(t *testing.T) {
+	viewCard := &CardConfig{Fields: []string{CardFieldProgress}}
+	colCard := &CardConfig{Density: CardDensityCompact}
+	view := ViewConfig{
+		Name: "Planning",
+		Card: viewCard,
+		Columns: []ColumnConfig{
+			{Name: "Epics", Query: "type = epic"},
+			{Name: "Agents", Query: "type = agent", Card: colCard},
+		},
+	}
+
+	require.Same(t, viewCard, view.CardFor(0), "column without card inherits view card")
+	require.Same(t, colCard, view.CardFor(1), "column card overrides view card")
+	require.Same(t, viewCard, view.CardFor(5), "out of range falls back to view card")
+	require.Nil(t, ViewConfig{}.CardFor(0), "no card configured")
+}
+
 // Tests for orchestration config validation
 
 func TestValidateOrchestration_Empty(t *testing.T) {
@@ -448,6 +530,214 @@ func TestValidateOrchestration_ValidAmpModes(t *testing.T) {
 	}
 }
 
+func TestValidateOrchestration_Limits(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  LimitsConfig
+		wantErr string
+	}{
+		{name: "unlimited", limits: LimitsConfig{}},
+		{name: "valid", limits: LimitsConfig{MaxWorkflows: 2, MaxWorkers: 8, WorkflowCostUSD: 5, TotalTokens: 1_000_000, Queue: "priority"}},
+		{name: "negative workflows", limits: LimitsConfig{MaxWorkflows: -1}, wantErr: "orchestration.limits.max_workflows"},
+		{name: "negative workers", limits: LimitsConfig{MaxWorkers: -1}, wantErr: "orchestration.limits.max_workers"},
+		{name: "negative cost", limits: LimitsConfig{TotalCostUSD: -0.5}, wantErr: "orchestration.limits.total_cost_usd"},
+		{name: "unknown queue", limits: LimitsConfig{Queue: "lifo"}, wantErr: "orchestration.limits.queue must be"},
+		{name: "budget alerts", limits: LimitsConfig{BudgetAlerts: []float64{0.25, 0.9}}},
+		{name: "budget alert at limit", limits: LimitsConfig{BudgetAlerts: []float64{0.5, 1}}, wantErr: "orchestration.limits.budget_alerts[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Limits: tt.limits})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateOrchestration_Triggers(t *testing.T) {
+	valid := TriggerRuleConfig{When: "type = epic and label = auto-cook", Template: "cook"}
+	tests := []struct {
+		name    string
+		rule    TriggerRuleConfig
+		wantErr string
+	}{
+		{name: "valid", rule: valid},
+		{name: "worktree", rule: TriggerRuleConfig{When: valid.When, Template: "cook", Worktree: true, BaseBranch: "main"}},
+		{name: "missing query", rule: TriggerRuleConfig{Template: "cook"}, wantErr: "orchestration.triggers.rules[0].when is required"},
+		{name: "missing template", rule: TriggerRuleConfig{When: valid.When}, wantErr: "orchestration.triggers.rules[0].template is required"},
+		{name: "worktree without branch", rule: TriggerRuleConfig{When: valid.When, Template: "cook", Worktree: true}, wantErr: "base_branch is required"},
+		{name: "negative budget", rule: TriggerRuleConfig{When: valid.When, Template: "cook", BudgetUSD: -1}, wantErr: "budget_usd must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Triggers: TriggersConfig{Rules: []TriggerRuleConfig{tt.rule}}})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateOrchestration_Policies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[string]PolicyConfig
+		wantErr  string
+	}{
+		{name: "none"},
+		{name: "valid", policies: map[string]PolicyConfig{
+			"worker":   {AllowedCommands: []string{"go", "git"}, WritablePaths: []string{"internal/**"}, Network: "deny"},
+			"reviewer": {ReadOnly: true, Network: "allow", ProtectedPaths: []string{".env"}},
+		}},
+		{name: "unknown role", policies: map[string]PolicyConfig{"tester": {ReadOnly: true}}, wantErr: `orchestration.policies: unknown role "tester"`},
+		{name: "invalid network", policies: map[string]PolicyConfig{"worker": {Network: "offline"}}, wantErr: "orchestration.policies.worker.network"},
+		{name: "read-only with writable paths", policies: map[string]PolicyConfig{"reviewer": {ReadOnly: true, WritablePaths: []string{"docs/**"}}}, wantErr: "writable_paths cannot be set on a read_only policy"},
+		{name: "empty command", policies: map[string]PolicyConfig{"worker": {AllowedCommands: []string{" "}}}, wantErr: "orchestration.policies.worker.allowed_commands"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Policies: tt.policies})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestToolPolicies_Layers(t *testing.T) {
+	global := map[string]PolicyConfig{
+		"worker":   {Network: "deny"},
+		"reviewer": {ReadOnly: true, ProtectedPaths: []string{".env"}},
+	}
+	template := map[string]PolicyConfig{
+		"worker": {AllowedCommands: []string{"go test"}},
+	}
+
+	policies := ToolPolicies(global, template)
+
+	// The template replaces the global worker policy
+	require.Equal(t, []string{"go test"}, policies["worker"].AllowedCommands)
+	require.False(t, policies["worker"].DenyNetwork)
+	require.Equal(t, []string{".env"}, policies["reviewer"].ProtectedPaths)
+
+	// Reviewers are read-only by default
+	defaults := ToolPolicies(nil, nil)
+	require.True(t, defaults["reviewer"].ReadOnly)
+	require.Len(t, defaults, 1)
+}
+
+func TestValidateOrchestration_Health(t *testing.T) {
+	negative := -1
+	tests := []struct {
+		name    string
+		health  HealthConfig
+		wantErr string
+	}{
+		{name: "defaults", health: HealthConfig{}},
+		{name: "valid", health: HealthConfig{
+			ProgressTimeout: 10 * time.Minute,
+			Escalation: []EscalationStepConfig{
+				{Action: "nudge", Message: "Check on your workers", Repeat: 2},
+				{Action: "replace_worker"},
+				{Action: "notify"},
+				{Action: "pause"},
+			},
+		}},
+		{name: "negative timeout", health: HealthConfig{ProgressTimeout: -time.Minute}, wantErr: "orchestration.health.progress_timeout"},
+		{name: "negative interval", health: HealthConfig{CheckInterval: -time.Second}, wantErr: "orchestration.health.check_interval"},
+		{name: "unknown action", health: HealthConfig{Escalation: []EscalationStepConfig{{Action: "restart"}}}, wantErr: "orchestration.health.escalation[0].action must be one of"},
+		{name: "negative worker timeout", health: HealthConfig{WorkerTimeout: -time.Minute}, wantErr: "orchestration.health.worker_timeout"},
+		{name: "negative worker nudges", health: HealthConfig{WorkerNudges: &negative}, wantErr: "orchestration.health.worker_nudges"},
+		{name: "negative repeat", health: HealthConfig{Escalation: []EscalationStepConfig{{Action: "nudge", Repeat: -1}}}, wantErr: "orchestration.health.escalation[0].repeat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Health: tt.health})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateOrchestration_ContextHandoff(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{}))
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{ContextHandoff: ContextHandoffConfig{Threshold: 85}}))
+
+	err := ValidateOrchestration(OrchestrationConfig{ContextHandoff: ContextHandoffConfig{Threshold: 120}})
+	require.ErrorContains(t, err, "orchestration.context_handoff.threshold")
+
+	err = ValidateOrchestration(OrchestrationConfig{ContextHandoff: ContextHandoffConfig{Threshold: -5}})
+	require.ErrorContains(t, err, "orchestration.context_handoff.threshold")
+}
+
+func TestValidateOrchestration_Autoscale(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Autoscale: AutoscaleConfig{
+		Enabled: true, MinWorkers: 1, MaxWorkers: 3, IdleCooldown: time.Minute, Interval: 10 * time.Second,
+	}}))
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Autoscale: AutoscaleConfig{Enabled: true, MinWorkers: 2}}))
+
+	tests := []struct {
+		name      string
+		autoscale AutoscaleConfig
+		wantErr   string
+	}{
+		{"negative min", AutoscaleConfig{MinWorkers: -1}, "orchestration.autoscale.min_workers"},
+		{"negative max", AutoscaleConfig{MaxWorkers: -1}, "orchestration.autoscale.max_workers"},
+		{"min above max", AutoscaleConfig{MinWorkers: 4, MaxWorkers: 2}, "must not exceed max_workers"},
+		{"min above default max", AutoscaleConfig{MinWorkers: 5}, "must not exceed max_workers (4)"},
+		{"negative cooldown", AutoscaleConfig{IdleCooldown: -time.Second}, "orchestration.autoscale.idle_cooldown"},
+		{"negative interval", AutoscaleConfig{Interval: -time.Second}, "orchestration.autoscale.interval"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Autoscale: tt.autoscale})
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateOrchestration_Retry(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Retry: RetryConfig{
+		MaxAttempts: 3, Backoff: time.Minute, Client: "amp", RetryOn: []string{"rate_limit", "tool_error"}, RateLimitCooldown: 10 * time.Minute,
+	}}))
+
+	tests := []struct {
+		name    string
+		retry   RetryConfig
+		wantErr string
+	}{
+		{"negative attempts", RetryConfig{MaxAttempts: -1}, "orchestration.retry.max_attempts"},
+		{"negative backoff", RetryConfig{Backoff: -time.Second}, "orchestration.retry.backoff"},
+		{"negative cooldown", RetryConfig{RateLimitCooldown: -time.Second}, "orchestration.retry.rate_limit_cooldown"},
+		{"unknown client", RetryConfig{Client: "gpt"}, "orchestration.retry.client"},
+		{"unknown failure kind", RetryConfig{RetryOn: []string{"timeout"}}, `unknown failure kind "timeout"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Retry: tt.retry})
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestValidateOrchestration_ValidCoordinatorClient(t *testing.T) {
 	clients := []string{"claude", "amp", "codex", "gemini", "opencode"}
 	for _, c := range clients {
@@ -1428,10 +1718,10 @@ func TestDefaults_SoundEventConfigValues(t *testing.T) {
 	cfg := Defaults()
 
 	// All events should exist in the map
-	require.Len(t, cfg.Sound.Events, 6)
+	require.Len(t, cfg.Sound.Events, 9)
 
 	// Check each event has correct default values
-	for _, eventName := range []string{"review_verdict_approve", "review_verdict_deny", "workflow_complete", "worker_out_of_context", "coordinator_out_of_context", "user_notification"} {
+	for _, eventName := range []string{"review_verdict_approve", "review_verdict_deny", "workflow_complete", "worker_out_of_context", "coordinator_out_of_context", "user_notification", "approval_required", "budget_warning", "budget_exceeded"} {
 		eventConfig, exists := cfg.Sound.Events[eventName]
 		require.True(t, exists, "Event %q should exist in defaults", eventName)
 		require.True(t, eventConfig.Enabled, "Event %q should be enabled by default", eventName)
@@ -1440,11 +1730,11 @@ func TestDefaults_SoundEventConfigValues(t *testing.T) {
 }
 
 func TestDefaults_SoundEventsEnabled(t *testing.T) {
-	// Verify all 8 sound events are present and enabled by default
+	// Verify all 9 sound events are present and enabled by default
 	cfg := Defaults()
 
-	// Must have exactly 8 sound events
-	require.Len(t, cfg.Sound.Events, 6, "Defaults should have exactly 6 sound events")
+	// Must have exactly 9 sound events
+	require.Len(t, cfg.Sound.Events, 9, "Defaults should have exactly 9 sound events")
 
 	// All expected events must be present and enabled
 	expectedEvents := []string{
@@ -1454,6 +1744,9 @@ func TestDefaults_SoundEventsEnabled(t *testing.T) {
 		"worker_out_of_context",
 		"coordinator_out_of_context",
 		"user_notification",
+		"approval_required",
+		"budget_warning",
+		"budget_exceeded",
 	}
 
 	for _, eventName := range expectedEvents {
@@ -1471,7 +1764,7 @@ func TestDefaultConfigTemplate_SoundsEnabled(t *testing.T) {
 	require.Contains(t, template, "All events are enabled by default",
 		"Template should say events are enabled by default")
 
-	// All 6 expected events must be present with enabled: true
+	// All 7 expected events must be present with enabled: true
 	expectedEvents := []string{
 		"review_verdict_approve",
 		"review_verdict_deny",
@@ -1479,6 +1772,7 @@ func TestDefaultConfigTemplate_SoundsEnabled(t *testing.T) {
 		"worker_out_of_context",
 		"coordinator_out_of_context",
 		"user_notification",
+		"approval_required",
 	}
 
 	for _, eventName := range expectedEvents {
@@ -1928,6 +2222,17 @@ func TestAgentProviders_DifferentClients(t *testing.T) {
 	require.Equal(t, client.ClientType("amp"), providers[client.RoleWorker].Type())
 }
 
+func TestAgentProviders_RetryClient(t *testing.T) {
+	cfg := OrchestrationConfig{WorkerClient: "claude", Amp: AmpClientConfig{Model: "sonnet"}}
+	require.Nil(t, cfg.AgentProviders().RetryWorker())
+
+	cfg.Retry = RetryConfig{MaxAttempts: 3, Client: "amp"}
+	retry := cfg.AgentProviders().RetryWorker()
+	require.NotNil(t, retry)
+	require.Equal(t, client.ClientAmp, retry.Type())
+	require.Equal(t, "sonnet", retry.Extensions()[client.ExtAmpModel])
+}
+
 func TestAgentProviders_IncludesExtensions(t *testing.T) {
 	cfg := OrchestrationConfig{
 		CoordinatorClient: "claude",
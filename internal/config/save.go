@@ -170,6 +170,14 @@ func buildViewsNode(views []ViewConfig) *yaml.Node {
 			&yaml.Node{Kind: yaml.ScalarNode, Value: view.Name},
 		)
 
+		// Add view-level card template if configured
+		if view.Card != nil {
+			viewNode.Content = append(viewNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "card"},
+				buildCardNode(*view.Card),
+			)
+		}
+
 		// Add columns
 		columnsNode := buildColumnsNode(view.Columns)
 		viewNode.Content = append(viewNode.Content,
@@ -241,12 +249,52 @@ func buildColumnsNode(columns []ColumnConfig) *yaml.Node {
 			)
 		}
 
+		if col.Card != nil {
+			colNode.Content = append(colNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "card"},
+				buildCardNode(*col.Card),
+			)
+		}
+
 		node.Content = append(node.Content, colNode)
 	}
 
 	return node
 }
 
+// buildCardNode creates a yaml.Node representing a card template.
+func buildCardNode(card CardConfig) *yaml.Node {
+	node := &yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: make([]*yaml.Node, 0),
+	}
+
+	if card.Density != "" {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "density"},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: card.Density},
+		)
+	}
+
+	if len(card.Fields) > 0 {
+		fieldsNode := &yaml.Node{
+			Kind:  yaml.SequenceNode,
+			Style: yaml.FlowStyle,
+		}
+		for _, field := range card.Fields {
+			fieldsNode.Content = append(fieldsNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: field},
+			)
+		}
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "fields"},
+			fieldsNode,
+		)
+	}
+
+	return node
+}
+
 // UpdateColumnInView updates a single column within a specific view.
 func UpdateColumnInView(configPath string, viewIndex, colIndex int, newCol ColumnConfig, allCols []ColumnConfig, allViews []ViewConfig) error {
 	if colIndex < 0 || colIndex >= len(allCols) {
@@ -109,6 +109,42 @@ func TestSaveColumns_Roundtrip(t *testing.T) {
 	require.Equal(t, original[1].Query, loaded[0].Columns[1].Query)
 }
 
+func TestSaveViews_CardRoundtrip(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".perles.yaml")
+
+	views := []ViewConfig{
+		{
+			Name: "Planning",
+			Card: &CardConfig{Fields: []string{CardFieldProgress, CardFieldBlocked}},
+			Columns: []ColumnConfig{
+				{Name: "Epics", Query: "type = epic"},
+				{Name: "Agents", Query: "type = agent", Card: &CardConfig{
+					Density: CardDensityCompact,
+					Fields:  []string{CardFieldAgentState, CardFieldLastActivity},
+				}},
+			},
+		},
+	}
+
+	require.NoError(t, SaveViews(configPath, views))
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	var loaded []ViewConfig
+	require.NoError(t, v.UnmarshalKey("views", &loaded))
+
+	require.Len(t, loaded, 1)
+	require.NotNil(t, loaded[0].Card)
+	require.Equal(t, []string{"progress", "blocked"}, loaded[0].Card.Fields)
+	require.Nil(t, loaded[0].Columns[0].Card)
+	require.NotNil(t, loaded[0].Columns[1].Card)
+	require.Equal(t, CardDensityCompact, loaded[0].Columns[1].Card.Density)
+	require.Equal(t, []string{"agent_state", "last_activity"}, loaded[0].Columns[1].Card.Fields)
+}
+
 func TestSaveColumns_AtomicWrite(t *testing.T) {
 	tempDir := t.TempDir()
 	configPath := filepath.Join(tempDir, ".perles.yaml")
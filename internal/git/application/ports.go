@@ -60,6 +60,26 @@ type GitExecutor interface {
 	// If ref is empty, returns commits for HEAD (same behavior as GetCommitLog).
 	GetCommitLogForRef(ref string, limit int) ([]domain.CommitInfo, error)
 
+	// Merge operations
+	// CheckMerge does a dry-run merge of branch into base without touching any
+	// working tree or ref. Returns the conflicting paths, empty when the merge is clean.
+	CheckMerge(base, branch string) ([]string, error)
+	// Merge merges branch into the checked-out branch with message. With squash
+	// the branch's changes are committed as a single commit instead of a merge commit.
+	// A conflicting merge is aborted and returns ErrMergeConflict.
+	Merge(branch, message string, squash bool) error
+	// DeleteBranch deletes a local branch. force deletes it even if it is not
+	// merged, as after a squash merge.
+	DeleteBranch(name string, force bool) error
+
+	// Commit operations
+	// CommitAll stages every change in the working tree, including untracked
+	// files, and commits them with message.
+	CommitAll(message string) error
+	// ResetBranch points the checked-out branch at ref, keeping uncommitted
+	// changes. Fails without touching anything when they would be lost.
+	ResetBranch(ref string) error
+
 	// Remote operations
 	// GetRemoteURL returns the URL for the named remote (e.g., "origin").
 	// Returns empty string and nil error if remote doesn't exist.
@@ -30,4 +30,7 @@ var (
 
 	// ErrDiffTimeout is returned when a git diff operation times out.
 	ErrDiffTimeout = errors.New("git diff timed out")
+
+	// ErrMergeConflict is returned when a merge stops on conflicting changes.
+	ErrMergeConflict = errors.New("merge conflict")
 )
@@ -741,6 +741,104 @@ func parseCommitLog(output string) []domain.CommitInfo {
 	return commits
 }
 
+// CheckMerge does a dry-run merge of branch into base using git merge-tree
+// (git 2.38+), which computes the merge in memory. Exit status 1 means the
+// merge conflicts; the conflicting paths follow the tree ID on stdout.
+func (e *RealExecutor) CheckMerge(base, branch string) ([]string, error) {
+	args := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", base, branch}
+	//nolint:gosec // G204: args come from controlled sources
+	cmd := exec.Command("git", args...)
+	if e.workDir != "" {
+		cmd.Dir = e.workDir
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return nil, nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == 1:
+		return parseMergeTreeConflicts(stdout.String()), nil
+	}
+
+	stderrStr := strings.TrimSpace(stderr.String())
+	if stderrStr != "" {
+		return nil, parseGitError(stderrStr, err)
+	}
+	return nil, fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+}
+
+// parseMergeTreeConflicts parses the conflicting paths from git merge-tree
+// --name-only output: the merged tree ID, then one path per line.
+func parseMergeTreeConflicts(output string) []string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	var paths []string
+	for _, line := range lines[1:] {
+		if line = strings.TrimSpace(line); line != "" {
+			paths = append(paths, line)
+		}
+	}
+	return paths
+}
+
+// Merge merges branch into the checked-out branch. Failed merges are aborted
+// so the working tree is left as it was.
+func (e *RealExecutor) Merge(branch, message string, squash bool) error {
+	args := []string{"merge", "--no-ff", "-m", message, branch}
+	if squash {
+		args = []string{"merge", "--squash", branch}
+	}
+	if err := e.runGit(args...); err != nil {
+		return e.abortMerge(err)
+	}
+
+	if squash {
+		if err := e.runGit("commit", "-m", message); err != nil {
+			_ = e.runGit("reset", "--merge")
+			return fmt.Errorf("committing squash merge: %w", err)
+		}
+	}
+	return nil
+}
+
+// abortMerge undoes a failed merge, wrapping err with ErrMergeConflict when
+// the merge stopped on conflicting paths.
+func (e *RealExecutor) abortMerge(err error) error {
+	conflicts, _ := e.runGitOutput("diff", "--name-only", "--diff-filter=U")
+	_ = e.runGit("reset", "--merge")
+	if conflicts != "" {
+		return fmt.Errorf("%w: %s", domain.ErrMergeConflict, strings.ReplaceAll(conflicts, "\n", ", "))
+	}
+	return err
+}
+
+// DeleteBranch deletes a local branch.
+func (e *RealExecutor) DeleteBranch(name string, force bool) error {
+	flag := "-d"
+	if force {
+		flag = "-D"
+	}
+	return e.runGit("branch", flag, name)
+}
+
+// CommitAll stages every change in the working tree and commits it.
+func (e *RealExecutor) CommitAll(message string) error {
+	if err := e.runGit("add", "-A"); err != nil {
+		return fmt.Errorf("staging changes: %w", err)
+	}
+	return e.runGit("commit", "-m", message)
+}
+
+// ResetBranch points the checked-out branch at ref with reset --keep, which
+// refuses to run when uncommitted changes would be overwritten.
+func (e *RealExecutor) ResetBranch(ref string) error {
+	return e.runGit("reset", "--keep", ref)
+}
+
 // GetRemoteURL returns the URL for the named remote (e.g., "origin").
 // Returns empty string and nil error if remote doesn't exist.
 func (e *RealExecutor) GetRemoteURL(name string) (string, error) {
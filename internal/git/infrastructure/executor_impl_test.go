@@ -1051,3 +1051,104 @@ func TestParseGitError_InvalidBranchName(t *testing.T) {
 	err := parseGitError("fatal: 'my branch' is not a valid branch name", originalErr)
 	require.ErrorIs(t, err, domain.ErrInvalidBranchName, "parseGitError should return domain.ErrInvalidBranchName for invalid branch name stderr")
 }
+
+// initMergeTestRepo creates a repo on main with a feature branch that changes
+// README.md, and returns the repo directory.
+func initMergeTestRepo(t *testing.T) string {
+	t.Helper()
+	repoDir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	run("init", "-b", "main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Test\n"), 0644))
+	run("add", ".")
+	run("commit", "-m", "Initial commit")
+
+	run("checkout", "-b", "feature")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Feature\n"), 0644))
+	run("commit", "-am", "Change title")
+	run("checkout", "main")
+	return repoDir
+}
+
+func TestRealExecutor_CheckMerge_Clean(t *testing.T) {
+	repoDir := initMergeTestRepo(t)
+	executor := NewRealExecutor(repoDir)
+
+	conflicts, err := executor.CheckMerge("main", "feature")
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+}
+
+func TestRealExecutor_CheckMerge_Conflicts(t *testing.T) {
+	repoDir := initMergeTestRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Main\n"), 0644))
+	cmd := exec.Command("git", "commit", "-am", "Change title on main")
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s", out)
+	executor := NewRealExecutor(repoDir)
+
+	conflicts, err := executor.CheckMerge("main", "feature")
+	require.NoError(t, err)
+	require.Equal(t, []string{"README.md"}, conflicts)
+
+	err = executor.Merge("feature", "Merge feature", false)
+	require.ErrorIs(t, err, domain.ErrMergeConflict)
+	dirty, err := executor.HasUncommittedChanges()
+	require.NoError(t, err)
+	require.False(t, dirty, "a conflicting merge is aborted")
+}
+
+func TestRealExecutor_Merge_Squash(t *testing.T) {
+	repoDir := initMergeTestRepo(t)
+	executor := NewRealExecutor(repoDir)
+
+	require.NoError(t, executor.Merge("feature", "Land feature", true))
+
+	commits, err := executor.GetCommitLog(5)
+	require.NoError(t, err)
+	require.Len(t, commits, 2, "a squash merge adds a single commit")
+	require.Equal(t, "Land feature", commits[0].Subject)
+	content, err := os.ReadFile(filepath.Join(repoDir, "README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "# Feature\n", string(content))
+
+	require.Error(t, executor.DeleteBranch("feature", false), "squashed branches look unmerged")
+	require.NoError(t, executor.DeleteBranch("feature", true))
+	require.False(t, executor.BranchExists("feature"))
+}
+
+func TestRealExecutor_CommitAllAndResetBranch(t *testing.T) {
+	repoDir := initMergeTestRepo(t)
+	executor := NewRealExecutor(repoDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "NOTES.md"), []byte("notes\n"), 0644))
+	require.NoError(t, executor.CommitAll("Add notes"))
+
+	commits, err := executor.GetCommitLog(5)
+	require.NoError(t, err)
+	require.Equal(t, "Add notes", commits[0].Subject, "untracked files are committed too")
+	dirty, err := executor.HasUncommittedChanges()
+	require.NoError(t, err)
+	require.False(t, dirty)
+
+	require.NoError(t, executor.ResetBranch("feature"))
+	content, err := os.ReadFile(filepath.Join(repoDir, "README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "# Feature\n", string(content))
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Local\n"), 0644))
+	require.Error(t, executor.ResetBranch("HEAD~1"), "uncommitted changes are never discarded")
+	content, err = os.ReadFile(filepath.Join(repoDir, "README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "# Local\n", string(content))
+}
@@ -0,0 +1,3 @@
+ALTER TABLE sessions DROP COLUMN budget_tokens;
+ALTER TABLE sessions DROP COLUMN budget_usd;
+ALTER TABLE sessions DROP COLUMN cost_usd;
@@ -0,0 +1,4 @@
+-- Per-workflow spend and budgets (0 = no budget)
+ALTER TABLE sessions ADD COLUMN cost_usd REAL NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN budget_usd REAL NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN budget_tokens INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+DROP TABLE schedule_targets;
+DROP INDEX idx_schedules_project;
+DROP TABLE schedules;
+ALTER TABLE sessions DROP COLUMN scheduled_at;
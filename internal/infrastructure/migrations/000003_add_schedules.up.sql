@@ -0,0 +1,43 @@
+-- Requested start time for pending workflows (delayed start or upcoming scheduled run)
+ALTER TABLE sessions ADD COLUMN scheduled_at INTEGER;
+
+-- Schedules create workflows from a template on a cron expression
+CREATE TABLE schedules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    guid TEXT NOT NULL UNIQUE,
+    project TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cron TEXT NOT NULL,
+    template_id TEXT NOT NULL,
+    target_query TEXT,  -- BQL query; one workflow per new matching issue
+    args TEXT,          -- JSON encoded map[string]string
+    labels TEXT,        -- JSON encoded map[string]string
+
+    -- Workflow settings
+    worktree_enabled INTEGER NOT NULL DEFAULT 0,
+    worktree_base_branch TEXT,
+    priority INTEGER NOT NULL DEFAULT 0,
+    budget_usd REAL NOT NULL DEFAULT 0,
+
+    -- Scheduling state
+    enabled INTEGER NOT NULL DEFAULT 1,
+    next_run_at INTEGER,
+    last_run_at INTEGER,
+    pending_workflow_id TEXT,  -- Pre-created workflow for the next run
+
+    -- Timestamps
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    deleted_at INTEGER
+);
+
+CREATE INDEX idx_schedules_project ON schedules(project) WHERE deleted_at IS NULL;
+
+-- Issues a schedule has already created a workflow for, so each runs once
+CREATE TABLE schedule_targets (
+    schedule_id INTEGER NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    target_id TEXT NOT NULL,
+    workflow_id TEXT,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (schedule_id, target_id)
+);
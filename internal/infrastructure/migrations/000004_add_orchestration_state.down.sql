@@ -0,0 +1,4 @@
+DROP INDEX idx_orchestration_queue_entries_worker;
+DROP TABLE orchestration_queue_entries;
+DROP TABLE orchestration_tasks;
+DROP TABLE orchestration_processes;
@@ -0,0 +1,45 @@
+-- Orchestration state of running workflows, so task assignments, review
+-- states and queued messages survive a crash. Timestamps are Unix nanoseconds.
+
+-- Coordinator, observer and worker processes
+CREATE TABLE orchestration_processes (
+    workflow_id TEXT NOT NULL,
+    id TEXT NOT NULL,
+    role TEXT NOT NULL,
+    status TEXT NOT NULL,
+    session_id TEXT,
+    metrics TEXT,  -- JSON encoded metrics.TokenMetrics
+    has_completed_turn INTEGER NOT NULL DEFAULT 0,
+    phase TEXT,
+    task_id TEXT,
+    agent_type TEXT,
+    created_at INTEGER,
+    last_activity_at INTEGER,
+    retired_at INTEGER,
+    PRIMARY KEY (workflow_id, id)
+);
+
+-- Task assignments and their review state
+CREATE TABLE orchestration_tasks (
+    workflow_id TEXT NOT NULL,
+    task_id TEXT NOT NULL,
+    implementer TEXT NOT NULL,
+    reviewer TEXT,
+    status TEXT NOT NULL,
+    thread_id TEXT,
+    started_at INTEGER,
+    review_started_at INTEGER,
+    PRIMARY KEY (workflow_id, task_id)
+);
+
+-- Messages queued for busy processes, in FIFO order by id
+CREATE TABLE orchestration_queue_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    workflow_id TEXT NOT NULL,
+    worker_id TEXT NOT NULL,
+    content TEXT NOT NULL,
+    sender TEXT NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX idx_orchestration_queue_entries_worker ON orchestration_queue_entries(workflow_id, worker_id, id);
@@ -0,0 +1,3 @@
+DROP INDEX idx_orchestration_queue_entries_worker;
+CREATE INDEX idx_orchestration_queue_entries_worker ON orchestration_queue_entries(workflow_id, worker_id, id);
+ALTER TABLE orchestration_queue_entries DROP COLUMN priority;
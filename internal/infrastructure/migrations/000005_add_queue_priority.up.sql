@@ -0,0 +1,12 @@
+-- Queued messages are delivered by priority, then in FIFO order by id
+ALTER TABLE orchestration_queue_entries ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+-- Messages queued before priorities existed get their sender's default priority
+UPDATE orchestration_queue_entries SET priority = CASE sender
+    WHEN 'user' THEN 3
+    WHEN 'coordinator' THEN 2
+    ELSE 1
+END;
+
+DROP INDEX idx_orchestration_queue_entries_worker;
+CREATE INDEX idx_orchestration_queue_entries_worker ON orchestration_queue_entries(workflow_id, worker_id, priority DESC, id);
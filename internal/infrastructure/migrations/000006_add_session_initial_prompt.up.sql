@@ -0,0 +1,2 @@
+-- Coordinator prompt, so pending workflows can start after a restart
+ALTER TABLE sessions ADD COLUMN initial_prompt TEXT;
@@ -0,0 +1,3 @@
+ALTER TABLE sessions DROP COLUMN outputs;
+ALTER TABLE sessions DROP COLUMN args;
+ALTER TABLE sessions DROP COLUMN depends_on;
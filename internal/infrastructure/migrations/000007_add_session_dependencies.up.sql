@@ -0,0 +1,4 @@
+-- Workflow dependencies, so waiting workflows start after a restart
+ALTER TABLE sessions ADD COLUMN depends_on TEXT;  -- JSON encoded []string of session GUIDs
+ALTER TABLE sessions ADD COLUMN args TEXT;        -- JSON encoded map[string]string
+ALTER TABLE sessions ADD COLUMN outputs TEXT;     -- JSON encoded map[string]string
@@ -0,0 +1,2 @@
+-- Per-worker worktrees, so resumed workflows keep giving workers their own worktree
+ALTER TABLE sessions ADD COLUMN worker_worktrees INTEGER NOT NULL DEFAULT 0;
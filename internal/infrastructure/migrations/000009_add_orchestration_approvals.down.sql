@@ -0,0 +1 @@
+DROP TABLE orchestration_approvals;
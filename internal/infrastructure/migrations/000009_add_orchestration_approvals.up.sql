@@ -0,0 +1,14 @@
+-- Approval requests awaiting a human decision, so gated tasks stay held after
+-- a crash. Resolved approvals are deleted. Timestamps are Unix nanoseconds.
+CREATE TABLE orchestration_approvals (
+    workflow_id TEXT NOT NULL,
+    id TEXT NOT NULL,
+    summary TEXT NOT NULL,
+    diff TEXT,
+    artifacts TEXT,               -- JSON encoded []string
+    task_ids TEXT,                -- JSON encoded []string
+    paused_process_ids TEXT,      -- JSON encoded []string
+    interrupted_process_ids TEXT, -- JSON encoded []string
+    requested_at INTEGER,
+    PRIMARY KEY (workflow_id, id)
+);
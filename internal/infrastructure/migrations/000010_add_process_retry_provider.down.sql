@@ -0,0 +1 @@
+ALTER TABLE orchestration_processes DROP COLUMN retry_provider;
@@ -0,0 +1,2 @@
+-- Workers spawned on the retry provider, so resumed workflows keep resuming them on it
+ALTER TABLE orchestration_processes ADD COLUMN retry_provider INTEGER NOT NULL DEFAULT 0;
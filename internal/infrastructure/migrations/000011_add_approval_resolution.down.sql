@@ -0,0 +1,3 @@
+ALTER TABLE orchestration_approvals DROP COLUMN resolved_at;
+ALTER TABLE orchestration_approvals DROP COLUMN feedback;
+ALTER TABLE orchestration_approvals DROP COLUMN approved;
@@ -0,0 +1,5 @@
+-- Resolved approvals are kept with their decision, so a granted approval gate
+-- can be closed after a restart. resolved_at is NULL while pending.
+ALTER TABLE orchestration_approvals ADD COLUMN approved INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE orchestration_approvals ADD COLUMN feedback TEXT;
+ALTER TABLE orchestration_approvals ADD COLUMN resolved_at INTEGER;
@@ -78,7 +78,10 @@ func TestMigrations_Schema(t *testing.T) {
 	}
 	require.NoError(t, rows.Err())
 
-	expectedColumns := []string{"id", "guid", "project", "state", "created_at", "updated_at", "deleted_at"}
+	expectedColumns := []string{
+		"id", "guid", "project", "state", "created_at", "updated_at", "deleted_at",
+		"cost_usd", "budget_usd", "budget_tokens", "scheduled_at", "initial_prompt", "depends_on", "args", "outputs", "worker_worktrees",
+	}
 	for _, col := range expectedColumns {
 		require.True(t, columns[col], "column %s should exist", col)
 	}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/zjrosen/perles/internal/infrastructure/migrations"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	scheduledomain "github.com/zjrosen/perles/internal/schedules/domain"
 	"github.com/zjrosen/perles/internal/sessions/domain"
 
 	_ "github.com/ncruces/go-sqlite3/driver"
@@ -122,6 +124,41 @@ func (db *DB) SessionRepository() domain.SessionRepository {
 	return newSessionRepository(db.conn)
 }
 
+// ScheduleRepository returns a ScheduleRepository instance using this connection.
+// The repository implementation is in schedule_repository.go.
+func (db *DB) ScheduleRepository() scheduledomain.ScheduleRepository {
+	return newScheduleRepository(db.conn)
+}
+
+// ProcessRepository returns a ProcessRepository holding the orchestration
+// processes of a workflow, loaded with the state persisted for it.
+// The repository implementation is in orchestration_repository.go.
+func (db *DB) ProcessRepository(workflowID string) (repository.ProcessRepository, error) {
+	return newProcessRepository(db.conn, workflowID)
+}
+
+// TaskRepository returns a TaskRepository holding the task assignments of a
+// workflow, loaded with the state persisted for it.
+// The repository implementation is in orchestration_repository.go.
+func (db *DB) TaskRepository(workflowID string) (repository.TaskRepository, error) {
+	return newTaskRepository(db.conn, workflowID)
+}
+
+// QueueRepository returns a QueueRepository holding the message queues of a
+// workflow, loaded with the messages persisted for it. New queues hold at most
+// maxSize messages (0 means unlimited).
+// The repository implementation is in orchestration_repository.go.
+func (db *DB) QueueRepository(workflowID string, maxSize int) (repository.QueueRepository, error) {
+	return newQueueRepository(db.conn, workflowID, maxSize)
+}
+
+// ApprovalRepository returns an ApprovalRepository holding the approvals of a
+// workflow, loaded with the approvals still pending for it.
+// The repository implementation is in orchestration_repository.go.
+func (db *DB) ApprovalRepository(workflowID string) (repository.ApprovalRepository, error) {
+	return newApprovalRepository(db.conn, workflowID)
+}
+
 // Connection returns the underlying *sql.DB for testing purposes.
 func (db *DB) Connection() *sql.DB {
 	return db.conn
@@ -4,6 +4,11 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	scheduledomain "github.com/zjrosen/perles/internal/schedules/domain"
 	"github.com/zjrosen/perles/internal/sessions/domain"
 )
 
@@ -20,10 +25,19 @@ type SessionModel struct {
 	WorkDir    *string // nullable
 	Labels     *string // nullable, JSON encoded
 
+	// Coordinator prompt for starting pending sessions
+	InitialPrompt *string // nullable
+
+	// Dependencies
+	DependsOn *string // nullable, JSON encoded []string
+	Args      *string // nullable, JSON encoded
+	Outputs   *string // nullable, JSON encoded
+
 	// Worktree configuration
 	WorktreeEnabled    bool
 	WorktreeBaseBranch *string // nullable
 	WorktreeBranchName *string // nullable
+	WorkerWorktrees    bool
 
 	// Worktree state
 	WorktreePath   *string // nullable
@@ -39,6 +53,11 @@ type SessionModel struct {
 	// Metrics
 	TokensUsed    int64
 	ActiveWorkers int
+	CostUSD       float64
+
+	// Budget
+	BudgetTokens int64
+	BudgetUSD    float64
 
 	// Health tracking
 	LastHeartbeatAt *int64 // Unix timestamp, nullable
@@ -49,6 +68,7 @@ type SessionModel struct {
 	StartedAt   *int64 // Unix timestamp, nullable
 	PausedAt    *int64 // Unix timestamp, nullable
 	CompletedAt *int64 // Unix timestamp, nullable
+	ScheduledAt *int64 // Unix timestamp, nullable
 	UpdatedAt   int64  // Unix timestamp
 	ArchivedAt  *int64 // Unix timestamp, nullable
 	DeletedAt   *int64 // Unix timestamp, nullable
@@ -62,8 +82,12 @@ func toSessionModel(s *domain.Session) *SessionModel {
 		Project:         s.Project(),
 		State:           string(s.State()),
 		WorktreeEnabled: s.WorktreeEnabled(),
+		WorkerWorktrees: s.WorkerWorktrees(),
 		TokensUsed:      s.TokensUsed(),
 		ActiveWorkers:   s.ActiveWorkers(),
+		CostUSD:         s.CostUSD(),
+		BudgetTokens:    s.BudgetTokens(),
+		BudgetUSD:       s.BudgetUSD(),
 		CreatedAt:       s.CreatedAt().Unix(),
 		UpdatedAt:       s.UpdatedAt().Unix(),
 	}
@@ -83,6 +107,10 @@ func toSessionModel(s *domain.Session) *SessionModel {
 		workDir := s.WorkDir()
 		m.WorkDir = &workDir
 	}
+	if s.InitialPrompt() != "" {
+		initialPrompt := s.InitialPrompt()
+		m.InitialPrompt = &initialPrompt
+	}
 	if len(s.Labels()) > 0 {
 		labelsJSON, err := json.Marshal(s.Labels())
 		if err == nil {
@@ -90,6 +118,27 @@ func toSessionModel(s *domain.Session) *SessionModel {
 			m.Labels = &labels
 		}
 	}
+	if len(s.After()) > 0 {
+		afterJSON, err := json.Marshal(s.After())
+		if err == nil {
+			dependsOn := string(afterJSON)
+			m.DependsOn = &dependsOn
+		}
+	}
+	if len(s.Args()) > 0 {
+		argsJSON, err := json.Marshal(s.Args())
+		if err == nil {
+			args := string(argsJSON)
+			m.Args = &args
+		}
+	}
+	if len(s.Outputs()) > 0 {
+		outputsJSON, err := json.Marshal(s.Outputs())
+		if err == nil {
+			outputs := string(outputsJSON)
+			m.Outputs = &outputs
+		}
+	}
 	if s.WorktreeBaseBranch() != "" {
 		worktreeBaseBranch := s.WorktreeBaseBranch()
 		m.WorktreeBaseBranch = &worktreeBaseBranch
@@ -138,6 +187,10 @@ func toSessionModel(s *domain.Session) *SessionModel {
 		completedAt := s.CompletedAt().Unix()
 		m.CompletedAt = &completedAt
 	}
+	if s.ScheduledAt() != nil {
+		scheduledAt := s.ScheduledAt().Unix()
+		m.ScheduledAt = &scheduledAt
+	}
 	if s.ArchivedAt() != nil {
 		archivedAt := s.ArchivedAt().Unix()
 		m.ArchivedAt = &archivedAt
@@ -165,10 +218,25 @@ func (m *SessionModel) toDomain() *domain.Session {
 	if m.WorkDir != nil {
 		workDir = *m.WorkDir
 	}
+	var initialPrompt string
+	if m.InitialPrompt != nil {
+		initialPrompt = *m.InitialPrompt
+	}
 	var labels map[string]string
 	if m.Labels != nil {
 		_ = json.Unmarshal([]byte(*m.Labels), &labels)
 	}
+	var after []string
+	if m.DependsOn != nil {
+		_ = json.Unmarshal([]byte(*m.DependsOn), &after)
+	}
+	var args, outputs map[string]string
+	if m.Args != nil {
+		_ = json.Unmarshal([]byte(*m.Args), &args)
+	}
+	if m.Outputs != nil {
+		_ = json.Unmarshal([]byte(*m.Outputs), &outputs)
+	}
 	if m.WorktreeBaseBranch != nil {
 		worktreeBaseBranch = *m.WorktreeBaseBranch
 	}
@@ -220,6 +288,11 @@ func (m *SessionModel) toDomain() *domain.Session {
 		t := time.Unix(*m.CompletedAt, 0)
 		completedAt = &t
 	}
+	var scheduledAt *time.Time
+	if m.ScheduledAt != nil {
+		t := time.Unix(*m.ScheduledAt, 0)
+		scheduledAt = &t
+	}
 	var archivedAt *time.Time
 	if m.ArchivedAt != nil {
 		t := time.Unix(*m.ArchivedAt, 0)
@@ -239,8 +312,12 @@ func (m *SessionModel) toDomain() *domain.Session {
 		templateID,
 		epicID,
 		workDir,
+		initialPrompt,
 		labels,
-		m.WorktreeEnabled,
+		after,
+		args,
+		outputs,
+		m.WorktreeEnabled, m.WorkerWorktrees,
 		worktreeBaseBranch,
 		worktreeBranchName,
 		worktreePath,
@@ -250,14 +327,369 @@ func (m *SessionModel) toDomain() *domain.Session {
 		ownerCurrentPID,
 		m.TokensUsed,
 		m.ActiveWorkers,
+		m.CostUSD,
+		m.BudgetTokens,
+		m.BudgetUSD,
 		lastHeartbeatAt,
 		lastProgressAt,
 		time.Unix(m.CreatedAt, 0),
 		startedAt,
 		pausedAt,
 		completedAt,
+		scheduledAt,
 		time.Unix(m.UpdatedAt, 0),
 		archivedAt,
 		deletedAt,
 	)
 }
+
+// ScheduleModel represents the database row for the schedules table.
+// Fields map directly to SQL columns with Unix timestamps for time values.
+type ScheduleModel struct {
+	ID          int64
+	GUID        string
+	Project     string
+	Name        string
+	Cron        string
+	TemplateID  string
+	TargetQuery *string // nullable
+	Args        *string // nullable, JSON encoded
+	Labels      *string // nullable, JSON encoded
+
+	// Workflow settings
+	WorktreeEnabled    bool
+	WorktreeBaseBranch *string // nullable
+	Priority           int
+	BudgetUSD          float64
+
+	// Scheduling state
+	Enabled           bool
+	NextRunAt         *int64  // Unix timestamp, nullable
+	LastRunAt         *int64  // Unix timestamp, nullable
+	PendingWorkflowID *string // nullable
+
+	// Timestamps
+	CreatedAt int64  // Unix timestamp
+	UpdatedAt int64  // Unix timestamp
+	DeletedAt *int64 // Unix timestamp, nullable
+}
+
+// toScheduleModel converts a domain Schedule to a database ScheduleModel.
+func toScheduleModel(s *scheduledomain.Schedule) *ScheduleModel {
+	return &ScheduleModel{
+		ID:                 s.ID,
+		GUID:               s.GUID,
+		Project:            s.Project,
+		Name:               s.Name,
+		Cron:               s.Cron,
+		TemplateID:         s.TemplateID,
+		TargetQuery:        nullableString(s.TargetQuery),
+		Args:               nullableJSON(s.Args),
+		Labels:             nullableJSON(s.Labels),
+		WorktreeEnabled:    s.WorktreeEnabled,
+		WorktreeBaseBranch: nullableString(s.WorktreeBaseBranch),
+		Priority:           s.Priority,
+		BudgetUSD:          s.BudgetUSD,
+		Enabled:            s.Enabled,
+		NextRunAt:          nullableUnix(s.NextRunAt),
+		LastRunAt:          nullableUnix(s.LastRunAt),
+		PendingWorkflowID:  nullableString(s.PendingWorkflowID),
+		CreatedAt:          s.CreatedAt.Unix(),
+		UpdatedAt:          s.UpdatedAt.Unix(),
+		DeletedAt:          nullableUnix(s.DeletedAt),
+	}
+}
+
+// toDomain converts a database ScheduleModel to a domain Schedule.
+func (m *ScheduleModel) toDomain() *scheduledomain.Schedule {
+	s := &scheduledomain.Schedule{
+		ID:              m.ID,
+		GUID:            m.GUID,
+		Project:         m.Project,
+		Name:            m.Name,
+		Cron:            m.Cron,
+		TemplateID:      m.TemplateID,
+		WorktreeEnabled: m.WorktreeEnabled,
+		Priority:        m.Priority,
+		BudgetUSD:       m.BudgetUSD,
+		Enabled:         m.Enabled,
+		NextRunAt:       unixTime(m.NextRunAt),
+		LastRunAt:       unixTime(m.LastRunAt),
+		CreatedAt:       time.Unix(m.CreatedAt, 0),
+		UpdatedAt:       time.Unix(m.UpdatedAt, 0),
+		DeletedAt:       unixTime(m.DeletedAt),
+	}
+	if m.TargetQuery != nil {
+		s.TargetQuery = *m.TargetQuery
+	}
+	if m.Args != nil {
+		_ = json.Unmarshal([]byte(*m.Args), &s.Args)
+	}
+	if m.Labels != nil {
+		_ = json.Unmarshal([]byte(*m.Labels), &s.Labels)
+	}
+	if m.WorktreeBaseBranch != nil {
+		s.WorktreeBaseBranch = *m.WorktreeBaseBranch
+	}
+	if m.PendingWorkflowID != nil {
+		s.PendingWorkflowID = *m.PendingWorkflowID
+	}
+	return s
+}
+
+// ProcessModel represents the database row for the orchestration_processes table.
+// Fields map directly to SQL columns with Unix nanosecond timestamps for time values.
+type ProcessModel struct {
+	WorkflowID       string
+	ID               string
+	Role             string
+	Status           string
+	SessionID        *string // nullable
+	Metrics          *string // nullable, JSON encoded
+	HasCompletedTurn bool
+	Phase            *string // nullable
+	TaskID           *string // nullable
+	AgentType        *string // nullable
+	CreatedAt        *int64  // Unix nanoseconds, nullable
+	LastActivityAt   *int64  // Unix nanoseconds, nullable
+	RetiredAt        *int64  // Unix nanoseconds, nullable
+	RetryProvider    bool
+}
+
+// toProcessModel converts a v2 Process to a database ProcessModel.
+func toProcessModel(workflowID string, p *repository.Process) *ProcessModel {
+	m := &ProcessModel{
+		WorkflowID:       workflowID,
+		ID:               p.ID,
+		Role:             string(p.Role),
+		Status:           string(p.Status),
+		SessionID:        nullableString(p.SessionID),
+		HasCompletedTurn: p.HasCompletedTurn,
+		TaskID:           nullableString(p.TaskID),
+		AgentType:        nullableString(string(p.AgentType)),
+		CreatedAt:        nullableUnixNano(p.CreatedAt),
+		LastActivityAt:   nullableUnixNano(p.LastActivityAt),
+		RetiredAt:        nullableUnixNano(p.RetiredAt),
+		RetryProvider:    p.RetryProvider,
+	}
+	if p.Metrics != nil {
+		if data, err := json.Marshal(p.Metrics); err == nil {
+			encoded := string(data)
+			m.Metrics = &encoded
+		}
+	}
+	if p.Phase != nil {
+		phase := string(*p.Phase)
+		m.Phase = &phase
+	}
+	return m
+}
+
+// toDomain converts a database ProcessModel to a v2 Process.
+func (m *ProcessModel) toDomain() *repository.Process {
+	p := &repository.Process{
+		ID:               m.ID,
+		Role:             repository.ProcessRole(m.Role),
+		Status:           repository.ProcessStatus(m.Status),
+		HasCompletedTurn: m.HasCompletedTurn,
+		CreatedAt:        unixNanoTime(m.CreatedAt),
+		LastActivityAt:   unixNanoTime(m.LastActivityAt),
+		RetiredAt:        unixNanoTime(m.RetiredAt),
+		RetryProvider:    m.RetryProvider,
+	}
+	if m.SessionID != nil {
+		p.SessionID = *m.SessionID
+	}
+	if m.Metrics != nil {
+		var tokenMetrics metrics.TokenMetrics
+		if err := json.Unmarshal([]byte(*m.Metrics), &tokenMetrics); err == nil {
+			p.Metrics = &tokenMetrics
+		}
+	}
+	if m.Phase != nil {
+		phase := events.ProcessPhase(*m.Phase)
+		p.Phase = &phase
+	}
+	if m.TaskID != nil {
+		p.TaskID = *m.TaskID
+	}
+	if m.AgentType != nil {
+		p.AgentType = roles.AgentType(*m.AgentType)
+	}
+	return p
+}
+
+// TaskModel represents the database row for the orchestration_tasks table.
+// Fields map directly to SQL columns with Unix nanosecond timestamps for time values.
+type TaskModel struct {
+	WorkflowID      string
+	TaskID          string
+	Implementer     string
+	Reviewer        *string // nullable
+	Status          string
+	ThreadID        *string // nullable
+	StartedAt       *int64  // Unix nanoseconds, nullable
+	ReviewStartedAt *int64  // Unix nanoseconds, nullable
+}
+
+// toTaskModel converts a v2 TaskAssignment to a database TaskModel.
+func toTaskModel(workflowID string, t *repository.TaskAssignment) *TaskModel {
+	return &TaskModel{
+		WorkflowID:      workflowID,
+		TaskID:          t.TaskID,
+		Implementer:     t.Implementer,
+		Reviewer:        nullableString(t.Reviewer),
+		Status:          string(t.Status),
+		ThreadID:        nullableString(t.ThreadID),
+		StartedAt:       nullableUnixNano(t.StartedAt),
+		ReviewStartedAt: nullableUnixNano(t.ReviewStartedAt),
+	}
+}
+
+// toDomain converts a database TaskModel to a v2 TaskAssignment.
+func (m *TaskModel) toDomain() *repository.TaskAssignment {
+	t := &repository.TaskAssignment{
+		TaskID:          m.TaskID,
+		Implementer:     m.Implementer,
+		Status:          repository.TaskStatus(m.Status),
+		StartedAt:       unixNanoTime(m.StartedAt),
+		ReviewStartedAt: unixNanoTime(m.ReviewStartedAt),
+	}
+	if m.Reviewer != nil {
+		t.Reviewer = *m.Reviewer
+	}
+	if m.ThreadID != nil {
+		t.ThreadID = *m.ThreadID
+	}
+	return t
+}
+
+// ApprovalModel represents the database row for the orchestration_approvals table.
+// Fields map directly to SQL columns with Unix nanosecond timestamps for time values.
+type ApprovalModel struct {
+	WorkflowID            string
+	ID                    string
+	Summary               string
+	Diff                  *string // nullable
+	Artifacts             *string // nullable, JSON encoded []string
+	TaskIDs               *string // nullable, JSON encoded []string
+	PausedProcessIDs      *string // nullable, JSON encoded []string
+	InterruptedProcessIDs *string // nullable, JSON encoded []string
+	RequestedAt           *int64  // Unix nanoseconds, nullable
+	Approved              bool
+	Feedback              *string // nullable
+	ResolvedAt            *int64  // Unix nanoseconds, nullable while pending
+}
+
+// toApprovalModel converts a v2 Approval to a database ApprovalModel.
+func toApprovalModel(workflowID string, a *repository.Approval) *ApprovalModel {
+	return &ApprovalModel{
+		WorkflowID:            workflowID,
+		ID:                    a.ID,
+		Summary:               a.Summary,
+		Diff:                  nullableString(a.Diff),
+		Artifacts:             nullableJSONList(a.Artifacts),
+		TaskIDs:               nullableJSONList(a.TaskIDs),
+		PausedProcessIDs:      nullableJSONList(a.PausedProcessIDs),
+		InterruptedProcessIDs: nullableJSONList(a.InterruptedProcessIDs),
+		RequestedAt:           nullableUnixNano(a.RequestedAt),
+		Approved:              a.Approved,
+		Feedback:              nullableString(a.Feedback),
+		ResolvedAt:            nullableUnixNano(a.ResolvedAt),
+	}
+}
+
+// toDomain converts a database ApprovalModel to a v2 Approval.
+func (m *ApprovalModel) toDomain() *repository.Approval {
+	a := &repository.Approval{
+		ID:                    m.ID,
+		Summary:               m.Summary,
+		Artifacts:             jsonList(m.Artifacts),
+		TaskIDs:               jsonList(m.TaskIDs),
+		PausedProcessIDs:      jsonList(m.PausedProcessIDs),
+		InterruptedProcessIDs: jsonList(m.InterruptedProcessIDs),
+		RequestedAt:           unixNanoTime(m.RequestedAt),
+		Approved:              m.Approved,
+		ResolvedAt:            unixNanoTime(m.ResolvedAt),
+	}
+	if m.Diff != nil {
+		a.Diff = *m.Diff
+	}
+	if m.Feedback != nil {
+		a.Feedback = *m.Feedback
+	}
+	return a
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullableJSON(v map[string]string) *string {
+	if len(v) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+func nullableJSONList(v []string) *string {
+	if len(v) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+func jsonList(s *string) []string {
+	if s == nil {
+		return nil
+	}
+	var v []string
+	if err := json.Unmarshal([]byte(*s), &v); err != nil {
+		return nil
+	}
+	return v
+}
+
+func nullableUnix(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	u := t.Unix()
+	return &u
+}
+
+func unixTime(u *int64) *time.Time {
+	if u == nil {
+		return nil
+	}
+	t := time.Unix(*u, 0)
+	return &t
+}
+
+func nullableUnixNano(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	u := t.UnixNano()
+	return &u
+}
+
+func unixNanoTime(u *int64) time.Time {
+	if u == nil {
+		return time.Time{}
+	}
+	return time.Unix(0, *u)
+}
@@ -0,0 +1,475 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// The orchestration repositories keep the state of one workflow in memory and
+// write every change through to SQLite. Reads never touch the database, so
+// handlers see the same behavior as with the in-memory repositories, while a
+// restarted process loads the exact state the workflow had before a crash.
+
+// processColumns is the list of columns to select for process queries.
+const processColumns = `workflow_id, id, role, status, session_id, metrics, has_completed_turn,
+	phase, task_id, agent_type, created_at, last_activity_at, retired_at, retry_provider`
+
+// taskColumns is the list of columns to select for task queries.
+const taskColumns = `workflow_id, task_id, implementer, reviewer, status, thread_id,
+	started_at, review_started_at`
+
+// approvalColumns is the list of columns to select for approval queries.
+const approvalColumns = `workflow_id, id, summary, diff, artifacts, task_ids,
+	paused_process_ids, interrupted_process_ids, requested_at, approved, feedback, resolved_at`
+
+// ===========================================================================
+// processRepository
+// ===========================================================================
+
+// processRepository implements repository.ProcessRepository using SQLite.
+type processRepository struct {
+	db         *sql.DB
+	workflowID string
+	cache      *repository.MemoryProcessRepository
+}
+
+// Ensure processRepository implements repository.ProcessRepository.
+var _ repository.ProcessRepository = (*processRepository)(nil)
+
+// newProcessRepository creates a processRepository for a workflow, loaded with
+// the processes persisted for it.
+func newProcessRepository(db *sql.DB, workflowID string) (*processRepository, error) {
+	r := &processRepository{
+		db:         db,
+		workflowID: workflowID,
+		cache:      repository.NewMemoryProcessRepository(),
+	}
+
+	rows, err := db.Query(`SELECT `+processColumns+` FROM orchestration_processes WHERE workflow_id = ?`, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load processes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var model ProcessModel
+		if err := rows.Scan(
+			&model.WorkflowID, &model.ID, &model.Role, &model.Status, &model.SessionID, &model.Metrics,
+			&model.HasCompletedTurn, &model.Phase, &model.TaskID, &model.AgentType,
+			&model.CreatedAt, &model.LastActivityAt, &model.RetiredAt, &model.RetryProvider,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan process row: %w", err)
+		}
+		r.cache.AddProcess(model.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating process rows: %w", err)
+	}
+	return r, nil
+}
+
+// Get retrieves a process by ID.
+// Returns ErrProcessNotFound if the process does not exist.
+func (r *processRepository) Get(processID string) (*repository.Process, error) {
+	return r.cache.Get(processID)
+}
+
+// Save persists a process. Creates new or updates existing.
+func (r *processRepository) Save(process *repository.Process) error {
+	model := toProcessModel(r.workflowID, process)
+	_, err := r.db.Exec(
+		`INSERT OR REPLACE INTO orchestration_processes (`+processColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		model.WorkflowID, model.ID, model.Role, model.Status, model.SessionID, model.Metrics,
+		model.HasCompletedTurn, model.Phase, model.TaskID, model.AgentType,
+		model.CreatedAt, model.LastActivityAt, model.RetiredAt, model.RetryProvider,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save process %s: %w", process.ID, err)
+	}
+	return r.cache.Save(process)
+}
+
+// List returns all processes in the repository.
+func (r *processRepository) List() []*repository.Process {
+	return r.cache.List()
+}
+
+// GetCoordinator returns the coordinator process.
+// Returns ErrProcessNotFound if coordinator hasn't been created.
+func (r *processRepository) GetCoordinator() (*repository.Process, error) {
+	return r.cache.GetCoordinator()
+}
+
+// Workers returns all worker processes (excluding coordinator).
+func (r *processRepository) Workers() []*repository.Process {
+	return r.cache.Workers()
+}
+
+// ActiveWorkers returns workers not in terminal state (not Retired or Failed).
+func (r *processRepository) ActiveWorkers() []*repository.Process {
+	return r.cache.ActiveWorkers()
+}
+
+// ReadyWorkers returns workers available for assignment (Ready status, Idle phase).
+func (r *processRepository) ReadyWorkers() []*repository.Process {
+	return r.cache.ReadyWorkers()
+}
+
+// RetiredWorkers returns workers that were gracefully retired.
+func (r *processRepository) RetiredWorkers() []*repository.Process {
+	return r.cache.RetiredWorkers()
+}
+
+// FailedWorkers returns workers that failed (session expired, crashed, etc.).
+func (r *processRepository) FailedWorkers() []*repository.Process {
+	return r.cache.FailedWorkers()
+}
+
+// ===========================================================================
+// taskRepository
+// ===========================================================================
+
+// taskRepository implements repository.TaskRepository using SQLite.
+type taskRepository struct {
+	db         *sql.DB
+	workflowID string
+	cache      *repository.MemoryTaskRepository
+}
+
+// Ensure taskRepository implements repository.TaskRepository.
+var _ repository.TaskRepository = (*taskRepository)(nil)
+
+// newTaskRepository creates a taskRepository for a workflow, loaded with the
+// task assignments persisted for it.
+func newTaskRepository(db *sql.DB, workflowID string) (*taskRepository, error) {
+	r := &taskRepository{
+		db:         db,
+		workflowID: workflowID,
+		cache:      repository.NewMemoryTaskRepository(),
+	}
+
+	rows, err := db.Query(`SELECT `+taskColumns+` FROM orchestration_tasks WHERE workflow_id = ?`, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var model TaskModel
+		if err := rows.Scan(
+			&model.WorkflowID, &model.TaskID, &model.Implementer, &model.Reviewer, &model.Status,
+			&model.ThreadID, &model.StartedAt, &model.ReviewStartedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan task row: %w", err)
+		}
+		r.cache.AddTask(model.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task rows: %w", err)
+	}
+	return r, nil
+}
+
+// Get retrieves a task assignment by task ID.
+// Returns ErrTaskNotFound if the task does not exist.
+func (r *taskRepository) Get(taskID string) (*repository.TaskAssignment, error) {
+	return r.cache.Get(taskID)
+}
+
+// Save persists a task assignment. Creates new or updates existing.
+func (r *taskRepository) Save(task *repository.TaskAssignment) error {
+	model := toTaskModel(r.workflowID, task)
+	_, err := r.db.Exec(
+		`INSERT OR REPLACE INTO orchestration_tasks (`+taskColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		model.WorkflowID, model.TaskID, model.Implementer, model.Reviewer, model.Status,
+		model.ThreadID, model.StartedAt, model.ReviewStartedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save task %s: %w", task.TaskID, err)
+	}
+	return r.cache.Save(task)
+}
+
+// GetByWorker retrieves the task currently assigned to a worker (as implementer or reviewer).
+// Returns ErrTaskNotFound if no task is assigned to the worker.
+func (r *taskRepository) GetByWorker(workerID string) (*repository.TaskAssignment, error) {
+	return r.cache.GetByWorker(workerID)
+}
+
+// GetByImplementer retrieves all tasks where the worker is the implementer.
+func (r *taskRepository) GetByImplementer(workerID string) ([]*repository.TaskAssignment, error) {
+	return r.cache.GetByImplementer(workerID)
+}
+
+// All returns all task assignments in the repository.
+func (r *taskRepository) All() []*repository.TaskAssignment {
+	return r.cache.All()
+}
+
+// Delete removes a task assignment from the repository.
+func (r *taskRepository) Delete(taskID string) error {
+	_, err := r.db.Exec(
+		`DELETE FROM orchestration_tasks WHERE workflow_id = ? AND task_id = ?`,
+		r.workflowID, taskID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete task %s: %w", taskID, err)
+	}
+	return r.cache.Delete(taskID)
+}
+
+// ===========================================================================
+// queueRepository
+// ===========================================================================
+
+// queueRepository implements repository.QueueRepository using SQLite.
+// It is also the repository.QueueStore of its queues, so every enqueue and
+// dequeue is persisted as it happens.
+type queueRepository struct {
+	db         *sql.DB
+	workflowID string
+	maxSize    int
+
+	mu     sync.RWMutex
+	queues map[string]*repository.MessageQueue
+}
+
+// Ensure queueRepository implements repository.QueueRepository and repository.QueueStore.
+var (
+	_ repository.QueueRepository = (*queueRepository)(nil)
+	_ repository.QueueStore      = (*queueRepository)(nil)
+)
+
+// newQueueRepository creates a queueRepository for a workflow, loaded with the
+// queued messages persisted for it.
+func newQueueRepository(db *sql.DB, workflowID string, maxSize int) (*queueRepository, error) {
+	r := &queueRepository{
+		db:         db,
+		workflowID: workflowID,
+		maxSize:    maxSize,
+		queues:     make(map[string]*repository.MessageQueue),
+	}
+
+	rows, err := db.Query(
+		`SELECT worker_id, content, sender, priority, created_at FROM orchestration_queue_entries
+		 WHERE workflow_id = ? ORDER BY priority DESC, id`,
+		workflowID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load queued messages: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	entries := make(map[string][]repository.QueueEntry)
+	for rows.Next() {
+		var workerID, sender string
+		var createdAt int64
+		var entry repository.QueueEntry
+		if err := rows.Scan(&workerID, &entry.Content, &sender, &entry.Priority, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan queued message row: %w", err)
+		}
+		entry.Sender = repository.SenderType(sender)
+		entry.Timestamp = time.Unix(0, createdAt)
+		entries[workerID] = append(entries[workerID], entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating queued message rows: %w", err)
+	}
+
+	for workerID, workerEntries := range entries {
+		r.queues[workerID] = repository.NewStoredMessageQueue(workerID, maxSize, workerEntries, r)
+	}
+	return r, nil
+}
+
+// GetOrCreate retrieves or creates a message queue for a worker.
+// Never returns nil - creates a new queue if one doesn't exist.
+func (r *queueRepository) GetOrCreate(workerID string) *repository.MessageQueue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue, ok := r.queues[workerID]
+	if !ok {
+		queue = repository.NewStoredMessageQueue(workerID, r.maxSize, nil, r)
+		r.queues[workerID] = queue
+	}
+	return queue
+}
+
+// Delete removes a worker's message queue from the repository.
+func (r *queueRepository) Delete(workerID string) {
+	r.mu.Lock()
+	delete(r.queues, workerID)
+	r.mu.Unlock()
+
+	r.RemoveAll(workerID)
+}
+
+// Size returns the number of messages in a worker's queue.
+// Returns 0 if the worker has no queue.
+func (r *queueRepository) Size(workerID string) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	queue, ok := r.queues[workerID]
+	if !ok {
+		return 0
+	}
+	return queue.Size()
+}
+
+// ClearAll removes all message queues from the repository.
+// Used when pausing workflows to discard all pending messages.
+func (r *queueRepository) ClearAll() {
+	r.mu.Lock()
+	r.queues = make(map[string]*repository.MessageQueue)
+	r.mu.Unlock()
+
+	if _, err := r.db.Exec(`DELETE FROM orchestration_queue_entries WHERE workflow_id = ?`, r.workflowID); err != nil {
+		log.ErrorErr(log.CatDB, "Failed to clear queued messages", err, "workflowID", r.workflowID)
+	}
+}
+
+// Append persists an entry added to a worker's queue.
+func (r *queueRepository) Append(workerID string, entry repository.QueueEntry) error {
+	_, err := r.db.Exec(
+		`INSERT INTO orchestration_queue_entries (workflow_id, worker_id, content, sender, priority, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?)`,
+		r.workflowID, workerID, entry.Content, string(entry.Sender), int(entry.Priority), entry.Timestamp.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to persist queued message for %s: %w", workerID, err)
+	}
+	return nil
+}
+
+// RemoveFirst removes the oldest persisted entry of the highest priority in a worker's queue.
+func (r *queueRepository) RemoveFirst(workerID string) {
+	_, err := r.db.Exec(
+		`DELETE FROM orchestration_queue_entries WHERE id = (
+			SELECT id FROM orchestration_queue_entries WHERE workflow_id = ? AND worker_id = ?
+			ORDER BY priority DESC, id LIMIT 1
+		)`,
+		r.workflowID, workerID,
+	)
+	if err != nil {
+		log.ErrorErr(log.CatDB, "Failed to remove dequeued message", err,
+			"workflowID", r.workflowID, "workerID", workerID)
+	}
+}
+
+// RemoveAll removes every persisted entry of a worker's queue.
+func (r *queueRepository) RemoveAll(workerID string) {
+	_, err := r.db.Exec(
+		`DELETE FROM orchestration_queue_entries WHERE workflow_id = ? AND worker_id = ?`,
+		r.workflowID, workerID,
+	)
+	if err != nil {
+		log.ErrorErr(log.CatDB, "Failed to remove queued messages", err,
+			"workflowID", r.workflowID, "workerID", workerID)
+	}
+}
+
+// ===========================================================================
+// approvalRepository
+// ===========================================================================
+
+// approvalRepository implements repository.ApprovalRepository using SQLite.
+type approvalRepository struct {
+	db         *sql.DB
+	workflowID string
+	cache      *repository.MemoryApprovalRepository
+}
+
+// Ensure approvalRepository implements repository.ApprovalRepository.
+var _ repository.ApprovalRepository = (*approvalRepository)(nil)
+
+// newApprovalRepository creates an approvalRepository for a workflow, loaded
+// with its pending and resolved approvals.
+func newApprovalRepository(db *sql.DB, workflowID string) (*approvalRepository, error) {
+	r := &approvalRepository{
+		db:         db,
+		workflowID: workflowID,
+		cache:      repository.NewMemoryApprovalRepository(),
+	}
+
+	rows, err := db.Query(`SELECT `+approvalColumns+` FROM orchestration_approvals WHERE workflow_id = ?`, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load approvals: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var model ApprovalModel
+		if err := rows.Scan(
+			&model.WorkflowID, &model.ID, &model.Summary, &model.Diff, &model.Artifacts, &model.TaskIDs,
+			&model.PausedProcessIDs, &model.InterruptedProcessIDs, &model.RequestedAt,
+			&model.Approved, &model.Feedback, &model.ResolvedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan approval row: %w", err)
+		}
+		if err := r.cache.Save(model.toDomain()); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating approval rows: %w", err)
+	}
+	return r, nil
+}
+
+// Get retrieves a pending approval by ID.
+// Returns ErrApprovalNotFound if no such approval is pending.
+func (r *approvalRepository) Get(approvalID string) (*repository.Approval, error) {
+	return r.cache.Get(approvalID)
+}
+
+// Save persists an approval. Creates new or updates existing.
+func (r *approvalRepository) Save(approval *repository.Approval) error {
+	model := toApprovalModel(r.workflowID, approval)
+	_, err := r.db.Exec(
+		`INSERT OR REPLACE INTO orchestration_approvals (`+approvalColumns+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		model.WorkflowID, model.ID, model.Summary, model.Diff, model.Artifacts, model.TaskIDs,
+		model.PausedProcessIDs, model.InterruptedProcessIDs, model.RequestedAt,
+		model.Approved, model.Feedback, model.ResolvedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save approval %s: %w", approval.ID, err)
+	}
+	return r.cache.Save(approval)
+}
+
+// Delete removes an approval from the repository.
+func (r *approvalRepository) Delete(approvalID string) error {
+	_, err := r.db.Exec(
+		`DELETE FROM orchestration_approvals WHERE workflow_id = ? AND id = ?`,
+		r.workflowID, approvalID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete approval %s: %w", approvalID, err)
+	}
+	return r.cache.Delete(approvalID)
+}
+
+// Pending returns all pending approvals, oldest first.
+func (r *approvalRepository) Pending() []*repository.Approval {
+	return r.cache.Pending()
+}
+
+// ForTask returns the pending approval holding a task, or false if none does.
+func (r *approvalRepository) ForTask(taskID string) (*repository.Approval, bool) {
+	return r.cache.ForTask(taskID)
+}
+
+// Granted reports whether a resolved approval covering a task was approved.
+func (r *approvalRepository) Granted(taskID string) bool {
+	return r.cache.Granted(taskID)
+}
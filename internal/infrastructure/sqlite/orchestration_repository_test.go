@@ -0,0 +1,191 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository/repositorytest"
+)
+
+// openTestDB opens the database at path and closes it when the test ends.
+func openTestDB(t *testing.T, path string) *DB {
+	t.Helper()
+	db, err := NewDB(path)
+	require.NoError(t, err, "Failed to create test database")
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestProcessRepository_Contract(t *testing.T) {
+	repositorytest.TestProcessRepository(t, func(t *testing.T) repository.ProcessRepository {
+		repo, err := openTestDB(t, filepath.Join(t.TempDir(), "test.db")).ProcessRepository("wf-1")
+		require.NoError(t, err)
+		return repo
+	})
+}
+
+func TestTaskRepository_Contract(t *testing.T) {
+	repositorytest.TestTaskRepository(t, func(t *testing.T) repository.TaskRepository {
+		repo, err := openTestDB(t, filepath.Join(t.TempDir(), "test.db")).TaskRepository("wf-1")
+		require.NoError(t, err)
+		return repo
+	})
+}
+
+func TestQueueRepository_Contract(t *testing.T) {
+	repositorytest.TestQueueRepository(t, func(t *testing.T, maxSize int) repository.QueueRepository {
+		repo, err := openTestDB(t, filepath.Join(t.TempDir(), "test.db")).QueueRepository("wf-1", maxSize)
+		require.NoError(t, err)
+		return repo
+	})
+}
+
+func TestOrchestrationRepositories_SurviveRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	db := openTestDB(t, path)
+
+	processes, err := db.ProcessRepository("wf-1")
+	require.NoError(t, err)
+	tasks, err := db.TaskRepository("wf-1")
+	require.NoError(t, err)
+	queues, err := db.QueueRepository("wf-1", 0)
+	require.NoError(t, err)
+
+	awaitingReview := events.ProcessPhaseAwaitingReview
+	worker := &repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusReady,
+		SessionID:        "session-abc",
+		Metrics:          &metrics.TokenMetrics{TokensUsed: 27000, TotalTokens: 200000, CumulativeCostUSD: 0.42},
+		CreatedAt:        time.Unix(1767225600, 123456789),
+		HasCompletedTurn: true,
+		Phase:            &awaitingReview,
+		TaskID:           "perles-abc1.2",
+		RetryProvider:    true,
+	}
+	require.NoError(t, processes.Save(worker))
+	task := &repository.TaskAssignment{
+		TaskID:          "perles-abc1.2",
+		Implementer:     "worker-1",
+		Reviewer:        "worker-2",
+		Status:          repository.TaskInReview,
+		StartedAt:       time.Unix(1767225600, 0),
+		ReviewStartedAt: time.Unix(1767229200, 987654321),
+		ThreadID:        "thread-7",
+	}
+	require.NoError(t, tasks.Save(task))
+	require.NoError(t, queues.GetOrCreate("worker-2").Enqueue("review perles-abc1.2", repository.SenderCoordinator))
+	require.NoError(t, queues.GetOrCreate("worker-2").Enqueue("reminder", repository.SenderSystem))
+	require.NoError(t, queues.GetOrCreate("worker-2").Enqueue("hurry up", repository.SenderUser))
+	require.NoError(t, queues.GetOrCreate("worker-2").EnqueueWithPriority("STOP", repository.SenderUser, repository.PriorityInterrupt))
+	_, _ = queues.GetOrCreate("worker-2").Dequeue()
+
+	// Simulate a crash: reopen the database without any shutdown.
+	reopened := openTestDB(t, path)
+
+	restoredProcesses, err := reopened.ProcessRepository("wf-1")
+	require.NoError(t, err)
+	restoredWorker, err := restoredProcesses.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, worker, restoredWorker)
+
+	restoredTasks, err := reopened.TaskRepository("wf-1")
+	require.NoError(t, err)
+	restoredTask, err := restoredTasks.Get("perles-abc1.2")
+	require.NoError(t, err)
+	require.Equal(t, task, restoredTask)
+
+	restoredQueues, err := reopened.QueueRepository("wf-1", 0)
+	require.NoError(t, err)
+	entries := restoredQueues.GetOrCreate("worker-2").Drain()
+	require.Len(t, entries, 3, "dequeued messages are not restored")
+	require.Equal(t, "hurry up", entries[0].Content)
+	require.Equal(t, repository.PriorityUser, entries[0].Priority)
+	require.Equal(t, "review perles-abc1.2", entries[1].Content)
+	require.Equal(t, "reminder", entries[2].Content)
+	require.Equal(t, repository.SenderSystem, entries[2].Sender)
+	require.Equal(t, repository.PriorityNotification, entries[2].Priority)
+
+	drained, err := openTestDB(t, path).QueueRepository("wf-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, drained.Size("worker-2"), "drained messages are not restored")
+}
+
+func TestOrchestrationRepositories_ScopedToWorkflow(t *testing.T) {
+	db := openTestDB(t, filepath.Join(t.TempDir(), "test.db"))
+
+	processes, err := db.ProcessRepository("wf-1")
+	require.NoError(t, err)
+	require.NoError(t, processes.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady}))
+	tasks, err := db.TaskRepository("wf-1")
+	require.NoError(t, err)
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	queues, err := db.QueueRepository("wf-1", 0)
+	require.NoError(t, err)
+	require.NoError(t, queues.GetOrCreate("worker-1").Enqueue("hello", repository.SenderCoordinator))
+
+	otherProcesses, err := db.ProcessRepository("wf-2")
+	require.NoError(t, err)
+	require.Empty(t, otherProcesses.List())
+	otherTasks, err := db.TaskRepository("wf-2")
+	require.NoError(t, err)
+	require.Empty(t, otherTasks.All())
+	otherQueues, err := db.QueueRepository("wf-2", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, otherQueues.Size("worker-1"))
+
+	otherQueues.ClearAll()
+	restoredQueues, err := db.QueueRepository("wf-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, restoredQueues.Size("worker-1"), "clearing another workflow keeps this workflow's messages")
+}
+
+func TestApprovalRepository_SurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	approvals, err := openTestDB(t, path).ApprovalRepository("wf-1")
+	require.NoError(t, err)
+
+	pending := &repository.Approval{
+		ID:                    "approval-1",
+		Summary:               "Drop the legacy users table",
+		Diff:                  "-CREATE TABLE legacy_users",
+		Artifacts:             []string{"migrations/0042_drop_legacy_users.sql"},
+		TaskIDs:               []string{"perles-abc1.2", "perles-abc1.3"},
+		PausedProcessIDs:      []string{"worker-1", "worker-2"},
+		InterruptedProcessIDs: []string{"worker-1"},
+		RequestedAt:           time.Unix(1767225600, 123456789),
+	}
+	require.NoError(t, approvals.Save(pending))
+	require.NoError(t, approvals.Save(&repository.Approval{ID: "approval-2", Summary: "Deploy", RequestedAt: time.Unix(1767229200, 0)}))
+	require.NoError(t, approvals.Delete("approval-2"))
+	granted := &repository.Approval{
+		ID:          "approval-3",
+		Summary:     "Release gate",
+		TaskIDs:     []string{"perles-abc1.4"},
+		RequestedAt: time.Unix(1767229200, 0),
+		Approved:    true,
+		Feedback:    "Ship it",
+		ResolvedAt:  time.Unix(1767232800, 0),
+	}
+	require.NoError(t, approvals.Save(granted))
+
+	// Simulate a crash: reopen the database without any shutdown.
+	restored, err := openTestDB(t, path).ApprovalRepository("wf-1")
+	require.NoError(t, err)
+	require.Equal(t, []*repository.Approval{pending}, restored.Pending(), "resolved approvals are not pending")
+	require.True(t, restored.Granted("perles-abc1.4"), "granted approvals are restored")
+	held, ok := restored.ForTask("perles-abc1.3")
+	require.True(t, ok)
+	require.Equal(t, "approval-1", held.ID)
+
+	other, err := openTestDB(t, path).ApprovalRepository("wf-2")
+	require.NoError(t, err)
+	require.Empty(t, other.Pending())
+}
@@ -0,0 +1,184 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	scheduledomain "github.com/zjrosen/perles/internal/schedules/domain"
+)
+
+// scheduleColumns is the list of columns to select for schedule queries.
+const scheduleColumns = `id, guid, project, name, cron, template_id, target_query, args, labels,
+	worktree_enabled, worktree_base_branch, priority, budget_usd,
+	enabled, next_run_at, last_run_at, pending_workflow_id,
+	created_at, updated_at, deleted_at`
+
+// scheduleRepository implements scheduledomain.ScheduleRepository using SQLite.
+type scheduleRepository struct {
+	db *sql.DB
+}
+
+// newScheduleRepository creates a new scheduleRepository instance.
+func newScheduleRepository(db *sql.DB) *scheduleRepository {
+	return &scheduleRepository{db: db}
+}
+
+// Ensure scheduleRepository implements scheduledomain.ScheduleRepository.
+var _ scheduledomain.ScheduleRepository = (*scheduleRepository)(nil)
+
+// scanSchedule scans a row into a ScheduleModel.
+func scanSchedule(scanner interface{ Scan(...any) error }) (*ScheduleModel, error) {
+	var model ScheduleModel
+	err := scanner.Scan(
+		&model.ID, &model.GUID, &model.Project, &model.Name, &model.Cron,
+		&model.TemplateID, &model.TargetQuery, &model.Args, &model.Labels,
+		&model.WorktreeEnabled, &model.WorktreeBaseBranch, &model.Priority, &model.BudgetUSD,
+		&model.Enabled, &model.NextRunAt, &model.LastRunAt, &model.PendingWorkflowID,
+		&model.CreatedAt, &model.UpdatedAt, &model.DeletedAt,
+	)
+	return &model, err
+}
+
+// Save persists a schedule to the database.
+// For new schedules (ID == 0), inserts a new row and sets the schedule ID.
+// For existing schedules (ID > 0), updates the existing row.
+func (r *scheduleRepository) Save(schedule *scheduledomain.Schedule) error {
+	model := toScheduleModel(schedule)
+
+	if schedule.ID == 0 {
+		result, err := r.db.Exec(
+			`INSERT INTO schedules (
+				guid, project, name, cron, template_id, target_query, args, labels,
+				worktree_enabled, worktree_base_branch, priority, budget_usd,
+				enabled, next_run_at, last_run_at, pending_workflow_id,
+				created_at, updated_at, deleted_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			model.GUID, model.Project, model.Name, model.Cron, model.TemplateID,
+			model.TargetQuery, model.Args, model.Labels,
+			model.WorktreeEnabled, model.WorktreeBaseBranch, model.Priority, model.BudgetUSD,
+			model.Enabled, model.NextRunAt, model.LastRunAt, model.PendingWorkflowID,
+			model.CreatedAt, model.UpdatedAt, model.DeletedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert schedule: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		schedule.ID = id
+		return nil
+	}
+
+	_, err := r.db.Exec(
+		`UPDATE schedules SET
+			name = ?, cron = ?, template_id = ?, target_query = ?, args = ?, labels = ?,
+			worktree_enabled = ?, worktree_base_branch = ?, priority = ?, budget_usd = ?,
+			enabled = ?, next_run_at = ?, last_run_at = ?, pending_workflow_id = ?,
+			updated_at = ?, deleted_at = ?
+		WHERE id = ?`,
+		model.Name, model.Cron, model.TemplateID, model.TargetQuery, model.Args, model.Labels,
+		model.WorktreeEnabled, model.WorktreeBaseBranch, model.Priority, model.BudgetUSD,
+		model.Enabled, model.NextRunAt, model.LastRunAt, model.PendingWorkflowID,
+		model.UpdatedAt, model.DeletedAt,
+		model.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// FindByGUID retrieves a schedule by its GUID within a specific project.
+// Returns ScheduleNotFoundError if no matching schedule exists.
+func (r *scheduleRepository) FindByGUID(project, guid string) (*scheduledomain.Schedule, error) {
+	row := r.db.QueryRow(
+		`SELECT `+scheduleColumns+` FROM schedules WHERE project = ? AND guid = ? AND deleted_at IS NULL`,
+		project, guid,
+	)
+	model, err := scanSchedule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &scheduledomain.ScheduleNotFoundError{GUID: guid, Project: project}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find schedule by guid: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// List retrieves all schedules for a project ordered by creation time.
+func (r *scheduleRepository) List(project string) ([]*scheduledomain.Schedule, error) {
+	rows, err := r.db.Query(
+		`SELECT `+scheduleColumns+` FROM schedules WHERE project = ? AND deleted_at IS NULL ORDER BY created_at, id`,
+		project,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var schedules []*scheduledomain.Schedule
+	for rows.Next() {
+		model, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule row: %w", err)
+		}
+		schedules = append(schedules, model.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating schedule rows: %w", err)
+	}
+	return schedules, nil
+}
+
+// Delete performs a soft delete on a schedule by setting its deleted_at timestamp.
+// Returns ScheduleNotFoundError if no matching schedule exists.
+func (r *scheduleRepository) Delete(project, guid string) error {
+	now := time.Now().Unix()
+	result, err := r.db.Exec(
+		`UPDATE schedules SET deleted_at = ?, updated_at = ?
+		 WHERE project = ? AND guid = ? AND deleted_at IS NULL`,
+		now, now, project, guid,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &scheduledomain.ScheduleNotFoundError{GUID: guid, Project: project}
+	}
+	return nil
+}
+
+// HasTarget reports whether a workflow was already created for a target issue.
+func (r *scheduleRepository) HasTarget(scheduleID int64, targetID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(
+		`SELECT EXISTS(SELECT 1 FROM schedule_targets WHERE schedule_id = ? AND target_id = ?)`,
+		scheduleID, targetID,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check schedule target: %w", err)
+	}
+	return exists, nil
+}
+
+// RecordTarget records that a workflow was created for a target issue.
+// Recording a target again keeps the original record.
+func (r *scheduleRepository) RecordTarget(scheduleID int64, targetID, workflowID string) error {
+	_, err := r.db.Exec(
+		`INSERT OR IGNORE INTO schedule_targets (schedule_id, target_id, workflow_id, created_at)
+		 VALUES (?, ?, ?, ?)`,
+		scheduleID, targetID, workflowID, time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record schedule target: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	scheduledomain "github.com/zjrosen/perles/internal/schedules/domain"
+)
+
+// setupTestScheduleRepo creates a new DB and returns the schedule repository for testing.
+func setupTestScheduleRepo(t *testing.T) scheduledomain.ScheduleRepository {
+	t.Helper()
+	db, err := NewDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err, "Failed to create test database")
+	t.Cleanup(func() { db.Close() })
+	return db.ScheduleRepository()
+}
+
+func newTestSchedule(guid, project string) *scheduledomain.Schedule {
+	now := time.Now().Truncate(time.Second)
+	next := now.Add(time.Hour)
+	return &scheduledomain.Schedule{
+		GUID:               guid,
+		Project:            project,
+		Name:               "Nightly planning",
+		Cron:               "0 2 * * *",
+		TemplateID:         "research_to_tasks",
+		TargetQuery:        "type = epic and label = needs-plan",
+		Args:               map[string]string{"goal": "plan"},
+		Labels:             map[string]string{"source": "schedule"},
+		WorktreeEnabled:    true,
+		WorktreeBaseBranch: "main",
+		Priority:           2,
+		BudgetUSD:          5,
+		Enabled:            true,
+		NextRunAt:          &next,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+}
+
+func TestScheduleRepository_SaveAndFind(t *testing.T) {
+	repo := setupTestScheduleRepo(t)
+
+	schedule := newTestSchedule("sch-1", "project-a")
+	require.NoError(t, repo.Save(schedule))
+	require.Greater(t, schedule.ID, int64(0))
+
+	found, err := repo.FindByGUID("project-a", "sch-1")
+	require.NoError(t, err)
+	require.Equal(t, schedule, found)
+
+	// Update
+	last := time.Now().Truncate(time.Second)
+	found.Enabled = false
+	found.LastRunAt = &last
+	found.PendingWorkflowID = "wf-next"
+	found.TargetQuery = ""
+	require.NoError(t, repo.Save(found))
+
+	updated, err := repo.FindByGUID("project-a", "sch-1")
+	require.NoError(t, err)
+	require.False(t, updated.Enabled)
+	require.Equal(t, last, *updated.LastRunAt)
+	require.Equal(t, "wf-next", updated.PendingWorkflowID)
+	require.Empty(t, updated.TargetQuery)
+}
+
+func TestScheduleRepository_FindByGUID_NotFound(t *testing.T) {
+	repo := setupTestScheduleRepo(t)
+	require.NoError(t, repo.Save(newTestSchedule("sch-1", "project-a")))
+
+	_, err := repo.FindByGUID("project-b", "sch-1")
+
+	var notFound *scheduledomain.ScheduleNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	require.Equal(t, "sch-1", notFound.GUID)
+}
+
+func TestScheduleRepository_ListAndDelete(t *testing.T) {
+	repo := setupTestScheduleRepo(t)
+	require.NoError(t, repo.Save(newTestSchedule("sch-1", "project-a")))
+	require.NoError(t, repo.Save(newTestSchedule("sch-2", "project-a")))
+	require.NoError(t, repo.Save(newTestSchedule("sch-3", "project-b")))
+
+	schedules, err := repo.List("project-a")
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	require.Equal(t, "sch-1", schedules[0].GUID)
+
+	require.NoError(t, repo.Delete("project-a", "sch-1"))
+	schedules, err = repo.List("project-a")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+
+	var notFound *scheduledomain.ScheduleNotFoundError
+	require.ErrorAs(t, repo.Delete("project-a", "sch-1"), &notFound)
+}
+
+func TestScheduleRepository_Targets(t *testing.T) {
+	repo := setupTestScheduleRepo(t)
+	schedule := newTestSchedule("sch-1", "project-a")
+	require.NoError(t, repo.Save(schedule))
+
+	has, err := repo.HasTarget(schedule.ID, "perles-abc1")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, repo.RecordTarget(schedule.ID, "perles-abc1", "wf-1"))
+	require.NoError(t, repo.RecordTarget(schedule.ID, "perles-abc1", "wf-2"), "recording again is a no-op")
+
+	has, err = repo.HasTarget(schedule.ID, "perles-abc1")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = repo.HasTarget(schedule.ID, "perles-def2")
+	require.NoError(t, err)
+	require.False(t, has)
+}
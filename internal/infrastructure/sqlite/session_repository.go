@@ -13,7 +13,9 @@ import (
 const sessionColumns = `id, guid, project, name, state, template_id, epic_id, work_dir, labels, 
 	worktree_enabled, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir,
 	owner_created_pid, owner_current_pid, tokens_used, active_workers, last_heartbeat_at, last_progress_at,
-	created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at`
+	created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at,
+	cost_usd, budget_tokens, budget_usd, scheduled_at, initial_prompt,
+	depends_on, args, outputs, worker_worktrees`
 
 // sessionRepository implements domain.SessionRepository using SQLite.
 type sessionRepository struct {
@@ -41,6 +43,8 @@ func scanSession(scanner interface{ Scan(...any) error }) (*SessionModel, error)
 		&model.LastHeartbeatAt, &model.LastProgressAt,
 		&model.CreatedAt, &model.StartedAt, &model.PausedAt, &model.CompletedAt, &model.UpdatedAt,
 		&model.ArchivedAt, &model.DeletedAt,
+		&model.CostUSD, &model.BudgetTokens, &model.BudgetUSD, &model.ScheduledAt, &model.InitialPrompt,
+		&model.DependsOn, &model.Args, &model.Outputs, &model.WorkerWorktrees,
 	)
 	return &model, err
 }
@@ -58,8 +62,10 @@ func (r *sessionRepository) Save(session *domain.Session) error {
 				guid, project, name, state, template_id, epic_id, work_dir, labels,
 				worktree_enabled, worktree_base_branch, worktree_branch_name, worktree_path, worktree_branch, session_dir,
 				owner_created_pid, owner_current_pid, tokens_used, active_workers, last_heartbeat_at, last_progress_at,
-				created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				created_at, started_at, paused_at, completed_at, updated_at, archived_at, deleted_at,
+				cost_usd, budget_tokens, budget_usd, scheduled_at, initial_prompt,
+				depends_on, args, outputs, worker_worktrees
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			model.GUID, model.Project, model.Name, model.State, model.TemplateID, model.EpicID,
 			model.WorkDir, model.Labels,
 			model.WorktreeEnabled, model.WorktreeBaseBranch, model.WorktreeBranchName,
@@ -67,6 +73,8 @@ func (r *sessionRepository) Save(session *domain.Session) error {
 			model.OwnerCreatedPID, model.OwnerCurrentPID,
 			model.TokensUsed, model.ActiveWorkers, model.LastHeartbeatAt, model.LastProgressAt,
 			model.CreatedAt, model.StartedAt, model.PausedAt, model.CompletedAt, model.UpdatedAt, model.ArchivedAt, model.DeletedAt,
+			model.CostUSD, model.BudgetTokens, model.BudgetUSD, model.ScheduledAt, model.InitialPrompt,
+			model.DependsOn, model.Args, model.Outputs, model.WorkerWorktrees,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert session: %w", err)
@@ -86,13 +94,17 @@ func (r *sessionRepository) Save(session *domain.Session) error {
 			worktree_enabled = ?, worktree_base_branch = ?, worktree_branch_name = ?, worktree_path = ?, worktree_branch = ?, session_dir = ?,
 			owner_created_pid = ?, owner_current_pid = ?, tokens_used = ?, active_workers = ?, 
 			last_heartbeat_at = ?, last_progress_at = ?,
-			started_at = ?, paused_at = ?, completed_at = ?, updated_at = ?, archived_at = ?, deleted_at = ?
+			started_at = ?, paused_at = ?, completed_at = ?, updated_at = ?, archived_at = ?, deleted_at = ?,
+			cost_usd = ?, budget_tokens = ?, budget_usd = ?, scheduled_at = ?, initial_prompt = ?,
+			depends_on = ?, args = ?, outputs = ?, worker_worktrees = ?
 		WHERE id = ?`,
 		model.Name, model.State, model.TemplateID, model.EpicID, model.WorkDir, model.Labels,
 		model.WorktreeEnabled, model.WorktreeBaseBranch, model.WorktreeBranchName, model.WorktreePath, model.WorktreeBranch, model.SessionDir,
 		model.OwnerCreatedPID, model.OwnerCurrentPID, model.TokensUsed, model.ActiveWorkers,
 		model.LastHeartbeatAt, model.LastProgressAt,
 		model.StartedAt, model.PausedAt, model.CompletedAt, model.UpdatedAt, model.ArchivedAt, model.DeletedAt,
+		model.CostUSD, model.BudgetTokens, model.BudgetUSD, model.ScheduledAt, model.InitialPrompt,
+		model.DependsOn, model.Args, model.Outputs, model.WorkerWorktrees,
 		model.ID,
 	)
 	if err != nil {
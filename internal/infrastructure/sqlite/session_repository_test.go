@@ -69,6 +69,23 @@ func TestSessionRepository_Save_Update(t *testing.T) {
 	require.Equal(t, originalCreatedAt.Unix(), found.CreatedAt().Unix(), "CreatedAt should not change")
 }
 
+func TestSessionRepository_Save_Budget(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	session := domain.NewSession("guid-1", "project-a", domain.SessionStateRunning)
+	session.SetBudget(500_000, 12.5)
+	require.NoError(t, repo.Save(session))
+
+	session.SetCostUSD(3.75)
+	require.NoError(t, repo.Save(session))
+
+	found, err := repo.FindByID(session.ID())
+	require.NoError(t, err)
+	require.Equal(t, int64(500_000), found.BudgetTokens())
+	require.InDelta(t, 12.5, found.BudgetUSD(), 1e-9)
+	require.InDelta(t, 3.75, found.CostUSD(), 1e-9)
+}
+
 func TestSessionRepository_FindByGUID(t *testing.T) {
 	repo := setupTestRepo(t)
 
@@ -326,27 +343,27 @@ func TestSessionRepository_ListWithFilter_OrderByCreatedAtDesc(t *testing.T) {
 
 	// Create sessions with explicitly different timestamps (Unix seconds)
 	baseTime := time.Now()
-	s1 := domain.ReconstituteSession(0, "guid-1", "project-a", "", domain.SessionStateCompleted, "", "", "",
-		nil, false, "", "", "", "",
+	s1 := domain.ReconstituteSession(0, "guid-1", "project-a", "", domain.SessionStateCompleted, "", "", "", "",
+		nil, nil, nil, nil, false, false, "", "", "", "",
 		"", // sessionDir
-		nil, nil, 0, 0, nil, nil,
-		baseTime.Add(-3*time.Second), nil, nil, nil, baseTime.Add(-3*time.Second), nil, nil)
+		nil, nil, 0, 0, 0, 0, 0, nil, nil,
+		baseTime.Add(-3*time.Second), nil, nil, nil, nil, baseTime.Add(-3*time.Second), nil, nil)
 	err := repo.Save(s1)
 	require.NoError(t, err)
 
-	s2 := domain.ReconstituteSession(0, "guid-2", "project-a", "", domain.SessionStateCompleted, "", "", "",
-		nil, false, "", "", "", "",
+	s2 := domain.ReconstituteSession(0, "guid-2", "project-a", "", domain.SessionStateCompleted, "", "", "", "",
+		nil, nil, nil, nil, false, false, "", "", "", "",
 		"", // sessionDir
-		nil, nil, 0, 0, nil, nil,
-		baseTime.Add(-2*time.Second), nil, nil, nil, baseTime.Add(-2*time.Second), nil, nil)
+		nil, nil, 0, 0, 0, 0, 0, nil, nil,
+		baseTime.Add(-2*time.Second), nil, nil, nil, nil, baseTime.Add(-2*time.Second), nil, nil)
 	err = repo.Save(s2)
 	require.NoError(t, err)
 
-	s3 := domain.ReconstituteSession(0, "guid-3", "project-a", "", domain.SessionStateCompleted, "", "", "",
-		nil, false, "", "", "", "",
+	s3 := domain.ReconstituteSession(0, "guid-3", "project-a", "", domain.SessionStateCompleted, "", "", "", "",
+		nil, nil, nil, nil, false, false, "", "", "", "",
 		"", // sessionDir
-		nil, nil, 0, 0, nil, nil,
-		baseTime.Add(-1*time.Second), nil, nil, nil, baseTime.Add(-1*time.Second), nil, nil)
+		nil, nil, 0, 0, 0, 0, 0, nil, nil,
+		baseTime.Add(-1*time.Second), nil, nil, nil, nil, baseTime.Add(-1*time.Second), nil, nil)
 	err = repo.Save(s3)
 	require.NoError(t, err)
 
@@ -523,8 +540,12 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 		"template-abc",
 		"epic-123",
 		"/work/dir",
+		"Coordinate the epic",
 		nil,
-		false,
+		[]string{"wf-upstream"},
+		map[string]string{"epic_id": "epic-123"},
+		map[string]string{"artifacts": "docs/plan.md"},
+		false, false,
 		"", "",
 		"/worktree/path",
 		"feature/branch",
@@ -532,12 +553,13 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 		&ownerCreatedPID,
 		&ownerCurrentPID,
 		0,
-		0,
+		0, 0, 0, 0,
 		nil, nil,
 		now,
 		&startedAt,
 		&pausedAt,
 		nil, // completedAt
+		nil, // scheduledAt
 		now,
 		&archivedAt,
 		&deletedAt,
@@ -556,6 +578,10 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 	require.Equal(t, "epic-123", *model.EpicID)
 	require.NotNil(t, model.WorkDir)
 	require.Equal(t, "/work/dir", *model.WorkDir)
+	require.NotNil(t, model.InitialPrompt)
+	require.Equal(t, "Coordinate the epic", *model.InitialPrompt)
+	require.NotNil(t, model.DependsOn)
+	require.JSONEq(t, `["wf-upstream"]`, *model.DependsOn)
 	require.NotNil(t, model.WorktreePath)
 	require.Equal(t, "/worktree/path", *model.WorktreePath)
 	require.NotNil(t, model.WorktreeBranch)
@@ -584,6 +610,10 @@ func TestSessionModel_RoundTrip(t *testing.T) {
 	require.Equal(t, original.TemplateID(), restored.TemplateID())
 	require.Equal(t, original.EpicID(), restored.EpicID())
 	require.Equal(t, original.WorkDir(), restored.WorkDir())
+	require.Equal(t, original.InitialPrompt(), restored.InitialPrompt())
+	require.Equal(t, original.After(), restored.After())
+	require.Equal(t, original.Args(), restored.Args())
+	require.Equal(t, original.Outputs(), restored.Outputs())
 	require.Equal(t, original.WorktreePath(), restored.WorktreePath())
 	require.Equal(t, original.WorktreeBranch(), restored.WorktreeBranch())
 	require.NotNil(t, restored.OwnerCreatedPID())
@@ -611,19 +641,20 @@ func TestSessionModel_RoundTrip_NilDeletedAt(t *testing.T) {
 		"test-project",
 		"",
 		domain.SessionStateRunning,
-		"", "", "",
-		nil,
-		false,
+		"", "", "", "",
+		nil, nil, nil, nil,
+		false, false,
 		"", "",
 		"", "",
 		"", // sessionDir
 		nil, nil,
 		0,
-		0,
+		0, 0, 0, 0,
 		nil, nil,
 		now,
 		nil, nil,
 		nil, // completedAt
+		nil, // scheduledAt
 		now,
 		nil,
 		nil,
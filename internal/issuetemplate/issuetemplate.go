@@ -0,0 +1,246 @@
+// Package issuetemplate loads reusable issue templates from .perles/issue-templates/*.md.
+//
+// A template is a markdown file whose body becomes the new issue's description.
+// Optional YAML frontmatter supplies defaults for the created issue:
+//
+//	---
+//	name: Bug report
+//	description: Something is broken
+//	title: "Bug: "
+//	type: bug
+//	priority: 1
+//	labels: [bug, triage]
+//	---
+//	## Steps to reproduce
+//	...
+package issuetemplate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// DirName is the directory (under .perles) that holds issue templates.
+const DirName = "issue-templates"
+
+// frontmatterDelimiter is the standard YAML frontmatter delimiter.
+const frontmatterDelimiter = "---"
+
+// Template is a parsed issue template.
+type Template struct {
+	ID          string // Derived from the filename ("bug.md" -> "bug")
+	Name        string // Display name; defaults to ID
+	Description string // Short summary shown in pickers
+	TitlePrefix string // Prepended to the title entered by the user
+	Type        beads.IssueType
+	Priority    beads.Priority
+	Labels      []string
+	Body        string // Markdown body used as the issue description
+	FilePath    string
+}
+
+// frontmatter is the YAML header of a template file.
+type frontmatter struct {
+	Name        string    `yaml:"name"`
+	Description string    `yaml:"description"`
+	Title       string    `yaml:"title"`
+	Type        string    `yaml:"type"`
+	Priority    yaml.Node `yaml:"priority"`
+	Labels      []string  `yaml:"labels"`
+}
+
+// validTypes are the issue types a template may default to.
+var validTypes = []beads.IssueType{
+	beads.TypeBug, beads.TypeFeature, beads.TypeTask, beads.TypeEpic, beads.TypeChore,
+}
+
+// ProjectDir returns the project template directory relative to the working directory.
+func ProjectDir() string {
+	return filepath.Join(".perles", DirName)
+}
+
+// UserDir returns the user template directory (~/.perles/issue-templates).
+func UserDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".perles", DirName)
+}
+
+// Load reads templates from the given directories. Missing directories are skipped.
+// When the same ID appears in several directories, the earliest directory wins,
+// so pass the project directory before the user directory.
+// Templates are returned sorted by ID.
+func Load(dirs ...string) ([]Template, error) {
+	byID := make(map[string]Template)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("reading issue template directory %s: %w", dir, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+				continue
+			}
+			id := strings.TrimSuffix(entry.Name(), ".md")
+			if _, exists := byID[id]; exists {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			content, err := os.ReadFile(path) //nolint:gosec // G304: path comes from a directory listing
+			if err != nil {
+				return nil, fmt.Errorf("reading issue template %s: %w", path, err)
+			}
+			tmpl, err := Parse(string(content), entry.Name())
+			if err != nil {
+				return nil, fmt.Errorf("parsing issue template %s: %w", path, err)
+			}
+			tmpl.FilePath = path
+			byID[id] = tmpl
+		}
+	}
+
+	templates := make([]Template, 0, len(byID))
+	for _, tmpl := range byID {
+		templates = append(templates, tmpl)
+	}
+	slices.SortFunc(templates, func(a, b Template) int { return strings.Compare(a.ID, b.ID) })
+	return templates, nil
+}
+
+// LoadDefault loads templates from the project directory, then the user directory.
+func LoadDefault() ([]Template, error) {
+	return Load(ProjectDir(), UserDir())
+}
+
+// Find returns the template with the given ID.
+func Find(templates []Template, id string) (Template, bool) {
+	for _, tmpl := range templates {
+		if tmpl.ID == id {
+			return tmpl, true
+		}
+	}
+	return Template{}, false
+}
+
+// Parse parses a template from its content and filename. Frontmatter is optional;
+// without it the whole file is the body and the defaults are a P2 task.
+func Parse(content, filename string) (Template, error) {
+	tmpl := Template{
+		ID:       strings.TrimSuffix(filename, ".md"),
+		Type:     beads.TypeTask,
+		Priority: beads.PriorityMedium,
+		Body:     content,
+	}
+
+	yamlContent, body, ok := splitFrontmatter(content)
+	if ok {
+		var fm frontmatter
+		if err := yaml.NewDecoder(strings.NewReader(yamlContent)).Decode(&fm); err != nil && !errors.Is(err, io.EOF) {
+			return Template{}, fmt.Errorf("parsing frontmatter: %w", err)
+		}
+		if err := applyFrontmatter(&tmpl, fm); err != nil {
+			return Template{}, err
+		}
+		tmpl.Body = body
+	}
+
+	if tmpl.Name == "" {
+		tmpl.Name = tmpl.ID
+	}
+	tmpl.Body = strings.TrimSpace(tmpl.Body)
+	return tmpl, nil
+}
+
+// Title returns the issue title for user input, applying the template prefix
+// unless the input already starts with it (e.g. a prefilled TUI input).
+func (t Template) Title(input string) string {
+	input = strings.TrimSpace(input)
+	if t.TitlePrefix == "" || strings.HasPrefix(input, strings.TrimSpace(t.TitlePrefix)) {
+		return input
+	}
+	return t.TitlePrefix + input
+}
+
+// Options returns create options for a new issue built from this template.
+func (t Template) Options(title string) beads.CreateIssueOptions {
+	return beads.CreateIssueOptions{
+		Title:       t.Title(title),
+		Description: t.Body,
+		Type:        t.Type,
+		Priority:    t.Priority,
+		Labels:      slices.Clone(t.Labels),
+	}
+}
+
+// splitFrontmatter separates YAML frontmatter from the markdown body.
+func splitFrontmatter(content string) (yamlContent, body string, ok bool) {
+	if !strings.HasPrefix(content, frontmatterDelimiter) {
+		return "", content, false
+	}
+	rest := content[len(frontmatterDelimiter):]
+	yamlContent, body, found := strings.Cut(rest, "\n"+frontmatterDelimiter)
+	if !found {
+		return "", content, false
+	}
+	// Drop the remainder of the closing delimiter line
+	if _, after, hasNewline := strings.Cut(body, "\n"); hasNewline {
+		body = after
+	} else {
+		body = ""
+	}
+	return strings.TrimPrefix(yamlContent, "\n"), body, true
+}
+
+// applyFrontmatter validates frontmatter values and copies them onto the template.
+func applyFrontmatter(tmpl *Template, fm frontmatter) error {
+	tmpl.Name = fm.Name
+	tmpl.Description = fm.Description
+	tmpl.TitlePrefix = fm.Title
+	tmpl.Labels = fm.Labels
+
+	if fm.Type != "" {
+		issueType := beads.IssueType(strings.ToLower(fm.Type))
+		if !slices.Contains(validTypes, issueType) {
+			return fmt.Errorf("invalid type %q (valid: bug, feature, task, epic, chore)", fm.Type)
+		}
+		tmpl.Type = issueType
+	}
+
+	if fm.Priority.Value != "" {
+		priority, err := parsePriority(fm.Priority.Value)
+		if err != nil {
+			return err
+		}
+		tmpl.Priority = priority
+	}
+	return nil
+}
+
+// parsePriority accepts 0-4 or P0-P4.
+func parsePriority(value string) (beads.Priority, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(value), "P"))
+	if err != nil || n < 0 || n > 4 {
+		return 0, fmt.Errorf("invalid priority %q (use 0-4 or P0-P4)", value)
+	}
+	return beads.Priority(n), nil
+}
@@ -0,0 +1,140 @@
+package issuetemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestParse_Frontmatter(t *testing.T) {
+	content := `---
+name: Bug report
+description: Something is broken
+title: "Bug: "
+type: bug
+priority: P1
+labels: [bug, triage]
+---
+
+## Steps to reproduce
+1. ...
+`
+	tmpl, err := Parse(content, "bug.md")
+	require.NoError(t, err)
+
+	require.Equal(t, "bug", tmpl.ID)
+	require.Equal(t, "Bug report", tmpl.Name)
+	require.Equal(t, "Something is broken", tmpl.Description)
+	require.Equal(t, "Bug: ", tmpl.TitlePrefix)
+	require.Equal(t, beads.TypeBug, tmpl.Type)
+	require.Equal(t, beads.PriorityHigh, tmpl.Priority)
+	require.Equal(t, []string{"bug", "triage"}, tmpl.Labels)
+	require.Equal(t, "## Steps to reproduce\n1. ...", tmpl.Body)
+}
+
+func TestParse_NoFrontmatterUsesDefaults(t *testing.T) {
+	tmpl, err := Parse("Just a body\n", "chore.md")
+	require.NoError(t, err)
+
+	require.Equal(t, "chore", tmpl.Name)
+	require.Equal(t, beads.TypeTask, tmpl.Type)
+	require.Equal(t, beads.PriorityMedium, tmpl.Priority)
+	require.Equal(t, "Just a body", tmpl.Body)
+}
+
+func TestParse_EmptyFrontmatter(t *testing.T) {
+	tmpl, err := Parse("---\n---\nBody", "empty.md")
+	require.NoError(t, err)
+	require.Equal(t, "Body", tmpl.Body)
+	require.Equal(t, beads.TypeTask, tmpl.Type)
+}
+
+func TestParse_InvalidValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"bad type", "---\ntype: story\n---\n", `invalid type "story"`},
+		{"priority out of range", "---\npriority: 7\n---\n", `invalid priority "7"`},
+		{"priority not a number", "---\npriority: high\n---\n", `invalid priority "high"`},
+		{"bad yaml", "---\nlabels: [unclosed\n---\n", "parsing frontmatter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.content, "x.md")
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLoad_ProjectOverridesUser(t *testing.T) {
+	projectDir := filepath.Join(t.TempDir(), "project")
+	userDir := filepath.Join(t.TempDir(), "user")
+
+	writeTemplate(t, projectDir, "bug.md", "---\nname: Project bug\n---\n")
+	writeTemplate(t, userDir, "bug.md", "---\nname: User bug\n---\n")
+	writeTemplate(t, userDir, "feature.md", "---\ntype: feature\n---\n")
+	writeTemplate(t, userDir, "notes.txt", "ignored")
+
+	templates, err := Load(projectDir, userDir, filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+
+	require.Equal(t, "bug", templates[0].ID)
+	require.Equal(t, "Project bug", templates[0].Name)
+	require.Equal(t, filepath.Join(projectDir, "bug.md"), templates[0].FilePath)
+	require.Equal(t, "feature", templates[1].ID)
+	require.Equal(t, beads.TypeFeature, templates[1].Type)
+
+	found, ok := Find(templates, "feature")
+	require.True(t, ok)
+	require.Equal(t, "feature", found.ID)
+	_, ok = Find(templates, "missing")
+	require.False(t, ok)
+}
+
+func TestLoad_ReportsInvalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "broken.md", "---\ntype: story\n---\n")
+
+	_, err := Load(dir)
+	require.ErrorContains(t, err, "broken.md")
+}
+
+func TestTemplate_Options(t *testing.T) {
+	tmpl := Template{
+		TitlePrefix: "Bug: ",
+		Type:        beads.TypeBug,
+		Priority:    beads.PriorityHigh,
+		Labels:      []string{"bug"},
+		Body:        "Steps",
+	}
+
+	opts := tmpl.Options("  Crash on save ")
+	require.Equal(t, beads.CreateIssueOptions{
+		Title:       "Bug: Crash on save",
+		Description: "Steps",
+		Type:        beads.TypeBug,
+		Priority:    beads.PriorityHigh,
+		Labels:      []string{"bug"},
+	}, opts)
+
+	// A title that already carries the prefix is not prefixed twice
+	require.Equal(t, "Bug: Crash", tmpl.Title("Bug: Crash"))
+
+	// Labels are copied so callers can append without mutating the template
+	opts.Labels = append(opts.Labels, "extra")
+	require.Equal(t, []string{"bug"}, tmpl.Labels)
+}
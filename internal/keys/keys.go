@@ -81,6 +81,7 @@ var Kanban = struct {
 	Escape           key.Binding // Kanban-specific escape (go back)
 	Refresh          key.Binding
 	Yank             key.Binding
+	NewIssue         key.Binding // Create an issue, optionally from a template
 	Status           key.Binding
 	Priority         key.Binding
 	AddColumn        key.Binding
@@ -113,6 +114,10 @@ var Kanban = struct {
 		key.WithKeys("y"),
 		key.WithHelp("y", "copy issue ID"),
 	),
+	NewIssue: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new issue"),
+	),
 	Status: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "change status"),
@@ -283,6 +288,7 @@ var Component = struct {
 	ModeToggle key.Binding // Mode toggle (m)
 	Close      key.Binding // Close overlay (ctrl+x)
 	Save       key.Binding // Save action (ctrl+s)
+	TabToggle  key.Binding // Switch between overview and history tabs (t)
 }{
 	Confirm: key.NewBinding(
 		key.WithKeys("enter"),
@@ -348,6 +354,10 @@ var Component = struct {
 		key.WithKeys("ctrl+s"),
 		key.WithHelp("ctrl+s", "save"),
 	),
+	TabToggle: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "toggle history"),
+	),
 }
 
 // LogOverlay contains keybindings specific to the log overlay.
@@ -509,7 +519,7 @@ func ShortHelp() []key.Binding {
 func FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{Common.Up, Common.Down, Common.Left, Common.Right},
-		{Common.Enter, Kanban.Refresh, Kanban.Yank, Kanban.Status, Kanban.Priority, Kanban.AddColumn, Kanban.EditColumn, Kanban.MoveColumnLeft, Kanban.MoveColumnRight},
+		{Common.Enter, Kanban.Refresh, Kanban.Yank, Kanban.NewIssue, Kanban.Status, Kanban.Priority, Kanban.AddColumn, Kanban.EditColumn, Kanban.MoveColumnLeft, Kanban.MoveColumnRight},
 		{Kanban.NextView, Kanban.PrevView, Kanban.ViewMenu, Kanban.DeleteColumn},
 		{Common.Help, Kanban.ToggleStatus, Common.Escape, Kanban.QuitConfirm},
 	}
@@ -527,6 +537,8 @@ var Dashboard = struct {
 	Enter           key.Binding
 	Start           key.Binding
 	Stop            key.Binding
+	Land            key.Binding
+	Approve         key.Binding
 	New             key.Binding
 	Rename          key.Binding
 	Filter          key.Binding
@@ -576,6 +588,14 @@ var Dashboard = struct {
 		key.WithKeys("x"),
 		key.WithHelp("x", "pause workflow"),
 	),
+	Land: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "land workflow branch"),
+	),
+	Approve: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "review approval"),
+	),
 	New: key.NewBinding(
 		key.WithKeys("n", "N"),
 		key.WithHelp("n", "new workflow"),
@@ -78,6 +78,64 @@ func (_c *MockBeadsClient_GetComments_Call) RunAndReturn(run func(string) ([]dom
 	return _c
 }
 
+// GetEvents provides a mock function with given fields: issueID
+func (_m *MockBeadsClient) GetEvents(issueID string) ([]domain.Event, error) {
+	ret := _m.Called(issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.Event, error)); ok {
+		return rf(issueID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.Event); ok {
+		r0 = rf(issueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(issueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBeadsClient_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockBeadsClient_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - issueID string
+func (_e *MockBeadsClient_Expecter) GetEvents(issueID interface{}) *MockBeadsClient_GetEvents_Call {
+	return &MockBeadsClient_GetEvents_Call{Call: _e.mock.On("GetEvents", issueID)}
+}
+
+func (_c *MockBeadsClient_GetEvents_Call) Run(run func(issueID string)) *MockBeadsClient_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockBeadsClient_GetEvents_Call) Return(_a0 []domain.Event, _a1 error) *MockBeadsClient_GetEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBeadsClient_GetEvents_Call) RunAndReturn(run func(string) ([]domain.Event, error)) *MockBeadsClient_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function with no fields
 func (_m *MockBeadsClient) Version() (string, error) {
 	ret := _m.Called()
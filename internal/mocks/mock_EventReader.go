@@ -0,0 +1,93 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// MockEventReader is an autogenerated mock type for the EventReader type
+type MockEventReader struct {
+	mock.Mock
+}
+
+type MockEventReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventReader) EXPECT() *MockEventReader_Expecter {
+	return &MockEventReader_Expecter{mock: &_m.Mock}
+}
+
+// GetEvents provides a mock function with given fields: issueID
+func (_m *MockEventReader) GetEvents(issueID string) ([]domain.Event, error) {
+	ret := _m.Called(issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.Event, error)); ok {
+		return rf(issueID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.Event); ok {
+		r0 = rf(issueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(issueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventReader_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockEventReader_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - issueID string
+func (_e *MockEventReader_Expecter) GetEvents(issueID interface{}) *MockEventReader_GetEvents_Call {
+	return &MockEventReader_GetEvents_Call{Call: _e.mock.On("GetEvents", issueID)}
+}
+
+func (_c *MockEventReader_GetEvents_Call) Run(run func(issueID string)) *MockEventReader_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockEventReader_GetEvents_Call) Return(_a0 []domain.Event, _a1 error) *MockEventReader_GetEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventReader_GetEvents_Call) RunAndReturn(run func(string) ([]domain.Event, error)) *MockEventReader_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventReader creates a new instance of MockEventReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventReader {
+	mock := &MockEventReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
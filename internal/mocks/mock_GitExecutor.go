@@ -68,6 +68,111 @@ func (_c *MockGitExecutor_BranchExists_Call) RunAndReturn(run func(string) bool)
 	return _c
 }
 
+// CheckMerge provides a mock function with given fields: base, branch
+func (_m *MockGitExecutor) CheckMerge(base string, branch string) ([]string, error) {
+	ret := _m.Called(base, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckMerge")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return rf(base, branch)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(base, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(base, branch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGitExecutor_CheckMerge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckMerge'
+type MockGitExecutor_CheckMerge_Call struct {
+	*mock.Call
+}
+
+// CheckMerge is a helper method to define mock.On call
+//   - base string
+//   - branch string
+func (_e *MockGitExecutor_Expecter) CheckMerge(base interface{}, branch interface{}) *MockGitExecutor_CheckMerge_Call {
+	return &MockGitExecutor_CheckMerge_Call{Call: _e.mock.On("CheckMerge", base, branch)}
+}
+
+func (_c *MockGitExecutor_CheckMerge_Call) Run(run func(base string, branch string)) *MockGitExecutor_CheckMerge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_CheckMerge_Call) Return(_a0 []string, _a1 error) *MockGitExecutor_CheckMerge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGitExecutor_CheckMerge_Call) RunAndReturn(run func(string, string) ([]string, error)) *MockGitExecutor_CheckMerge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitAll provides a mock function with given fields: message
+func (_m *MockGitExecutor) CommitAll(message string) error {
+	ret := _m.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_CommitAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitAll'
+type MockGitExecutor_CommitAll_Call struct {
+	*mock.Call
+}
+
+// CommitAll is a helper method to define mock.On call
+//   - message string
+func (_e *MockGitExecutor_Expecter) CommitAll(message interface{}) *MockGitExecutor_CommitAll_Call {
+	return &MockGitExecutor_CommitAll_Call{Call: _e.mock.On("CommitAll", message)}
+}
+
+func (_c *MockGitExecutor_CommitAll_Call) Run(run func(message string)) *MockGitExecutor_CommitAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_CommitAll_Call) Return(_a0 error) *MockGitExecutor_CommitAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_CommitAll_Call) RunAndReturn(run func(string) error) *MockGitExecutor_CommitAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorktreeWithContext provides a mock function with given fields: ctx, path, newBranch, baseBranch
 func (_m *MockGitExecutor) CreateWorktreeWithContext(ctx context.Context, path string, newBranch string, baseBranch string) error {
 	ret := _m.Called(ctx, path, newBranch, baseBranch)
@@ -117,6 +222,53 @@ func (_c *MockGitExecutor_CreateWorktreeWithContext_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DeleteBranch provides a mock function with given fields: name, force
+func (_m *MockGitExecutor) DeleteBranch(name string, force bool) error {
+	ret := _m.Called(name, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(name, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGitExecutor_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - name string
+//   - force bool
+func (_e *MockGitExecutor_Expecter) DeleteBranch(name interface{}, force interface{}) *MockGitExecutor_DeleteBranch_Call {
+	return &MockGitExecutor_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", name, force)}
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Run(run func(name string, force bool)) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Return(_a0 error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) RunAndReturn(run func(string, bool) error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DetermineWorktreePath provides a mock function with given fields: sessionID
 func (_m *MockGitExecutor) DetermineWorktreePath(sessionID string) (string, error) {
 	ret := _m.Called(sessionID)
@@ -1338,6 +1490,54 @@ func (_c *MockGitExecutor_ListWorktrees_Call) RunAndReturn(run func() ([]domain.
 	return _c
 }
 
+// Merge provides a mock function with given fields: branch, message, squash
+func (_m *MockGitExecutor) Merge(branch string, message string, squash bool) error {
+	ret := _m.Called(branch, message, squash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(branch, message, squash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockGitExecutor_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - branch string
+//   - message string
+//   - squash bool
+func (_e *MockGitExecutor_Expecter) Merge(branch interface{}, message interface{}, squash interface{}) *MockGitExecutor_Merge_Call {
+	return &MockGitExecutor_Merge_Call{Call: _e.mock.On("Merge", branch, message, squash)}
+}
+
+func (_c *MockGitExecutor_Merge_Call) Run(run func(branch string, message string, squash bool)) *MockGitExecutor_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_Merge_Call) Return(_a0 error) *MockGitExecutor_Merge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_Merge_Call) RunAndReturn(run func(string, string, bool) error) *MockGitExecutor_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PruneWorktrees provides a mock function with no fields
 func (_m *MockGitExecutor) PruneWorktrees() error {
 	ret := _m.Called()
@@ -1429,6 +1629,52 @@ func (_c *MockGitExecutor_RemoveWorktree_Call) RunAndReturn(run func(string) err
 	return _c
 }
 
+// ResetBranch provides a mock function with given fields: ref
+func (_m *MockGitExecutor) ResetBranch(ref string) error {
+	ret := _m.Called(ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_ResetBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetBranch'
+type MockGitExecutor_ResetBranch_Call struct {
+	*mock.Call
+}
+
+// ResetBranch is a helper method to define mock.On call
+//   - ref string
+func (_e *MockGitExecutor_Expecter) ResetBranch(ref interface{}) *MockGitExecutor_ResetBranch_Call {
+	return &MockGitExecutor_ResetBranch_Call{Call: _e.mock.On("ResetBranch", ref)}
+}
+
+func (_c *MockGitExecutor_ResetBranch_Call) Run(run func(ref string)) *MockGitExecutor_ResetBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_ResetBranch_Call) Return(_a0 error) *MockGitExecutor_ResetBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_ResetBranch_Call) RunAndReturn(run func(string) error) *MockGitExecutor_ResetBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateBranchName provides a mock function with given fields: name
 func (_m *MockGitExecutor) ValidateBranchName(name string) error {
 	ret := _m.Called(name)
@@ -220,6 +220,62 @@ func (_c *MockIssueWriter_CreateEpic_Call) RunAndReturn(run func(string, string,
 	return _c
 }
 
+// CreateIssue provides a mock function with given fields: opts
+func (_m *MockIssueWriter) CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIssue")
+	}
+
+	var r0 domain.CreateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.CreateIssueOptions) (domain.CreateResult, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(domain.CreateIssueOptions) domain.CreateResult); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(domain.CreateResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.CreateIssueOptions) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueWriter_CreateIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIssue'
+type MockIssueWriter_CreateIssue_Call struct {
+	*mock.Call
+}
+
+// CreateIssue is a helper method to define mock.On call
+//   - opts domain.CreateIssueOptions
+func (_e *MockIssueWriter_Expecter) CreateIssue(opts interface{}) *MockIssueWriter_CreateIssue_Call {
+	return &MockIssueWriter_CreateIssue_Call{Call: _e.mock.On("CreateIssue", opts)}
+}
+
+func (_c *MockIssueWriter_CreateIssue_Call) Run(run func(opts domain.CreateIssueOptions)) *MockIssueWriter_CreateIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.CreateIssueOptions))
+	})
+	return _c
+}
+
+func (_c *MockIssueWriter_CreateIssue_Call) Return(_a0 domain.CreateResult, _a1 error) *MockIssueWriter_CreateIssue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueWriter_CreateIssue_Call) RunAndReturn(run func(domain.CreateIssueOptions) (domain.CreateResult, error)) *MockIssueWriter_CreateIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function with given fields: title, description, parentID, assignee, labels
 func (_m *MockIssueWriter) CreateTask(title string, description string, parentID string, assignee string, labels []string) (domain.CreateResult, error) {
 	ret := _m.Called(title, description, parentID, assignee, labels)
@@ -0,0 +1,227 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// approvalDiffLines is how many diff lines the approval modal shows.
+const approvalDiffLines = 20
+
+var (
+	approvalLabelStyle    = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	approvalAdditionStyle = lipgloss.NewStyle().Foreground(styles.DiffAdditionColor)
+	approvalDeletionStyle = lipgloss.NewStyle().Foreground(styles.DiffDeletionColor)
+	approvalHunkStyle     = lipgloss.NewStyle().Foreground(styles.DiffHunkColor)
+	approvalContextStyle  = lipgloss.NewStyle().Foreground(styles.DiffContextColor)
+)
+
+// approvalsLoadedMsg carries the pending approvals of a workflow.
+type approvalsLoadedMsg struct {
+	workflowID controlplane.WorkflowID
+	name       string
+	approvals  []*repository.Approval
+	err        error
+}
+
+// reviewSelectedApproval loads the pending approvals of the selected workflow.
+// The approval modal is opened for the oldest one once they arrive.
+func (m Model) reviewSelectedApproval() (mode.Controller, tea.Cmd) {
+	workflow := m.SelectedWorkflow()
+	if workflow == nil || m.controlPlane == nil {
+		return m, nil
+	}
+
+	// Check if workflow is locked by another process
+	if workflow.IsLocked {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "🔒 Workflow is owned by another Perles process",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	if workflow.State != controlplane.WorkflowRunning {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "Approvals can only be reviewed while the workflow is running",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	cp := m.controlPlane
+	workflowID, name := workflow.ID, workflow.Name
+	return m, func() tea.Msg {
+		approvals, err := cp.Approvals(context.Background(), workflowID)
+		return approvalsLoadedMsg{workflowID: workflowID, name: name, approvals: approvals, err: err}
+	}
+}
+
+// handleApprovalsLoaded opens the approval modal for the oldest pending approval.
+func (m Model) handleApprovalsLoaded(msg approvalsLoadedMsg) (mode.Controller, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "Failed to load approvals: " + msg.err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+	}
+	if len(msg.approvals) == 0 {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "No approvals pending: " + msg.name,
+				Style:   toaster.StyleInfo,
+			}
+		}
+	}
+
+	approval := msg.approvals[0]
+	title := "Approval Required"
+	if len(msg.approvals) > 1 {
+		title = fmt.Sprintf("Approval Required (1 of %d)", len(msg.approvals))
+	}
+
+	m.approvalModalWfID = msg.workflowID
+	m.approvalModalWfName = msg.name
+	m.approvalModalID = approval.ID
+	approvalModal := formmodal.New(formmodal.FormConfig{
+		Title:         title,
+		HeaderContent: func(width int) string { return renderApproval(approval, width) },
+		Fields: []formmodal.FieldConfig{
+			{
+				Key:   "decision",
+				Type:  formmodal.FieldTypeToggle,
+				Label: "Decision",
+				Options: []formmodal.ListOption{
+					{Label: "Approve", Value: "approve"},
+					{Label: "Reject", Value: "reject"},
+				},
+			},
+			{
+				Key:         "feedback",
+				Type:        formmodal.FieldTypeTextArea,
+				Label:       "Feedback",
+				Hint:        "required to reject",
+				Placeholder: "Sent to the coordinator with your decision",
+			},
+		},
+		SubmitLabel: " Submit ",
+		MinWidth:    70,
+		Validate: func(values map[string]any) error {
+			feedback, _ := values["feedback"].(string)
+			if values["decision"] == "reject" && strings.TrimSpace(feedback) == "" {
+				return errors.New("feedback is required to reject")
+			}
+			return nil
+		},
+	}).SetSize(m.width, m.height)
+	m.approvalModal = &approvalModal
+
+	return m, approvalModal.Init()
+}
+
+// doResolveApproval sends the decision of the approval modal to the workflow.
+func (m Model) doResolveApproval(values map[string]any) (mode.Controller, tea.Cmd) {
+	workflowID, name, approvalID := m.approvalModalWfID, m.approvalModalWfName, m.approvalModalID
+	m.clearApprovalModal()
+
+	approved := values["decision"] != "reject"
+	feedback, _ := values["feedback"].(string)
+	feedback = strings.TrimSpace(feedback)
+
+	cp := m.controlPlane
+	return m, func() tea.Msg {
+		if cp == nil {
+			return nil
+		}
+		if err := cp.ResolveApproval(context.Background(), workflowID, approvalID, approved, feedback); err != nil {
+			return mode.ShowToastMsg{
+				Message: "Failed to resolve approval: " + err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+		if approved {
+			return mode.ShowToastMsg{Message: "Approved: " + name, Style: toaster.StyleSuccess}
+		}
+		return mode.ShowToastMsg{Message: "Rejected with feedback: " + name, Style: toaster.StyleInfo}
+	}
+}
+
+// clearApprovalModal closes the approval modal.
+func (m *Model) clearApprovalModal() {
+	m.approvalModal = nil
+	m.approvalModalWfID = ""
+	m.approvalModalWfName = ""
+	m.approvalModalID = ""
+}
+
+// approvalRequestedToast returns a command showing a toast for a new approval request.
+func approvalRequestedToast(event controlplane.ControlPlaneEvent) tea.Cmd {
+	return func() tea.Msg {
+		return mode.ShowToastMsg{
+			Message: "Approval required: " + event.WorkflowName + " (press A to review)",
+			Style:   toaster.StyleWarn,
+		}
+	}
+}
+
+// renderApproval renders the summary, held tasks, artifacts and the start of
+// the diff of an approval request.
+func renderApproval(approval *repository.Approval, width int) string {
+	lines := []string{approval.Summary}
+	if len(approval.TaskIDs) > 0 {
+		lines = append(lines, "", approvalLabelStyle.Render("Tasks      ")+strings.Join(approval.TaskIDs, ", "))
+	}
+	if len(approval.Artifacts) > 0 {
+		lines = append(lines, approvalLabelStyle.Render("Artifacts  ")+strings.Join(approval.Artifacts, ", "))
+	}
+	if len(approval.PausedProcessIDs) > 0 {
+		lines = append(lines, approvalLabelStyle.Render("Paused     ")+strings.Join(approval.PausedProcessIDs, ", "))
+	}
+
+	if approval.Diff != "" {
+		diff := strings.Split(strings.TrimRight(approval.Diff, "\n"), "\n")
+		lines = append(lines, "", approvalLabelStyle.Render("Diff"))
+		for i, line := range diff {
+			if i == approvalDiffLines {
+				lines = append(lines, approvalLabelStyle.Render(fmt.Sprintf("… %d more lines", len(diff)-approvalDiffLines)))
+				break
+			}
+			lines = append(lines, renderDiffLine(ansi.Truncate(line, width, "…")))
+		}
+	}
+
+	return lipgloss.NewStyle().Width(width).Render(strings.Join(lines, "\n"))
+}
+
+// renderDiffLine colors a unified diff line by its prefix.
+func renderDiffLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return approvalLabelStyle.Render(line)
+	case strings.HasPrefix(line, "+"):
+		return approvalAdditionStyle.Render(line)
+	case strings.HasPrefix(line, "-"):
+		return approvalDeletionStyle.Render(line)
+	case strings.HasPrefix(line, "@@"):
+		return approvalHunkStyle.Render(line)
+	default:
+		return approvalContextStyle.Render(line)
+	}
+}
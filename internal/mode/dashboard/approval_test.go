@@ -0,0 +1,124 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+func TestReviewSelectedApproval_NotRunning_ReturnsToast(t *testing.T) {
+	wf := createTestWorkflow("wf-approve", "Deploy", controlplane.WorkflowPaused)
+
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.selectedIndex = 0
+
+	_, cmd := m.reviewSelectedApproval()
+
+	require.NotNil(t, cmd)
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, toaster.StyleWarn, toastMsg.Style)
+}
+
+func TestReviewSelectedApproval_NoneOpen_ReturnsToast(t *testing.T) {
+	wf := createTestWorkflow("wf-approve", "Deploy", controlplane.WorkflowRunning)
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.selectedIndex = 0
+	mockCP.EXPECT().Approvals(mock.Anything, wf.ID).Return(nil, nil).Once()
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	m = result.(Model)
+	require.NotNil(t, cmd)
+	result, cmd = m.Update(cmd())
+	m = result.(Model)
+
+	require.Nil(t, m.approvalModal)
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, "No approvals pending: Deploy", toastMsg.Message)
+}
+
+func TestReviewSelectedApproval_ShowsRequestAndResolvesOnSubmit(t *testing.T) {
+	wf := createTestWorkflow("wf-approve", "Deploy", controlplane.WorkflowRunning)
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.selectedIndex = 0
+	mockCP.EXPECT().Approvals(mock.Anything, wf.ID).Return([]*repository.Approval{{
+		ID:        "approval-1",
+		Summary:   "Apply the billing migration to prod",
+		Diff:      "@@ -1 +1 @@\n-old\n+new",
+		Artifacts: []string{"docs/migration.md"},
+		TaskIDs:   []string{"perles-abc.2"},
+	}}, nil).Once()
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	m = result.(Model)
+	require.NotNil(t, cmd)
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+
+	require.NotNil(t, m.approvalModal, "approval modal should be shown")
+	view := m.approvalModal.View()
+	require.Contains(t, view, "Apply the billing migration to prod")
+	require.Contains(t, view, "docs/migration.md")
+	require.Contains(t, view, "+new")
+
+	mockCP.EXPECT().ResolveApproval(mock.Anything, wf.ID, "approval-1", false, "Run it on staging first").
+		Return(nil).Once()
+	result, cmd = m.Update(formmodal.SubmitMsg{Values: map[string]any{"decision": "reject", "feedback": " Run it on staging first\n"}})
+	m = result.(Model)
+
+	require.Nil(t, m.approvalModal, "approval modal should be cleared after submit")
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, "Rejected with feedback: Deploy", toastMsg.Message)
+}
+
+func TestDoResolveApproval_Error_ReturnsErrorToast(t *testing.T) {
+	wf := createTestWorkflow("wf-approve", "Deploy", controlplane.WorkflowRunning)
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.approvalModalWfID = wf.ID
+	m.approvalModalWfName = wf.Name
+	m.approvalModalID = "approval-1"
+	mockCP.EXPECT().ResolveApproval(mock.Anything, wf.ID, "approval-1", true, "").
+		Return(errors.New("approval not found")).Once()
+
+	_, cmd := m.doResolveApproval(map[string]any{"decision": "approve", "feedback": ""})
+
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, toaster.StyleError, toastMsg.Style)
+	require.Contains(t, toastMsg.Message, "approval not found")
+}
+
+func TestHandleControlPlaneEvent_ApprovalRequested_FlagsWorkflow(t *testing.T) {
+	wf := createTestWorkflow("wf-approve", "Deploy", controlplane.WorkflowRunning)
+	event := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventApprovalRequested,
+		WorkflowID:   wf.ID,
+		WorkflowName: wf.Name,
+	}
+
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	result, cmd := m.handleControlPlaneEvent(event)
+	m = result.(Model)
+
+	require.True(t, m.getOrCreateUIState(wf.ID).HasNotification)
+	require.NotNil(t, cmd)
+
+	toastMsg, ok := approvalRequestedToast(event)().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, "Approval required: Deploy (press A to review)", toastMsg.Message)
+	require.Equal(t, toaster.StyleWarn, toastMsg.Style)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // newMockControlPlane creates a mockery-generated MockControlPlane with default expectations.
-// The mock includes a default GetHealthStatus expectation that returns healthy status.
+// The mock includes default GetHealthStatus (healthy) and Queue (empty) expectations.
 // Individual tests can override this with more specific expectations using EXPECT().
 func newMockControlPlane(t *testing.T) *controlplanemocks.MockControlPlane {
 	m := controlplanemocks.NewMockControlPlane(t)
@@ -19,5 +19,7 @@ func newMockControlPlane(t *testing.T) *controlplanemocks.MockControlPlane {
 	m.EXPECT().GetHealthStatus(mock.Anything).Return(controlplane.HealthStatus{
 		IsHealthy: true,
 	}, true).Maybe()
+	// Default mock for Queue - nothing is waiting for a slot
+	m.EXPECT().Queue().Return(nil).Maybe()
 	return m
 }
@@ -1743,6 +1743,8 @@ func (m Model) handleSlashCommand(workflowID controlplane.WorkflowID, content st
 		return m.handleRetireCommand(workflowID, parts)
 	case "/replace":
 		return m.handleReplaceCommand(workflowID, parts)
+	case "/interrupt":
+		return m.handleInterruptCommand(workflowID, parts)
 	default:
 		// Unknown slash commands are sent to coordinator as-is
 		return m, m.sendToCoordinator(workflowID, content)
@@ -1815,6 +1817,22 @@ func (m Model) handleReplaceCommand(workflowID controlplane.WorkflowID, parts []
 	})
 }
 
+// handleInterruptCommand handles the /interrupt <process-id> <message> command.
+// The process's current turn is cancelled and the message delivered immediately.
+func (m Model) handleInterruptCommand(workflowID controlplane.WorkflowID, parts []string) (Model, tea.Cmd) {
+	if len(parts) < 3 {
+		return m, showWarning("Usage: /interrupt <process-id> <message>")
+	}
+
+	processID := parts[1]
+	content := strings.Join(parts[2:], " ")
+
+	return m, m.submitCommand(workflowID, func(submitter process.CommandSubmitter) {
+		cmd := command.NewSendToProcessCommand(command.SourceUser, processID, content, command.WithInterrupt())
+		submitter.Submit(cmd)
+	})
+}
+
 // showWarning returns a command that shows a warning toast.
 func showWarning(msg string) tea.Cmd {
 	return func() tea.Msg {
@@ -761,6 +761,31 @@ func TestHandleSlashCommand_Replace_MissingProcessID(t *testing.T) {
 	require.Contains(t, toastMsg.Message, "Usage:")
 }
 
+func TestHandleSlashCommand_Interrupt_Valid(t *testing.T) {
+	m := Model{}
+	workflowID := controlplane.WorkflowID("wf-123")
+
+	newM, cmd := m.handleSlashCommand(workflowID, "/interrupt worker-1 STOP, wrong file")
+
+	require.NotNil(t, newM)
+	require.NotNil(t, cmd)
+}
+
+func TestHandleSlashCommand_Interrupt_MissingMessage(t *testing.T) {
+	m := Model{}
+	workflowID := controlplane.WorkflowID("wf-123")
+
+	newM, cmd := m.handleSlashCommand(workflowID, "/interrupt worker-1")
+
+	require.NotNil(t, newM)
+	require.NotNil(t, cmd)
+	// Should return a warning toast
+	msg := cmd()
+	toastMsg, ok := msg.(mode.ShowToastMsg)
+	require.True(t, ok, "expected ShowToastMsg, got %T", msg)
+	require.Contains(t, toastMsg.Message, "Usage: /interrupt")
+}
+
 func TestHandleSlashCommand_UnknownCommand_PassedToCoordinator(t *testing.T) {
 	m := Model{}
 	workflowID := controlplane.WorkflowID("wf-123")
@@ -493,6 +493,25 @@ func TestDashboard_View_Golden_WithTimestamps(t *testing.T) {
 	teatest.RequireEqualOutput(t, []byte(view))
 }
 
+func TestDashboard_View_Golden_QueuedAndScheduled(t *testing.T) {
+	// Waiting workflows show their status and estimated start
+	queued := createTestWorkflowWithDetails("wf-001", "Queued for a slot", controlplane.WorkflowPending, 0, 0)
+	queued.QueuedAt = testNow.Add(-time.Minute)
+	scheduled := createTestWorkflowWithDetails("wf-002", "Nightly plan", controlplane.WorkflowPending, 0, 0)
+	startAt := testNow.Add(14 * time.Hour)
+	scheduled.ScheduledAt = &startAt
+	waiting := createTestWorkflowWithDetails("wf-003", "Cook the plan", controlplane.WorkflowPending, 0, 0)
+	waiting.After = []controlplane.WorkflowID{"wf-002"}
+	workflows := []*controlplane.WorkflowInstance{queued, scheduled, waiting}
+
+	m := createGoldenTestModel(t, workflows)
+	m.queueETAs = map[controlplane.WorkflowID]time.Time{"wf-001": testNow.Add(25 * time.Minute)}
+	m.width = 120
+	m.height = 20
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}
+
 func TestDashboard_View_Golden_WithTimestamps_Narrow(t *testing.T) {
 	// Test with StartedAt timestamps at narrower width to check border bleeding
 	startTime := testNow.Add(-5 * time.Minute)
@@ -0,0 +1,229 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/modal"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+// landPreviewLoadedMsg carries the land preview of a workflow's worktree branch.
+type landPreviewLoadedMsg struct {
+	workflowID controlplane.WorkflowID
+	name       string
+	state      controlplane.WorkflowState
+	preview    *controlplane.LandPreview
+	err        error
+}
+
+// workflowLandedMsg is sent when a workflow's branch has been landed.
+type workflowLandedMsg struct {
+	name   string
+	result *controlplane.LandResult
+}
+
+// landSelectedWorkflow loads the land preview of the selected workflow.
+// The land or conflict modal is opened once the preview arrives.
+func (m Model) landSelectedWorkflow() (mode.Controller, tea.Cmd) {
+	workflow := m.SelectedWorkflow()
+	if workflow == nil || m.controlPlane == nil {
+		return m, nil
+	}
+
+	// Check if workflow is locked by another process
+	if workflow.IsLocked {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "🔒 Workflow is owned by another Perles process",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	if workflow.WorktreePath == "" {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "Workflow has no worktree to land",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+
+	cp := m.controlPlane
+	workflowID, name, state := workflow.ID, workflow.Name, workflow.State
+	return m, func() tea.Msg {
+		preview, err := cp.PreviewLand(context.Background(), workflowID)
+		return landPreviewLoadedMsg{workflowID: workflowID, name: name, state: state, preview: preview, err: err}
+	}
+}
+
+// handleLandPreviewLoaded opens the land modal alongside a diff of the branch,
+// or the conflict modal when the branch does not merge cleanly.
+func (m Model) handleLandPreviewLoaded(msg landPreviewLoadedMsg) (mode.Controller, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "Failed to preview land: " + msg.err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+	}
+
+	preview := msg.preview
+	if len(preview.Conflicts) > 0 {
+		return m.openLandConflictModal(msg)
+	}
+	if len(preview.Files) == 0 {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: fmt.Sprintf("Nothing to land: %s has no changes vs %s", preview.Branch, preview.BaseBranch),
+				Style:   toaster.StyleInfo,
+			}
+		}
+	}
+
+	fields := []formmodal.FieldConfig{
+		{
+			Key:   "strategy",
+			Type:  formmodal.FieldTypeToggle,
+			Label: "Strategy",
+			Options: []formmodal.ListOption{
+				{Label: "Merge", Value: "merge"},
+				{Label: "Squash", Value: "squash"},
+			},
+		},
+	}
+	// Running and paused workflows still need their worktree
+	if msg.state != controlplane.WorkflowRunning && msg.state != controlplane.WorkflowPaused {
+		fields = append(fields, formmodal.FieldConfig{
+			Key:   "worktree",
+			Type:  formmodal.FieldTypeToggle,
+			Label: "Worktree",
+			Options: []formmodal.ListOption{
+				{Label: "Keep", Value: "keep"},
+				{Label: "Remove", Value: "remove"},
+			},
+		})
+	}
+
+	additions, deletions := preview.Totals()
+	summary := fmt.Sprintf("%d files changed (+%d -%d)\n%s → %s",
+		len(preview.Files), additions, deletions, preview.Branch, preview.BaseBranch)
+
+	m.landModalWfID = msg.workflowID
+	m.landModalWfName = msg.name
+	landModal := formmodal.New(formmodal.FormConfig{
+		Title:         "Land Workflow",
+		HeaderContent: func(int) string { return summary },
+		Fields:        fields,
+		SubmitLabel:   " Land ",
+	}).SetSize(m.width, m.height)
+	m.landModal = &landModal
+
+	// Show the branch's changes; the land modal is waiting underneath
+	showDiff := func() tea.Msg {
+		return diffviewer.ShowDiffViewerMsg{WorkDir: preview.WorkDir, BaseRef: preview.BaseBranch}
+	}
+	return m, tea.Batch(landModal.Init(), showDiff)
+}
+
+// openLandConflictModal offers to hand merge conflicts to the coordinator.
+// Conflicts of workflows without a running coordinator can only be reported.
+func (m Model) openLandConflictModal(msg landPreviewLoadedMsg) (mode.Controller, tea.Cmd) {
+	preview := msg.preview
+	if msg.state == controlplane.WorkflowRunning {
+		m.landModalWfID = msg.workflowID
+		m.landModalWfName = msg.name
+		conflictModal := modal.New(modal.Config{
+			Title: "Merge Conflicts",
+			Message: fmt.Sprintf("%s conflicts with %s in:\n\n%s\n\nSend the conflicts to the coordinator to resolve?",
+				preview.Branch, preview.BaseBranch, strings.Join(preview.Conflicts, "\n")),
+			ConfirmText: "Send to coordinator",
+		})
+		conflictModal.SetSize(m.width, m.height)
+		m.landConflictModal = &conflictModal
+		return m, nil
+	}
+
+	return m, func() tea.Msg {
+		return mode.ShowToastMsg{
+			Message: fmt.Sprintf("%s conflicts with %s in %d files", preview.Branch, preview.BaseBranch, len(preview.Conflicts)),
+			Style:   toaster.StyleWarn,
+		}
+	}
+}
+
+// doLandWorkflow lands the workflow of the land modal with the submitted options.
+func (m Model) doLandWorkflow(values map[string]any) (mode.Controller, tea.Cmd) {
+	workflowID, name := m.landModalWfID, m.landModalWfName
+	m.landModal = nil
+	m.landModalWfID = ""
+	m.landModalWfName = ""
+
+	opts := controlplane.LandOptions{
+		Squash:  values["strategy"] == "squash",
+		Cleanup: values["worktree"] == "remove",
+	}
+	cp := m.controlPlane
+	return m, func() tea.Msg {
+		if cp == nil {
+			return nil
+		}
+		result, err := cp.Land(context.Background(), workflowID, opts)
+		if err != nil {
+			style := toaster.StyleError
+			if errors.Is(err, controlplane.ErrMergeConflicts) || errors.Is(err, controlplane.ErrUncommittedChanges) {
+				style = toaster.StyleWarn
+			}
+			return mode.ShowToastMsg{Message: "Failed to land workflow: " + err.Error(), Style: style}
+		}
+		return workflowLandedMsg{name: name, result: result}
+	}
+}
+
+// doResolveLandConflicts hands the conflicts of the conflict modal to the coordinator.
+func (m Model) doResolveLandConflicts() (mode.Controller, tea.Cmd) {
+	workflowID, name := m.landModalWfID, m.landModalWfName
+	m.landConflictModal = nil
+	m.landModalWfID = ""
+	m.landModalWfName = ""
+
+	cp := m.controlPlane
+	return m, func() tea.Msg {
+		if cp == nil {
+			return nil
+		}
+		if err := cp.ResolveLandConflicts(context.Background(), workflowID); err != nil {
+			return mode.ShowToastMsg{
+				Message: "Failed to send conflicts to the coordinator: " + err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+		return mode.ShowToastMsg{
+			Message: "Sent merge conflicts to the coordinator: " + name,
+			Style:   toaster.StyleInfo,
+		}
+	}
+}
+
+// landedToast describes a landed workflow.
+func landedToast(msg workflowLandedMsg) string {
+	verb := "Merged"
+	if msg.result.Squashed {
+		verb = "Squashed"
+	}
+	text := fmt.Sprintf("🛬 %s %s into %s", verb, msg.name, msg.result.BaseBranch)
+	if msg.result.CleanedUp {
+		text += " and removed its worktree"
+	}
+	return text
+}
@@ -0,0 +1,93 @@
+package dashboard
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/modal"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+func TestLandSelectedWorkflow_NoWorktree_ReturnsToast(t *testing.T) {
+	wf := createTestWorkflow("wf-land", "Auth", controlplane.WorkflowCompleted)
+
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.selectedIndex = 0
+
+	_, cmd := m.landSelectedWorkflow()
+
+	require.NotNil(t, cmd)
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, "Workflow has no worktree to land", toastMsg.Message)
+}
+
+func TestLandSelectedWorkflow_ShowsDiffAndLandsOnSubmit(t *testing.T) {
+	wf := createTestWorkflow("wf-land", "Auth", controlplane.WorkflowCompleted)
+	wf.WorktreePath = "/worktrees/perles-auth"
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m.selectedIndex = 0
+	mockCP.EXPECT().PreviewLand(mock.Anything, wf.ID).Return(&controlplane.LandPreview{
+		Branch:     "perles-auth",
+		BaseBranch: "main",
+		WorkDir:    wf.WorktreePath,
+		Files:      []controlplane.LandFileStat{{Path: "auth.go", Additions: 10, Deletions: 2}},
+	}, nil).Once()
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	m = result.(Model)
+	require.NotNil(t, cmd)
+	result, cmd = m.Update(cmd())
+	m = result.(Model)
+
+	require.NotNil(t, m.landModal, "land modal should be shown for a clean branch")
+	require.Contains(t, m.landModal.View(), "1 files changed (+10 -2)")
+	require.Equal(t, diffviewer.ShowDiffViewerMsg{WorkDir: wf.WorktreePath, BaseRef: "main"}, cmd(),
+		"the branch diff opens over the land modal")
+
+	mockCP.EXPECT().Land(mock.Anything, wf.ID, controlplane.LandOptions{Squash: true, Cleanup: true}).
+		Return(&controlplane.LandResult{Branch: "perles-auth", BaseBranch: "main", Squashed: true, CleanedUp: true}, nil).
+		Once()
+	result, cmd = m.Update(formmodal.SubmitMsg{Values: map[string]any{"strategy": "squash", "worktree": "remove"}})
+	m = result.(Model)
+
+	require.Nil(t, m.landModal, "land modal should be cleared after submit")
+	landed, ok := cmd().(workflowLandedMsg)
+	require.True(t, ok, "should return workflowLandedMsg")
+	require.Equal(t, "🛬 Squashed Auth into main and removed its worktree", landedToast(landed))
+}
+
+func TestLandSelectedWorkflow_ConflictsSentToCoordinator(t *testing.T) {
+	wf := createTestWorkflow("wf-land", "Auth", controlplane.WorkflowRunning)
+	wf.WorktreePath = "/worktrees/perles-auth"
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	result, _ := m.handleLandPreviewLoaded(landPreviewLoadedMsg{
+		workflowID: wf.ID,
+		name:       wf.Name,
+		state:      wf.State,
+		preview:    &controlplane.LandPreview{Branch: "perles-auth", BaseBranch: "main", Conflicts: []string{"go.mod"}},
+	})
+	m = result.(Model)
+
+	require.Nil(t, m.landModal)
+	require.NotNil(t, m.landConflictModal, "conflict modal should be shown for a running workflow")
+
+	mockCP.EXPECT().ResolveLandConflicts(mock.Anything, wf.ID).Return(nil).Once()
+	result, cmd := m.Update(modal.SubmitMsg{})
+	m = result.(Model)
+
+	require.Nil(t, m.landConflictModal)
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "should return ShowToastMsg")
+	require.Equal(t, "Sent merge conflicts to the coordinator: Auth", toastMsg.Message)
+	require.Equal(t, toaster.StyleInfo, toastMsg.Style)
+}
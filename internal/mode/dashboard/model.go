@@ -105,6 +105,9 @@ type Model struct {
 	workflowList      WorkflowList      // Component for sorting/filtering state
 	resourceSummary   ResourceSummary   // Component for resource bar
 
+	// Estimated start times of workflows waiting for a scheduler slot
+	queueETAs map[controlplane.WorkflowID]time.Time
+
 	// New workflow modal state (nil when not showing modal)
 	newWorkflowModal *NewWorkflowModal
 
@@ -121,6 +124,18 @@ type Model struct {
 	renameModal     *formmodal.Model        // nil when not showing
 	renameModalWfID controlplane.WorkflowID // Workflow ID to rename on confirm
 
+	// Land workflow modal state
+	landModal         *formmodal.Model        // nil when not showing
+	landConflictModal *modal.Model            // nil when not showing
+	landModalWfID     controlplane.WorkflowID // Workflow ID to land or resolve on confirm
+	landModalWfName   string                  // Workflow name for display/toast
+
+	// Approval modal state
+	approvalModal       *formmodal.Model        // nil when not showing
+	approvalModalWfID   controlplane.WorkflowID // Workflow ID of the approval on submit
+	approvalModalWfName string                  // Workflow name for display/toast
+	approvalModalID     string                  // Approval ID to resolve on submit
+
 	// Issue editor modal state (nil when not showing)
 	issueEditor *issueeditor.Model
 
@@ -154,6 +169,8 @@ type Model struct {
 
 	// API server port (for display in header)
 	apiPort int
+	// API token appended to browser URLs so the viewer can authenticate
+	apiToken string
 
 	// Debug mode enables command log tab in coordinator panel
 	debugMode bool
@@ -174,6 +191,7 @@ type WorkflowTableRow struct {
 	Index           int                            // 1-based row number
 	Workflow        *controlplane.WorkflowInstance // The workflow data
 	HasNotification bool                           // Whether this workflow has a pending notification
+	StartETA        time.Time                      // Estimated start of a queued or scheduled workflow (zero if none)
 }
 
 // Config holds configuration for creating a dashboard Model.
@@ -195,6 +213,8 @@ type Config struct {
 	// APIPort is the port the HTTP API server is running on.
 	// Shown in the dashboard header for external tool integration.
 	APIPort int
+	// APIToken authenticates the session viewer opened in the browser.
+	APIToken string
 	// DebugMode enables the command log tab in the coordinator panel.
 	// When true, an additional tab showing command processing activity is displayed.
 	DebugMode bool
@@ -228,6 +248,7 @@ func New(cfg Config) Model {
 		gitExecutorFactory: cfg.GitExecutorFactory,
 		workDir:            cfg.WorkDir,
 		apiPort:            cfg.APIPort,
+		apiToken:           cfg.APIToken,
 		debugMode:          cfg.DebugMode,
 		vimMode:            cfg.VimMode,
 		observerEnabled:    cfg.ObserverEnabled,
@@ -405,6 +426,91 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 		}
 	}
 
+	// Handle land modal when visible
+	if m.landModal != nil {
+		switch msg := msg.(type) {
+		case formmodal.SubmitMsg:
+			return m.doLandWorkflow(msg.Values)
+		case formmodal.CancelMsg:
+			m.landModal = nil
+			m.landModalWfID = ""
+			m.landModalWfName = ""
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			*m.landModal = m.landModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			// Handle control plane events even when modal is open to maintain event subscription.
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		default:
+			var cmd tea.Cmd
+			*m.landModal, cmd = m.landModal.Update(msg)
+			return m, cmd
+		}
+	}
+
+	// Handle approval modal when visible
+	if m.approvalModal != nil {
+		switch msg := msg.(type) {
+		case formmodal.SubmitMsg:
+			return m.doResolveApproval(msg.Values)
+		case formmodal.CancelMsg:
+			m.clearApprovalModal()
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			*m.approvalModal = m.approvalModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			// Handle control plane events even when modal is open to maintain event subscription.
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		default:
+			var cmd tea.Cmd
+			*m.approvalModal, cmd = m.approvalModal.Update(msg)
+			return m, cmd
+		}
+	}
+
+	// Handle land conflict modal when visible
+	if m.landConflictModal != nil {
+		switch msg := msg.(type) {
+		case modal.SubmitMsg:
+			return m.doResolveLandConflicts()
+		case modal.CancelMsg:
+			m.landConflictModal = nil
+			m.landModalWfID = ""
+			m.landModalWfName = ""
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			m.landConflictModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			// Handle control plane events even when modal is open to maintain event subscription.
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		default:
+			var cmd tea.Cmd
+			*m.landConflictModal, cmd = m.landConflictModal.Update(msg)
+			return m, cmd
+		}
+	}
+
 	// Handle issue editor modal when visible
 	if m.issueEditor != nil {
 		switch msg := msg.(type) {
@@ -520,6 +626,12 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 
 		m.workflows = msg.workflows
 		m.workflowList = m.workflowList.SetWorkflows(m.workflows)
+		m.queueETAs = make(map[controlplane.WorkflowID]time.Time, len(msg.queue))
+		for _, q := range msg.queue {
+			if !q.EstimatedStartAt.IsZero() {
+				m.queueETAs[q.ID] = q.EstimatedStartAt
+			}
+		}
 		m.resourceSummary = m.resourceSummary.Update(m.workflows)
 
 		// Find the previously selected workflow's new index in the reordered list
@@ -574,6 +686,24 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 	case StartWorkflowFailedMsg:
 		return m.handleStartWorkflowFailed(msg)
 
+	case landPreviewLoadedMsg:
+		return m.handleLandPreviewLoaded(msg)
+
+	case approvalsLoadedMsg:
+		return m.handleApprovalsLoaded(msg)
+
+	case workflowLandedMsg:
+		// Reload workflows after landing and show toast
+		return m, tea.Batch(
+			m.loadWorkflows(),
+			func() tea.Msg {
+				return mode.ShowToastMsg{
+					Message: landedToast(msg),
+					Style:   toaster.StyleSuccess,
+				}
+			},
+		)
+
 	case workflowArchivedMsg:
 		// Reload workflows after archiving and show toast
 		return m, tea.Batch(
@@ -711,6 +841,23 @@ func (m Model) View() string {
 		return m.renameModal.Overlay(dashboardView)
 	}
 
+	// If land modal is showing, render it as an overlay
+	// Note: formmodal already calls zone.Scan() internally, so we don't scan here
+	if m.landModal != nil {
+		return m.landModal.Overlay(dashboardView)
+	}
+
+	// If approval modal is showing, render it as an overlay
+	// Note: formmodal already calls zone.Scan() internally, so we don't scan here
+	if m.approvalModal != nil {
+		return m.approvalModal.Overlay(dashboardView)
+	}
+
+	// If land conflict modal is showing, render it as an overlay
+	if m.landConflictModal != nil {
+		return zone.Scan(m.landConflictModal.Overlay(dashboardView))
+	}
+
 	// If archive confirmation modal is showing, render it as an overlay
 	if m.archiveModal != nil {
 		return zone.Scan(m.archiveModal.Overlay(dashboardView))
@@ -808,6 +955,7 @@ type StartWorkflowFailedMsg struct {
 // workflowsLoadedMsg contains loaded workflow list.
 type workflowsLoadedMsg struct {
 	workflows []*controlplane.WorkflowInstance
+	queue     []controlplane.QueuedWorkflow
 	err       error
 }
 
@@ -837,7 +985,7 @@ func (m Model) loadWorkflows() tea.Cmd {
 			return workflowsLoadedMsg{workflows: make([]*controlplane.WorkflowInstance, 0)}
 		}
 		workflows, err := m.controlPlane.List(context.Background(), controlplane.ListQuery{})
-		return workflowsLoadedMsg{workflows: workflows, err: err}
+		return workflowsLoadedMsg{workflows: workflows, queue: m.controlPlane.Queue(), err: err}
 	}
 }
 
@@ -989,6 +1137,12 @@ func (m Model) handleTableKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	case "a": // Archive workflow (only when session persistence is enabled)
 		return m.archiveSelectedWorkflow()
 
+	case "L": // Land workflow branch into its base branch
+		return m.landSelectedWorkflow()
+
+	case "A": // Review the pending approval of the selected workflow
+		return m.reviewSelectedApproval()
+
 	case "o": // Open session in browser
 		return m.openSessionInBrowser()
 
@@ -1314,8 +1468,8 @@ func (m Model) getFilteredWorkflows() []*controlplane.WorkflowInstance {
 // It updates the cached WorkflowUIState for any workflow that sends events,
 // regardless of whether that workflow is currently selected.
 func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mode.Controller, tea.Cmd) {
-	// Handle EventWorkflowFailed: proactively clean up state for failed workflows
-	if event.Type == controlplane.EventWorkflowFailed && event.WorkflowID != "" {
+	// Handle EventWorkflowFailed/Deleted: proactively clean up state for finished workflows
+	if (event.Type == controlplane.EventWorkflowFailed || event.Type == controlplane.EventWorkflowDeleted) && event.WorkflowID != "" {
 		delete(m.workflowUIState, event.WorkflowID)
 	}
 
@@ -1327,6 +1481,23 @@ func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mo
 		)
 	}
 
+	// Surface budget alerts as toasts and refresh so the spend column updates
+	if event.Type.IsBudgetEvent() {
+		return m, tea.Batch(
+			m.budgetToast(event),
+			m.loadWorkflows(),
+			m.listenForEvents(),
+		)
+	}
+
+	// Surface worker recovery as toasts
+	if event.Type.IsHealthEvent() && event.ProcessID != "" {
+		return m, tea.Batch(
+			m.workerHealthToast(event),
+			m.listenForEvents(),
+		)
+	}
+
 	// Update cached UI state for this workflow (even if not currently selected)
 	if event.WorkflowID != "" {
 		m.updateCachedUIState(event)
@@ -1339,10 +1510,100 @@ func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mo
 		}
 	}
 
+	// Surface approval requests as toasts
+	if event.Type == controlplane.EventApprovalRequested {
+		return m, tea.Batch(
+			approvalRequestedToast(event),
+			m.listenForEvents(),
+		)
+	}
+
+	// Surface tool policy violations as toasts
+	if event.Type == controlplane.EventPolicyViolation {
+		return m, tea.Batch(
+			m.policyViolationToast(event),
+			m.listenForEvents(),
+		)
+	}
+
+	// Surface context handoffs so process replacements are not silent
+	if event.Type == controlplane.EventContextHandoff {
+		return m, tea.Batch(
+			m.contextHandoffToast(event),
+			m.listenForEvents(),
+		)
+	}
+
 	// For other events, just continue listening
 	return m, m.listenForEvents()
 }
 
+// budgetToast returns a command showing a toast for a budget event.
+func (m Model) budgetToast(event controlplane.ControlPlaneEvent) tea.Cmd {
+	payload, _ := event.Payload.(controlplane.BudgetPayload)
+	toast := mode.ShowToastMsg{
+		Message: fmt.Sprintf("Budget %.0f%% used: %s", payload.Burn*100, event.WorkflowName),
+		Style:   toaster.StyleWarn,
+	}
+	if event.Type == controlplane.EventBudgetExceeded {
+		toast = mode.ShowToastMsg{
+			Message: fmt.Sprintf("Budget reached, pausing: %s", event.WorkflowName),
+			Style:   toaster.StyleError,
+		}
+	}
+	return func() tea.Msg { return toast }
+}
+
+// policyViolationToast returns a command showing a toast when a worker reports
+// an action its tool policy forbids.
+func (m Model) policyViolationToast(event controlplane.ControlPlaneEvent) tea.Cmd {
+	toast := mode.ShowToastMsg{
+		Message: fmt.Sprintf("Policy blocked %s: %s", event.ProcessID, event.WorkflowName),
+		Style:   toaster.StyleWarn,
+	}
+	return func() tea.Msg { return toast }
+}
+
+// contextHandoffToast returns a command showing a toast for a context handoff.
+func (m Model) contextHandoffToast(event controlplane.ControlPlaneEvent) tea.Cmd {
+	toast := mode.ShowToastMsg{
+		Message: fmt.Sprintf("Context handoff %s: %s", event.ProcessID, event.WorkflowName),
+		Style:   toaster.StyleInfo,
+	}
+	return func() tea.Msg { return toast }
+}
+
+// workerHealthToast returns a command showing a toast when a stuck worker is
+// nudged or replaced, or nil for intermediate health events.
+func (m Model) workerHealthToast(event controlplane.ControlPlaneEvent) tea.Cmd {
+	health, ok := event.Payload.(controlplane.HealthEvent)
+	if !ok {
+		return nil
+	}
+
+	var toast mode.ShowToastMsg
+	switch {
+	case health.Type == controlplane.HealthRecoverySuccess && health.RecoveryAction == controlplane.RecoveryNudge.String():
+		toast = mode.ShowToastMsg{
+			Message: fmt.Sprintf("Nudged stuck %s: %s", event.ProcessID, event.WorkflowName),
+			Style:   toaster.StyleWarn,
+		}
+	case health.Type == controlplane.HealthRecoverySuccess:
+		toast = mode.ShowToastMsg{
+			Message: fmt.Sprintf("Replaced stuck %s: %s", event.ProcessID, event.WorkflowName),
+			Style:   toaster.StyleWarn,
+		}
+	case health.Type == controlplane.HealthRecoveryFailed:
+		toast = mode.ShowToastMsg{
+			Message: fmt.Sprintf("Recovering stuck %s failed: %s", event.ProcessID, event.WorkflowName),
+			Style:   toaster.StyleError,
+		}
+	default:
+		return nil
+	}
+	return func() tea.Msg { return toast }
+}
+
 // handleStartWorkflowFailed handles errors when starting a workflow fails.
 // It converts worktree-specific errors to user-friendly messages.
 func (m Model) handleStartWorkflowFailed(msg StartWorkflowFailedMsg) (mode.Controller, tea.Cmd) {
@@ -1554,7 +1815,7 @@ func (m *Model) updateCachedUIState(event controlplane.ControlPlaneEvent) {
 			}
 		}
 
-	case controlplane.EventUserNotification:
+	case controlplane.EventUserNotification, controlplane.EventApprovalRequested, controlplane.EventPolicyViolation:
 		// Set notification flag to highlight this workflow row
 		uiState.HasNotification = true
 
@@ -1876,6 +2137,9 @@ func (m Model) openSessionInBrowser() (mode.Controller, tea.Cmd) {
 	// Build the session viewer URL with URL-encoded path
 	encodedPath := url.QueryEscape(workflow.SessionDir)
 	viewerURL := fmt.Sprintf("http://localhost:%d/?path=%s", m.apiPort, encodedPath)
+	if m.apiToken != "" {
+		viewerURL += "&token=" + url.QueryEscape(m.apiToken)
+	}
 
 	// Attempt to open the browser
 	if err := frontend.OpenBrowser(viewerURL); err != nil {
@@ -3074,3 +3074,131 @@ func TestModel_FabricThreadCreatedMsg_NoPanelOpen(t *testing.T) {
 	// Should still have no panel
 	require.Nil(t, m.coordinatorPanel)
 }
+
+// === Unit Tests: Budget Events ===
+
+func TestModel_budgetToast(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+
+	warning := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventBudgetWarning,
+		WorkflowID:   "wf-1",
+		WorkflowName: "Workflow 1",
+		Payload:      controlplane.BudgetPayload{Threshold: 0.8, Burn: 0.82},
+	}
+	toast, ok := m.budgetToast(warning)().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Budget 82% used: Workflow 1", toast.Message)
+	require.Equal(t, toaster.StyleWarn, toast.Style)
+
+	exceeded := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventBudgetExceeded,
+		WorkflowID:   "wf-1",
+		WorkflowName: "Workflow 1",
+		Payload:      controlplane.BudgetPayload{Threshold: 1, Burn: 1.01},
+	}
+	toast, ok = m.budgetToast(exceeded)().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Budget reached, pausing: Workflow 1", toast.Message)
+	require.Equal(t, toaster.StyleError, toast.Style)
+}
+
+// === Unit Tests: Policy Events ===
+
+func TestHandleControlPlaneEvent_PolicyViolation_FlagsWorkflow(t *testing.T) {
+	wf := createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning)
+	event := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventPolicyViolation,
+		WorkflowID:   wf.ID,
+		WorkflowName: wf.Name,
+		ProcessID:    "worker-2",
+	}
+
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	result, cmd := m.handleControlPlaneEvent(event)
+	m = result.(Model)
+
+	require.True(t, m.getOrCreateUIState(wf.ID).HasNotification)
+	require.NotNil(t, cmd)
+
+	toast, ok := m.policyViolationToast(event)().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Policy blocked worker-2: Workflow 1", toast.Message)
+	require.Equal(t, toaster.StyleWarn, toast.Style)
+}
+
+// === Unit Tests: Context Handoff Events ===
+
+func TestHandleControlPlaneEvent_ContextHandoff_ShowsToast(t *testing.T) {
+	wf := createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning)
+	event := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventContextHandoff,
+		WorkflowID:   wf.ID,
+		WorkflowName: wf.Name,
+		ProcessID:    "coordinator",
+	}
+
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	result, cmd := m.handleControlPlaneEvent(event)
+	m = result.(Model)
+
+	require.False(t, m.getOrCreateUIState(wf.ID).HasNotification)
+	require.NotNil(t, cmd)
+
+	toast, ok := m.contextHandoffToast(event)().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Context handoff coordinator: Workflow 1", toast.Message)
+	require.Equal(t, toaster.StyleInfo, toast.Style)
+}
+
+// === Unit Tests: Worker Health Events ===
+
+func TestModel_workerHealthToast(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+
+	event := func(health controlplane.HealthEvent) controlplane.ControlPlaneEvent {
+		return controlplane.ControlPlaneEvent{
+			Type:         controlplane.EventHealthRecovered,
+			WorkflowID:   "wf-1",
+			WorkflowName: "Workflow 1",
+			ProcessID:    "worker-2",
+			Payload:      health,
+		}
+	}
+
+	nudged := controlplane.NewHealthEvent(controlplane.HealthRecoverySuccess, "wf-1").
+		WithProcess("worker-2").WithRecoveryAction("nudge")
+	toast, ok := m.workerHealthToast(event(nudged))().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Nudged stuck worker-2: Workflow 1", toast.Message)
+	require.Equal(t, toaster.StyleWarn, toast.Style)
+
+	replaced := controlplane.NewHealthEvent(controlplane.HealthRecoverySuccess, "wf-1").
+		WithProcess("worker-2").WithRecoveryAction("replace_worker")
+	toast, ok = m.workerHealthToast(event(replaced))().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Replaced stuck worker-2: Workflow 1", toast.Message)
+
+	failed := controlplane.NewHealthEvent(controlplane.HealthRecoveryFailed, "wf-1").
+		WithProcess("worker-2").WithRecoveryAction("replace_worker")
+	toast, ok = m.workerHealthToast(event(failed))().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, toaster.StyleError, toast.Style)
+
+	started := controlplane.NewHealthEvent(controlplane.HealthRecoveryStarted, "wf-1").WithProcess("worker-2")
+	require.Nil(t, m.workerHealthToast(event(started)))
+}
+
+func TestGetSpendDisplay(t *testing.T) {
+	wf := createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning)
+	require.Equal(t, "-", getSpendDisplay(wf))
+
+	wf.CostUSD = 1.5
+	require.Equal(t, "$1.50", getSpendDisplay(wf))
+
+	wf.BudgetUSD = 5
+	require.Equal(t, "$1.50 30%", getSpendDisplay(wf))
+
+	wf.CostUSD = 6
+	require.Contains(t, getSpendDisplay(wf), "$6.00 120%")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	zone "github.com/lrstanley/bubblezone"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
@@ -32,6 +33,9 @@ var (
 const (
 	statusRunning   = "RUNNING"
 	statusPending   = "PENDING"
+	statusQueued    = "QUEUED"
+	statusScheduled = "SCHEDULED"
+	statusWaiting   = "WAITING"
 	statusPaused    = "PAUSED"
 	statusCompleted = "COMPLETED"
 	statusFailed    = "FAILED"
@@ -120,6 +124,13 @@ func (m Model) createWorkflowTableConfig() table.TableConfig {
 				Render: func(row any, _ string, _ int, _ bool) string {
 					r := row.(WorkflowTableRow)
 					text, color := getStatusTextAndColor(r.Workflow.State)
+					if r.Workflow.IsQueued() {
+						text = statusQueued // Waiting for a scheduler slot
+					} else if r.Workflow.IsScheduled() {
+						text = statusScheduled // Waiting for its start time
+					} else if r.Workflow.IsWaiting() {
+						text = statusWaiting // Waiting for upstream workflows
+					}
 					return lipgloss.NewStyle().Foreground(color).Render(text)
 				},
 			},
@@ -200,17 +211,17 @@ func (m Model) createWorkflowTableConfig() table.TableConfig {
 					return fmt.Sprintf("%d", r.Workflow.ActiveWorkers)
 				},
 			},
-			// TODO: Re-enable tokens column once token tracking is implemented
-			// {
-			// 	Key:    "tokens",
-			// 	Header: "Tokens",
-			// 	Width:  8,
-			// 	Type:   table.ColumnTypeText,
-			// 	Render: func(row any, _ string, _ int, _ bool) string {
-			// 		r := row.(WorkflowTableRow)
-			// 		return formatTokenCount(r.Workflow.TokensUsed)
-			// 	},
-			// },
+			{
+				Key:       "spend",
+				Header:    "Spend",
+				Width:     12, // "$12.34 100%" = 11 chars + 1 padding
+				Type:      table.ColumnTypeText,
+				HideBelow: 100, // Hide when table width < 100 (e.g., coordinator panel open)
+				Render: func(row any, _ string, _ int, _ bool) string {
+					r := row.(WorkflowTableRow)
+					return getSpendDisplay(r.Workflow)
+				},
+			},
 			{
 				Key:    "health",
 				Header: "Health",
@@ -228,7 +239,7 @@ func (m Model) createWorkflowTableConfig() table.TableConfig {
 				Type:   table.ColumnTypeText,
 				Render: func(row any, _ string, _ int, _ bool) string {
 					r := row.(WorkflowTableRow)
-					return m.getUptimeDisplay(r.Workflow)
+					return m.getUptimeDisplay(r)
 				},
 			},
 			{
@@ -239,7 +250,7 @@ func (m Model) createWorkflowTableConfig() table.TableConfig {
 				HideBelow: 110, // Hide when table width < 110 (e.g., coordinator panel open)
 				Render: func(row any, _ string, _ int, _ bool) string {
 					r := row.(WorkflowTableRow)
-					return m.getStartedDisplay(r.Workflow)
+					return m.getStartedDisplay(r)
 				},
 			},
 		},
@@ -382,10 +393,12 @@ func (m Model) renderBorderedWorkflowTable(width, height int) string {
 		if uiState, exists := m.workflowUIState[wf.ID]; exists {
 			hasNotification = uiState.HasNotification
 		}
+		startETA, _ := m.getStartETA(wf)
 		rows[i] = WorkflowTableRow{
 			Index:           i + 1,
 			Workflow:        wf,
 			HasNotification: hasNotification,
+			StartETA:        startETA,
 		}
 	}
 
@@ -447,6 +460,27 @@ func getStatusTextAndColor(state controlplane.WorkflowState) (string, lipgloss.T
 	}
 }
 
+// getSpendDisplay returns the workflow's spend and, when it has a budget, the
+// percentage burned. Yellow past 80% of the budget, red once it is reached.
+func getSpendDisplay(wf *controlplane.WorkflowInstance) string {
+	if !wf.HasBudget() {
+		if wf.CostUSD == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("$%.2f", wf.CostUSD)
+	}
+
+	burn := wf.BudgetBurn()
+	display := fmt.Sprintf("$%.2f %d%%", wf.CostUSD, int(burn*100))
+	switch {
+	case burn >= 1:
+		return lipgloss.NewStyle().Foreground(colorFailed).Render(display)
+	case burn >= 0.8:
+		return lipgloss.NewStyle().Foreground(colorPaused).Render(display)
+	}
+	return display
+}
+
 // getHealthDisplay returns the health display string for a workflow.
 func (m Model) getHealthDisplay(wf *controlplane.WorkflowInstance) string {
 	// Only show heartbeat for running workflows
@@ -482,30 +516,55 @@ func (m Model) getHealthDisplay(wf *controlplane.WorkflowInstance) string {
 }
 
 // getUptimeDisplay returns the uptime display string for a workflow.
-func (m Model) getUptimeDisplay(wf *controlplane.WorkflowInstance) string {
+func (m Model) getUptimeDisplay(r WorkflowTableRow) string {
+	wf := r.Workflow
 	if wf.StartedAt == nil {
+		// Waiting workflows count down to their estimated start
+		if !r.StartETA.IsZero() {
+			return "in " + formatDuration(max(r.StartETA.Sub(m.now()), 0))
+		}
 		return "-"
 	}
 
-	// Get current time from clock (or use time.Now() if no clock configured)
-	now := time.Now()
-	if m.services.Clock != nil {
-		now = m.services.Clock.Now()
-	}
-
-	elapsed := now.Sub(*wf.StartedAt)
+	elapsed := m.now().Sub(*wf.StartedAt)
 	return formatDuration(elapsed)
 }
 
 // getStartedDisplay returns the started time display string for a workflow.
-func (m Model) getStartedDisplay(wf *controlplane.WorkflowInstance) string {
+// Queued and scheduled workflows show their estimated start, dimmed.
+func (m Model) getStartedDisplay(r WorkflowTableRow) string {
+	wf := r.Workflow
 	if wf.StartedAt == nil {
+		if !r.StartETA.IsZero() {
+			return lipgloss.NewStyle().Foreground(colorDimmed).Render(r.StartETA.Format("01/02 03:04PM"))
+		}
 		return "-"
 	}
 
 	return wf.StartedAt.Format("01/02 03:04PM")
 }
 
+// getStartETA returns when a waiting workflow is expected to start: the
+// scheduler's estimate for queued workflows, the start time for scheduled ones.
+func (m Model) getStartETA(wf *controlplane.WorkflowInstance) (time.Time, bool) {
+	if wf.IsQueued() {
+		eta, ok := m.queueETAs[wf.ID]
+		return eta, ok
+	}
+	if wf.IsScheduled() {
+		return *wf.ScheduledAt, true
+	}
+	return time.Time{}, false
+}
+
+// now returns the current time from the clock (or time.Now() if no clock configured).
+func (m Model) now() time.Time {
+	if m.services.Clock != nil {
+		return m.services.Clock.Now()
+	}
+	return time.Now()
+}
+
 // phaseShortName returns a short display name for a workflow phase.
 // Currently unused because WorkflowInstance doesn't expose Phase yet.
 //
@@ -600,10 +659,19 @@ func (m Model) renderEpicSection(width, height int) string {
 	treePaneStyle := m.getEpicPaneBorderConfig(EpicFocusTree, layout.treeWidth, height, "Epic")
 
 	// Calculate progress bar for tree pane
+	// Prefer the BQL rollup (recursive over all descendants, with in-progress and
+	// blocked highlights); fall back to counting the loaded tree nodes.
 	var progressBar string
-	if m.epicTree.Root() != nil {
-		closed, total := m.epicTree.Root().CalculateProgress()
-		progressBar = renderCompactProgress(closed, total)
+	if root := m.epicTree.Root(); root != nil {
+		if rollup := root.Issue.Progress; rollup.Total > 0 {
+			progressBar = renderCompactProgress(rollup.Closed, rollup.Total)
+			if highlights := renderRollupHighlights(rollup); highlights != "" {
+				progressBar += " " + highlights
+			}
+		} else {
+			closed, total := root.CalculateProgress()
+			progressBar = renderCompactProgress(closed, total)
+		}
 	}
 
 	treePane := zone.Mark(zoneEpicTree, panes.BorderedPane(panes.BorderConfig{
@@ -695,6 +763,18 @@ func renderCompactProgress(closed, total int) string {
 	return fmt.Sprintf("%s%s %.0f%% (%d/%d)", filled, empty, percent, closed, total)
 }
 
+// renderRollupHighlights renders the in-progress and blocked descendant counts, e.g. "●2 ⊘1".
+func renderRollupHighlights(p beads.Progress) string {
+	var parts []string
+	if p.InProgress > 0 {
+		parts = append(parts, lipgloss.NewStyle().Foreground(styles.StatusInProgressColor).Render(fmt.Sprintf("●%d", p.InProgress)))
+	}
+	if p.Blocked > 0 {
+		parts = append(parts, lipgloss.NewStyle().Foreground(styles.StatusErrorColor).Render(fmt.Sprintf("⊘%d", p.Blocked)))
+	}
+	return strings.Join(parts, " ")
+}
+
 // ResourceSummary holds aggregated resource statistics.
 type ResourceSummary struct {
 	TotalWorkflows   int
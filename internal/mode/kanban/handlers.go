@@ -2,12 +2,15 @@ package kanban
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/issuetemplate"
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/mode"
@@ -50,6 +53,10 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m.handleEditIssueKey(msg)
 	case ViewDeleteIssue:
 		return m.handleDeleteIssueKey(msg)
+	case ViewNewIssueMenu:
+		return m.handleViewMenuKey(msg)
+	case ViewNewIssueModal:
+		return m.handleRenameViewModalKey(msg)
 	}
 	return m, nil
 }
@@ -226,6 +233,9 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case key.Matches(msg, keys.Kanban.NewIssue):
+		return m.openNewIssueMenu()
+
 	case key.Matches(msg, keys.Kanban.ViewMenu):
 		m.picker = picker.NewWithConfig(picker.Config{
 			Title: "View Menu",
@@ -731,6 +741,11 @@ func (m Model) handleModalSubmit(msg modal.SubmitMsg) (Model, tea.Cmd) {
 	if m.view == ViewRenameViewModal {
 		return m.renameCurrentView(msg.Values["name"])
 	}
+	if m.view == ViewNewIssueModal {
+		opts := m.newIssueTemplate.Options(msg.Values["title"])
+		m.view = ViewBoard
+		return m, m.createIssueCmd(opts)
+	}
 	if m.view == ViewDeleteIssue {
 		if len(m.deleteIssueIDs) > 0 {
 			issueIDs := m.deleteIssueIDs
@@ -753,7 +768,7 @@ func (m Model) handleModalSubmit(msg modal.SubmitMsg) (Model, tea.Cmd) {
 
 // handleModalCancel processes modal cancellation.
 func (m Model) handleModalCancel() (Model, tea.Cmd) {
-	if m.view == ViewNewViewModal || m.view == ViewDeleteViewModal || m.view == ViewDeleteColumnModal || m.view == ViewRenameViewModal {
+	if m.view == ViewNewViewModal || m.view == ViewDeleteViewModal || m.view == ViewDeleteColumnModal || m.view == ViewRenameViewModal || m.view == ViewNewIssueModal {
 		m.view = ViewBoard
 		m.pendingDeleteColumn = -1
 		return m, nil
@@ -813,3 +828,99 @@ func (m Model) handleIssueDeleted(msg issueDeletedMsg) (Model, tea.Cmd) {
 		func() tea.Msg { return mode.ShowToastMsg{Message: "Issue deleted", Style: toaster.StyleSuccess} },
 	)
 }
+
+// openNewIssueMenu loads issue templates and offers them in a picker.
+// Without templates it goes straight to the title modal for a blank issue.
+func (m Model) openNewIssueMenu() (Model, tea.Cmd) {
+	templates, err := issuetemplate.Load(
+		filepath.Join(m.services.WorkDir, ".perles", issuetemplate.DirName),
+		issuetemplate.UserDir(),
+	)
+	if err != nil {
+		log.ErrorErr(log.CatConfig, "Failed to load issue templates", err)
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Issue templates: " + err.Error(), Style: toaster.StyleError}
+		}
+	}
+	m.issueTemplates = templates
+	if len(templates) == 0 {
+		return m.openNewIssueModal("")
+	}
+
+	options := make([]picker.Option, 0, len(templates)+1)
+	options = append(options, picker.Option{Label: "Blank issue", Value: ""})
+	for _, t := range templates {
+		options = append(options, picker.Option{Label: t.Name, Value: t.ID})
+	}
+	m.picker = picker.NewWithConfig(picker.Config{
+		Title:   "New Issue",
+		Options: options,
+		OnSelect: func(opt picker.Option) tea.Msg {
+			return newIssueTemplateMsg{templateID: opt.Value}
+		},
+		OnCancel: func() tea.Msg { return pickerCancelledMsg{} },
+	}).SetSize(m.width, m.height)
+	m.view = ViewNewIssueMenu
+	return m, nil
+}
+
+// openNewIssueModal prompts for the title of an issue created from the given template.
+func (m Model) openNewIssueModal(templateID string) (Model, tea.Cmd) {
+	tmpl := issuetemplate.Template{Type: beads.TypeTask, Priority: beads.PriorityMedium}
+	title := "New Issue"
+	if t, ok := issuetemplate.Find(m.issueTemplates, templateID); ok {
+		tmpl = t
+		title = "New " + t.Name
+	}
+	m.newIssueTemplate = tmpl
+
+	message := fmt.Sprintf("%s · P%d", tmpl.Type, tmpl.Priority)
+	if len(tmpl.Labels) > 0 {
+		message += " · " + strings.Join(tmpl.Labels, ", ")
+	}
+	m.modal = modal.New(modal.Config{
+		Title:          title,
+		Message:        message,
+		ConfirmVariant: modal.ButtonPrimary,
+		ConfirmText:    "Create",
+		Inputs: []modal.InputConfig{
+			{Key: "title", Label: "Title", Placeholder: "Enter issue title...", Value: tmpl.TitlePrefix, MaxLength: 200},
+		},
+	})
+	m.modal.SetSize(m.width, m.height)
+	m.view = ViewNewIssueModal
+	return m, m.modal.Init()
+}
+
+// issueCreatedMsg is sent when issue creation completes.
+type issueCreatedMsg struct {
+	result beads.CreateResult
+	err    error
+}
+
+// createIssueCmd creates a command that creates an issue via the BD CLI.
+func (m Model) createIssueCmd(opts beads.CreateIssueOptions) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.services.BeadsExecutor.CreateIssue(opts)
+		return issueCreatedMsg{result: result, err: err}
+	}
+}
+
+// handleIssueCreated processes issue creation results.
+func (m Model) handleIssueCreated(msg issueCreatedMsg) (Model, tea.Cmd) {
+	m.newIssueTemplate = issuetemplate.Template{}
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Create failed: " + msg.err.Error(), Style: toaster.StyleError}
+		}
+	}
+
+	m.loading = true
+	m.board = m.board.InvalidateViews()
+	return m, tea.Batch(
+		m.board.LoadAllColumns(),
+		func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Created " + msg.result.ID, Style: toaster.StyleSuccess}
+		},
+	)
+}
@@ -10,6 +10,7 @@ import (
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/issuetemplate"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/mode"
 	"github.com/zjrosen/perles/internal/mode/shared"
@@ -37,8 +38,10 @@ const (
 	ViewViewMenu
 	ViewDeleteColumnModal
 	ViewRenameViewModal
-	ViewEditIssue   // Unified issue editor modal
-	ViewDeleteIssue // Delete issue confirmation modal
+	ViewEditIssue     // Unified issue editor modal
+	ViewDeleteIssue   // Delete issue confirmation modal
+	ViewNewIssueMenu  // Issue template picker
+	ViewNewIssueModal // New issue title modal
 )
 
 // cursorState tracks the current selection for restoration after refresh.
@@ -69,6 +72,10 @@ type Model struct {
 	deleteIssueIDs      []string     // IDs to delete (includes descendants for epics)
 	selectedIssue       *beads.Issue // Issue being deleted
 
+	// New issue state
+	issueTemplates   []issuetemplate.Template // Templates offered by the new issue picker
+	newIssueTemplate issuetemplate.Template   // Template applied to the issue being created
+
 	// Pending cursor restoration after refresh
 	pendingCursor *cursorState
 
@@ -244,6 +251,12 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case issueDeletedMsg:
 		return m.handleIssueDeleted(msg)
 
+	case newIssueTemplateMsg:
+		return m.openNewIssueModal(msg.templateID)
+
+	case issueCreatedMsg:
+		return m.handleIssueCreated(msg)
+
 	case labelsChangedMsg:
 		return m.handleLabelsChanged(msg)
 
@@ -351,7 +364,7 @@ func (m Model) View() string {
 	case ViewColumnEditor:
 		// Full-screen column editor
 		return m.colEditor.View()
-	case ViewNewViewModal, ViewDeleteViewModal, ViewRenameViewModal:
+	case ViewNewViewModal, ViewDeleteViewModal, ViewRenameViewModal, ViewNewIssueModal:
 		// Render modal overlay on top of board
 		bg := m.renderBoardWithStatusBar()
 		return m.modal.Overlay(bg)
@@ -359,8 +372,8 @@ func (m Model) View() string {
 		// Render issue editor overlay on top of board
 		bg := m.renderBoardWithStatusBar()
 		return m.issueEditor.Overlay(bg)
-	case ViewViewMenu:
-		// Render view menu overlay on top of board
+	case ViewViewMenu, ViewNewIssueMenu:
+		// Render picker overlay on top of board
 		bg := m.renderBoardWithStatusBar()
 		return m.picker.Overlay(bg)
 	case ViewDeleteColumnModal, ViewDeleteIssue:
@@ -713,6 +726,12 @@ type viewMenuDeleteMsg struct{}
 // viewMenuRenameMsg is produced when "rename view" is selected in view menu picker.
 type viewMenuRenameMsg struct{}
 
+// newIssueTemplateMsg is produced when a template is selected in the new issue picker.
+// An empty templateID means a blank issue.
+type newIssueTemplateMsg struct {
+	templateID string
+}
+
 // Async commands
 
 func (m Model) updateStatusCmd(issueID string, status beads.Status) tea.Cmd {
@@ -2,6 +2,8 @@ package kanban
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 	"time"
@@ -19,6 +21,7 @@ import (
 	"github.com/zjrosen/perles/internal/ui/board"
 	"github.com/zjrosen/perles/internal/ui/modals/issueeditor"
 	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
+	"github.com/zjrosen/perles/internal/ui/shared/modal"
 )
 
 // Note: TestMain is defined in golden_test.go and initializes zone.NewGlobal()
@@ -988,3 +991,85 @@ func TestNew_NilActionsWhenNotConfigured(t *testing.T) {
 	// Actions should be nil when not configured
 	require.Nil(t, m.actions, "actions should be nil when not configured")
 }
+
+// createNewIssueTestModel creates a model whose template directories live under temp dirs.
+func createNewIssueTestModel(t *testing.T, executor *mocks.MockIssueExecutor) (Model, string) {
+	t.Setenv("HOME", t.TempDir())
+	workDir := t.TempDir()
+
+	m := createTestModelWithIssue("test-123", "status = open")
+	m.services.WorkDir = workDir
+	m.services.BeadsExecutor = executor
+	return m, filepath.Join(workDir, ".perles", "issue-templates")
+}
+
+func TestKanban_NewIssue_NoTemplates_OpensTitleModal(t *testing.T) {
+	m, _ := createNewIssueTestModel(t, mocks.NewMockIssueExecutor(t))
+
+	m, cmd := m.handleBoardKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	require.Equal(t, ViewNewIssueModal, m.view, "expected title modal when no templates exist")
+	require.NotNil(t, cmd, "expected modal init command")
+	require.Equal(t, beads.TypeTask, m.newIssueTemplate.Type)
+}
+
+func TestKanban_NewIssue_FromTemplate_CreatesIssue(t *testing.T) {
+	executor := mocks.NewMockIssueExecutor(t)
+	executor.EXPECT().CreateIssue(beads.CreateIssueOptions{
+		Title:       "Bug: Crash on save",
+		Description: "Steps to reproduce",
+		Type:        beads.TypeBug,
+		Priority:    beads.PriorityHigh,
+		Labels:      []string{"bug"},
+	}).Return(beads.CreateResult{ID: "test-999", Title: "Bug: Crash on save"}, nil)
+
+	m, templateDir := createNewIssueTestModel(t, executor)
+	require.NoError(t, os.MkdirAll(templateDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(templateDir, "bug.md"),
+		[]byte("---\nname: Bug report\ntitle: \"Bug: \"\ntype: bug\npriority: 1\nlabels: [bug]\n---\nSteps to reproduce\n"), 0o600))
+
+	// n opens the template picker
+	m, _ = m.handleBoardKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.Equal(t, ViewNewIssueMenu, m.view)
+	require.Len(t, m.issueTemplates, 1)
+
+	// Selecting the template opens the title modal
+	m, _ = m.Update(newIssueTemplateMsg{templateID: "bug"})
+	require.Equal(t, ViewNewIssueModal, m.view)
+	require.Equal(t, "bug", m.newIssueTemplate.ID)
+
+	// Submitting the prefilled title creates the issue
+	m, cmd := m.Update(modal.SubmitMsg{Values: map[string]string{"title": "Bug: Crash on save"}})
+	require.Equal(t, ViewBoard, m.view)
+	require.NotNil(t, cmd)
+
+	created, ok := cmd().(issueCreatedMsg)
+	require.True(t, ok, "expected issueCreatedMsg")
+	require.NoError(t, created.err)
+
+	m, cmd = m.Update(created)
+	require.True(t, m.loading, "expected board refresh after create")
+	require.NotNil(t, cmd)
+}
+
+func TestKanban_NewIssue_CancelReturnsToBoard(t *testing.T) {
+	m, _ := createNewIssueTestModel(t, mocks.NewMockIssueExecutor(t))
+
+	m, _ = m.handleBoardKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	require.Equal(t, ViewNewIssueModal, m.view)
+
+	m, _ = m.Update(modal.CancelMsg{})
+	require.Equal(t, ViewBoard, m.view)
+}
+
+func TestKanban_NewIssue_CreateError_ShowsToast(t *testing.T) {
+	m, _ := createNewIssueTestModel(t, mocks.NewMockIssueExecutor(t))
+
+	m, cmd := m.Update(issueCreatedMsg{err: fmt.Errorf("bd create failed")})
+	require.False(t, m.loading)
+	require.NotNil(t, cmd)
+
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "expected ShowToastMsg")
+	require.Contains(t, toast.Message, "bd create failed")
+}
@@ -47,10 +47,11 @@ type Controller interface {
 	SetSize(width, height int) Controller
 }
 
-// BeadsClient combines version and comment reading for mode controllers.
+// BeadsClient combines version, comment and event reading for mode controllers.
 type BeadsClient interface {
 	appbeads.VersionReader
 	appbeads.CommentReader
+	appbeads.EventReader
 }
 
 // Services contains shared dependencies injected into mode controllers.
@@ -0,0 +1,153 @@
+// Package replay provides a scrubber for stepping through a replayed command log.
+package replay
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	v2replay "github.com/zjrosen/perles/internal/orchestration/v2/replay"
+	"github.com/zjrosen/perles/internal/ui/shared/quitmodal"
+)
+
+// Model holds the scrubber state.
+type Model struct {
+	// session labels the replayed session in the title bar.
+	session string
+	// steps is the state after each replayed command.
+	steps []v2replay.Step
+	// index is the selected command.
+	index int
+
+	// Quit confirmation modal
+	quitModal quitmodal.Model
+
+	// Dimensions
+	width    int
+	height   int
+	quitting bool
+}
+
+// New creates a scrubber over the replayed steps of a session, starting at the
+// first command.
+func New(session string, steps []v2replay.Step) Model {
+	return Model{
+		session: session,
+		steps:   steps,
+		quitModal: quitmodal.New(quitmodal.Config{
+			Title:   "Quit Replay",
+			Message: "Are you sure you want to exit?",
+		}),
+	}
+}
+
+// Init implements tea.Model.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle quit modal first when visible
+	if m.quitModal.IsVisible() {
+		var cmd tea.Cmd
+		var result quitmodal.Result
+		m.quitModal, cmd, result = m.quitModal.Update(msg)
+		switch result {
+		case quitmodal.ResultQuit:
+			m.quitting = true
+			return m, tea.Quit
+		case quitmodal.ResultCancel:
+			return m, nil
+		}
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.quitModal.SetSize(msg.Width, msg.Height)
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKeyMsg(msg)
+	}
+
+	return m, nil
+}
+
+// handleKeyMsg moves the selected command.
+func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		m.quitModal.Show()
+	case "right", "l":
+		m.seek(m.index + 1)
+	case "left", "h":
+		m.seek(m.index - 1)
+	case "pgdown", "L":
+		m.seek(m.index + m.pageSize())
+	case "pgup", "H":
+		m.seek(m.index - m.pageSize())
+	case "home", "g":
+		m.seek(0)
+	case "end", "G":
+		m.seek(len(m.steps) - 1)
+	case "n":
+		if next, ok := m.findDivergence(m.index+1, 1); ok {
+			m.seek(next)
+		}
+	case "N", "p":
+		if prev, ok := m.findDivergence(m.index-1, -1); ok {
+			m.seek(prev)
+		}
+	}
+	return m, nil
+}
+
+// seek selects the command at index, clamped to the log.
+func (m *Model) seek(index int) {
+	m.index = max(min(index, len(m.steps)-1), 0)
+}
+
+// pageSize is how far a page jump moves: a tenth of the log, at least one command.
+func (m Model) pageSize() int {
+	return max(len(m.steps)/10, 1)
+}
+
+// findDivergence scans from index in direction dir for a command whose replay
+// differs from the recording.
+func (m Model) findDivergence(index, dir int) (int, bool) {
+	for i := index; i >= 0 && i < len(m.steps); i += dir {
+		if m.steps[i].Diverged() {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// divergenceCount returns how many replayed commands differ from the recording.
+func (m Model) divergenceCount() int {
+	count := 0
+	for _, step := range m.steps {
+		if step.Diverged() {
+			count++
+		}
+	}
+	return count
+}
+
+// View implements tea.Model.
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	content := m.render()
+
+	// Overlay quit confirmation modal if showing
+	if m.quitModal.IsVisible() {
+		return m.quitModal.Overlay(content)
+	}
+
+	return content
+}
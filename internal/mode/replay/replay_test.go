@@ -0,0 +1,182 @@
+package replay
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	v2replay "github.com/zjrosen/perles/internal/orchestration/v2/replay"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// testSteps replays a short workflow: the coordinator and a worker start, the
+// worker takes a task, a second assignment fails on replay although it was
+// recorded as a success, and a message is queued for the busy worker.
+func testSteps() []v2replay.Step {
+	start := time.Date(2026, 1, 15, 2, 14, 7, 0, time.UTC)
+	event := func(i int, cmdType, payload string, success bool) processor.CommandEvent {
+		return processor.CommandEvent{
+			CommandID:   "cmd-" + string(rune('a'+i)),
+			CommandType: cmdType,
+			Source:      "mcp_tool",
+			Success:     success,
+			DurationMs:  int64(i + 1),
+			Timestamp:   start.Add(time.Duration(i) * time.Second),
+			Payload:     json.RawMessage(payload),
+		}
+	}
+
+	coordinator := repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady}
+	idle := events.ProcessPhaseIdle
+	implementing := events.ProcessPhaseImplementing
+	readyWorker := repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle}
+	busyWorker := repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &implementing, TaskID: "perles-abc1.2"}
+	task := repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing}
+
+	return []v2replay.Step{
+		{
+			Index: 0, Replayed: true, Success: true,
+			Event:     event(0, "spawn_process", `{"Role":"coordinator","ProcessID":"coordinator"}`, true),
+			Processes: []repository.Process{coordinator},
+		},
+		{
+			Index: 1, Replayed: true, Success: true,
+			Event:     event(1, "spawn_process", `{"Role":"worker","ProcessID":"worker-1"}`, true),
+			Processes: []repository.Process{coordinator, readyWorker},
+		},
+		{
+			Index: 2, Replayed: true, Success: true,
+			Event:     event(2, "assign_task", `{"WorkerID":"worker-1","TaskID":"perles-abc1.2"}`, true),
+			Processes: []repository.Process{coordinator, busyWorker},
+			Tasks:     []repository.TaskAssignment{task},
+		},
+		{
+			Index: 3, Replayed: true, Success: false, Error: "worker worker-1 is not ready",
+			Event:     event(3, "assign_task", `{"WorkerID":"worker-1","TaskID":"perles-abc1.3"}`, true),
+			Processes: []repository.Process{coordinator, busyWorker},
+			Tasks:     []repository.TaskAssignment{task},
+		},
+		{
+			Index: 4, Replayed: true, Success: true,
+			Event:     event(4, "send_to_process", `{"ProcessID":"worker-1","Content":"also update the docs"}`, true),
+			Processes: []repository.Process{coordinator, busyWorker},
+			Tasks:     []repository.TaskAssignment{task},
+			Queues: map[string][]repository.QueueEntry{
+				"worker-1": {{Content: "also update the docs", Sender: repository.SenderCoordinator}},
+			},
+		},
+	}
+}
+
+// createTestModel creates a Model sized for reproducible golden tests.
+func createTestModel(t *testing.T, steps []v2replay.Step) Model {
+	t.Helper()
+	m := New("a1b2c3d4", steps)
+	return updateModel(t, m, tea.WindowSizeMsg{Width: 120, Height: 30})
+}
+
+// updateModel is a helper to update the model and return the typed Model.
+func updateModel(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+	result, _ := m.Update(msg)
+	return result.(Model)
+}
+
+// press sends a key to the model.
+func press(t *testing.T, m Model, key string) Model {
+	t.Helper()
+	switch key {
+	case "right":
+		return updateModel(t, m, tea.KeyMsg{Type: tea.KeyRight})
+	case "left":
+		return updateModel(t, m, tea.KeyMsg{Type: tea.KeyLeft})
+	case "home":
+		return updateModel(t, m, tea.KeyMsg{Type: tea.KeyHome})
+	case "end":
+		return updateModel(t, m, tea.KeyMsg{Type: tea.KeyEnd})
+	}
+	return updateModel(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+}
+
+func TestReplay_StepsThroughCommands(t *testing.T) {
+	m := createTestModel(t, testSteps())
+	require.Equal(t, 0, m.index)
+
+	m = press(t, m, "left")
+	require.Equal(t, 0, m.index, "stepping back from the first command stays put")
+
+	m = press(t, m, "right")
+	m = press(t, m, "l")
+	require.Equal(t, 2, m.index)
+
+	m = press(t, m, "h")
+	require.Equal(t, 1, m.index)
+
+	m = press(t, m, "end")
+	require.Equal(t, 4, m.index)
+
+	m = press(t, m, "right")
+	require.Equal(t, 4, m.index, "stepping past the last command stays put")
+
+	m = press(t, m, "home")
+	require.Equal(t, 0, m.index)
+}
+
+func TestReplay_JumpsBetweenDivergences(t *testing.T) {
+	m := createTestModel(t, testSteps())
+
+	m = press(t, m, "n")
+	require.Equal(t, 3, m.index)
+
+	m = press(t, m, "n")
+	require.Equal(t, 3, m.index, "no later divergence keeps the selection")
+
+	m = press(t, m, "end")
+	m = press(t, m, "N")
+	require.Equal(t, 3, m.index)
+}
+
+func TestReplay_QuitShowsConfirmation(t *testing.T) {
+	m := createTestModel(t, testSteps())
+
+	m = press(t, m, "q")
+	require.True(t, m.quitModal.IsVisible())
+}
+
+// Golden tests for replay mode rendering.
+// Run with -update flag to update golden files: go test -update ./internal/mode/replay/...
+
+func TestReplay_Golden_FirstCommand(t *testing.T) {
+	m := createTestModel(t, testSteps())
+	teatest.RequireEqualOutput(t, []byte(m.View()))
+}
+
+func TestReplay_Golden_TaskAssigned(t *testing.T) {
+	m := createTestModel(t, testSteps())
+	m = press(t, m, "right")
+	m = press(t, m, "right")
+	teatest.RequireEqualOutput(t, []byte(m.View()))
+}
+
+func TestReplay_Golden_Divergence(t *testing.T) {
+	m := createTestModel(t, testSteps())
+	m = press(t, m, "n")
+	teatest.RequireEqualOutput(t, []byte(m.View()))
+}
+
+func TestReplay_Golden_QueuedMessage(t *testing.T) {
+	m := createTestModel(t, testSteps())
+	m = press(t, m, "end")
+	teatest.RequireEqualOutput(t, []byte(m.View()))
+}
+
+func TestReplay_Golden_Empty(t *testing.T) {
+	m := createTestModel(t, nil)
+	teatest.RequireEqualOutput(t, []byte(m.View()))
+}
@@ -0,0 +1,308 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	v2replay "github.com/zjrosen/perles/internal/orchestration/v2/replay"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/ui/shared/panes"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// scrubberHeight is the height of the timeline pane, including borders.
+const scrubberHeight = 4
+
+var (
+	labelStyle   = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	mutedStyle   = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	successStyle = lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	errorStyle   = lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+	changedStyle = lipgloss.NewStyle().Foreground(styles.StatusWarningColor)
+	cursorStyle  = lipgloss.NewStyle().Bold(true).Foreground(styles.BorderHighlightFocusColor)
+)
+
+// render draws the timeline on top, the selected command on the left and the
+// repository state after it on the right.
+func (m Model) render() string {
+	footer := mutedStyle.Width(m.width).Render(
+		"←/→: Step  PgUp/PgDn: Jump  Home/End: First/Last  n/N: Next/Prev divergence  q: Quit")
+
+	if len(m.steps) == 0 {
+		empty := panes.BorderedPane(panes.BorderConfig{
+			Content: mutedStyle.Render("No commands recorded in this session."),
+			Width:   m.width,
+			Height:  m.height - 1,
+			TopLeft: "Replay: " + m.session,
+		})
+		return empty + "\n" + footer
+	}
+
+	step := m.steps[m.index]
+	var prev *v2replay.Step
+	if m.index > 0 {
+		prev = &m.steps[m.index-1]
+	}
+
+	scrubber := panes.BorderedPane(panes.BorderConfig{
+		Content:            m.renderTimeline(m.width-2) + "\n" + renderSummary(step, len(m.steps)),
+		Width:              m.width,
+		Height:             scrubberHeight,
+		TopLeft:            "Replay: " + m.session,
+		TopRight:           fmt.Sprintf("%d divergent", m.divergenceCount()),
+		Focused:            true,
+		FocusedBorderColor: styles.BorderHighlightFocusColor,
+	})
+
+	bodyHeight := max(m.height-scrubberHeight-1, 6) // -1 for footer
+	leftWidth := m.width * 45 / 100
+	rightWidth := m.width - leftWidth
+
+	command := panes.BorderedPane(panes.BorderConfig{
+		Content: fitLines(renderCommand(step), leftWidth-2, bodyHeight-2),
+		Width:   leftWidth,
+		Height:  bodyHeight,
+		TopLeft: "Command",
+	})
+
+	processHeight := bodyHeight * 40 / 100
+	taskHeight := bodyHeight * 30 / 100
+	queueHeight := bodyHeight - processHeight - taskHeight
+
+	processes := panes.BorderedPane(panes.BorderConfig{
+		Content:  fitLines(renderProcesses(step, prev), rightWidth-2, processHeight-2),
+		Width:    rightWidth,
+		Height:   processHeight,
+		TopLeft:  "Processes",
+		TopRight: fmt.Sprintf("%d", len(step.Processes)),
+	})
+	tasks := panes.BorderedPane(panes.BorderConfig{
+		Content:  fitLines(renderTasks(step, prev), rightWidth-2, taskHeight-2),
+		Width:    rightWidth,
+		Height:   taskHeight,
+		TopLeft:  "Tasks",
+		TopRight: fmt.Sprintf("%d", len(step.Tasks)),
+	})
+	queues := panes.BorderedPane(panes.BorderConfig{
+		Content: fitLines(renderQueues(step), rightWidth-2, queueHeight-2),
+		Width:   rightWidth,
+		Height:  queueHeight,
+		TopLeft: "Queues",
+	})
+
+	state := lipgloss.JoinVertical(lipgloss.Left, processes, tasks, queues)
+	body := lipgloss.JoinHorizontal(lipgloss.Top, command, state)
+
+	return scrubber + "\n" + body + "\n" + footer
+}
+
+// renderTimeline draws the command log as a track of width cells. Each cell
+// covers an equal share of the commands; divergent commands are marked ✗ and
+// the selected command ●.
+func (m Model) renderTimeline(width int) string {
+	cells := min(width, len(m.steps))
+	if cells < 1 {
+		return ""
+	}
+
+	var b strings.Builder
+	for c := range cells {
+		start := c * len(m.steps) / cells
+		end := (c + 1) * len(m.steps) / cells
+
+		switch {
+		case m.index >= start && m.index < end:
+			b.WriteString(cursorStyle.Render("●"))
+		case m.hasDivergence(start, end):
+			b.WriteString(errorStyle.Render("✗"))
+		default:
+			b.WriteString(mutedStyle.Render("─"))
+		}
+	}
+	return b.String()
+}
+
+// hasDivergence reports whether any command in [start, end) diverged.
+func (m Model) hasDivergence(start, end int) bool {
+	for _, step := range m.steps[start:end] {
+		if step.Diverged() {
+			return true
+		}
+	}
+	return false
+}
+
+// renderSummary describes the selected command on one line.
+func renderSummary(step v2replay.Step, total int) string {
+	summary := fmt.Sprintf("#%d/%d  %s  %s", step.Index+1, total, step.Event.CommandType, step.Event.Timestamp.Format("15:04:05.000"))
+	if step.Diverged() {
+		return summary + "  " + errorStyle.Render("✗ diverged")
+	}
+	return summary + "  " + successStyle.Render("✓ matches recording")
+}
+
+// renderCommand lists the selected command's recorded fields, both outcomes and
+// its payload.
+func renderCommand(step v2replay.Step) []string {
+	event := step.Event
+	lines := []string{
+		labelStyle.Render("Type      ") + event.CommandType,
+		labelStyle.Render("ID        ") + event.CommandID,
+		labelStyle.Render("Source    ") + event.Source,
+		labelStyle.Render("Time      ") + event.Timestamp.Format("2006-01-02 15:04:05.000"),
+		labelStyle.Render("Took      ") + fmt.Sprintf("%dms", event.DurationMs),
+		labelStyle.Render("Recorded  ") + renderOutcome(event.Success, event.Error),
+	}
+
+	if step.Replayed {
+		lines = append(lines, labelStyle.Render("Replayed  ")+renderOutcome(step.Success, step.Error))
+	} else {
+		lines = append(lines, labelStyle.Render("Replayed  ")+errorStyle.Render("✗ skipped: "+step.Error))
+	}
+
+	if len(event.Payload) > 0 {
+		lines = append(lines, "", labelStyle.Render("Payload"))
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, event.Payload, "", "  "); err != nil {
+			lines = append(lines, string(event.Payload))
+		} else {
+			lines = append(lines, strings.Split(pretty.String(), "\n")...)
+		}
+	}
+
+	return lines
+}
+
+// renderOutcome shows a command result as a check or a cross with its error.
+func renderOutcome(success bool, errMsg string) string {
+	if success {
+		return successStyle.Render("✓ success")
+	}
+	if errMsg == "" {
+		return errorStyle.Render("✗ failed")
+	}
+	return errorStyle.Render("✗ " + errMsg)
+}
+
+// renderProcesses lists each process with its status, phase, task and queue
+// depth. Processes the selected command changed are marked with *.
+func renderProcesses(step v2replay.Step, prev *v2replay.Step) []string {
+	if len(step.Processes) == 0 {
+		return []string{mutedStyle.Render("No processes")}
+	}
+
+	idWidth := 0
+	for _, proc := range step.Processes {
+		idWidth = max(idWidth, len(proc.ID))
+	}
+
+	lines := make([]string, 0, len(step.Processes))
+	for _, proc := range step.Processes {
+		phase := "-"
+		if proc.Phase != nil {
+			phase = string(*proc.Phase)
+		}
+		task := proc.TaskID
+		if task == "" {
+			task = "-"
+		}
+		line := fmt.Sprintf("%-*s  %-9s  %-14s  %s", idWidth, proc.ID, proc.Status, phase, task)
+		if queued := len(step.Queues[proc.ID]); queued > 0 {
+			line += fmt.Sprintf("  ✉ %d", queued)
+		}
+
+		if prev != nil && !reflect.DeepEqual(findProcess(prev.Processes, proc.ID), &proc) {
+			line = changedStyle.Render("* " + line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// findProcess returns the process with the given ID, or nil.
+func findProcess(processes []repository.Process, id string) *repository.Process {
+	for i := range processes {
+		if processes[i].ID == id {
+			return &processes[i]
+		}
+	}
+	return nil
+}
+
+// renderTasks lists each task assignment with its status and workers. Tasks the
+// selected command changed are marked with *.
+func renderTasks(step v2replay.Step, prev *v2replay.Step) []string {
+	if len(step.Tasks) == 0 {
+		return []string{mutedStyle.Render("No tasks")}
+	}
+
+	lines := make([]string, 0, len(step.Tasks))
+	for _, task := range step.Tasks {
+		line := fmt.Sprintf("%s  %s  impl %s", task.TaskID, task.Status, task.Implementer)
+		if task.Reviewer != "" {
+			line += "  rev " + task.Reviewer
+		}
+
+		if prev != nil && !reflect.DeepEqual(findTask(prev.Tasks, task.TaskID), &task) {
+			line = changedStyle.Render("* " + line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// findTask returns the task assignment with the given ID, or nil.
+func findTask(tasks []repository.TaskAssignment, taskID string) *repository.TaskAssignment {
+	for i := range tasks {
+		if tasks[i].TaskID == taskID {
+			return &tasks[i]
+		}
+	}
+	return nil
+}
+
+// renderQueues lists the pending messages of each process, in process order.
+func renderQueues(step v2replay.Step) []string {
+	var lines []string
+	for _, proc := range step.Processes {
+		entries := step.Queues[proc.ID]
+		if len(entries) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s (%d)", proc.ID, len(entries)))
+		for _, entry := range entries {
+			content, _, _ := strings.Cut(entry.Content, "\n")
+			lines = append(lines, "  "+labelStyle.Render(string(entry.Sender)+":")+" "+content)
+		}
+	}
+	if len(lines) == 0 {
+		return []string{mutedStyle.Render("No queued messages")}
+	}
+	return lines
+}
+
+// fitLines truncates each line to width and keeps at most height lines,
+// replacing the overflow with a count.
+func fitLines(lines []string, width, height int) string {
+	if height < 1 {
+		return ""
+	}
+	if len(lines) > height {
+		hidden := len(lines) - height + 1
+		lines = append(lines[:height-1:height-1], mutedStyle.Render(fmt.Sprintf("… %d more", hidden)))
+	}
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, width, "…")
+	}
+	return strings.Join(lines, "\n")
+}
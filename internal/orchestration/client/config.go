@@ -43,6 +43,11 @@ type Config struct {
 	// Use with caution.
 	SkipPermissions bool
 
+	// Policy restricts the agent's tools. Providers translate it into their
+	// native permission flags, taking precedence over SkipPermissions.
+	// Nil means no restrictions.
+	Policy *ToolPolicy
+
 	// Extensions holds provider-specific configuration.
 	// Use the Ext* constants for standard keys.
 	Extensions map[string]any
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policy keys for the process roles. Workers spawned with an agent type
+// (implementer, reviewer, researcher) are looked up by the agent type first.
+const (
+	PolicyCoordinator = "coordinator"
+	PolicyWorker      = "worker"
+	PolicyObserver    = "observer"
+)
+
+// ToolPolicy restricts what an agent may do with its tools.
+// Providers translate it into their native permission flags; rules a provider
+// cannot enforce natively are still stated in the agent's system prompt.
+// The zero value allows everything.
+type ToolPolicy struct {
+	// AllowedCommands are the shell commands the agent may run, matched by
+	// prefix (e.g. "go test", "git"). Empty allows any command.
+	AllowedCommands []string
+
+	// WritablePaths are path globs, relative to the working directory, the
+	// agent may write. Empty allows writing anywhere unless ReadOnly is set.
+	WritablePaths []string
+
+	// ReadOnly forbids writing files at all. Providers that cannot sandbox the
+	// shell limit it to read-only commands unless AllowedCommands is set.
+	ReadOnly bool
+
+	// DenyNetwork forbids web fetches and searches.
+	DenyNetwork bool
+
+	// ProtectedPaths are path globs the agent must never read or write.
+	ProtectedPaths []string
+}
+
+// IsZero reports whether the policy allows everything.
+func (p ToolPolicy) IsZero() bool {
+	return len(p.AllowedCommands) == 0 &&
+		len(p.WritablePaths) == 0 &&
+		!p.ReadOnly &&
+		!p.DenyNetwork &&
+		len(p.ProtectedPaths) == 0
+}
+
+// Rules returns the policy as human-readable rules, one per restriction.
+func (p ToolPolicy) Rules() []string {
+	var rules []string
+	if len(p.AllowedCommands) > 0 {
+		rules = append(rules, fmt.Sprintf("Only run these shell commands: %s", strings.Join(p.AllowedCommands, ", ")))
+	}
+	switch {
+	case p.ReadOnly:
+		rules = append(rules, "Never create, edit or delete files")
+	case len(p.WritablePaths) > 0:
+		rules = append(rules, fmt.Sprintf("Only write files matching: %s", strings.Join(p.WritablePaths, ", ")))
+	}
+	if p.DenyNetwork {
+		rules = append(rules, "Never access the network (no web fetches, searches, curl or wget)")
+	}
+	if len(p.ProtectedPaths) > 0 {
+		rules = append(rules, fmt.Sprintf("Never read or write files matching: %s", strings.Join(p.ProtectedPaths, ", ")))
+	}
+	return rules
+}
+
+// ToolPolicies maps policy keys (process roles and agent types) to policies.
+type ToolPolicies map[string]ToolPolicy
+
+// Lookup returns the policy of the first key that has one. Empty keys are
+// skipped. Returns nil if none of the keys has a policy or it allows everything.
+func (p ToolPolicies) Lookup(keys ...string) *ToolPolicy {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if policy, ok := p[key]; ok {
+			if policy.IsZero() {
+				return nil
+			}
+			return &policy
+		}
+	}
+	return nil
+}
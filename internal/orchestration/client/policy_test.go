@@ -0,0 +1,59 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolPolicy_IsZero(t *testing.T) {
+	require.True(t, ToolPolicy{}.IsZero())
+	require.False(t, ToolPolicy{ReadOnly: true}.IsZero())
+	require.False(t, ToolPolicy{ProtectedPaths: []string{".env"}}.IsZero())
+}
+
+func TestToolPolicy_Rules(t *testing.T) {
+	policy := ToolPolicy{
+		AllowedCommands: []string{"go test", "git"},
+		WritablePaths:   []string{"internal/**"},
+		DenyNetwork:     true,
+		ProtectedPaths:  []string{".env", "secrets/**"},
+	}
+
+	require.Equal(t, []string{
+		"Only run these shell commands: go test, git",
+		"Only write files matching: internal/**",
+		"Never access the network (no web fetches, searches, curl or wget)",
+		"Never read or write files matching: .env, secrets/**",
+	}, policy.Rules())
+
+	// Read-only replaces the writable paths rule
+	policy.ReadOnly = true
+	require.Contains(t, policy.Rules(), "Never create, edit or delete files")
+	require.NotContains(t, policy.Rules(), "Only write files matching: internal/**")
+
+	require.Empty(t, ToolPolicy{}.Rules())
+}
+
+func TestToolPolicies_Lookup(t *testing.T) {
+	policies := ToolPolicies{
+		PolicyWorker: {DenyNetwork: true},
+		"reviewer":   {ReadOnly: true},
+		"researcher": {},
+	}
+
+	reviewer := policies.Lookup("reviewer", PolicyWorker)
+	require.NotNil(t, reviewer)
+	require.True(t, reviewer.ReadOnly)
+
+	// Generic workers have no agent type and fall back to the worker policy
+	worker := policies.Lookup("", PolicyWorker)
+	require.NotNil(t, worker)
+	require.True(t, worker.DenyNetwork)
+
+	// An empty policy lifts the worker policy
+	require.Nil(t, policies.Lookup("researcher", PolicyWorker))
+
+	require.Nil(t, policies.Lookup(PolicyCoordinator))
+	require.Nil(t, ToolPolicies(nil).Lookup(PolicyWorker))
+}
@@ -14,6 +14,8 @@ const (
 	RoleWorker = AgentProviderRole("WORKER")
 	// RoleObserver is the observer role.
 	RoleObserver = AgentProviderRole("OBSERVER")
+	// RoleRetryWorker is the provider for workers that retry a failed task.
+	RoleRetryWorker = AgentProviderRole("RETRY_WORKER")
 )
 
 // AgentProviders maps roles to their providers.
@@ -37,6 +39,12 @@ func (p AgentProviders) Worker() AgentProvider {
 	return p.Coordinator()
 }
 
+// RetryWorker returns the provider for workers retrying a failed task.
+// Returns nil if retries run on the regular worker provider.
+func (p AgentProviders) RetryWorker() AgentProvider {
+	return p[RoleRetryWorker]
+}
+
 // AgentProvider creates and configures AI agent processes.
 // It combines the client factory with provider-specific configuration,
 // providing a single object that can be passed through the orchestration
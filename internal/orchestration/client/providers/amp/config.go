@@ -21,6 +21,8 @@ type Config struct {
 }
 
 // configFromClient converts a client.Config to an amp.Config.
+// Amp has no per-process permission flags, so a tool policy (cfg.Policy) is
+// only enforced through the rules stated in the system prompt.
 func configFromClient(cfg client.Config) Config {
 	// Amp doesn't have a separate system prompt flag, so prefix the prompt
 	prompt := cfg.Prompt
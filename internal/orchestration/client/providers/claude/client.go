@@ -2,6 +2,7 @@ package claude
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
 )
@@ -38,7 +39,7 @@ func (c *ClaudeClient) Spawn(ctx context.Context, cfg client.Config) (client.Hea
 
 // configFromClient converts a client.Config to a claude.Config.
 func configFromClient(cfg client.Config) Config {
-	return Config{
+	claudeCfg := Config{
 		WorkDir:            cfg.WorkDir,
 		BeadsDir:           cfg.BeadsDir,
 		Prompt:             cfg.Prompt,
@@ -52,6 +53,13 @@ func configFromClient(cfg client.Config) Config {
 		MCPConfig:          cfg.MCPConfig,
 		Env:                cfg.ClaudeEnv(),
 	}
+	if cfg.Policy != nil {
+		// Clone so the policy's rules aren't appended to the caller's slices
+		claudeCfg.AllowedTools = slices.Clone(cfg.AllowedTools)
+		claudeCfg.DisallowedTools = slices.Clone(cfg.DisallowedTools)
+		applyPolicy(&claudeCfg, *cfg.Policy)
+	}
+	return claudeCfg
 }
 
 // Ensure ClaudeClient implements client.HeadlessClient at compile time.
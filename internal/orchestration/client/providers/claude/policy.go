@@ -0,0 +1,112 @@
+package claude
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+// writeTools are the Claude tools that modify files.
+var writeTools = []string{"Edit", "MultiEdit", "Write", "NotebookEdit"}
+
+// readTools are the Claude tools that only read, always allowed under an allowlist.
+var readTools = []string{"Read", "Glob", "Grep", "LS", "TodoWrite", "Task"}
+
+// readOnlyCommands are the shell commands a read-only policy allows when it
+// lists none, since any other command could write files.
+var readOnlyCommands = []string{
+	"git status", "git diff", "git log", "git show", "git blame",
+	"ls", "cat", "head", "tail", "wc", "grep", "rg",
+}
+
+// networkTools are the Claude tools that access the network.
+var networkTools = []string{"WebFetch", "WebSearch"}
+
+// applyPolicy translates a tool policy into Claude permission rules.
+//
+// Denials become --disallowed-tools rules, which Claude enforces even when
+// permission prompts are skipped. Command and writable path allowlists need
+// the opposite: permissions are no longer skipped and every permitted tool is
+// listed in --allowed-tools, so Claude denies anything else in headless mode.
+// Read-only policies always use a command allowlist, falling back to
+// readOnlyCommands, because Bash can write files too. The MCP servers of the
+// process are always allowed.
+func applyPolicy(cfg *Config, policy client.ToolPolicy) {
+	var denied []string
+	if policy.ReadOnly {
+		denied = append(denied, writeTools...)
+	}
+	if policy.DenyNetwork {
+		denied = append(denied, networkTools...)
+		denied = append(denied, "Bash(curl:*)", "Bash(wget:*)")
+	}
+	for _, glob := range policy.ProtectedPaths {
+		denied = append(denied, "Read("+glob+")", "Edit("+glob+")", "Write("+glob+")")
+	}
+	cfg.DisallowedTools = appendMissing(cfg.DisallowedTools, denied...)
+
+	if len(policy.AllowedCommands) == 0 && len(policy.WritablePaths) == 0 && !policy.ReadOnly {
+		return
+	}
+
+	allowed := slices.Clone(readTools)
+	for _, server := range mcpServerNames(cfg.MCPConfig) {
+		allowed = append(allowed, "mcp__"+server)
+	}
+	commands := policy.AllowedCommands
+	if len(commands) == 0 && policy.ReadOnly {
+		commands = readOnlyCommands
+	}
+	if len(commands) > 0 {
+		for _, command := range commands {
+			allowed = append(allowed, "Bash("+command+":*)")
+		}
+	} else {
+		allowed = append(allowed, "Bash")
+	}
+	switch {
+	case policy.ReadOnly:
+	case len(policy.WritablePaths) > 0:
+		for _, glob := range policy.WritablePaths {
+			allowed = append(allowed, "Edit("+glob+")", "Write("+glob+")")
+		}
+	default:
+		allowed = append(allowed, writeTools...)
+	}
+	if !policy.DenyNetwork {
+		allowed = append(allowed, networkTools...)
+	}
+
+	cfg.AllowedTools = appendMissing(cfg.AllowedTools, allowed...)
+	cfg.SkipPermissions = false
+}
+
+// mcpServerNames returns the server names of a Claude MCP config.
+func mcpServerNames(mcpConfig string) []string {
+	if mcpConfig == "" {
+		return nil
+	}
+	var parsed struct {
+		MCPServers map[string]json.RawMessage `json:"mcpServers"`
+	}
+	if err := json.Unmarshal([]byte(mcpConfig), &parsed); err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(parsed.MCPServers))
+	for name := range parsed.MCPServers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// appendMissing appends the values not already in list.
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
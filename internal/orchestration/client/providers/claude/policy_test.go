@@ -0,0 +1,104 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+const testMCPConfig = `{"mcpServers":{"perles-worker":{"type":"http","url":"http://localhost:8765/worker/worker-1"}}}`
+
+func TestConfigFromClient_NoPolicy(t *testing.T) {
+	cfg := configFromClient(client.Config{
+		SkipPermissions: true,
+		DisallowedTools: []string{"AskUserQuestion"},
+	})
+
+	require.True(t, cfg.SkipPermissions)
+	require.Equal(t, []string{"AskUserQuestion"}, cfg.DisallowedTools)
+	require.Empty(t, cfg.AllowedTools)
+}
+
+func TestConfigFromClient_DenialsKeepSkippingPermissions(t *testing.T) {
+	cfg := configFromClient(client.Config{
+		SkipPermissions: true,
+		Policy:          &client.ToolPolicy{DenyNetwork: true, ProtectedPaths: []string{".env"}},
+	})
+
+	// Denials are enforced while permission prompts are still skipped
+	require.True(t, cfg.SkipPermissions)
+	require.Empty(t, cfg.AllowedTools)
+	require.Equal(t, []string{
+		"WebFetch", "WebSearch", "Bash(curl:*)", "Bash(wget:*)",
+		"Read(.env)", "Edit(.env)", "Write(.env)",
+	}, cfg.DisallowedTools)
+}
+
+func TestConfigFromClient_ReadOnlyPolicyDeniesWrites(t *testing.T) {
+	disallowed := []string{"AskUserQuestion"}
+	cfg := configFromClient(client.Config{
+		SkipPermissions: true,
+		DisallowedTools: disallowed,
+		MCPConfig:       testMCPConfig,
+		Policy:          &client.ToolPolicy{ReadOnly: true, DenyNetwork: true, ProtectedPaths: []string{".env"}},
+	})
+
+	// Bash can write files too, so it is limited to read-only commands
+	require.False(t, cfg.SkipPermissions)
+	require.Equal(t, []string{
+		"Read", "Glob", "Grep", "LS", "TodoWrite", "Task",
+		"mcp__perles-worker",
+		"Bash(git status:*)", "Bash(git diff:*)", "Bash(git log:*)", "Bash(git show:*)", "Bash(git blame:*)",
+		"Bash(ls:*)", "Bash(cat:*)", "Bash(head:*)", "Bash(tail:*)", "Bash(wc:*)", "Bash(grep:*)", "Bash(rg:*)",
+	}, cfg.AllowedTools)
+	require.NotContains(t, cfg.AllowedTools, "Bash")
+	require.NotContains(t, buildArgs(cfg), "--dangerously-skip-permissions")
+	require.Equal(t, []string{
+		"AskUserQuestion",
+		"Edit", "MultiEdit", "Write", "NotebookEdit",
+		"WebFetch", "WebSearch", "Bash(curl:*)", "Bash(wget:*)",
+		"Read(.env)", "Edit(.env)", "Write(.env)",
+	}, cfg.DisallowedTools)
+	require.Equal(t, []string{"AskUserQuestion"}, disallowed, "caller's slice is not modified")
+}
+
+func TestConfigFromClient_AllowlistPolicyStopsSkippingPermissions(t *testing.T) {
+	cfg := configFromClient(client.Config{
+		SkipPermissions: true,
+		MCPConfig:       testMCPConfig,
+		Policy: &client.ToolPolicy{
+			AllowedCommands: []string{"go test", "git"},
+			WritablePaths:   []string{"internal/**"},
+		},
+	})
+
+	require.False(t, cfg.SkipPermissions)
+	require.Equal(t, []string{
+		"Read", "Glob", "Grep", "LS", "TodoWrite", "Task",
+		"mcp__perles-worker",
+		"Bash(go test:*)", "Bash(git:*)",
+		"Edit(internal/**)", "Write(internal/**)",
+		"WebFetch", "WebSearch",
+	}, cfg.AllowedTools)
+
+	args := buildArgs(cfg)
+	require.NotContains(t, args, "--dangerously-skip-permissions")
+	require.Contains(t, args, "Bash(go test:*)")
+}
+
+func TestConfigFromClient_CommandAllowlistKeepsReadOnly(t *testing.T) {
+	cfg := configFromClient(client.Config{
+		SkipPermissions: true,
+		Policy: &client.ToolPolicy{
+			AllowedCommands: []string{"git diff"},
+			ReadOnly:        true,
+			DenyNetwork:     true,
+		},
+	})
+
+	require.False(t, cfg.SkipPermissions)
+	require.Equal(t, []string{"Read", "Glob", "Grep", "LS", "TodoWrite", "Task", "Bash(git diff:*)"}, cfg.AllowedTools)
+	require.Contains(t, cfg.DisallowedTools, "Write")
+}
@@ -1,5 +1,7 @@
 package codex
 
+import "fmt"
+
 // buildArgs constructs the command line arguments for Codex CLI.
 //
 // Codex uses `codex exec` for headless execution with the following argument pattern:
@@ -8,6 +10,7 @@ package codex
 //   - Base: ["exec", "--json"]
 //   - Model: ["-m", "<model>"]
 //   - Sandbox: ["-s", "<mode>"]
+//   - Network access: ["-c", "sandbox_workspace_write.network_access=true"] (workspace-write only)
 //   - Skip permissions: ["--dangerously-bypass-approvals-and-sandbox"]
 //   - Working directory: ["-C", "<dir>"]
 //   - MCP config: ["-c", "mcp_servers.NAME={url=\"...\"}"] (TOML syntax)
@@ -15,6 +18,7 @@ package codex
 //
 // For resume sessions (exec resume):
 //   - Base: ["exec", "--json", "resume", "<session-id>"]
+//   - Sandbox: ["-c", "sandbox_mode=\"<mode>\""] (plus network access as above)
 //   - MCP config: ["-c", "mcp_servers.NAME={url=\"...\"}"] (TOML syntax)
 //   - Prompt: optional final argument
 //
@@ -28,6 +32,12 @@ func buildArgs(cfg Config, isResume bool) []string {
 		// Format: codex exec --json resume <session-id> [-c config] [prompt]
 		args = append(args, "resume", cfg.SessionID)
 
+		// Sandbox via -c, since resume has no -s flag
+		if cfg.SandboxMode != "" {
+			args = append(args, "-c", fmt.Sprintf("sandbox_mode=%q", cfg.SandboxMode))
+		}
+		args = append(args, networkAccessArgs(cfg)...)
+
 		// MCP configuration via -c flag (supported in resume)
 		if cfg.MCPConfig != "" {
 			args = append(args, "-c", cfg.MCPConfig)
@@ -55,6 +65,7 @@ func buildArgs(cfg Config, isResume bool) []string {
 			// Fall back to --dangerously-bypass-approvals-and-sandbox
 			args = append(args, "--dangerously-bypass-approvals-and-sandbox")
 		}
+		args = append(args, networkAccessArgs(cfg)...)
 
 		// Working directory (-C flag)
 		// Note: We also set cmd.Dir in process.go for belt-and-suspenders reliability
@@ -76,3 +87,12 @@ func buildArgs(cfg Config, isResume bool) []string {
 
 	return args
 }
+
+// networkAccessArgs returns the -c flag allowing network access, which Codex
+// denies by default in the workspace-write sandbox.
+func networkAccessArgs(cfg Config) []string {
+	if cfg.SandboxMode != "workspace-write" || !cfg.NetworkAccess {
+		return nil
+	}
+	return []string{"-c", "sandbox_workspace_write.network_access=true"}
+}
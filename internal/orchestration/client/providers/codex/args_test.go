@@ -132,6 +132,43 @@ func TestBuildArgs_SandboxModeTakesPrecedenceOverSkipPermissions(t *testing.T) {
 	assert.NotContains(t, args, "--dangerously-bypass-approvals-and-sandbox")
 }
 
+func TestBuildArgs_NetworkAccess(t *testing.T) {
+	cfg := Config{
+		SandboxMode:   "workspace-write",
+		NetworkAccess: true,
+		Prompt:        "Hello",
+	}
+
+	args := buildArgs(cfg, false)
+
+	assert.Equal(t, []string{
+		"exec", "--json", "-s", "workspace-write",
+		"-c", "sandbox_workspace_write.network_access=true",
+		"Hello",
+	}, args)
+
+	// Network access only applies to the workspace-write sandbox
+	cfg.SandboxMode = "read-only"
+	assert.NotContains(t, buildArgs(cfg, false), "sandbox_workspace_write.network_access=true")
+}
+
+func TestBuildArgs_ResumeWithSandboxMode(t *testing.T) {
+	cfg := Config{
+		SessionID:   "019b6dea-903b-7bd3-aef5-202a16205a9a",
+		SandboxMode: "read-only",
+		Prompt:      "Follow up question",
+	}
+
+	args := buildArgs(cfg, true)
+
+	// Resume has no -s flag, so the sandbox is set through -c
+	assert.Equal(t, []string{
+		"exec", "--json", "resume", "019b6dea-903b-7bd3-aef5-202a16205a9a",
+		"-c", `sandbox_mode="read-only"`,
+		"Follow up question",
+	}, args)
+}
+
 func TestBuildArgs_WorkingDirectory(t *testing.T) {
 	cfg := Config{
 		WorkDir: "/home/user/project",
@@ -14,6 +14,7 @@ type Config struct {
 	SessionID       string // For resume (Codex uses "sessions")
 	Model           string // e.g., "gpt-5.2-codex", "o4-mini" (default: gpt-5.2-codex)
 	SandboxMode     string // "read-only", "workspace-write", "danger-full-access"
+	NetworkAccess   bool   // Allows network access in the workspace-write sandbox
 	SkipPermissions bool
 	Timeout         time.Duration
 	MCPConfig       string // JSON string for -c flag TOML conversion
@@ -36,7 +37,7 @@ func configFromClient(cfg client.Config) Config {
 		sandboxMode = "danger-full-access"
 	}
 
-	return Config{
+	codexCfg := Config{
 		WorkDir:         cfg.WorkDir,
 		BeadsDir:        cfg.BeadsDir,
 		Prompt:          prompt,
@@ -47,4 +48,18 @@ func configFromClient(cfg client.Config) Config {
 		Timeout:         cfg.Timeout,
 		MCPConfig:       cfg.MCPConfig,
 	}
+
+	// A tool policy replaces the sandbox mode. Codex can only enforce file
+	// writes and network access natively; command allowlists and path globs
+	// are left to the system prompt.
+	if cfg.Policy != nil {
+		codexCfg.SkipPermissions = false
+		codexCfg.SandboxMode = "workspace-write"
+		if cfg.Policy.ReadOnly {
+			codexCfg.SandboxMode = "read-only"
+		}
+		codexCfg.NetworkAccess = !cfg.Policy.DenyNetwork
+	}
+
+	return codexCfg
 }
@@ -112,6 +112,32 @@ func TestConfigFromClient(t *testing.T) {
 				Model:           "gpt-5.2-codex",
 			},
 		},
+		{
+			name: "read-only policy uses read-only sandbox",
+			input: client.Config{
+				SkipPermissions: true,
+				Policy:          &client.ToolPolicy{ReadOnly: true},
+			},
+			expected: Config{
+				SandboxMode:   "read-only",
+				NetworkAccess: true,
+				Model:         "gpt-5.2-codex",
+			},
+		},
+		{
+			name: "policy overrides ExtCodexSandbox",
+			input: client.Config{
+				SkipPermissions: true,
+				Policy:          &client.ToolPolicy{DenyNetwork: true, AllowedCommands: []string{"go"}},
+				Extensions: map[string]any{
+					client.ExtCodexSandbox: "danger-full-access",
+				},
+			},
+			expected: Config{
+				SandboxMode: "workspace-write",
+				Model:       "gpt-5.2-codex",
+			},
+		},
 		{
 			name: "ExtCodexModel is extracted",
 			input: client.Config{
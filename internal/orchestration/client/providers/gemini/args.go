@@ -9,6 +9,7 @@ package gemini
 //   - Session resume: ["--resume", "<session-id>"] (to continue existing session)
 //   - Approval mode: ["--approval-mode", "<mode>"] (takes precedence over --yolo)
 //   - Skip permissions: ["--yolo"] (when SkipPermissions)
+//   - Allowed tools: ["--allowed-tools", "<tool>"] per tool, replacing --yolo (tool policy)
 func buildArgs(cfg Config) []string {
 	var args []string
 
@@ -22,7 +23,13 @@ func buildArgs(cfg Config) []string {
 		args = append(args, "--resume", cfg.SessionID)
 	}
 
-	args = append(args, "--yolo")
+	if cfg.AllowedTools != nil {
+		for _, tool := range cfg.AllowedTools {
+			args = append(args, "--allowed-tools", tool)
+		}
+	} else {
+		args = append(args, "--yolo")
+	}
 
 	// Output format (always stream-json for headless)
 	args = append(args, "--output-format", "stream-json")
@@ -80,6 +80,22 @@ func TestBuildArgs_SkipPermissions(t *testing.T) {
 	assert.Equal(t, []string{"--yolo", "--output-format", "stream-json", "Hello"}, args)
 }
 
+func TestBuildArgs_AllowedToolsReplaceYolo(t *testing.T) {
+	cfg := Config{
+		AllowedTools: []string{"run_shell_command(git)", "write_file"},
+		Prompt:       "Hello",
+	}
+
+	args := buildArgs(cfg)
+
+	assert.Equal(t, []string{
+		"--allowed-tools", "run_shell_command(git)",
+		"--allowed-tools", "write_file",
+		"--output-format", "stream-json",
+		"Hello",
+	}, args)
+}
+
 func TestBuildArgs_FullConfigCombination(t *testing.T) {
 	cfg := Config{
 		Prompt:          "Implement a feature",
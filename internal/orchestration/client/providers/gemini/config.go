@@ -1,6 +1,8 @@
 package gemini
 
 import (
+	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
@@ -9,11 +11,12 @@ import (
 // Config holds configuration for spawning a Gemini process.
 type Config struct {
 	WorkDir         string
-	BeadsDir        string // Path to beads database directory for BEADS_DIR env var
-	Prompt          string // Includes prefixed system prompt
-	Model           string // e.g., "gemini-2.5-pro", "gemini-2.5-flash"
-	SessionID       string // For --resume to continue existing session
-	SkipPermissions bool   // Enables --yolo
+	BeadsDir        string   // Path to beads database directory for BEADS_DIR env var
+	Prompt          string   // Includes prefixed system prompt
+	Model           string   // e.g., "gemini-2.5-pro", "gemini-2.5-flash"
+	SessionID       string   // For --resume to continue existing session
+	SkipPermissions bool     // Enables --yolo
+	AllowedTools    []string // Tools run without confirmation, replacing --yolo (set by a tool policy)
 	Timeout         time.Duration
 	MCPConfig       string // JSON for settings.json
 }
@@ -26,7 +29,7 @@ func configFromClient(cfg client.Config) Config {
 		prompt = cfg.SystemPrompt + "\n\n" + cfg.Prompt
 	}
 
-	return Config{
+	geminiCfg := Config{
 		WorkDir:         cfg.WorkDir,
 		BeadsDir:        cfg.BeadsDir,
 		Prompt:          prompt,
@@ -36,4 +39,56 @@ func configFromClient(cfg client.Config) Config {
 		Timeout:         cfg.Timeout,
 		MCPConfig:       cfg.MCPConfig,
 	}
+	if cfg.Policy != nil {
+		geminiCfg.SkipPermissions = false
+		geminiCfg.AllowedTools = policyTools(*cfg.Policy, cfg.MCPConfig)
+	}
+	return geminiCfg
+}
+
+// policyTools translates a tool policy into the tools Gemini may run without
+// confirmation. In headless mode Gemini excludes every other tool that needs
+// confirmation, so omitting a tool denies it. Read-only tools never need
+// confirmation; writable path globs and protected paths cannot be expressed
+// and are left to the system prompt. The tools of the process's MCP servers
+// are always allowed.
+func policyTools(policy client.ToolPolicy, mcpConfig string) []string {
+	var tools []string
+	for _, server := range mcpServerNames(mcpConfig) {
+		tools = append(tools, server+"__*")
+	}
+	if len(policy.AllowedCommands) > 0 {
+		for _, command := range policy.AllowedCommands {
+			tools = append(tools, "run_shell_command("+command+")")
+		}
+	} else {
+		tools = append(tools, "run_shell_command")
+	}
+	if !policy.ReadOnly {
+		tools = append(tools, "write_file", "replace")
+	}
+	if !policy.DenyNetwork {
+		tools = append(tools, "web_fetch")
+	}
+	return tools
+}
+
+// mcpServerNames returns the sorted server names of an MCP config, or nil if
+// it is empty or invalid.
+func mcpServerNames(mcpConfig string) []string {
+	if mcpConfig == "" {
+		return nil
+	}
+	var parsed struct {
+		MCPServers map[string]json.RawMessage `json:"mcpServers"`
+	}
+	if err := json.Unmarshal([]byte(mcpConfig), &parsed); err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(parsed.MCPServers))
+	for name := range parsed.MCPServers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
 }
@@ -233,3 +233,41 @@ func TestConfigFromClient_ModelDefaulting(t *testing.T) {
 		assert.Equal(t, "gemini-3-pro-preview", result.Model)
 	})
 }
+
+func TestConfigFromClient_Policy(t *testing.T) {
+	t.Run("read-only policy allows only the listed commands", func(t *testing.T) {
+		result := configFromClient(client.Config{
+			SkipPermissions: true,
+			Policy: &client.ToolPolicy{
+				AllowedCommands: []string{"git diff", "go test"},
+				ReadOnly:        true,
+			},
+		})
+
+		assert.False(t, result.SkipPermissions)
+		assert.Equal(t, []string{"run_shell_command(git diff)", "run_shell_command(go test)", "web_fetch"}, result.AllowedTools)
+	})
+
+	t.Run("network denial drops web_fetch", func(t *testing.T) {
+		result := configFromClient(client.Config{
+			Policy: &client.ToolPolicy{DenyNetwork: true},
+		})
+
+		assert.Equal(t, []string{"run_shell_command", "write_file", "replace"}, result.AllowedTools)
+	})
+
+	t.Run("MCP server tools stay allowed", func(t *testing.T) {
+		result := configFromClient(client.Config{
+			MCPConfig: `{"mcpServers":{"perles-worker":{"httpUrl":"http://localhost:8765/worker/worker-1"}}}`,
+			Policy:    &client.ToolPolicy{ReadOnly: true},
+		})
+
+		assert.Equal(t, []string{"perles-worker__*", "run_shell_command", "web_fetch"}, result.AllowedTools)
+	})
+
+	t.Run("no policy keeps yolo", func(t *testing.T) {
+		result := configFromClient(client.Config{SkipPermissions: true})
+
+		assert.Nil(t, result.AllowedTools)
+	})
+}
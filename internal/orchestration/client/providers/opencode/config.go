@@ -1,6 +1,9 @@
 package opencode
 
 import (
+	"encoding/json"
+	"fmt"
+	"maps"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
@@ -15,7 +18,8 @@ type Config struct {
 	SessionID       string // For --session to continue existing session
 	SkipPermissions bool   // Future: if OpenCode supports --yolo equivalent
 	Timeout         time.Duration
-	MCPConfig       string // JSON for opencode.jsonc
+	MCPConfig       string         // JSON for opencode.jsonc
+	Permission      map[string]any // Permission rules from a tool policy, merged into the config
 }
 
 // configFromClient converts a client.Config to an opencode.Config.
@@ -26,7 +30,7 @@ func configFromClient(cfg client.Config) Config {
 		prompt = cfg.SystemPrompt + "\n\n" + cfg.Prompt
 	}
 
-	return Config{
+	openCodeCfg := Config{
 		WorkDir:         cfg.WorkDir,
 		BeadsDir:        cfg.BeadsDir,
 		Prompt:          prompt,
@@ -36,4 +40,83 @@ func configFromClient(cfg client.Config) Config {
 		Timeout:         cfg.Timeout,
 		MCPConfig:       cfg.MCPConfig,
 	}
+	if cfg.Policy != nil {
+		openCodeCfg.Permission = policyPermission(*cfg.Policy)
+	}
+	return openCodeCfg
+}
+
+// policyPermission translates a tool policy into OpenCode permission rules.
+// Pattern rules start with a "*" fallback so the more specific patterns,
+// which sort after it, take precedence.
+func policyPermission(policy client.ToolPolicy) map[string]any {
+	permission := make(map[string]any)
+
+	if len(policy.AllowedCommands) > 0 {
+		bash := map[string]any{"*": "deny"}
+		for _, command := range policy.AllowedCommands {
+			bash[command] = "allow"
+			bash[command+" *"] = "allow"
+		}
+		permission["bash"] = bash
+	}
+
+	switch {
+	case policy.ReadOnly:
+		permission["edit"] = "deny"
+	case len(policy.WritablePaths) > 0 || len(policy.ProtectedPaths) > 0:
+		edit := map[string]any{"*": "allow"}
+		if len(policy.WritablePaths) > 0 {
+			edit["*"] = "deny"
+		}
+		for _, glob := range policy.WritablePaths {
+			edit[glob] = "allow"
+		}
+		for _, glob := range policy.ProtectedPaths {
+			edit[glob] = "deny"
+		}
+		permission["edit"] = edit
+	}
+
+	if len(policy.ProtectedPaths) > 0 {
+		read := map[string]any{"*": "allow"}
+		for _, glob := range policy.ProtectedPaths {
+			read[glob] = "deny"
+		}
+		permission["read"] = read
+	}
+
+	if policy.DenyNetwork {
+		permission["webfetch"] = "deny"
+		permission["websearch"] = "deny"
+	}
+
+	return permission
+}
+
+// configContent returns the OpenCode config content for the process: the MCP
+// config with the policy's permission rules merged into its permission block.
+func configContent(cfg Config) (string, error) {
+	if len(cfg.Permission) == 0 {
+		return cfg.MCPConfig, nil
+	}
+
+	content := make(map[string]any)
+	if cfg.MCPConfig != "" {
+		if err := json.Unmarshal([]byte(cfg.MCPConfig), &content); err != nil {
+			return "", fmt.Errorf("failed to parse MCPConfig JSON: %w", err)
+		}
+	}
+	permission, _ := content["permission"].(map[string]any)
+	if permission == nil {
+		permission = make(map[string]any)
+	}
+	maps.Copy(permission, cfg.Permission)
+	content["permission"] = permission
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return string(data), nil
 }
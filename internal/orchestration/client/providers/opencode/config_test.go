@@ -1,10 +1,12 @@
 package opencode
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
 )
@@ -233,3 +235,46 @@ func TestConfigFromClient_ModelDefaulting(t *testing.T) {
 		assert.Equal(t, "anthropic/claude-opus-4-5", result.Model)
 	})
 }
+
+func TestConfigFromClient_Policy(t *testing.T) {
+	result := configFromClient(client.Config{
+		MCPConfig: `{"permission":{"*":{"*":"allow"}},"mcp":{"perles-worker":{"type":"remote","url":"http://localhost:8765/worker/worker-1"}}}`,
+		Policy: &client.ToolPolicy{
+			AllowedCommands: []string{"git"},
+			WritablePaths:   []string{"internal/**"},
+			DenyNetwork:     true,
+			ProtectedPaths:  []string{".env"},
+		},
+	})
+
+	assert.Equal(t, map[string]any{
+		"bash":      map[string]any{"*": "deny", "git": "allow", "git *": "allow"},
+		"edit":      map[string]any{"*": "deny", "internal/**": "allow", ".env": "deny"},
+		"read":      map[string]any{"*": "allow", ".env": "deny"},
+		"webfetch":  "deny",
+		"websearch": "deny",
+	}, result.Permission)
+
+	content, err := configContent(result)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(content), &parsed))
+	permission := parsed["permission"].(map[string]any)
+	assert.Equal(t, map[string]any{"*": "allow"}, permission["*"], "existing rules are kept")
+	assert.Equal(t, "deny", permission["webfetch"])
+	assert.Contains(t, parsed, "mcp")
+}
+
+func TestConfigFromClient_ReadOnlyPolicyDeniesEdits(t *testing.T) {
+	result := configFromClient(client.Config{Policy: &client.ToolPolicy{ReadOnly: true}})
+
+	assert.Equal(t, map[string]any{"edit": "deny"}, result.Permission)
+}
+
+func TestConfigContent_NoPolicy(t *testing.T) {
+	content, err := configContent(Config{MCPConfig: `{"mcp":{}}`})
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"mcp":{}}`, content, "config is passed through untouched")
+}
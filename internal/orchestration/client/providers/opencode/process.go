@@ -51,9 +51,14 @@ func spawnProcess(ctx context.Context, cfg Config, isResume bool) (*Process, err
 
 	// Build environment variables for MCP config
 	// OPENCODE_CONFIG_CONTENT allows per-process MCP config without file conflicts
+	// Permission rules from a tool policy are merged into the same config
+	content, err := configContent(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("opencode: %w", err)
+	}
 	var env []string
-	if cfg.MCPConfig != "" {
-		env = append(env, "OPENCODE_CONFIG_CONTENT="+cfg.MCPConfig)
+	if content != "" {
+		env = append(env, "OPENCODE_CONFIG_CONTENT="+content)
 	}
 	// Append common environment variables (BEADS_DIR if set)
 	env = append(env, client.BuildEnvVars(client.Config{BeadsDir: cfg.BeadsDir})...)
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// tokenCookieName is the cookie that carries the token for browser sessions.
+const tokenCookieName = "perles_token"
+
+// ErrNoToken is returned when an API token is required but none is configured.
+var ErrNoToken = errors.New("no API token configured")
+
+// TokenFile stores the bearer token that authenticates API requests.
+// The file holds a single token and must only be accessible by its owner.
+// It is read on every request, so rotating the token takes effect immediately.
+type TokenFile struct {
+	path string
+}
+
+// NewTokenFile returns a TokenFile backed by path.
+func NewTokenFile(path string) *TokenFile {
+	return &TokenFile{path: path}
+}
+
+// Path returns the token file path.
+func (f *TokenFile) Path() string {
+	return f.path
+}
+
+// Load returns the stored token. It returns ErrNoToken if the file does not
+// exist or is empty, and an error if other users can access it.
+func (f *TokenFile) Load() (string, error) {
+	if f.path == "" {
+		return "", ErrNoToken
+	}
+
+	info, err := os.Stat(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading API token: %w", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("API token file %s is accessible by other users (mode %s); run chmod 600 on it", f.path, info.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("reading API token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", ErrNoToken
+	}
+	return token, nil
+}
+
+// Rotate generates a new token, replacing any existing one, and returns it.
+func (f *TokenFile) Rotate() (string, error) {
+	if f.path == "" {
+		return "", fmt.Errorf("API token path unavailable")
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generating API token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return "", fmt.Errorf("creating token directory: %w", err)
+	}
+
+	// Write to a temp file and rename so readers never see a partial token
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), ".token-*")
+	if err != nil {
+		return "", fmt.Errorf("writing API token: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("writing API token: %w", err)
+	}
+	if _, err := tmp.WriteString(token + "\n"); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("writing API token: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("writing API token: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return "", fmt.Errorf("writing API token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Ensure returns the stored token, generating one if none exists.
+func (f *TokenFile) Ensure() (string, error) {
+	token, err := f.Load()
+	if errors.Is(err, ErrNoToken) {
+		return f.Rotate()
+	}
+	return token, err
+}
+
+// requireToken rejects requests that do not carry the current token. The token
+// is accepted as an "Authorization: Bearer" header or a cookie. Browsers are
+// sent to a URL with a ?token= query parameter, which sets the cookie so the
+// web frontend's own requests are authenticated.
+func requireToken(tokens *TokenFile, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want, err := tokens.Load()
+		if err != nil {
+			writeUnauthorized(w, "API token unavailable")
+			return
+		}
+
+		got, fromQuery := requestToken(r)
+		if subtle.ConstantTimeCompare([]byte(got), []byte(want)) != 1 {
+			writeUnauthorized(w, "Missing or invalid API token")
+			return
+		}
+
+		if fromQuery {
+			http.SetCookie(w, &http.Cookie{
+				Name:     tokenCookieName,
+				Value:    got,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestToken extracts the token from a request and reports whether it came
+// from the query string.
+func requestToken(r *http.Request) (string, bool) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			return strings.TrimSpace(token), false
+		}
+		return "", false
+	}
+	if cookie, err := r.Cookie(tokenCookieName); err == nil {
+		return cookie.Value, false
+	}
+	if r.Method == http.MethodGet {
+		if token := r.URL.Query().Get("token"); token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="perles"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{Error: message, Code: "unauthorized"})
+}
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+)
+
+func newTestTokenFile(t *testing.T) (*TokenFile, string) {
+	t.Helper()
+	tokens := NewTokenFile(filepath.Join(t.TempDir(), "daemon", "token"))
+	token, err := tokens.Rotate()
+	require.NoError(t, err)
+	return tokens, token
+}
+
+// shortTempDir returns a temp dir short enough for a Unix socket path.
+func shortTempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "perles")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	require.NoError(t, os.Chmod(dir, 0o700))
+	return dir
+}
+
+func TestTokenFile_LoadMissing(t *testing.T) {
+	tokens := NewTokenFile(filepath.Join(t.TempDir(), "token"))
+
+	_, err := tokens.Load()
+	require.ErrorIs(t, err, ErrNoToken)
+}
+
+func TestTokenFile_RotateReplacesToken(t *testing.T) {
+	tokens, first := newTestTokenFile(t)
+	require.Len(t, first, 64)
+
+	second, err := tokens.Rotate()
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	loaded, err := tokens.Load()
+	require.NoError(t, err)
+	require.Equal(t, second, loaded)
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(tokens.Path())
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+}
+
+func TestTokenFile_EnsureKeepsExistingToken(t *testing.T) {
+	tokens, token := newTestTokenFile(t)
+
+	ensured, err := tokens.Ensure()
+	require.NoError(t, err)
+	require.Equal(t, token, ensured)
+}
+
+func TestTokenFile_LoadRejectsSharedFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	tokens, _ := newTestTokenFile(t)
+	require.NoError(t, os.Chmod(tokens.Path(), 0o644))
+
+	_, err := tokens.Load()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "accessible by other users")
+}
+
+func TestRequireToken(t *testing.T) {
+	tokens, token := newTestTokenFile(t)
+	handler := requireToken(tokens, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		header string
+		cookie string
+		want   int
+	}{
+		{name: "missing token", method: http.MethodGet, target: "/workflows", want: http.StatusUnauthorized},
+		{name: "wrong bearer", method: http.MethodGet, target: "/workflows", header: "Bearer nope", want: http.StatusUnauthorized},
+		{name: "bearer", method: http.MethodGet, target: "/workflows", header: "Bearer " + token, want: http.StatusNoContent},
+		{name: "cookie", method: http.MethodPost, target: "/workflows", cookie: token, want: http.StatusNoContent},
+		{name: "query on GET", method: http.MethodGet, target: "/?token=" + token, want: http.StatusNoContent},
+		{name: "query on POST", method: http.MethodPost, target: "/workflows?token=" + token, want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: tokenCookieName, Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.want, w.Code)
+			if tt.want == http.StatusUnauthorized {
+				requireErrorCode(t, w, http.StatusUnauthorized, "unauthorized")
+				assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestRequireToken_QuerySetsCookie(t *testing.T) {
+	tokens, token := newTestTokenFile(t)
+	handler := requireToken(tokens, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?token="+token, nil))
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, tokenCookieName, cookies[0].Name)
+	require.Equal(t, token, cookies[0].Value)
+	require.True(t, cookies[0].HttpOnly)
+}
+
+func TestRequireToken_RotationRevokesOldToken(t *testing.T) {
+	tokens, old := newTestTokenFile(t)
+	handler := requireToken(tokens, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+
+	_, err := tokens.Rotate()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/workflows", nil)
+	req.Header.Set("Authorization", "Bearer "+old)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestNewServer_TCPRequiresToken(t *testing.T) {
+	_, err := NewServer(ServerConfig{Addr: "localhost:0", ControlPlane: mocks.NewMockControlPlane(t)})
+	require.ErrorIs(t, err, ErrNoToken)
+
+	_, err = NewServer(ServerConfig{
+		Addr:         "localhost:0",
+		ControlPlane: mocks.NewMockControlPlane(t),
+		Tokens:       NewTokenFile(filepath.Join(t.TempDir(), "token")),
+	})
+	require.ErrorIs(t, err, ErrNoToken)
+}
+
+func TestNewServer_TCPWithToken(t *testing.T) {
+	tokens, token := newTestTokenFile(t)
+	server, err := NewServer(ServerConfig{
+		Addr:         "localhost:0",
+		ControlPlane: mocks.NewMockControlPlane(t),
+		Tokens:       tokens,
+	})
+	require.NoError(t, err)
+	go func() { _ = server.Start() }()
+	t.Cleanup(func() { _ = server.Stop(context.Background()) })
+
+	url := "http://" + server.Addr() + "/openapi.json"
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewServer_UnixSocket(t *testing.T) {
+	socketPath := filepath.Join(shortTempDir(t), "perles.sock")
+	server, err := NewServer(ServerConfig{
+		Addr:         socketPath,
+		Network:      "unix",
+		ControlPlane: mocks.NewMockControlPlane(t),
+	})
+	require.NoError(t, err)
+	go func() { _ = server.Start() }()
+	t.Cleanup(func() { _ = server.Stop(context.Background()) })
+
+	require.Equal(t, socketPath, server.Addr())
+	require.Zero(t, server.Port())
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(socketPath)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+	resp, err := client.Get("http://perles/openapi.json")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewServer_UnixSocketRemovesStaleSocket(t *testing.T) {
+	socketPath := filepath.Join(shortTempDir(t), "perles.sock")
+
+	// Leave a socket file behind with nothing listening on it
+	stale, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	server, err := NewServer(ServerConfig{Addr: socketPath, Network: "unix", ControlPlane: mocks.NewMockControlPlane(t)})
+	require.NoError(t, err)
+	require.NoError(t, server.Stop(context.Background()))
+}
+
+func TestNewServer_UnixSocketInUse(t *testing.T) {
+	socketPath := filepath.Join(shortTempDir(t), "perles.sock")
+	first, err := NewServer(ServerConfig{Addr: socketPath, Network: "unix", ControlPlane: mocks.NewMockControlPlane(t)})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = first.Stop(context.Background()) })
+
+	_, err = NewServer(ServerConfig{Addr: socketPath, Network: "unix", ControlPlane: mocks.NewMockControlPlane(t)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already listening")
+}
+
+func TestNewServer_UnixSocketRejectsSharedDirectory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	dir := shortTempDir(t)
+	require.NoError(t, os.Chmod(dir, 0o777))
+
+	_, err := NewServer(ServerConfig{
+		Addr:         filepath.Join(dir, "perles.sock"),
+		Network:      "unix",
+		ControlPlane: mocks.NewMockControlPlane(t),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "writable by other users")
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIError is a non-2xx response from the daemon.
+type APIError struct {
+	StatusCode int
+	ErrorResponse
+}
+
+func (e *APIError) Error() string {
+	msg := e.ErrorResponse.Error
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	if e.Details != "" {
+		msg += ": " + e.Details
+	}
+	return msg
+}
+
+// StreamEventConnected is the type of the event that opens every event stream.
+// For workflow streams its data is a StreamConnectedData.
+const StreamEventConnected = "connected"
+
+// StreamEvent is a single server-sent event from an event stream.
+type StreamEvent struct {
+	Type string
+	Data json.RawMessage
+}
+
+// Client calls the control plane API of a running daemon.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewClient returns a client for the daemon described by info. The token is
+// sent as a bearer token when non-empty; it is required for TCP daemons.
+func NewClient(info DaemonInfo, token string) *Client {
+	c := &Client{token: token}
+	if info.Network == "unix" {
+		socketPath := info.Addr
+		c.baseURL = "http://perles" + APIPrefix
+		c.http = &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		}}
+	} else {
+		c.baseURL = "http://" + info.Addr + APIPrefix
+		c.http = &http.Client{}
+	}
+	return c
+}
+
+// ListWorkflows lists workflows, optionally filtered by state.
+func (c *Client) ListWorkflows(ctx context.Context, state string) (*ListWorkflowsResponse, error) {
+	path := "/workflows"
+	if state != "" {
+		path += "?state=" + url.QueryEscape(state)
+	}
+	var resp ListWorkflowsResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetWorkflow returns a single workflow.
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateWorkflow creates a pending workflow, and any workflows chained after
+// it, and returns their IDs.
+func (c *Client) CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (*CreateWorkflowResponse, error) {
+	var resp CreateWorkflowResponse
+	if err := c.do(ctx, http.MethodPost, "/workflows", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StartWorkflow starts, or queues, a pending workflow.
+func (c *Client) StartWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/start", nil, nil)
+}
+
+// PauseWorkflow pauses a running workflow.
+func (c *Client) PauseWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/pause", nil, nil)
+}
+
+// ResumeWorkflow resumes a paused workflow.
+func (c *Client) ResumeWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/resume", nil, nil)
+}
+
+// StopWorkflow stops a workflow.
+func (c *Client) StopWorkflow(ctx context.Context, id string, req StopWorkflowRequest) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/stop", req, nil)
+}
+
+// SendMessage sends a message to a workflow's coordinator or a worker.
+func (c *Client) SendMessage(ctx context.Context, id string, req SendMessageRequest) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/message", req, nil)
+}
+
+// PreviewLand returns the diff stat and merge conflicts of a workflow's branch.
+func (c *Client) PreviewLand(ctx context.Context, id string) (*LandPreviewResponse, error) {
+	var resp LandPreviewResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id)+"/land", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LandWorkflow merges or squashes a workflow's branch into its base branch.
+func (c *Client) LandWorkflow(ctx context.Context, id string, req LandWorkflowRequest) (*LandWorkflowResponse, error) {
+	var resp LandWorkflowResponse
+	if err := c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/land", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResolveLandConflicts asks a workflow's coordinator to resolve merge conflicts.
+func (c *Client) ResolveLandConflicts(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/land/resolve", nil, nil)
+}
+
+// Health returns the daemon and workflow health.
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	var resp HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StreamWorkflowEvents calls fn for each event of a workflow until ctx is
+// cancelled, the daemon closes the stream, or fn returns an error. The first
+// event is a StreamEventConnected event with the workflow's state.
+func (c *Client) StreamWorkflowEvents(ctx context.Context, id string, fn func(StreamEvent) error) error {
+	req, err := c.newRequest(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id)+"/events", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return c.connectError(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		return decodeAPIError(resp)
+	}
+
+	var event StreamEvent
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// A blank line ends the event
+			if event.Type != "" {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			event = StreamEvent{}
+		case strings.HasPrefix(line, ":"):
+			// Heartbeat comment
+		case strings.HasPrefix(line, "event:"):
+			event.Type = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			event.Data = json.RawMessage(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return scanner.Err()
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return c.connectError(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return decodeAPIError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	return req, nil
+}
+
+// connectError reports a daemon that cannot be reached as ErrDaemonNotRunning.
+func (c *Client) connectError(err error) error {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return fmt.Errorf("%w: %v", ErrDaemonNotRunning, opErr)
+	}
+	return err
+}
+
+func decodeAPIError(resp *http.Response) error {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	_ = json.NewDecoder(resp.Body).Decode(&apiErr.ErrorResponse)
+	return apiErr
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+)
+
+func newTestClient(t *testing.T, mockCP *mocks.MockControlPlane) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.StripPrefix(APIPrefix, NewHandler(mockCP).Routes()))
+	t.Cleanup(srv.Close)
+	return NewClient(DaemonInfo{Network: "tcp", Addr: strings.TrimPrefix(srv.URL, "http://")}, "")
+}
+
+func TestClient_ListWorkflows(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		List(mock.Anything, controlplane.ListQuery{States: []controlplane.WorkflowState{controlplane.WorkflowRunning}}).
+		Return([]*controlplane.WorkflowInstance{{ID: "wf-1", Name: "One", State: controlplane.WorkflowRunning}}, nil).
+		Once()
+	mockCP.EXPECT().GetHealthStatus(mock.Anything).Return(controlplane.HealthStatus{}, false).Maybe()
+
+	resp, err := newTestClient(t, mockCP).ListWorkflows(context.Background(), "running")
+
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, "wf-1", resp.Workflows[0].ID)
+}
+
+func TestClient_APIError(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Pause(mock.Anything, controlplane.WorkflowID("wf-missing")).Return(controlplane.ErrWorkflowNotFound).Once()
+
+	err := newTestClient(t, mockCP).PauseWorkflow(context.Background(), "wf-missing")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "not_found", apiErr.Code)
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().SendToProcess(mock.Anything, controlplane.WorkflowID("wf-1"), "coordinator", "hello").Return(nil).Once()
+
+	err := newTestClient(t, mockCP).SendMessage(context.Background(), "wf-1", SendMessageRequest{Content: "hello"})
+
+	require.NoError(t, err)
+}
+
+func TestClient_StreamWorkflowEvents(t *testing.T) {
+	events := make(chan controlplane.ControlPlaneEvent, 2)
+	events <- controlplane.ControlPlaneEvent{Type: controlplane.EventWorkflowStarted, WorkflowID: "wf-1", Timestamp: time.Now()}
+	events <- controlplane.ControlPlaneEvent{Type: controlplane.EventWorkflowCompleted, WorkflowID: "wf-1", Timestamp: time.Now()}
+
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-1")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-1", State: controlplane.WorkflowRunning}, nil).Twice()
+	mockCP.EXPECT().SubscribeWorkflow(mock.Anything, controlplane.WorkflowID("wf-1")).
+		Return((<-chan controlplane.ControlPlaneEvent)(events), func() {}).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []string
+	err := newTestClient(t, mockCP).StreamWorkflowEvents(ctx, "wf-1", func(event StreamEvent) error {
+		got = append(got, event.Type)
+		require.Contains(t, string(event.Data), `"workflow_id":"wf-1"`)
+		if event.Type == StreamEventConnected {
+			require.Contains(t, string(event.Data), `"state":"running"`)
+		}
+		if len(got) == 3 {
+			cancel()
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{StreamEventConnected, "workflow.started", "workflow.completed"}, got)
+}
+
+func TestClient_StreamWorkflowEvents_EndedWorkflow(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-1")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-1", State: controlplane.WorkflowCompleted}, nil).Twice()
+	mockCP.EXPECT().SubscribeWorkflow(mock.Anything, controlplane.WorkflowID("wf-1")).
+		Return((<-chan controlplane.ControlPlaneEvent)(make(chan controlplane.ControlPlaneEvent)), func() {}).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The connected event tells the caller there is nothing left to wait for
+	errEnded := errors.New("ended")
+	err := newTestClient(t, mockCP).StreamWorkflowEvents(ctx, "wf-1", func(event StreamEvent) error {
+		require.Equal(t, StreamEventConnected, event.Type)
+		var connected StreamConnectedData
+		require.NoError(t, json.Unmarshal(event.Data, &connected))
+		require.Equal(t, StreamConnectedData{WorkflowID: "wf-1", State: "completed"}, connected)
+		return errEnded
+	})
+
+	require.ErrorIs(t, err, errEnded)
+	require.NoError(t, ctx.Err(), "the stream returned without waiting for the timeout")
+}
+
+func TestClient_DaemonNotRunning(t *testing.T) {
+	client := NewClient(DaemonInfo{Network: "unix", Addr: filepath.Join(t.TempDir(), "missing.sock")}, "")
+
+	_, err := client.Health(context.Background())
+
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+}
+
+func TestClient_ServerWithFrontend(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		List(mock.Anything, controlplane.ListQuery{}).
+		Return([]*controlplane.WorkflowInstance{{ID: "wf-1", Name: "One", State: controlplane.WorkflowRunning}}, nil).
+		Once()
+	mockCP.EXPECT().GetHealthStatus(mock.Anything).Return(controlplane.HealthStatus{}, false).Maybe()
+
+	// The frontend takes over every path outside the API prefix
+	socketPath := filepath.Join(shortTempDir(t), "perles.sock")
+	server, err := NewServer(ServerConfig{
+		Addr:         socketPath,
+		Network:      "unix",
+		ControlPlane: mockCP,
+		FrontendFS: fstest.MapFS{
+			"dist/index.html": &fstest.MapFile{Data: []byte("<!doctype html>")},
+		},
+	})
+	require.NoError(t, err)
+	go func() { _ = server.Start() }()
+	t.Cleanup(func() { _ = server.Stop(context.Background()) })
+
+	resp, err := NewClient(DaemonInfo{Network: "unix", Addr: socketPath}, "").ListWorkflows(context.Background(), "")
+
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, "wf-1", resp.Workflows[0].ID)
+}
+
+func TestClient_SendsToken(t *testing.T) {
+	tokens, token := newTestTokenFile(t)
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().List(mock.Anything, controlplane.ListQuery{}).Return(nil, nil).Once()
+
+	srv := httptest.NewServer(requireToken(tokens, http.StripPrefix(APIPrefix, NewHandler(mockCP).Routes())))
+	t.Cleanup(srv.Close)
+	info := DaemonInfo{Network: "tcp", Addr: strings.TrimPrefix(srv.URL, "http://")}
+
+	_, err := NewClient(info, "wrong").Health(context.Background())
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+
+	resp, err := NewClient(info, token).Health(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp.Status)
+}
+
+func TestDaemonInfo_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "daemon", "daemon.json")
+
+	_, err := ReadDaemonInfo(path)
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+
+	info := DaemonInfo{PID: 42, Network: "unix", Addr: "/tmp/perles.sock", StartedAt: time.Now().UTC().Truncate(time.Second)}
+	require.NoError(t, WriteDaemonInfo(path, info))
+
+	got, err := ReadDaemonInfo(path)
+	require.NoError(t, err)
+	require.Equal(t, info, got)
+
+	// Another daemon's file is left alone
+	RemoveDaemonInfo(path, 7)
+	_, err = ReadDaemonInfo(path)
+	require.NoError(t, err)
+
+	RemoveDaemonInfo(path, 42)
+	_, err = ReadDaemonInfo(path)
+	require.ErrorIs(t, err, ErrDaemonNotRunning)
+}
@@ -0,0 +1,319 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// defaultStopGracePeriod is how long a graceful stop waits for the workflow's
+// MCP server to shut down when the request does not set grace_period_seconds.
+const defaultStopGracePeriod = 10 * time.Second
+
+// === Request/Response Types ===
+
+// StopWorkflowRequest is the optional request body for stopping a workflow.
+type StopWorkflowRequest struct {
+	// Force skips graceful shutdown and discards uncommitted worktree changes.
+	Force bool `json:"force,omitempty"`
+	// Reason describes why the workflow is being stopped.
+	Reason string `json:"reason,omitempty"`
+	// GracePeriodSeconds bounds a graceful shutdown (default 10).
+	GracePeriodSeconds int `json:"grace_period_seconds,omitempty"`
+}
+
+// SendMessageRequest is the request body for messaging a workflow's processes.
+type SendMessageRequest struct {
+	// Content is the message text (required).
+	Content string `json:"content"`
+	// ProcessID is the recipient (optional, defaults to the coordinator).
+	ProcessID string `json:"process_id,omitempty"`
+	// Interrupt cancels the recipient's current turn to deliver the message
+	// immediately (optional).
+	Interrupt bool `json:"interrupt,omitempty"`
+}
+
+// ProcessActionRequest is the optional request body for replacing or retiring a process.
+type ProcessActionRequest struct {
+	// Reason is recorded with the replacement or retirement.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ProcessResponse is the response body for a single coordinator or worker process.
+type ProcessResponse struct {
+	ID             string          `json:"id"`
+	Role           string          `json:"role"`
+	Status         string          `json:"status"`
+	Phase          string          `json:"phase,omitempty"`
+	TaskID         string          `json:"task_id,omitempty"`
+	AgentType      string          `json:"agent_type,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	LastActivityAt *time.Time      `json:"last_activity_at,omitempty"`
+	RetiredAt      *time.Time      `json:"retired_at,omitempty"`
+	Metrics        *ProcessMetrics `json:"metrics,omitempty"`
+}
+
+// ProcessMetrics is the token usage and cost of a process.
+type ProcessMetrics struct {
+	ContextTokens int     `json:"context_tokens"`
+	ContextWindow int     `json:"context_window"`
+	OutputTokens  int     `json:"output_tokens"`
+	TurnCostUSD   float64 `json:"turn_cost_usd"`
+	TotalCostUSD  float64 `json:"total_cost_usd"`
+}
+
+// ListProcessesResponse is the response body for listing a workflow's processes.
+type ListProcessesResponse struct {
+	Processes []ProcessResponse `json:"processes"`
+	Total     int               `json:"total"`
+}
+
+// === Handlers ===
+
+// Stop terminates a workflow and releases its resources. The workflow ends failed.
+// POST /workflows/{id}/stop
+func (h *Handler) Stop(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var req StopWorkflowRequest
+	if !h.decodeOptionalJSON(w, r, &req) {
+		return
+	}
+
+	opts := controlplane.StopOptions{
+		Reason:      req.Reason,
+		Force:       req.Force,
+		GracePeriod: defaultStopGracePeriod,
+	}
+	if req.GracePeriodSeconds > 0 {
+		opts.GracePeriod = time.Duration(req.GracePeriodSeconds) * time.Second
+	}
+
+	if err := h.cp.Stop(r.Context(), id, opts); err != nil {
+		h.writeControlError(w, err, "stop_failed", "Failed to stop workflow")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Complete marks a workflow as completed.
+// POST /workflows/{id}/complete
+func (h *Handler) Complete(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	if err := h.cp.Complete(r.Context(), id); err != nil {
+		if errors.Is(err, controlplane.ErrWorkflowNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+			return
+		}
+		h.writeError(w, http.StatusBadRequest, "invalid_state", "Cannot complete workflow in current state", err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Fail marks a workflow as failed.
+// POST /workflows/{id}/fail
+func (h *Handler) Fail(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	if err := h.cp.Fail(r.Context(), id); err != nil {
+		if errors.Is(err, controlplane.ErrWorkflowNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+			return
+		}
+		h.writeError(w, http.StatusBadRequest, "invalid_state", "Cannot fail workflow in current state", err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Archive hides a workflow from default listings.
+// POST /workflows/{id}/archive
+func (h *Handler) Archive(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	if err := h.cp.Archive(r.Context(), id); err != nil {
+		h.writeControlError(w, err, "archive_failed", "Failed to archive workflow")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Delete removes a workflow that is not running or paused.
+// DELETE /workflows/{id}
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	if err := h.cp.Delete(r.Context(), id); err != nil {
+		h.writeControlError(w, err, "delete_failed", "Failed to delete workflow")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SendMessage sends a user message to the coordinator, or to process_id,
+// interrupting its current turn when interrupt is set.
+// POST /workflows/{id}/message
+func (h *Handler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var req SendMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_json", "Invalid JSON body", err.Error())
+		return
+	}
+	if req.Content == "" {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "content is required", "")
+		return
+	}
+	if req.ProcessID == "" {
+		req.ProcessID = repository.CoordinatorID
+	}
+
+	send := h.cp.SendToProcess
+	if req.Interrupt {
+		send = h.cp.InterruptProcess
+	}
+	if err := send(r.Context(), id, req.ProcessID, req.Content); err != nil {
+		h.writeControlError(w, err, "message_failed", "Failed to send message")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProcesses returns the coordinator and workers of a running workflow.
+// GET /workflows/{id}/processes
+func (h *Handler) ListProcesses(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	processes, err := h.cp.Processes(r.Context(), id)
+	if err != nil {
+		h.writeControlError(w, err, "list_processes_failed", "Failed to list processes")
+		return
+	}
+
+	resp := ListProcessesResponse{
+		Processes: make([]ProcessResponse, 0, len(processes)),
+		Total:     len(processes),
+	}
+	for _, p := range processes {
+		resp.Processes = append(resp.Processes, processToResponse(p))
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ReplaceProcess retires a process and spawns a replacement with fresh context.
+// POST /workflows/{id}/processes/{process_id}/replace
+func (h *Handler) ReplaceProcess(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var req ProcessActionRequest
+	if !h.decodeOptionalJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.cp.ReplaceProcess(r.Context(), id, r.PathValue("process_id"), req.Reason); err != nil {
+		h.writeControlError(w, err, "replace_failed", "Failed to replace process")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RetireProcess gracefully retires a worker.
+// POST /workflows/{id}/processes/{process_id}/retire
+func (h *Handler) RetireProcess(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var req ProcessActionRequest
+	if !h.decodeOptionalJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.cp.RetireProcess(r.Context(), id, r.PathValue("process_id"), req.Reason); err != nil {
+		h.writeControlError(w, err, "retire_failed", "Failed to retire process")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// === Helpers ===
+
+// decodeOptionalJSON decodes a request body that may be empty. It writes an
+// error response and returns false when the body is not valid JSON.
+func (h *Handler) decodeOptionalJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, "invalid_json", "Invalid JSON body", err.Error())
+		return false
+	}
+	return true
+}
+
+// writeControlError maps control plane errors to HTTP responses, falling back
+// to a 500 with the given code and message.
+func (h *Handler) writeControlError(w http.ResponseWriter, err error, code, message string) {
+	switch {
+	case errors.Is(err, controlplane.ErrWorkflowNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+	case errors.Is(err, repository.ErrProcessNotFound):
+		h.writeError(w, http.StatusNotFound, "process_not_found", "Process not found", err.Error())
+	case errors.Is(err, controlplane.ErrWorkflowNotRunning):
+		h.writeError(w, http.StatusConflict, "not_running", "Workflow is not running", err.Error())
+	case errors.Is(err, controlplane.ErrUncommittedChanges):
+		h.writeError(w, http.StatusConflict, "uncommitted_changes", "Worktree has uncommitted changes", err.Error())
+	case errors.Is(err, controlplane.ErrMergeConflicts):
+		h.writeError(w, http.StatusConflict, "merge_conflicts", "Branch conflicts with its base branch", err.Error())
+	case errors.Is(err, controlplane.ErrNoWorktree):
+		h.writeError(w, http.StatusBadRequest, "no_worktree", "Workflow has no worktree", err.Error())
+	case errors.Is(err, controlplane.ErrInvalidState):
+		h.writeError(w, http.StatusBadRequest, "invalid_state", "Operation not allowed in current state", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, code, message, err.Error())
+	}
+}
+
+func processToResponse(p *repository.Process) ProcessResponse {
+	resp := ProcessResponse{
+		ID:        p.ID,
+		Role:      string(p.Role),
+		Status:    string(p.Status),
+		TaskID:    p.TaskID,
+		AgentType: string(p.AgentType),
+		CreatedAt: p.CreatedAt,
+	}
+	if p.Phase != nil {
+		resp.Phase = string(*p.Phase)
+	}
+	if !p.LastActivityAt.IsZero() {
+		lastActivityAt := p.LastActivityAt
+		resp.LastActivityAt = &lastActivityAt
+	}
+	if !p.RetiredAt.IsZero() {
+		retiredAt := p.RetiredAt
+		resp.RetiredAt = &retiredAt
+	}
+	if p.Metrics != nil {
+		resp.Metrics = &ProcessMetrics{
+			ContextTokens: p.Metrics.TokensUsed,
+			ContextWindow: p.Metrics.TotalTokens,
+			OutputTokens:  p.Metrics.OutputTokens,
+			TurnCostUSD:   p.Metrics.TurnCostUSD,
+			TotalCostUSD:  p.Metrics.TotalCostUSD,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func serveRequest(t *testing.T, h *Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	h.Routes().ServeHTTP(w, req)
+	return w
+}
+
+func requireErrorCode(t *testing.T, w *httptest.ResponseRecorder, status int, code string) {
+	t.Helper()
+	require.Equal(t, status, w.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, code, resp.Code)
+}
+
+func TestHandler_Stop(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Stop(mock.Anything, controlplane.WorkflowID("wf-123"), controlplane.StopOptions{
+			Reason:      "obsolete",
+			Force:       true,
+			GracePeriod: 30 * time.Second,
+		}).
+		Return(nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/stop",
+		`{"force": true, "reason": "obsolete", "grace_period_seconds": 30}`)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Stop_EmptyBodyUsesDefaults(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Stop(mock.Anything, controlplane.WorkflowID("wf-123"), controlplane.StopOptions{GracePeriod: defaultStopGracePeriod}).
+		Return(nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/stop", "")
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Stop_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", controlplane.ErrWorkflowNotFound, http.StatusNotFound, "not_found"},
+		{"uncommitted changes", fmt.Errorf("stopping workflow: %w", controlplane.ErrUncommittedChanges), http.StatusConflict, "uncommitted_changes"},
+		{"invalid state", fmt.Errorf("%w: cannot stop workflow in state completed", controlplane.ErrInvalidState), http.StatusBadRequest, "invalid_state"},
+		{"other", fmt.Errorf("boom"), http.StatusInternalServerError, "stop_failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCP := mocks.NewMockControlPlane(t)
+			mockCP.EXPECT().Stop(mock.Anything, mock.Anything, mock.Anything).Return(tt.err).Once()
+
+			w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/stop", "")
+
+			requireErrorCode(t, w, tt.status, tt.code)
+		})
+	}
+}
+
+func TestHandler_Stop_InvalidJSON(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/stop", "not json")
+
+	requireErrorCode(t, w, http.StatusBadRequest, "invalid_json")
+}
+
+func TestHandler_CompleteAndFail(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Complete(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+	mockCP.EXPECT().Fail(mock.Anything, controlplane.WorkflowID("wf-2")).Return(nil).Once()
+	mockCP.EXPECT().Complete(mock.Anything, controlplane.WorkflowID("wf-3")).
+		Return(fmt.Errorf("transitioning to completed: invalid state transition from failed to completed")).Once()
+	mockCP.EXPECT().Fail(mock.Anything, controlplane.WorkflowID("missing")).Return(controlplane.ErrWorkflowNotFound).Once()
+
+	h := NewHandler(mockCP)
+
+	require.Equal(t, http.StatusNoContent, serveRequest(t, h, http.MethodPost, "/workflows/wf-1/complete", "").Code)
+	require.Equal(t, http.StatusNoContent, serveRequest(t, h, http.MethodPost, "/workflows/wf-2/fail", "").Code)
+	requireErrorCode(t, serveRequest(t, h, http.MethodPost, "/workflows/wf-3/complete", ""), http.StatusBadRequest, "invalid_state")
+	requireErrorCode(t, serveRequest(t, h, http.MethodPost, "/workflows/missing/fail", ""), http.StatusNotFound, "not_found")
+}
+
+func TestHandler_Archive(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Archive(mock.Anything, controlplane.WorkflowID("wf-123")).Return(nil).Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/archive", "")
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Delete(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Delete(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+	mockCP.EXPECT().Delete(mock.Anything, controlplane.WorkflowID("wf-2")).
+		Return(fmt.Errorf("%w: cannot delete workflow in state running, stop it first", controlplane.ErrInvalidState)).Once()
+
+	h := NewHandler(mockCP)
+
+	require.Equal(t, http.StatusNoContent, serveRequest(t, h, http.MethodDelete, "/workflows/wf-1", "").Code)
+	requireErrorCode(t, serveRequest(t, h, http.MethodDelete, "/workflows/wf-2", ""), http.StatusBadRequest, "invalid_state")
+}
+
+func TestHandler_SendMessage_DefaultsToCoordinator(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		SendToProcess(mock.Anything, controlplane.WorkflowID("wf-123"), repository.CoordinatorID, "Focus on tests").
+		Return(nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/message", `{"content": "Focus on tests"}`)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_SendMessage_ToWorker(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		SendToProcess(mock.Anything, controlplane.WorkflowID("wf-123"), "worker-2", "Status?").
+		Return(nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/message",
+		`{"content": "Status?", "process_id": "worker-2"}`)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_SendMessage_Interrupt(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		InterruptProcess(mock.Anything, controlplane.WorkflowID("wf-123"), "worker-2", "STOP, wrong file").
+		Return(nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/message",
+		`{"content": "STOP, wrong file", "process_id": "worker-2", "interrupt": true}`)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_SendMessage_RequiresContent(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/message", `{}`)
+
+	requireErrorCode(t, w, http.StatusBadRequest, "validation_error")
+}
+
+func TestHandler_SendMessage_NotRunning(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().SendToProcess(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: workflow is paused", controlplane.ErrWorkflowNotRunning)).Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/message", `{"content": "hi"}`)
+
+	requireErrorCode(t, w, http.StatusConflict, "not_running")
+}
+
+func TestHandler_ListProcesses(t *testing.T) {
+	phase := events.ProcessPhaseImplementing
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Processes(mock.Anything, controlplane.WorkflowID("wf-123")).
+		Return([]*repository.Process{
+			{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: events.ProcessStatusReady, CreatedAt: created},
+			{
+				ID: "worker-1", Role: repository.RoleWorker, Status: events.ProcessStatusWorking,
+				Phase: &phase, TaskID: "perles-abc.1", CreatedAt: created,
+				Metrics: &metrics.TokenMetrics{TokensUsed: 27000, TotalTokens: 200000, OutputTokens: 900, TotalCostUSD: 0.42},
+			},
+		}, nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodGet, "/workflows/wf-123/processes", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp ListProcessesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 2, resp.Total)
+	assert.Equal(t, "coordinator", resp.Processes[0].Role)
+	assert.Nil(t, resp.Processes[0].Metrics)
+
+	worker := resp.Processes[1]
+	assert.Equal(t, "worker-1", worker.ID)
+	assert.Equal(t, "working", worker.Status)
+	assert.Equal(t, "implementing", worker.Phase)
+	assert.Equal(t, "perles-abc.1", worker.TaskID)
+	require.NotNil(t, worker.Metrics)
+	assert.Equal(t, 27000, worker.Metrics.ContextTokens)
+	assert.InDelta(t, 0.42, worker.Metrics.TotalCostUSD, 0.0001)
+}
+
+func TestHandler_ReplaceAndRetireProcess(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().ReplaceProcess(mock.Anything, controlplane.WorkflowID("wf-123"), "coordinator", "stuck").Return(nil).Once()
+	mockCP.EXPECT().RetireProcess(mock.Anything, controlplane.WorkflowID("wf-123"), "worker-1", "").Return(nil).Once()
+	mockCP.EXPECT().RetireProcess(mock.Anything, controlplane.WorkflowID("wf-123"), "worker-9", "").
+		Return(fmt.Errorf("retire_process command failed: %w", repository.ErrProcessNotFound)).Once()
+
+	h := NewHandler(mockCP)
+
+	require.Equal(t, http.StatusNoContent,
+		serveRequest(t, h, http.MethodPost, "/workflows/wf-123/processes/coordinator/replace", `{"reason": "stuck"}`).Code)
+	require.Equal(t, http.StatusNoContent,
+		serveRequest(t, h, http.MethodPost, "/workflows/wf-123/processes/worker-1/retire", "").Code)
+	requireErrorCode(t, serveRequest(t, h, http.MethodPost, "/workflows/wf-123/processes/worker-9/retire", ""),
+		http.StatusNotFound, "process_not_found")
+}
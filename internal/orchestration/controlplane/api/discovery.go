@@ -0,0 +1,63 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrDaemonNotRunning is returned when no running daemon can be discovered.
+var ErrDaemonNotRunning = errors.New("perles daemon is not running")
+
+// DaemonInfo describes how to reach a running daemon. The daemon writes it to
+// a well-known file on startup and removes it on shutdown so clients such as
+// `perles ctl` can find the daemon without being told its address.
+type DaemonInfo struct {
+	PID       int       `json:"pid"`
+	Network   string    `json:"network"` // "unix" or "tcp"
+	Addr      string    `json:"addr"`    // Socket path, or host:port
+	StartedAt time.Time `json:"started_at"`
+}
+
+// WriteDaemonInfo writes info to path, readable only by the current user.
+func WriteDaemonInfo(path string, info DaemonInfo) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating daemon directory: %w", err)
+	}
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding daemon info: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing daemon info: %w", err)
+	}
+	return nil
+}
+
+// ReadDaemonInfo reads the daemon info at path. It returns ErrDaemonNotRunning
+// if the file does not exist.
+func ReadDaemonInfo(path string) (DaemonInfo, error) {
+	var info DaemonInfo
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return info, ErrDaemonNotRunning
+	}
+	if err != nil {
+		return info, fmt.Errorf("reading daemon info: %w", err)
+	}
+	if err := json.Unmarshal(data, &info); err != nil {
+		return info, fmt.Errorf("parsing daemon info %s: %w", path, err)
+	}
+	return info, nil
+}
+
+// RemoveDaemonInfo removes the daemon info at path if it still belongs to pid,
+// so a daemon shutting down never removes a newer daemon's file.
+func RemoveDaemonInfo(path string, pid int) {
+	if info, err := ReadDaemonInfo(path); err == nil && info.PID == pid {
+		_ = os.Remove(path)
+	}
+}
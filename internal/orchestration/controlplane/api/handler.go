@@ -10,7 +10,10 @@ import (
 	"io/fs"
 	"net"
 	"net/http"
-	"strings"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"time"
 
 	"github.com/zjrosen/perles/internal/frontend"
@@ -23,8 +26,9 @@ import (
 // Handler provides HTTP endpoints for ControlPlane operations.
 type Handler struct {
 	cp              controlplane.ControlPlane
-	workflowCreator *appreg.WorkflowCreator
 	registryService *appreg.RegistryService
+	specs           *TemplateSpecBuilder
+	schedules       controlplane.CronScheduler
 }
 
 // HandlerConfig configures the API handler.
@@ -37,45 +41,132 @@ type HandlerConfig struct {
 	// RegistryService provides access to workflow templates (optional).
 	// Required for building coordinator prompts with instructions.
 	RegistryService *appreg.RegistryService
+	// Schedules manages workflow schedules (optional).
+	// If nil, the schedule endpoints respond with 503.
+	Schedules controlplane.CronScheduler
 }
 
 // NewHandler creates a new API handler wrapping the given ControlPlane.
 func NewHandler(cp controlplane.ControlPlane) *Handler {
-	return &Handler{cp: cp}
+	return &Handler{cp: cp, specs: NewTemplateSpecBuilder(nil, nil)}
 }
 
 // NewHandlerWithConfig creates a new API handler with full configuration.
 func NewHandlerWithConfig(cfg HandlerConfig) *Handler {
 	return &Handler{
 		cp:              cfg.ControlPlane,
-		workflowCreator: cfg.WorkflowCreator,
 		registryService: cfg.RegistryService,
+		specs:           NewTemplateSpecBuilder(cfg.RegistryService, cfg.WorkflowCreator),
+		schedules:       cfg.Schedules,
 	}
 }
 
 // Routes returns an http.Handler with all API routes registered.
 func (h *Handler) Routes() http.Handler {
 	mux := http.NewServeMux()
+	for _, rt := range h.routes() {
+		mux.HandleFunc(rt.method+" "+rt.path, rt.handler)
+	}
+	return mux
+}
 
-	// Templates
-	mux.HandleFunc("GET /templates", h.ListTemplates)
-
-	// Workflow CRUD
-	mux.HandleFunc("POST /workflows", h.Create)
-	mux.HandleFunc("GET /workflows", h.List)
-	mux.HandleFunc("GET /workflows/{id}", h.Get)
-	mux.HandleFunc("POST /workflows/{id}/start", h.Start)
-	mux.HandleFunc("POST /workflows/{id}/pause", h.Pause)
-	mux.HandleFunc("POST /workflows/{id}/resume", h.Resume)
-
-	// Event streaming
-	mux.HandleFunc("GET /workflows/{id}/events", h.StreamWorkflowEvents)
-	mux.HandleFunc("GET /events", h.StreamAllEvents)
-
-	// Health check
-	mux.HandleFunc("GET /health", h.Health)
+// route describes one API endpoint. Routes registers the table with the mux and
+// OpenAPI documents it, so the two cannot drift apart.
+type route struct {
+	method  string
+	path    string
+	summary string
+	handler http.HandlerFunc
+	// query lists the query parameters the endpoint accepts.
+	query []string
+	// request is a zero value of the JSON request body, nil for none.
+	request any
+	// response is a zero value of the JSON response body, nil for none.
+	response any
+	// status is the status code on success.
+	status int
+	// stream marks Server-Sent Events endpoints.
+	stream bool
+}
 
-	return mux
+func (h *Handler) routes() []route {
+	return []route{
+		// Templates
+		{method: "GET", path: "/templates", summary: "List workflow templates", handler: h.ListTemplates,
+			response: ListTemplatesResponse{}, status: http.StatusOK},
+
+		// Workflow CRUD
+		{method: "POST", path: "/workflows", summary: "Create a workflow in the pending state", handler: h.Create,
+			request: CreateWorkflowRequest{}, response: CreateWorkflowResponse{}, status: http.StatusCreated},
+		{method: "GET", path: "/workflows", summary: "List workflows", handler: h.List,
+			query: []string{"state", "template_id"}, response: ListWorkflowsResponse{}, status: http.StatusOK},
+		{method: "GET", path: "/workflows/{id}", summary: "Get a workflow", handler: h.Get,
+			response: WorkflowResponse{}, status: http.StatusOK},
+		{method: "DELETE", path: "/workflows/{id}", summary: "Delete a workflow that is not running or paused", handler: h.Delete,
+			status: http.StatusNoContent},
+
+		// Workflow lifecycle
+		{method: "POST", path: "/workflows/{id}/start", summary: "Start a pending workflow, or queue it", handler: h.Start,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/pause", summary: "Pause a running workflow", handler: h.Pause,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/resume", summary: "Resume a paused workflow", handler: h.Resume,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/stop", summary: "Stop a workflow and release its resources", handler: h.Stop,
+			request: StopWorkflowRequest{}, status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/complete", summary: "Mark a workflow completed", handler: h.Complete,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/fail", summary: "Mark a workflow failed", handler: h.Fail,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/archive", summary: "Archive a workflow", handler: h.Archive,
+			status: http.StatusNoContent},
+
+		// Landing
+		{method: "GET", path: "/workflows/{id}/land", summary: "Preview landing a workflow's branch: diff stat and conflicts", handler: h.PreviewLand,
+			response: LandPreviewResponse{}, status: http.StatusOK},
+		{method: "POST", path: "/workflows/{id}/land", summary: "Merge or squash a workflow's branch into its base branch", handler: h.Land,
+			request: LandWorkflowRequest{}, response: LandWorkflowResponse{}, status: http.StatusOK},
+		{method: "POST", path: "/workflows/{id}/land/resolve", summary: "Ask the coordinator to resolve merge conflicts", handler: h.ResolveLandConflicts,
+			status: http.StatusNoContent},
+
+		// Scheduling
+		{method: "GET", path: "/queue", summary: "List workflows waiting for a slot, with estimated start times", handler: h.Queue,
+			response: QueueResponse{}, status: http.StatusOK},
+		{method: "POST", path: "/schedules", summary: "Create a cron schedule that starts workflows", handler: h.CreateSchedule,
+			request: CreateScheduleRequest{}, response: ScheduleResponse{}, status: http.StatusCreated},
+		{method: "GET", path: "/schedules", summary: "List schedules", handler: h.ListSchedules,
+			response: ListSchedulesResponse{}, status: http.StatusOK},
+		{method: "GET", path: "/schedules/{id}", summary: "Get a schedule", handler: h.GetSchedule,
+			response: ScheduleResponse{}, status: http.StatusOK},
+		{method: "DELETE", path: "/schedules/{id}", summary: "Delete a schedule and its upcoming workflow", handler: h.DeleteSchedule,
+			status: http.StatusNoContent},
+		{method: "POST", path: "/schedules/{id}/run", summary: "Run a schedule now", handler: h.RunSchedule,
+			response: RunScheduleResponse{}, status: http.StatusOK},
+
+		// Process control
+		{method: "POST", path: "/workflows/{id}/message", summary: "Send a user message to the coordinator or a worker", handler: h.SendMessage,
+			request: SendMessageRequest{}, status: http.StatusNoContent},
+		{method: "GET", path: "/workflows/{id}/processes", summary: "List the coordinator and workers with their metrics", handler: h.ListProcesses,
+			response: ListProcessesResponse{}, status: http.StatusOK},
+		{method: "POST", path: "/workflows/{id}/processes/{process_id}/replace", summary: "Replace a process with a fresh one", handler: h.ReplaceProcess,
+			request: ProcessActionRequest{}, status: http.StatusNoContent},
+		{method: "POST", path: "/workflows/{id}/processes/{process_id}/retire", summary: "Retire a worker", handler: h.RetireProcess,
+			request: ProcessActionRequest{}, status: http.StatusNoContent},
+
+		// Event streaming
+		{method: "GET", path: "/workflows/{id}/events", summary: "Stream a workflow's events", handler: h.StreamWorkflowEvents,
+			status: http.StatusOK, stream: true},
+		{method: "GET", path: "/events", summary: "Stream all control plane events", handler: h.StreamAllEvents,
+			status: http.StatusOK, stream: true},
+
+		// Health check
+		{method: "GET", path: "/health", summary: "Daemon and workflow health", handler: h.Health,
+			response: HealthResponse{}, status: http.StatusOK},
+
+		// API description
+		{method: "GET", path: "/openapi.json", summary: "OpenAPI document for this API", handler: h.OpenAPI,
+			response: map[string]any{}, status: http.StatusOK},
+	}
 }
 
 // === Request/Response Types ===
@@ -97,11 +188,43 @@ type CreateWorkflowRequest struct {
 	WorktreeBaseBranch string `json:"worktree_base_branch,omitempty"`
 	// BranchName is an optional custom branch name for the worktree.
 	BranchName string `json:"branch_name,omitempty"`
+	// WorkerWorktrees gives each worker its own worktree branched from the
+	// workflow branch (optional, requires worktree_enabled).
+	WorkerWorktrees bool `json:"worker_worktrees,omitempty"`
+	// Priority orders the workflow in the start queue when the priority policy is configured (optional).
+	Priority int `json:"priority,omitempty"`
+	// BudgetUSD caps the workflow's spend in USD; the workflow is paused when reached (optional).
+	BudgetUSD float64 `json:"budget_usd,omitempty"`
+	// BudgetTokens caps the workflow's output tokens; the workflow is paused when reached (optional).
+	BudgetTokens int64 `json:"budget_tokens,omitempty"`
+	// StartAt delays the start until the given time (RFC 3339); the workflow stays
+	// pending until then and is started by the scheduler (optional).
+	StartAt *time.Time `json:"start_at,omitempty"`
+	// After lists workflows that must complete first. The workflow starts on its
+	// own once they do, with their outputs (epic_id, artifacts) added to Args,
+	// and fails if any of them fails. Arguments are validated when it starts (optional).
+	After []string `json:"after,omitempty"`
+	// Then chains templates to run one after another once this workflow
+	// completes, each after the previous one (optional).
+	Then []ChainedWorkflowRequest `json:"then,omitempty"`
+}
+
+// ChainedWorkflowRequest is a workflow created to run after another completes.
+// It inherits the labels, worktree settings and priority of the request.
+type ChainedWorkflowRequest struct {
+	// TemplateID is the workflow template to use (required).
+	TemplateID string `json:"template_id"`
+	// Name is the display name for the workflow (optional, defaults to template name).
+	Name string `json:"name,omitempty"`
+	// Args are template argument values; they override upstream outputs (optional).
+	Args map[string]string `json:"args,omitempty"`
 }
 
 // CreateWorkflowResponse is the response body for creating a workflow.
 type CreateWorkflowResponse struct {
 	ID string `json:"id"`
+	// Chain holds the IDs of the workflows created from Then, in order.
+	Chain []string `json:"chain,omitempty"`
 }
 
 // WorkflowResponse is the response body for a single workflow.
@@ -112,18 +235,34 @@ type WorkflowResponse struct {
 	State         string            `json:"state"`
 	InitialPrompt string            `json:"initial_prompt"`
 	Labels        map[string]string `json:"labels,omitempty"`
+	Priority      int               `json:"priority,omitempty"`
 	CreatedAt     time.Time         `json:"created_at"`
-	StartedAt     *time.Time        `json:"started_at,omitempty"`
-	EndedAt       *time.Time        `json:"ended_at,omitempty"`
-	Port          int               `json:"port,omitempty"`
+	QueuedAt      *time.Time        `json:"queued_at,omitempty"`
+	ScheduledAt   *time.Time        `json:"scheduled_at,omitempty"`
+	// EstimatedStartAt is when a queued or scheduled workflow is expected to start.
+	EstimatedStartAt *time.Time `json:"estimated_start_at,omitempty"`
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	EndedAt          *time.Time `json:"ended_at,omitempty"`
+	Port             int        `json:"port,omitempty"`
+	// Dependency fields: the workflows this one waits for, and the results
+	// a completed workflow hands to its dependents
+	After   []string          `json:"after,omitempty"`
+	Outputs map[string]string `json:"outputs,omitempty"`
 	// Worktree fields
 	WorktreeEnabled bool   `json:"worktree_enabled,omitempty"`
 	WorktreePath    string `json:"worktree_path,omitempty"`
+	WorkerWorktrees bool   `json:"worker_worktrees,omitempty"`
 	// Health fields
 	IsHealthy       bool       `json:"is_healthy"`
 	LastHeartbeatAt *time.Time `json:"last_heartbeat_at,omitempty"`
 	LastProgressAt  *time.Time `json:"last_progress_at,omitempty"`
 	RecoveryCount   int        `json:"recovery_count,omitempty"`
+	// Spend and budget fields
+	TokensUsed   int64   `json:"tokens_used"`
+	CostUSD      float64 `json:"cost_usd"`
+	BudgetUSD    float64 `json:"budget_usd,omitempty"`
+	BudgetTokens int64   `json:"budget_tokens,omitempty"`
+	BudgetBurn   float64 `json:"budget_burn,omitempty"`
 }
 
 // ListWorkflowsResponse is the response body for listing workflows.
@@ -182,58 +321,42 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		h.writeError(w, http.StatusBadRequest, "validation_error", "template_id is required", "")
 		return
 	}
-
-	// Validate required template arguments if registry service is available
-	if h.registryService != nil {
-		if err := h.validateTemplateArgs(req.TemplateID, req.Args); err != nil {
-			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error(), "")
+	for i, next := range req.Then {
+		if next.TemplateID == "" {
+			h.writeError(w, http.StatusBadRequest, "validation_error", fmt.Sprintf("then[%d].template_id is required", i), "")
 			return
 		}
 	}
-
-	var epicID string
-	var initialPrompt string
-
-	// Check if this is an epic-driven workflow (uses existing epic from tracker)
-	isEpicDriven := false
-	if h.registryService != nil {
-		if reg, err := h.registryService.GetByKey("workflow", req.TemplateID); err == nil {
-			isEpicDriven = reg.IsEpicDriven()
-		}
+	if len(req.After) > 0 && req.StartAt != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", "start_at cannot be combined with after", "")
+		return
 	}
 
-	if isEpicDriven {
-		// Epic-driven workflow: use the provided epic_id directly, skip workflowCreator
-		epicID = req.Args["epic_id"]
-	} else if h.workflowCreator != nil {
-		// Standard workflow: create epic + tasks in beads first
-		// Use name as feature slug, or derive from templateID if empty
-		feature := req.Name
-		if feature == "" {
-			feature = req.TemplateID
-		}
-
-		result, err := h.workflowCreator.CreateWithArgs(feature, req.TemplateID, req.Args)
+	// Workflows with dependencies are built once their upstream workflows complete
+	spec := dependentSpec(req.TemplateID, req.Name, req.Args, req.After)
+	if len(req.After) == 0 {
+		var err error
+		spec, err = h.specs.BuildSpec(req.TemplateID, req.Name, req.Args)
 		if err != nil {
-			h.writeError(w, http.StatusInternalServerError, "epic_creation_failed", "Failed to create epic", err.Error())
+			var epicErr *epicCreationError
+			if errors.As(err, &epicErr) {
+				h.writeError(w, http.StatusInternalServerError, "epic_creation_failed", "Failed to create epic", epicErr.err.Error())
+				return
+			}
+			h.writeError(w, http.StatusBadRequest, "validation_error", err.Error(), "")
 			return
 		}
-
-		epicID = result.Epic.ID
 	}
-
-	// Build coordinator prompt: instructions template + epic ID section + args
-	initialPrompt = h.buildCoordinatorPrompt(req.TemplateID, epicID, req.Args)
-
-	spec := controlplane.WorkflowSpec{
-		TemplateID:         req.TemplateID,
-		Name:               req.Name,
-		InitialPrompt:      initialPrompt,
-		Labels:             req.Labels,
-		WorktreeEnabled:    req.WorktreeEnabled,
-		WorktreeBaseBranch: req.WorktreeBaseBranch,
-		WorktreeBranchName: req.BranchName,
-		EpicID:             epicID,
+	spec.Labels = req.Labels
+	spec.WorktreeEnabled = req.WorktreeEnabled
+	spec.WorktreeBaseBranch = req.WorktreeBaseBranch
+	spec.WorktreeBranchName = req.BranchName
+	spec.WorkerWorktrees = req.WorkerWorktrees
+	spec.Priority = req.Priority
+	spec.BudgetUSD = req.BudgetUSD
+	spec.BudgetTokens = req.BudgetTokens
+	if req.StartAt != nil {
+		spec.StartAt = *req.StartAt
 	}
 
 	id, err := h.cp.Create(r.Context(), spec)
@@ -242,7 +365,44 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.writeJSON(w, http.StatusCreated, CreateWorkflowResponse{ID: string(id)})
+	resp := CreateWorkflowResponse{ID: string(id)}
+	created := []controlplane.WorkflowID{id}
+	for i, next := range req.Then {
+		chained := dependentSpec(next.TemplateID, next.Name, next.Args, []string{string(created[len(created)-1])})
+		chained.Labels = req.Labels
+		chained.WorktreeEnabled = req.WorktreeEnabled
+		chained.WorktreeBaseBranch = req.WorktreeBaseBranch
+		chained.WorkerWorktrees = req.WorkerWorktrees
+		chained.Priority = req.Priority
+
+		chainedID, err := h.cp.Create(r.Context(), chained)
+		if err != nil {
+			// Don't leave a partial chain behind
+			for _, createdID := range slices.Backward(created) {
+				_ = h.cp.Delete(r.Context(), createdID)
+			}
+			h.writeError(w, http.StatusBadRequest, "create_failed", fmt.Sprintf("Failed to create then[%d] workflow", i), err.Error())
+			return
+		}
+		created = append(created, chainedID)
+		resp.Chain = append(resp.Chain, string(chainedID))
+	}
+
+	h.writeJSON(w, http.StatusCreated, resp)
+}
+
+// dependentSpec returns the spec of a workflow that runs after others. Its
+// prompt is built from the template by the control plane once they complete.
+func dependentSpec(templateID, name string, args map[string]string, after []string) controlplane.WorkflowSpec {
+	spec := controlplane.WorkflowSpec{
+		TemplateID: templateID,
+		Name:       name,
+		Args:       args,
+	}
+	for _, id := range after {
+		spec.After = append(spec.After, controlplane.WorkflowID(id))
+	}
+	return spec
 }
 
 // ListTemplates returns all available workflow templates.
@@ -290,61 +450,6 @@ func (h *Handler) ListTemplates(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// validateTemplateArgs validates that required template arguments are present and non-empty.
-func (h *Handler) validateTemplateArgs(templateID string, args map[string]string) error {
-	reg, err := h.registryService.GetByKey("workflow", templateID)
-	if err != nil {
-		return fmt.Errorf("template not found: %s", templateID)
-	}
-
-	for _, arg := range reg.Arguments() {
-		if arg.Required() {
-			val := ""
-			if args != nil {
-				val = strings.TrimSpace(args[arg.Key()])
-			}
-			if val == "" {
-				return fmt.Errorf("%s is required", arg.Label())
-			}
-		}
-	}
-
-	return nil
-}
-
-// buildCoordinatorPrompt assembles the coordinator prompt from:
-// 1. Instructions template content (from registration's instructions field)
-// 2. Epic ID section (so coordinator can read detailed instructions via bd show)
-func (h *Handler) buildCoordinatorPrompt(templateID, epicID string, _ map[string]string) string {
-	// Load system prompt template if registry service is available
-	var systemPromptContent string
-	if h.registryService != nil {
-		// Get the registration for this template
-		reg, err := h.registryService.GetByKey("workflow", templateID)
-		if err == nil {
-			content, err := h.registryService.GetSystemPromptTemplate(reg)
-			if err == nil {
-				systemPromptContent = content
-			}
-		}
-		// If error loading template, continue without it
-	}
-
-	// Build the full prompt
-	var parts []string
-
-	if systemPromptContent != "" {
-		parts = append(parts, systemPromptContent)
-	}
-
-	if epicID != "" {
-		epicSection := fmt.Sprintf("# Epic\n\nThe epic for this workflow is `%s`. Run `bd show %s` to read the detailed work breakdown and instructions.", epicID, epicID)
-		parts = append(parts, epicSection)
-	}
-
-	return strings.Join(parts, "\n\n")
-}
-
 // List returns all workflows matching optional filters.
 // GET /workflows?state=running&template_id=cook
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
@@ -396,7 +501,8 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, h.workflowToResponse(wf))
 }
 
-// Start transitions a workflow from Pending to Running.
+// Start transitions a workflow from Pending to Running, or queues it when the
+// scheduler has no free slot.
 // POST /workflows/{id}/start
 func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
 	id := controlplane.WorkflowID(r.PathValue("id"))
@@ -406,6 +512,14 @@ func (h *Handler) Start(w http.ResponseWriter, r *http.Request) {
 			h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
 			return
 		}
+		if errors.Is(err, controlplane.ErrBudgetExceeded) {
+			h.writeError(w, http.StatusConflict, "budget_exceeded", "Workflow budget exhausted", err.Error())
+			return
+		}
+		if errors.Is(err, controlplane.ErrInvalidState) {
+			h.writeError(w, http.StatusConflict, "invalid_state", "Cannot start workflow in current state", err.Error())
+			return
+		}
 		h.writeError(w, http.StatusBadRequest, "start_failed", "Failed to start workflow", err.Error())
 		return
 	}
@@ -473,7 +587,22 @@ func (h *Handler) StreamWorkflowEvents(w http.ResponseWriter, r *http.Request) {
 	events, unsub := h.cp.SubscribeWorkflow(r.Context(), id)
 	defer unsub()
 
-	h.streamEvents(w, r, events)
+	// Read the state after subscribing, so a client that stops at the end of a
+	// workflow never misses it: either the state is already terminal or the
+	// terminal event follows.
+	connected := StreamConnectedData{WorkflowID: string(id)}
+	if wf, err := h.cp.Get(r.Context(), id); err == nil {
+		connected.State = string(wf.State)
+	}
+
+	h.streamEvents(w, r, events, connected)
+}
+
+// StreamConnectedData is the data of the connected event that opens a workflow
+// event stream.
+type StreamConnectedData struct {
+	WorkflowID string `json:"workflow_id"`
+	State      string `json:"state"`
 }
 
 // StreamAllEvents streams all control plane events via SSE.
@@ -482,7 +611,7 @@ func (h *Handler) StreamAllEvents(w http.ResponseWriter, r *http.Request) {
 	events, unsub := h.cp.Subscribe(r.Context())
 	defer unsub()
 
-	h.streamEvents(w, r, events)
+	h.streamEvents(w, r, events, struct{}{})
 }
 
 // HealthResponse is the response body for the health endpoint.
@@ -546,7 +675,7 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 
 // === Helpers ===
 
-func (h *Handler) streamEvents(w http.ResponseWriter, r *http.Request, events <-chan controlplane.ControlPlaneEvent) {
+func (h *Handler) streamEvents(w http.ResponseWriter, r *http.Request, events <-chan controlplane.ControlPlaneEvent, connected any) {
 	// Set SSE headers
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
@@ -560,7 +689,8 @@ func (h *Handler) streamEvents(w http.ResponseWriter, r *http.Request, events <-
 	}
 
 	// Send initial connection event
-	_, _ = fmt.Fprintf(w, "event: connected\ndata: {}\n\n")
+	connectedData, _ := json.Marshal(connected)
+	_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", StreamEventConnected, connectedData)
 	flusher.Flush()
 
 	// Heartbeat ticker to keep connection alive
@@ -601,15 +731,42 @@ func (h *Handler) workflowToResponse(wf *controlplane.WorkflowInstance) Workflow
 		State:           string(wf.State),
 		InitialPrompt:   wf.InitialPrompt,
 		Labels:          wf.Labels,
+		Priority:        wf.Priority,
 		CreatedAt:       wf.CreatedAt,
 		Port:            wf.MCPPort,
 		WorktreeEnabled: wf.WorktreeEnabled,
 		WorktreePath:    wf.WorktreePath,
+		WorkerWorktrees: wf.WorkerWorktrees,
+		TokensUsed:      wf.TokensUsed,
+		CostUSD:         wf.CostUSD,
+		BudgetUSD:       wf.BudgetUSD,
+		BudgetTokens:    wf.BudgetTokens,
+		BudgetBurn:      wf.BudgetBurn(),
 	}
 
+	if wf.IsQueued() {
+		queuedAt := wf.QueuedAt
+		resp.QueuedAt = &queuedAt
+		for _, q := range h.cp.Queue() {
+			if q.ID == wf.ID && !q.EstimatedStartAt.IsZero() {
+				eta := q.EstimatedStartAt
+				resp.EstimatedStartAt = &eta
+			}
+		}
+	}
+	if wf.ScheduledAt != nil {
+		resp.ScheduledAt = wf.ScheduledAt
+		if wf.IsScheduled() {
+			resp.EstimatedStartAt = wf.ScheduledAt
+		}
+	}
 	if wf.StartedAt != nil {
 		resp.StartedAt = wf.StartedAt
 	}
+	for _, upstream := range wf.After {
+		resp.After = append(resp.After, string(upstream))
+	}
+	resp.Outputs = wf.Outputs
 
 	// Add health status if available
 	if status, ok := h.cp.GetHealthStatus(wf.ID); ok {
@@ -685,19 +842,28 @@ type Server struct {
 	server   *http.Server
 	listener net.Listener
 	addr     string
-	port     int // Actual port after binding (useful when using :0)
+	port     int // Actual port after binding (useful when using :0); 0 for Unix sockets
 }
 
 // ServerConfig configures the API server.
 type ServerConfig struct {
-	// Addr is the address to listen on (e.g., ":19999" or "/var/run/perles.sock").
+	// Addr is the address to listen on (e.g., "localhost:19999", or a socket
+	// path such as "~/.perles/daemon/perles.sock" when Network is "unix").
 	Addr string
+	// Network is "tcp" (default) or "unix".
+	Network string
+	// Tokens authenticates requests with a bearer token when set.
+	// Required for TCP, since any local user can connect to a TCP port.
+	// Unix sockets are protected by file permissions and may omit it.
+	Tokens *TokenFile
 	// ControlPlane is the control plane to expose via HTTP.
 	ControlPlane controlplane.ControlPlane
 	// WorkflowCreator creates epics and tasks in beads (optional).
 	WorkflowCreator *appreg.WorkflowCreator
 	// RegistryService provides access to workflow templates (optional).
 	RegistryService *appreg.RegistryService
+	// Schedules manages workflow schedules (optional).
+	Schedules controlplane.CronScheduler
 	// FrontendFS provides the embedded frontend assets filesystem.
 	// When set, the embedded frontend SPA is served at / with session APIs.
 	FrontendFS fs.FS
@@ -707,6 +873,9 @@ type ServerConfig struct {
 	WriteTimeout time.Duration
 }
 
+// APIPrefix is the path prefix the workflow API is served under.
+const APIPrefix = "/api/v1"
+
 // NewServer creates a new API server.
 // If Addr uses port 0 (e.g., "localhost:0" or ":0"), the OS will assign an available port.
 // Use Port() after Start() to get the actual port.
@@ -715,6 +884,7 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		ControlPlane:    cfg.ControlPlane,
 		WorkflowCreator: cfg.WorkflowCreator,
 		RegistryService: cfg.RegistryService,
+		Schedules:       cfg.Schedules,
 	})
 
 	readTimeout := cfg.ReadTimeout
@@ -727,10 +897,27 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		writeTimeout = 0 // No timeout for SSE
 	}
 
-	// Create listener first to get the actual port (important for :0)
-	listener, err := net.Listen("tcp", cfg.Addr)
-	if err != nil {
-		return nil, fmt.Errorf("failed to listen on %s: %w", cfg.Addr, err)
+	var listener net.Listener
+	var err error
+	if cfg.Network == "unix" {
+		listener, err = listenUnix(cfg.Addr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Any local user can reach a TCP port, so refuse to serve without a token
+		if cfg.Tokens == nil {
+			return nil, fmt.Errorf("refusing to listen on TCP without authentication: %w", ErrNoToken)
+		}
+		if _, err := cfg.Tokens.Load(); err != nil {
+			return nil, fmt.Errorf("refusing to listen on TCP without authentication: %w", err)
+		}
+
+		// Create listener first to get the actual port (important for :0)
+		listener, err = net.Listen("tcp", cfg.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen on %s: %w", cfg.Addr, err)
+		}
 	}
 
 	// Extract actual port from listener address
@@ -739,14 +926,14 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		port = tcpAddr.Port
 	}
 
-	// Build the combined HTTP handler
-	var httpHandler http.Handler
-	if cfg.FrontendFS != nil {
-		mux := http.NewServeMux()
-
-		// Mount workflow API routes under /api/v1/
-		mux.Handle("/api/v1/", http.StripPrefix("/api/v1", handler.Routes()))
+	// Build the combined HTTP handler. The workflow API is always mounted
+	// under /api/v1/, which is where Client sends its requests.
+	routes := handler.Routes()
+	mux := http.NewServeMux()
+	mux.Handle(APIPrefix+"/", http.StripPrefix(APIPrefix, routes))
 
+	var httpHandler http.Handler = mux
+	if cfg.FrontendFS != nil {
 		// Mount frontend session APIs and SPA
 		spaFS, err := fs.Sub(cfg.FrontendFS, "dist")
 		if err != nil {
@@ -756,10 +943,13 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		frontendHandler := frontend.NewHandler(session.DefaultBaseDir(), spaFS, cfg.ControlPlane)
 		frontendHandler.RegisterAPIRoutes(mux)
 		frontendHandler.RegisterSPAHandler(mux)
-
-		httpHandler = mux
 	} else {
-		httpHandler = handler.Routes()
+		// Without the frontend, the workflow API is also served at the root
+		mux.Handle("/", routes)
+	}
+
+	if cfg.Tokens != nil {
+		httpHandler = requireToken(cfg.Tokens, httpHandler)
 	}
 
 	return &Server{
@@ -778,14 +968,23 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 
 // Start starts the HTTP server. It blocks until the server is stopped or fails.
 func (s *Server) Start() error {
-	log.Info(log.CatOrch, "Starting API server", "addr", s.listener.Addr().String(), "port", s.port)
+	log.Info(log.CatOrch, "Starting API server", "network", s.listener.Addr().Network(), "addr", s.listener.Addr().String(), "port", s.port)
 	return s.server.Serve(s.listener)
 }
 
 // Stop gracefully shuts down the server.
 func (s *Server) Stop(ctx context.Context) error {
 	log.Info(log.CatOrch, "Stopping API server")
-	return s.server.Shutdown(ctx)
+	err := s.server.Shutdown(ctx)
+	// Shutdown only closes listeners passed to Serve; close ours in case Start
+	// was never called, which also removes a Unix socket file.
+	_ = s.listener.Close()
+	return err
+}
+
+// Addr returns the address the server is listening on: host:port, or the socket path.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
 }
 
 // Port returns the actual port the server is listening on.
@@ -793,3 +992,43 @@ func (s *Server) Stop(ctx context.Context) error {
 func (s *Server) Port() int {
 	return s.port
 }
+
+// listenUnix listens on a Unix socket only the current user can connect to.
+// The socket's directory must not be writable by other users, who could
+// otherwise replace the socket. A stale socket left by a crashed daemon is removed.
+func listenUnix(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating socket directory: %w", err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("checking socket directory: %w", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o022 != 0 {
+		return nil, fmt.Errorf("socket directory %s is writable by other users (mode %s)", dir, info.Mode().Perm())
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		if conn, err := net.Dial("unix", path); err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("another daemon is already listening on %s", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("removing stale socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("restricting socket permissions: %w", err)
+	}
+	return listener, nil
+}
@@ -3,8 +3,11 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"testing/fstest"
 
@@ -74,6 +77,8 @@ func TestHandler_Get(t *testing.T) {
 			State:         controlplane.WorkflowRunning,
 			InitialPrompt: "Build feature",
 			MCPPort:       19001,
+			CostUSD:       1.5,
+			BudgetUSD:     6,
 		}, nil).
 		Once()
 	mockCP.EXPECT().
@@ -100,6 +105,8 @@ func TestHandler_Get(t *testing.T) {
 	assert.Equal(t, "running", resp.State)
 	assert.Equal(t, 19001, resp.Port)
 	assert.True(t, resp.IsHealthy)
+	assert.InDelta(t, 1.5, resp.CostUSD, 1e-9)
+	assert.InDelta(t, 0.25, resp.BudgetBurn, 1e-9)
 }
 
 func TestHandler_Get_NotFound(t *testing.T) {
@@ -136,6 +143,118 @@ func TestHandler_Start(t *testing.T) {
 	require.Equal(t, http.StatusNoContent, w.Code)
 }
 
+func TestHandler_Start_BudgetExceeded(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Start(mock.Anything, controlplane.WorkflowID("wf-123")).
+		Return(fmt.Errorf("acquiring workflow slot: %w", controlplane.ErrBudgetExceeded)).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	req := httptest.NewRequest(http.MethodPost, "/workflows/wf-123/start", nil)
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	var resp ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "budget_exceeded", resp.Code)
+}
+
+func TestHandler_Create_PriorityAndBudget(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.Priority == 5 && spec.BudgetUSD == 2.5 && spec.BudgetTokens == 100000
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "priority": 5, "budget_usd": 2.5, "budget_tokens": 100000}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_ThenChainsWorkflows(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.TemplateID == "research_proposal" && len(spec.After) == 0
+		})).
+		Return(controlplane.WorkflowID("wf-1"), nil).
+		Once()
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.TemplateID == "cook" && spec.InitialPrompt == "" &&
+				slices.Equal(spec.After, []controlplane.WorkflowID{"wf-1"}) &&
+				spec.WorktreeEnabled && spec.WorktreeBaseBranch == "main" && spec.WorkerWorktrees
+		})).
+		Return(controlplane.WorkflowID("wf-2"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "research_proposal", "worktree_enabled": true, "worktree_base_branch": "main",
+		"worker_worktrees": true, "then": [{"template_id": "cook"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var resp CreateWorkflowResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, CreateWorkflowResponse{ID: "wf-1", Chain: []string{"wf-2"}}, resp)
+}
+
+func TestHandler_Create_ThenRollsBackOnFailure(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Create(mock.Anything, mock.Anything).Return(controlplane.WorkflowID("wf-1"), nil).Once()
+	mockCP.EXPECT().Create(mock.Anything, mock.Anything).Return(controlplane.WorkflowID(""), errors.New("boom")).Once()
+	mockCP.EXPECT().Delete(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "research_proposal", "then": [{"template_id": "cook"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Create_AfterSkipsSpecBuild(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.TemplateID == "cook" && spec.Args["reviewer"] == "alice" &&
+				slices.Equal(spec.After, []controlplane.WorkflowID{"wf-1"})
+		})).
+		Return(controlplane.WorkflowID("wf-2"), nil).
+		Once()
+
+	h := NewHandler(mockCP)
+
+	body := `{"template_id": "cook", "after": ["wf-1"], "args": {"reviewer": "alice"}}`
+	req := httptest.NewRequest(http.MethodPost, "/workflows", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+
+	h.Routes().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
 func TestHandler_Pause(t *testing.T) {
 	mockCP := mocks.NewMockControlPlane(t)
 	mockCP.EXPECT().
@@ -258,21 +377,6 @@ func TestHandler_Resume_InvalidState(t *testing.T) {
 	assert.Equal(t, "invalid_state", resp.Code)
 }
 
-func TestHandler_Stop_EndpointRemoved(t *testing.T) {
-	// The /stop endpoint should return 404 (not found) since it's been removed
-	mockCP := mocks.NewMockControlPlane(t)
-
-	h := NewHandler(mockCP)
-
-	req := httptest.NewRequest(http.MethodPost, "/workflows/wf-123/stop", nil)
-	w := httptest.NewRecorder()
-
-	h.Routes().ServeHTTP(w, req)
-
-	// http.ServeMux returns 404 for unregistered routes
-	require.Equal(t, http.StatusNotFound, w.Code)
-}
-
 func TestHandler_List(t *testing.T) {
 	mockCP := mocks.NewMockControlPlane(t)
 	mockCP.EXPECT().
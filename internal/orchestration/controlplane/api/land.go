@@ -0,0 +1,118 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+)
+
+// === Request/Response Types ===
+
+// LandWorkflowRequest is the optional request body for landing a workflow.
+type LandWorkflowRequest struct {
+	// Squash commits the branch's changes as a single commit instead of a merge commit.
+	Squash bool `json:"squash,omitempty"`
+	// Message is the commit message (optional, defaults to one naming the workflow).
+	Message string `json:"message,omitempty"`
+	// Cleanup removes the worktree and deletes the branch after landing.
+	// Only allowed once the workflow is no longer running or paused.
+	Cleanup bool `json:"cleanup,omitempty"`
+}
+
+// LandFileStatResponse is the diff stat of a single changed file.
+type LandFileStatResponse struct {
+	Path      string `json:"path"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// LandPreviewResponse is the response body for previewing a land.
+type LandPreviewResponse struct {
+	Branch     string                 `json:"branch"`
+	BaseBranch string                 `json:"base_branch"`
+	Files      []LandFileStatResponse `json:"files"`
+	Additions  int                    `json:"additions"`
+	Deletions  int                    `json:"deletions"`
+	// Conflicts lists the paths that conflict with the base branch.
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// LandWorkflowResponse is the response body for a landed workflow.
+type LandWorkflowResponse struct {
+	Branch     string `json:"branch"`
+	BaseBranch string `json:"base_branch"`
+	Squashed   bool   `json:"squashed"`
+	CleanedUp  bool   `json:"cleaned_up"`
+}
+
+// === Handlers ===
+
+// PreviewLand returns the diff stat of a workflow's branch against its base
+// branch and the paths a merge would conflict on.
+// GET /workflows/{id}/land
+func (h *Handler) PreviewLand(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	preview, err := h.cp.PreviewLand(r.Context(), id)
+	if err != nil {
+		h.writeControlError(w, err, "land_preview_failed", "Failed to preview land")
+		return
+	}
+
+	additions, deletions := preview.Totals()
+	resp := LandPreviewResponse{
+		Branch:     preview.Branch,
+		BaseBranch: preview.BaseBranch,
+		Files:      make([]LandFileStatResponse, 0, len(preview.Files)),
+		Additions:  additions,
+		Deletions:  deletions,
+		Conflicts:  preview.Conflicts,
+	}
+	for _, f := range preview.Files {
+		resp.Files = append(resp.Files, LandFileStatResponse{Path: f.Path, Additions: f.Additions, Deletions: f.Deletions})
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// Land merges or squashes a workflow's branch into its base branch.
+// POST /workflows/{id}/land
+func (h *Handler) Land(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var req LandWorkflowRequest
+	if !h.decodeOptionalJSON(w, r, &req) {
+		return
+	}
+
+	result, err := h.cp.Land(r.Context(), id, controlplane.LandOptions{
+		Squash:  req.Squash,
+		Message: req.Message,
+		Cleanup: req.Cleanup,
+	})
+	if err != nil {
+		h.writeControlError(w, err, "land_failed", "Failed to land workflow")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, LandWorkflowResponse{
+		Branch:     result.Branch,
+		BaseBranch: result.BaseBranch,
+		Squashed:   result.Squashed,
+		CleanedUp:  result.CleanedUp,
+	})
+}
+
+// ResolveLandConflicts asks the coordinator to resolve the conflicts that
+// block landing a workflow.
+// POST /workflows/{id}/land/resolve
+func (h *Handler) ResolveLandConflicts(w http.ResponseWriter, r *http.Request) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	if err := h.cp.ResolveLandConflicts(r.Context(), id); err != nil {
+		h.writeControlError(w, err, "resolve_failed", "Failed to hand conflicts to the coordinator")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
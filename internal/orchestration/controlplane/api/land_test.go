@@ -0,0 +1,87 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+)
+
+func TestHandler_PreviewLand(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().PreviewLand(mock.Anything, controlplane.WorkflowID("wf-123")).Return(&controlplane.LandPreview{
+		Branch:     "perles-auth",
+		BaseBranch: "main",
+		Files:      []controlplane.LandFileStat{{Path: "auth.go", Additions: 10, Deletions: 2}, {Path: "auth_test.go", Additions: 5}},
+		Conflicts:  []string{"go.mod"},
+	}, nil).Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodGet, "/workflows/wf-123/land", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp LandPreviewResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, LandPreviewResponse{
+		Branch:     "perles-auth",
+		BaseBranch: "main",
+		Files:      []LandFileStatResponse{{Path: "auth.go", Additions: 10, Deletions: 2}, {Path: "auth_test.go", Additions: 5}},
+		Additions:  15,
+		Deletions:  2,
+		Conflicts:  []string{"go.mod"},
+	}, resp)
+}
+
+func TestHandler_Land(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Land(mock.Anything, controlplane.WorkflowID("wf-123"), controlplane.LandOptions{Squash: true, Message: "Add auth", Cleanup: true}).
+		Return(&controlplane.LandResult{Branch: "perles-auth", BaseBranch: "main", Squashed: true, CleanedUp: true}, nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/land",
+		`{"squash": true, "message": "Add auth", "cleanup": true}`)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp LandWorkflowResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, LandWorkflowResponse{Branch: "perles-auth", BaseBranch: "main", Squashed: true, CleanedUp: true}, resp)
+}
+
+func TestHandler_Land_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"conflicts", fmt.Errorf("%w: go.mod", controlplane.ErrMergeConflicts), http.StatusConflict, "merge_conflicts"},
+		{"no worktree", controlplane.ErrNoWorktree, http.StatusBadRequest, "no_worktree"},
+		{"uncommitted changes", controlplane.ErrUncommittedChanges, http.StatusConflict, "uncommitted_changes"},
+		{"other", fmt.Errorf("boom"), http.StatusInternalServerError, "land_failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCP := mocks.NewMockControlPlane(t)
+			mockCP.EXPECT().Land(mock.Anything, mock.Anything, mock.Anything).Return(nil, tt.err).Once()
+
+			w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/land", "")
+
+			requireErrorCode(t, w, tt.status, tt.code)
+		})
+	}
+}
+
+func TestHandler_ResolveLandConflicts(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().ResolveLandConflicts(mock.Anything, controlplane.WorkflowID("wf-123")).Return(nil).Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows/wf-123/land/resolve", "")
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// openAPIVersion is the OpenAPI specification version of the generated document.
+const openAPIVersion = "3.1.0"
+
+// pathParamPattern matches {name} path parameters in route patterns.
+var pathParamPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// OpenAPI serves the OpenAPI document describing every route.
+// GET /openapi.json
+func (h *Handler) OpenAPI(w http.ResponseWriter, _ *http.Request) {
+	h.writeJSON(w, http.StatusOK, buildOpenAPI(h.routes()))
+}
+
+// buildOpenAPI generates an OpenAPI document from the route table. Request and
+// response schemas are derived from the handler types' JSON tags.
+func buildOpenAPI(routes []route) map[string]any {
+	schemas := map[string]any{}
+	paths := map[string]any{}
+
+	for _, rt := range routes {
+		op := map[string]any{
+			"summary":     rt.summary,
+			"operationId": rt.handlerName(),
+			"responses":   rt.responses(schemas),
+		}
+
+		var params []any
+		for _, m := range pathParamPattern.FindAllStringSubmatch(rt.path, -1) {
+			params = append(params, map[string]any{
+				"name": m[1], "in": "path", "required": true,
+				"schema": map[string]any{"type": "string"},
+			})
+		}
+		for _, q := range rt.query {
+			params = append(params, map[string]any{
+				"name": q, "in": "query",
+				"schema": map[string]any{"type": "string"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+
+		if rt.request != nil {
+			op["requestBody"] = map[string]any{
+				"required": isRequiredBody(rt.request),
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": schemaRef(reflect.TypeOf(rt.request), schemas)},
+				},
+			}
+		}
+
+		item, _ := paths[rt.path].(map[string]any)
+		if item == nil {
+			item = map[string]any{}
+			paths[rt.path] = item
+		}
+		item[strings.ToLower(rt.method)] = op
+	}
+
+	schemaRef(reflect.TypeOf(ErrorResponse{}), schemas)
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":       "Perles Control Plane API",
+			"version":     "v1",
+			"description": "Manage orchestration workflows. Served under /api/v1, and also at the daemon root when the web frontend is disabled.",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+}
+
+// responses documents the success response and the shared error response.
+func (rt route) responses(schemas map[string]any) map[string]any {
+	success := map[string]any{"description": http.StatusText(rt.status)}
+	switch {
+	case rt.stream:
+		success["content"] = map[string]any{
+			"text/event-stream": map[string]any{"schema": map[string]any{"type": "string"}},
+		}
+	case rt.response != nil:
+		success["content"] = map[string]any{
+			"application/json": map[string]any{"schema": schemaRef(reflect.TypeOf(rt.response), schemas)},
+		}
+	}
+
+	return map[string]any{
+		strconv.Itoa(rt.status): success,
+		"default": map[string]any{
+			"description": "Error",
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/ErrorResponse"}},
+			},
+		},
+	}
+}
+
+// handlerName returns the name of the route's handler method, used as operationId.
+func (rt route) handlerName() string {
+	name := runtime.FuncForPC(reflect.ValueOf(rt.handler).Pointer()).Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.TrimSuffix(name, "-fm")
+}
+
+// isRequiredBody reports whether a request type has any required field.
+func isRequiredBody(v any) bool {
+	t := reflect.TypeOf(v)
+	for i := range t.NumField() {
+		if name, omitempty := jsonField(t.Field(i)); name != "" && !omitempty {
+			return true
+		}
+	}
+	return false
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaRef returns the JSON schema for t. Named structs are added to schemas
+// and referenced so each type is described once.
+func schemaRef(t reflect.Type, schemas map[string]any) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Kind() == reflect.Struct:
+		name := t.Name()
+		if _, ok := schemas[name]; !ok {
+			schemas[name] = nil // Reserve the name before recursing
+			schemas[name] = structSchema(t, schemas)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": schemaRef(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaRef(t.Elem(), schemas)}
+	default:
+		return map[string]any{}
+	}
+}
+
+// structSchema describes a struct's JSON fields. Fields without omitempty are required.
+func structSchema(t reflect.Type, schemas map[string]any) map[string]any {
+	properties := map[string]any{}
+	var required []string
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, omitempty := jsonField(field)
+		if name == "" {
+			continue
+		}
+		properties[name] = schemaRef(field.Type, schemas)
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// jsonField returns a field's JSON name and whether it is omitempty.
+// The name is empty for unexported or skipped fields.
+func jsonField(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, strings.Contains(opts, "omitempty")
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+)
+
+func TestHandler_OpenAPI_DocumentsEveryRoute(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+
+	w := serveRequest(t, h, http.MethodGet, "/openapi.json", "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var doc struct {
+		OpenAPI string                               `json:"openapi"`
+		Paths   map[string]map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	require.Equal(t, openAPIVersion, doc.OpenAPI)
+
+	for _, rt := range h.routes() {
+		op, ok := doc.Paths[rt.path][strings.ToLower(rt.method)]
+		require.True(t, ok, "%s %s is not documented", rt.method, rt.path)
+		assert.NotEmpty(t, op["summary"], "%s %s has no summary", rt.method, rt.path)
+		assert.NotEmpty(t, op["operationId"], "%s %s has no operationId", rt.method, rt.path)
+	}
+}
+
+func TestBuildOpenAPI_SchemasFromHandlerTypes(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+	doc := buildOpenAPI(h.routes())
+
+	schemas := doc["components"].(map[string]any)["schemas"].(map[string]any)
+
+	create := schemas["CreateWorkflowRequest"].(map[string]any)
+	assert.Equal(t, []string{"template_id"}, create["required"])
+	props := create["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "number"}, props["budget_usd"])
+	assert.Equal(t, map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}}, props["args"])
+
+	workflow := schemas["WorkflowResponse"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string", "format": "date-time"}, workflow["created_at"])
+
+	list := schemas["ListProcessesResponse"].(map[string]any)["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{
+		"type":  "array",
+		"items": map[string]any{"$ref": "#/components/schemas/ProcessResponse"},
+	}, list["processes"])
+	require.Contains(t, schemas, "ProcessMetrics")
+	require.Contains(t, schemas, "ErrorResponse")
+
+	paths := doc["paths"].(map[string]any)
+	stop := paths["/workflows/{id}/stop"].(map[string]any)["post"].(map[string]any)
+	assert.Equal(t, "Stop", stop["operationId"])
+	assert.Equal(t, false, stop["requestBody"].(map[string]any)["required"])
+
+	message := paths["/workflows/{id}/message"].(map[string]any)["post"].(map[string]any)
+	assert.Equal(t, true, message["requestBody"].(map[string]any)["required"])
+}
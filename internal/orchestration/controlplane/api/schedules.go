@@ -0,0 +1,261 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/schedules/domain"
+)
+
+// === Request/Response Types ===
+
+// CreateScheduleRequest is the request body for creating a schedule.
+type CreateScheduleRequest struct {
+	// Name is the display name for the schedule (required).
+	Name string `json:"name"`
+	// Cron is a five-field cron expression such as "0 2 * * *", or a macro
+	// such as "@daily" (required). Times are in the daemon's local time zone.
+	Cron string `json:"cron"`
+	// TemplateID is the workflow template to run (required).
+	TemplateID string `json:"template_id"`
+	// TargetQuery is a BQL query; each run starts a workflow for every matching
+	// issue that has not had one, passing its ID as epic_id (optional).
+	TargetQuery string `json:"target_query,omitempty"`
+	// Args are template-defined argument values (optional).
+	Args map[string]string `json:"args,omitempty"`
+	// Labels are applied to the created workflows (optional).
+	Labels map[string]string `json:"labels,omitempty"`
+	// WorktreeEnabled indicates whether to create a git worktree (optional).
+	WorktreeEnabled bool `json:"worktree_enabled,omitempty"`
+	// WorktreeBaseBranch is the branch to base the worktree on (required if worktree_enabled).
+	WorktreeBaseBranch string `json:"worktree_base_branch,omitempty"`
+	// Priority orders the created workflows in the start queue (optional).
+	Priority int `json:"priority,omitempty"`
+	// BudgetUSD caps each created workflow's spend in USD (optional).
+	BudgetUSD float64 `json:"budget_usd,omitempty"`
+}
+
+// ScheduleResponse is the response body for a single schedule.
+type ScheduleResponse struct {
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	Cron               string            `json:"cron"`
+	TemplateID         string            `json:"template_id"`
+	TargetQuery        string            `json:"target_query,omitempty"`
+	Args               map[string]string `json:"args,omitempty"`
+	Labels             map[string]string `json:"labels,omitempty"`
+	WorktreeEnabled    bool              `json:"worktree_enabled,omitempty"`
+	WorktreeBaseBranch string            `json:"worktree_base_branch,omitempty"`
+	Priority           int               `json:"priority,omitempty"`
+	BudgetUSD          float64           `json:"budget_usd,omitempty"`
+	Enabled            bool              `json:"enabled"`
+	NextRunAt          *time.Time        `json:"next_run_at,omitempty"`
+	LastRunAt          *time.Time        `json:"last_run_at,omitempty"`
+	PendingWorkflowID  string            `json:"pending_workflow_id,omitempty"`
+	CreatedAt          time.Time         `json:"created_at"`
+}
+
+// ListSchedulesResponse is the response body for listing schedules.
+type ListSchedulesResponse struct {
+	Schedules []ScheduleResponse `json:"schedules"`
+	Total     int                `json:"total"`
+}
+
+// RunScheduleResponse is the response body for running a schedule now.
+type RunScheduleResponse struct {
+	WorkflowIDs []string `json:"workflow_ids"`
+	// Error describes targets that failed when others were started.
+	Error string `json:"error,omitempty"`
+}
+
+// QueuedWorkflowResponse is a workflow waiting for a workflow slot.
+type QueuedWorkflowResponse struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Priority         int        `json:"priority,omitempty"`
+	QueuedAt         time.Time  `json:"queued_at"`
+	EstimatedStartAt *time.Time `json:"estimated_start_at,omitempty"`
+}
+
+// QueueResponse is the response body for the start queue.
+type QueueResponse struct {
+	Workflows []QueuedWorkflowResponse `json:"workflows"`
+	Total     int                      `json:"total"`
+}
+
+// === Handlers ===
+
+// Queue returns the workflows waiting for a slot, in start order.
+// GET /queue
+func (h *Handler) Queue(w http.ResponseWriter, r *http.Request) {
+	queue := h.cp.Queue()
+	resp := QueueResponse{
+		Workflows: make([]QueuedWorkflowResponse, 0, len(queue)),
+		Total:     len(queue),
+	}
+	for _, q := range queue {
+		item := QueuedWorkflowResponse{
+			ID:       string(q.ID),
+			Name:     q.Name,
+			Priority: q.Priority,
+			QueuedAt: q.QueuedAt,
+		}
+		if !q.EstimatedStartAt.IsZero() {
+			eta := q.EstimatedStartAt
+			item.EstimatedStartAt = &eta
+		}
+		resp.Workflows = append(resp.Workflows, item)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// CreateSchedule creates an enabled schedule.
+// POST /schedules
+func (h *Handler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSchedules(w) {
+		return
+	}
+
+	var req CreateScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_json", "Invalid JSON body", err.Error())
+		return
+	}
+
+	schedule, err := h.schedules.CreateSchedule(r.Context(), controlplane.ScheduleSpec{
+		Name:               req.Name,
+		Cron:               req.Cron,
+		TemplateID:         req.TemplateID,
+		TargetQuery:        req.TargetQuery,
+		Args:               req.Args,
+		Labels:             req.Labels,
+		WorktreeEnabled:    req.WorktreeEnabled,
+		WorktreeBaseBranch: req.WorktreeBaseBranch,
+		Priority:           req.Priority,
+		BudgetUSD:          req.BudgetUSD,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "validation_error", err.Error(), "")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, scheduleToResponse(schedule))
+}
+
+// ListSchedules returns all schedules.
+// GET /schedules
+func (h *Handler) ListSchedules(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSchedules(w) {
+		return
+	}
+
+	schedules, err := h.schedules.ListSchedules(r.Context())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "list_failed", "Failed to list schedules", err.Error())
+		return
+	}
+
+	resp := ListSchedulesResponse{
+		Schedules: make([]ScheduleResponse, 0, len(schedules)),
+		Total:     len(schedules),
+	}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, scheduleToResponse(schedule))
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// GetSchedule returns a single schedule.
+// GET /schedules/{id}
+func (h *Handler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSchedules(w) {
+		return
+	}
+
+	schedule, err := h.schedules.GetSchedule(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeScheduleError(w, err, "get_failed", "Failed to get schedule")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, scheduleToResponse(schedule))
+}
+
+// DeleteSchedule deletes a schedule and its upcoming pending workflow.
+// DELETE /schedules/{id}
+func (h *Handler) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSchedules(w) {
+		return
+	}
+
+	if err := h.schedules.DeleteSchedule(r.Context(), r.PathValue("id")); err != nil {
+		h.writeScheduleError(w, err, "delete_failed", "Failed to delete schedule")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunSchedule runs a schedule now without changing its next run.
+// POST /schedules/{id}/run
+func (h *Handler) RunSchedule(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSchedules(w) {
+		return
+	}
+
+	ids, err := h.schedules.RunSchedule(r.Context(), r.PathValue("id"))
+	if err != nil && len(ids) == 0 {
+		h.writeScheduleError(w, err, "run_failed", "Failed to run schedule")
+		return
+	}
+
+	resp := RunScheduleResponse{WorkflowIDs: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		resp.WorkflowIDs = append(resp.WorkflowIDs, string(id))
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// requireSchedules writes a 503 and returns false when schedules are not configured.
+func (h *Handler) requireSchedules(w http.ResponseWriter) bool {
+	if h.schedules == nil {
+		h.writeError(w, http.StatusServiceUnavailable, "schedules_unavailable",
+			"Schedules are not available", controlplane.ErrSchedulesUnavailable.Error())
+		return false
+	}
+	return true
+}
+
+// writeScheduleError maps schedule errors to HTTP responses.
+func (h *Handler) writeScheduleError(w http.ResponseWriter, err error, code, message string) {
+	if errors.Is(err, controlplane.ErrScheduleNotFound) {
+		h.writeError(w, http.StatusNotFound, "not_found", "Schedule not found", "")
+		return
+	}
+	h.writeError(w, http.StatusInternalServerError, code, message, err.Error())
+}
+
+func scheduleToResponse(s *domain.Schedule) ScheduleResponse {
+	return ScheduleResponse{
+		ID:                 s.GUID,
+		Name:               s.Name,
+		Cron:               s.Cron,
+		TemplateID:         s.TemplateID,
+		TargetQuery:        s.TargetQuery,
+		Args:               s.Args,
+		Labels:             s.Labels,
+		WorktreeEnabled:    s.WorktreeEnabled,
+		WorktreeBaseBranch: s.WorktreeBaseBranch,
+		Priority:           s.Priority,
+		BudgetUSD:          s.BudgetUSD,
+		Enabled:            s.Enabled,
+		NextRunAt:          s.NextRunAt,
+		LastRunAt:          s.LastRunAt,
+		PendingWorkflowID:  s.PendingWorkflowID,
+		CreatedAt:          s.CreatedAt,
+	}
+}
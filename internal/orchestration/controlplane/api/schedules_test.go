@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+	"github.com/zjrosen/perles/internal/schedules/domain"
+)
+
+func newScheduleTestHandler(t *testing.T) (*Handler, *mocks.MockControlPlane, *mocks.MockCronScheduler) {
+	t.Helper()
+	mockCP := mocks.NewMockControlPlane(t)
+	mockSchedules := mocks.NewMockCronScheduler(t)
+	return NewHandlerWithConfig(HandlerConfig{ControlPlane: mockCP, Schedules: mockSchedules}), mockCP, mockSchedules
+}
+
+func TestHandler_Create_StartAt(t *testing.T) {
+	startAt := time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(spec controlplane.WorkflowSpec) bool {
+			return spec.StartAt.Equal(startAt)
+		})).
+		Return(controlplane.WorkflowID("wf-123"), nil).
+		Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodPost, "/workflows",
+		`{"template_id": "cook", "start_at": "2026-01-16T02:00:00Z"}`)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Get_ScheduledAndQueuedETA(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	eta := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().GetHealthStatus(mock.Anything).Return(controlplane.HealthStatus{}, false).Maybe()
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-scheduled")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-scheduled", State: controlplane.WorkflowPending, ScheduledAt: &scheduledAt}, nil).Once()
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-queued")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-queued", State: controlplane.WorkflowPending, QueuedAt: time.Now()}, nil).Once()
+	mockCP.EXPECT().Queue().Return([]controlplane.QueuedWorkflow{{ID: "wf-queued", EstimatedStartAt: eta}}).Once()
+	h := NewHandler(mockCP)
+
+	var resp WorkflowResponse
+	w := serveRequest(t, h, http.MethodGet, "/workflows/wf-scheduled", "")
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.True(t, scheduledAt.Equal(*resp.ScheduledAt))
+	require.True(t, scheduledAt.Equal(*resp.EstimatedStartAt))
+
+	resp = WorkflowResponse{}
+	w = serveRequest(t, h, http.MethodGet, "/workflows/wf-queued", "")
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.NotNil(t, resp.QueuedAt)
+	require.True(t, eta.Equal(*resp.EstimatedStartAt))
+}
+
+func TestHandler_Queue(t *testing.T) {
+	queuedAt := time.Now().Add(-time.Minute)
+	eta := time.Now().Add(time.Hour)
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Queue().Return([]controlplane.QueuedWorkflow{
+		{ID: "wf-1", Name: "First", Priority: 2, QueuedAt: queuedAt, EstimatedStartAt: eta},
+		{ID: "wf-2", Name: "Second", QueuedAt: queuedAt},
+	}).Once()
+
+	w := serveRequest(t, NewHandler(mockCP), http.MethodGet, "/queue", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp QueueResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, "wf-1", resp.Workflows[0].ID)
+	require.NotNil(t, resp.Workflows[0].EstimatedStartAt)
+	require.Nil(t, resp.Workflows[1].EstimatedStartAt)
+}
+
+func TestHandler_CreateSchedule(t *testing.T) {
+	h, _, mockSchedules := newScheduleTestHandler(t)
+	nextRun := time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)
+	mockSchedules.EXPECT().
+		CreateSchedule(mock.Anything, controlplane.ScheduleSpec{
+			Name:        "Plan epics",
+			Cron:        "0 2 * * *",
+			TemplateID:  "research_to_tasks",
+			TargetQuery: "type = epic and label = needs-plan",
+		}).
+		Return(&domain.Schedule{GUID: "sch-1", Name: "Plan epics", Cron: "0 2 * * *", Enabled: true, NextRunAt: &nextRun}, nil).
+		Once()
+
+	w := serveRequest(t, h, http.MethodPost, "/schedules",
+		`{"name": "Plan epics", "cron": "0 2 * * *", "template_id": "research_to_tasks", "target_query": "type = epic and label = needs-plan"}`)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	var resp ScheduleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "sch-1", resp.ID)
+	require.True(t, resp.Enabled)
+	require.True(t, nextRun.Equal(*resp.NextRunAt))
+}
+
+func TestHandler_CreateSchedule_Invalid(t *testing.T) {
+	h, _, mockSchedules := newScheduleTestHandler(t)
+	mockSchedules.EXPECT().CreateSchedule(mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("cron expression %q must have 5 fields, got 1", "nightly")).Once()
+
+	w := serveRequest(t, h, http.MethodPost, "/schedules", `{"name": "x", "cron": "nightly", "template_id": "cook"}`)
+
+	requireErrorCode(t, w, http.StatusBadRequest, "validation_error")
+}
+
+func TestHandler_ListSchedules(t *testing.T) {
+	h, _, mockSchedules := newScheduleTestHandler(t)
+	mockSchedules.EXPECT().ListSchedules(mock.Anything).
+		Return([]*domain.Schedule{{GUID: "sch-1"}, {GUID: "sch-2"}}, nil).Once()
+
+	w := serveRequest(t, h, http.MethodGet, "/schedules", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp ListSchedulesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 2, resp.Total)
+}
+
+func TestHandler_DeleteSchedule(t *testing.T) {
+	h, _, mockSchedules := newScheduleTestHandler(t)
+	mockSchedules.EXPECT().DeleteSchedule(mock.Anything, "sch-1").Return(nil).Once()
+	mockSchedules.EXPECT().DeleteSchedule(mock.Anything, "sch-missing").
+		Return(fmt.Errorf("%w: sch-missing", controlplane.ErrScheduleNotFound)).Once()
+
+	require.Equal(t, http.StatusNoContent, serveRequest(t, h, http.MethodDelete, "/schedules/sch-1", "").Code)
+	requireErrorCode(t, serveRequest(t, h, http.MethodDelete, "/schedules/sch-missing", ""), http.StatusNotFound, "not_found")
+}
+
+func TestHandler_RunSchedule(t *testing.T) {
+	h, _, mockSchedules := newScheduleTestHandler(t)
+	mockSchedules.EXPECT().RunSchedule(mock.Anything, "sch-1").
+		Return([]controlplane.WorkflowID{"wf-1"}, errors.New("perles-def2: template not found")).Once()
+	mockSchedules.EXPECT().RunSchedule(mock.Anything, "sch-2").
+		Return(nil, errors.New("running target query: syntax error")).Once()
+
+	// Partial failures still report the started workflows
+	w := serveRequest(t, h, http.MethodPost, "/schedules/sch-1/run", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp RunScheduleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, []string{"wf-1"}, resp.WorkflowIDs)
+	require.Contains(t, resp.Error, "perles-def2")
+
+	requireErrorCode(t, serveRequest(t, h, http.MethodPost, "/schedules/sch-2/run", ""), http.StatusInternalServerError, "run_failed")
+}
+
+func TestHandler_Schedules_Unavailable(t *testing.T) {
+	h := NewHandler(mocks.NewMockControlPlane(t))
+
+	w := serveRequest(t, h, http.MethodGet, "/schedules", "")
+
+	requireErrorCode(t, w, http.StatusServiceUnavailable, "schedules_unavailable")
+}
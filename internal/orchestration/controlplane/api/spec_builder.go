@@ -0,0 +1,146 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	appreg "github.com/zjrosen/perles/internal/registry/application"
+)
+
+// TemplateSpecBuilder builds workflow specs from registered workflow templates.
+// It validates template arguments, creates the workflow's epic in beads for
+// standard templates, and assembles the coordinator prompt. The API and the
+// CronScheduler share it so scheduled workflows match ones created on request.
+type TemplateSpecBuilder struct {
+	registryService *appreg.RegistryService
+	workflowCreator *appreg.WorkflowCreator
+}
+
+// Ensure TemplateSpecBuilder implements controlplane.SpecBuilder.
+var _ controlplane.SpecBuilder = (*TemplateSpecBuilder)(nil)
+
+// NewTemplateSpecBuilder creates a TemplateSpecBuilder. Both services are optional:
+// without a registry service arguments are not validated and the prompt has no
+// instructions, and without a workflow creator no epic is created.
+func NewTemplateSpecBuilder(registryService *appreg.RegistryService, workflowCreator *appreg.WorkflowCreator) *TemplateSpecBuilder {
+	return &TemplateSpecBuilder{
+		registryService: registryService,
+		workflowCreator: workflowCreator,
+	}
+}
+
+// epicCreationError is returned by BuildSpec when the workflow's epic could not be created.
+type epicCreationError struct {
+	err error
+}
+
+func (e *epicCreationError) Error() string {
+	return fmt.Sprintf("failed to create epic: %v", e.err)
+}
+
+func (e *epicCreationError) Unwrap() error {
+	return e.err
+}
+
+// BuildSpec returns a spec with the template, name, epic and coordinator prompt set.
+func (b *TemplateSpecBuilder) BuildSpec(templateID, name string, args map[string]string) (controlplane.WorkflowSpec, error) {
+	// Validate required template arguments if registry service is available
+	if b.registryService != nil {
+		if err := b.validateTemplateArgs(templateID, args); err != nil {
+			return controlplane.WorkflowSpec{}, err
+		}
+	}
+
+	var epicID string
+
+	// Check if this is an epic-driven workflow (uses existing epic from tracker)
+	isEpicDriven := false
+	if b.registryService != nil {
+		if reg, err := b.registryService.GetByKey("workflow", templateID); err == nil {
+			isEpicDriven = reg.IsEpicDriven()
+		}
+	}
+
+	if isEpicDriven {
+		// Epic-driven workflow: use the provided epic_id directly, skip workflowCreator
+		epicID = args["epic_id"]
+	} else if b.workflowCreator != nil {
+		// Standard workflow: create epic + tasks in beads first
+		// Use name as feature slug, or derive from templateID if empty
+		feature := name
+		if feature == "" {
+			feature = templateID
+		}
+
+		result, err := b.workflowCreator.CreateWithArgs(feature, templateID, args)
+		if err != nil {
+			return controlplane.WorkflowSpec{}, &epicCreationError{err: err}
+		}
+
+		epicID = result.Epic.ID
+	}
+
+	return controlplane.WorkflowSpec{
+		TemplateID: templateID,
+		Name:       name,
+		// Build coordinator prompt: instructions template + epic ID section
+		InitialPrompt: b.buildCoordinatorPrompt(templateID, epicID),
+		EpicID:        epicID,
+	}, nil
+}
+
+// validateTemplateArgs validates that required template arguments are present and non-empty.
+func (b *TemplateSpecBuilder) validateTemplateArgs(templateID string, args map[string]string) error {
+	reg, err := b.registryService.GetByKey("workflow", templateID)
+	if err != nil {
+		return fmt.Errorf("template not found: %s", templateID)
+	}
+
+	for _, arg := range reg.Arguments() {
+		if arg.Required() {
+			val := ""
+			if args != nil {
+				val = strings.TrimSpace(args[arg.Key()])
+			}
+			if val == "" {
+				return fmt.Errorf("%s is required", arg.Label())
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildCoordinatorPrompt assembles the coordinator prompt from:
+// 1. Instructions template content (from registration's instructions field)
+// 2. Epic ID section (so coordinator can read detailed instructions via bd show)
+func (b *TemplateSpecBuilder) buildCoordinatorPrompt(templateID, epicID string) string {
+	// Load system prompt template if registry service is available
+	var systemPromptContent string
+	if b.registryService != nil {
+		// Get the registration for this template
+		reg, err := b.registryService.GetByKey("workflow", templateID)
+		if err == nil {
+			content, err := b.registryService.GetSystemPromptTemplate(reg)
+			if err == nil {
+				systemPromptContent = content
+			}
+		}
+		// If error loading template, continue without it
+	}
+
+	// Build the full prompt
+	var parts []string
+
+	if systemPromptContent != "" {
+		parts = append(parts, systemPromptContent)
+	}
+
+	if epicID != "" {
+		epicSection := fmt.Sprintf("# Epic\n\nThe epic for this workflow is `%s`. Run `bd show %s` to read the detailed work breakdown and instructions.", epicID, epicID)
+		parts = append(parts, epicSection)
+	}
+
+	return strings.Join(parts, "\n\n")
+}
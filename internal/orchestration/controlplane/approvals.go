@@ -0,0 +1,28 @@
+package controlplane
+
+import (
+	"context"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Approvals returns the approval requests of a running workflow that await a
+// human decision, oldest first.
+func (cp *defaultControlPlane) Approvals(ctx context.Context, id WorkflowID) ([]*repository.Approval, error) {
+	inst, err := cp.runningWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+	approvals := inst.Infrastructure.Repositories.ApprovalRepo
+	if approvals == nil {
+		return nil, nil
+	}
+	return approvals.Pending(), nil
+}
+
+// ResolveApproval approves or rejects a pending approval request of a running
+// workflow, resuming the workers it paused.
+func (cp *defaultControlPlane) ResolveApproval(ctx context.Context, id WorkflowID, approvalID string, approved bool, feedback string) error {
+	return cp.submitProcessCommand(ctx, id, command.NewResolveApprovalCommand(command.SourceUser, approvalID, approved, feedback))
+}
@@ -0,0 +1,87 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newApprovalTestWorkflow creates a running workflow with one pending approval.
+func newApprovalTestWorkflow(t *testing.T) (ControlPlane, WorkflowID, repository.ApprovalRepository) {
+	t.Helper()
+
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+
+	approvalRepo := repository.NewMemoryApprovalRepository()
+	require.NoError(t, approvalRepo.Save(&repository.Approval{
+		ID:      "approval-1",
+		Summary: "Apply the migration to prod",
+		TaskIDs: []string{"perles-abc.1"},
+	}))
+
+	infra := createTestInfrastructure(t)
+	infra.Repositories.ApprovalRepo = approvalRepo
+	infra.Core.Processor.RegisterHandler(command.CmdResolveApproval, handler.NewResolveApprovalHandler(approvalRepo))
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	inst, _ := cp.(*defaultControlPlane).registry.Get(id)
+	inst.Infrastructure = infra
+	require.NoError(t, inst.TransitionTo(WorkflowRunning))
+
+	return cp, id, approvalRepo
+}
+
+func TestControlPlane_Approvals_ListsPending(t *testing.T) {
+	cp, id, _ := newApprovalTestWorkflow(t)
+
+	approvals, err := cp.Approvals(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	require.Equal(t, "approval-1", approvals[0].ID)
+}
+
+func TestControlPlane_Approvals_RequiresRunningWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+
+	_, err = cp.Approvals(ctx, id)
+	require.ErrorIs(t, err, ErrWorkflowNotRunning)
+}
+
+func TestControlPlane_ResolveApproval_RemovesPendingApproval(t *testing.T) {
+	cp, id, approvalRepo := newApprovalTestWorkflow(t)
+
+	err := cp.ResolveApproval(context.Background(), id, "approval-1", true, "")
+	require.NoError(t, err)
+	require.Empty(t, approvalRepo.Pending())
+}
+
+func TestControlPlane_ResolveApproval_RejectRequiresFeedback(t *testing.T) {
+	cp, id, approvalRepo := newApprovalTestWorkflow(t)
+
+	err := cp.ResolveApproval(context.Background(), id, "approval-1", false, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "feedback")
+	require.Len(t, approvalRepo.Pending(), 1)
+}
+
+func TestControlPlane_ResolveApproval_UnknownApproval(t *testing.T) {
+	cp, id, _ := newApprovalTestWorkflow(t)
+
+	err := cp.ResolveApproval(context.Background(), id, "approval-missing", true, "")
+	require.ErrorIs(t, err, repository.ErrApprovalNotFound)
+}
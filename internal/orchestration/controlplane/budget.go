@@ -0,0 +1,137 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// DefaultBudgetThresholds are the soft budget thresholds, as fractions of a
+// workflow's budget, at which the user is notified.
+var DefaultBudgetThresholds = []float64{0.5, 0.8}
+
+// crossedThreshold returns the highest threshold the burn moved past,
+// i.e. prev < threshold <= cur.
+func crossedThreshold(thresholds []float64, prev, cur float64) (float64, bool) {
+	crossed, ok := 0.0, false
+	for _, t := range thresholds {
+		if prev < t && cur >= t && t > crossed {
+			crossed, ok = t, true
+		}
+	}
+	return crossed, ok
+}
+
+// handleUsage checks a workflow's budget after token usage was recorded.
+// Soft thresholds notify the user; reaching the budget pauses the workflow.
+// Only crossings trigger actions, so a workflow resumed past its budget keeps
+// running until the user pauses it.
+func (cp *defaultControlPlane) handleUsage(inst *WorkflowInstance, prevBurn float64) {
+	if !inst.HasBudget() {
+		return
+	}
+
+	burn := inst.BudgetBurn()
+	if prevBurn < 1 && burn >= 1 {
+		cp.budgetExceeded(inst, burn)
+		return
+	}
+
+	if threshold, ok := crossedThreshold(cp.budgetThresholds, prevBurn, burn); ok {
+		cp.budgetWarning(inst, threshold, burn)
+	}
+}
+
+// budgetWarning notifies the user that a soft budget threshold was crossed.
+func (cp *defaultControlPlane) budgetWarning(inst *WorkflowInstance, threshold, burn float64) {
+	log.Info(log.CatOrch, "Workflow budget threshold reached",
+		"workflowID", inst.ID, "threshold", threshold, "burn", burn)
+
+	cp.publishBudgetEvent(inst, EventBudgetWarning, threshold, burn)
+	cp.notifyUser(inst, "budget_warning", fmt.Sprintf("Workflow %q has used %.0f%% of its budget (%s).",
+		inst.Name, burn*100, FormatBudget(inst)))
+}
+
+// budgetExceeded notifies the user and pauses a workflow that reached its budget.
+func (cp *defaultControlPlane) budgetExceeded(inst *WorkflowInstance, burn float64) {
+	log.Info(log.CatOrch, "Workflow budget exhausted, pausing",
+		"workflowID", inst.ID, "burn", burn)
+
+	cp.publishBudgetEvent(inst, EventBudgetExceeded, 1, burn)
+	cp.notifyUser(inst, "budget_exceeded", fmt.Sprintf("Workflow %q reached its budget (%s) and is being paused. Resume it to continue past the budget.",
+		inst.Name, FormatBudget(inst)))
+
+	// Pause off the event forwarding goroutine: pausing submits commands whose
+	// events flow back through it.
+	id := inst.ID
+	log.SafeGo("controlplane.budgetPause", func() {
+		if err := cp.Pause(context.Background(), id); err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to pause workflow over budget", err, "workflowID", id)
+		}
+	})
+}
+
+func (cp *defaultControlPlane) publishBudgetEvent(inst *WorkflowInstance, eventType EventType, threshold, burn float64) {
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         eventType,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Payload: BudgetPayload{
+			Threshold:    threshold,
+			Burn:         burn,
+			TokensUsed:   inst.TokensUsed,
+			CostUSD:      inst.CostUSD,
+			BudgetTokens: inst.BudgetTokens,
+			BudgetUSD:    inst.BudgetUSD,
+		},
+	})
+}
+
+// notifyUser raises a notify_user notification in the workflow so the human
+// sees it alongside coordinator notifications. The notify_user handler plays
+// the sound for the use case; without infrastructure it is played here.
+func (cp *defaultControlPlane) notifyUser(inst *WorkflowInstance, soundUseCase, message string) {
+	if inst.Infrastructure == nil || inst.Infrastructure.Core.Processor == nil {
+		cp.soundService.Play("deny", soundUseCase)
+		return
+	}
+	cmd := command.NewNotifyUserCommand(command.SourceInternal, message, "budget", "",
+		command.WithNotifySound("deny", soundUseCase))
+	if err := inst.Infrastructure.Core.Processor.Submit(cmd); err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to submit budget notification", err, "workflowID", inst.ID)
+	}
+}
+
+// FormatBudget renders a workflow's spend against its budget, e.g.
+// "$1.20 of $5.00" or "120k of 500k tokens". Both are shown when both budgets are set.
+func FormatBudget(inst *WorkflowInstance) string {
+	var parts []string
+	if inst.BudgetUSD > 0 {
+		parts = append(parts, fmt.Sprintf("$%.2f of $%.2f", inst.CostUSD, inst.BudgetUSD))
+	}
+	if inst.BudgetTokens > 0 {
+		parts = append(parts, fmt.Sprintf("%s of %s tokens",
+			formatTokenCount(inst.TokensUsed), formatTokenCount(inst.BudgetTokens)))
+	}
+	if len(parts) == 0 {
+		return "no budget"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatTokenCount abbreviates token counts: 950, 12.5k, 1.2M.
+func formatTokenCount(n int64) string {
+	switch {
+	case n >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	case n >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1_000)
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
@@ -0,0 +1,130 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossedThreshold(t *testing.T) {
+	thresholds := []float64{0.5, 0.8}
+
+	tests := []struct {
+		name      string
+		prev, cur float64
+		want      float64
+		wantOK    bool
+	}{
+		{"below first", 0.1, 0.4, 0, false},
+		{"crosses first", 0.4, 0.5, 0.5, true},
+		{"already past first", 0.5, 0.7, 0, false},
+		{"crosses both reports highest", 0.3, 0.9, 0.8, true},
+		{"past all", 0.9, 0.95, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := crossedThreshold(thresholds, tt.prev, tt.cur)
+			require.Equal(t, tt.wantOK, ok)
+			require.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestWorkflowInstance_BudgetBurn(t *testing.T) {
+	inst := &WorkflowInstance{TokensUsed: 250, CostUSD: 3}
+	require.False(t, inst.HasBudget())
+	require.Zero(t, inst.BudgetBurn())
+
+	inst.BudgetTokens = 1000
+	require.InDelta(t, 0.25, inst.BudgetBurn(), 1e-9)
+
+	// The budget closest to its limit wins
+	inst.BudgetUSD = 4
+	require.InDelta(t, 0.75, inst.BudgetBurn(), 1e-9)
+}
+
+func TestFormatBudget(t *testing.T) {
+	require.Equal(t, "no budget", FormatBudget(&WorkflowInstance{}))
+	require.Equal(t, "$1.20 of $5.00", FormatBudget(&WorkflowInstance{CostUSD: 1.2, BudgetUSD: 5}))
+	require.Equal(t, "$1.20 of $5.00, 120.0k of 1.5M tokens", FormatBudget(&WorkflowInstance{
+		CostUSD: 1.2, BudgetUSD: 5, TokensUsed: 120_000, BudgetTokens: 1_500_000,
+	}))
+}
+
+func TestWorkflowSpec_Validate_NegativeBudget(t *testing.T) {
+	spec := WorkflowSpec{TemplateID: "t", InitialPrompt: "p", BudgetUSD: -1}
+	require.ErrorContains(t, spec.Validate(), "budget_usd")
+
+	spec = WorkflowSpec{TemplateID: "t", InitialPrompt: "p", BudgetTokens: -1}
+	require.ErrorContains(t, spec.Validate(), "budget_tokens")
+}
+
+func TestControlPlane_BudgetThresholdsNotifyAndPause(t *testing.T) {
+	supervisor := newStubSupervisor()
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:   NewInMemoryRegistry(),
+		Supervisor: supervisor,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	events, unsubscribe := cp.SubscribeFiltered(ctx, EventFilter{
+		Types: []EventType{EventBudgetWarning, EventBudgetExceeded, EventWorkflowPaused},
+	})
+	defer unsubscribe()
+
+	id, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Build a feature",
+		BudgetUSD:     2,
+	})
+	require.NoError(t, err)
+	require.NoError(t, cp.Start(ctx, id))
+	supervisor.requireRunning(t, id)
+
+	inst, err := cp.Get(ctx, id)
+	require.NoError(t, err)
+
+	spend := func(usd float64) {
+		prev := inst.BudgetBurn()
+		inst.AddCost(usd)
+		cp.(*defaultControlPlane).handleUsage(inst, prev)
+	}
+	next := func() ControlPlaneEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for budget event")
+			return ControlPlaneEvent{}
+		}
+	}
+
+	// 40% spent: below every threshold
+	spend(0.8)
+
+	// 60% spent: crosses the 50% soft threshold
+	spend(0.4)
+	event := next()
+	require.Equal(t, EventBudgetWarning, event.Type)
+	payload := event.Payload.(BudgetPayload)
+	require.InDelta(t, 0.5, payload.Threshold, 1e-9)
+	require.InDelta(t, 1.2, payload.CostUSD, 1e-9)
+
+	// 100% spent: hard limit pauses the workflow
+	spend(0.8)
+	require.Equal(t, EventBudgetExceeded, next().Type)
+	require.Equal(t, EventWorkflowPaused, next().Type)
+
+	// Further spend does not pause again
+	spend(0.5)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %s", event.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	appgit "github.com/zjrosen/perles/internal/git/application"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/sound"
 )
 
 // ErrWorkflowNotFound is returned when a workflow is not found in the registry.
@@ -20,11 +25,17 @@ var ErrWorkflowNotFound = fmt.Errorf("workflow not found")
 // for workflow lifecycle management.
 type ControlPlane interface {
 	// Create creates a new workflow instance in Pending state.
-	// The workflow must be started with Start() to begin execution.
+	// The workflow must be started with Start() to begin execution, unless it
+	// depends on other workflows (spec.After): it is then started once they all
+	// complete, with their outputs as arguments, and fails if any of them fails.
+	// Returns ErrWorkflowNotFound or ErrUpstreamFailed for an invalid dependency.
 	Create(ctx context.Context, spec WorkflowSpec) (WorkflowID, error)
 
 	// Start transitions a pending workflow to running.
 	// Allocates resources, creates infrastructure, and spawns the coordinator.
+	// When the ResourceScheduler has no free workflow slot, the workflow is
+	// queued instead and started once a slot is released.
+	// Returns ErrInvalidState for a workflow still waiting on upstream workflows.
 	Start(ctx context.Context, id WorkflowID) error
 
 	// Pause suspends a running workflow, stopping all processes and clearing queues.
@@ -34,6 +45,7 @@ type ControlPlane interface {
 
 	// Resume restarts a paused workflow by respawning the coordinator.
 	// Sends a system message to the coordinator with pause context.
+	// Like Start, the workflow is queued when no workflow slot is free.
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Resume(ctx context.Context, id WorkflowID) error
 
@@ -47,6 +59,18 @@ type ControlPlane interface {
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Fail(ctx context.Context, id WorkflowID) error
 
+	// Stop terminates a workflow and releases all resources, leaving it in the
+	// Failed state. Running workflows are paused first so they can be inspected.
+	// Unless opts.Force is set, returns ErrUncommittedChanges when the workflow's
+	// worktree has uncommitted changes.
+	// Returns ErrWorkflowNotFound if the workflow does not exist.
+	Stop(ctx context.Context, id WorkflowID, opts StopOptions) error
+
+	// Delete removes a workflow that is not running or paused.
+	// Returns ErrInvalidState for running or paused workflows; stop them first.
+	// Returns ErrWorkflowNotFound if the workflow does not exist.
+	Delete(ctx context.Context, id WorkflowID) error
+
 	// Get retrieves a workflow by ID.
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Get(ctx context.Context, id WorkflowID) (*WorkflowInstance, error)
@@ -54,6 +78,10 @@ type ControlPlane interface {
 	// List returns workflows matching the query.
 	List(ctx context.Context, q ListQuery) ([]*WorkflowInstance, error)
 
+	// Queue returns the workflows waiting for a workflow slot in the order they
+	// will be started, with estimated start times.
+	Queue() []QueuedWorkflow
+
 	// Registry returns the underlying workflow registry.
 	// This enables direct registry updates for dashboard operations.
 	Registry() Registry
@@ -65,6 +93,57 @@ type ControlPlane interface {
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Archive(ctx context.Context, id WorkflowID) error
 
+	// === Process Control ===
+
+	// Processes returns the coordinator and workers of a running workflow.
+	// Returns ErrWorkflowNotRunning if the workflow has no live infrastructure.
+	Processes(ctx context.Context, id WorkflowID) ([]*repository.Process, error)
+
+	// SendToProcess sends a user message to a process of a running workflow,
+	// e.g. the coordinator.
+	SendToProcess(ctx context.Context, id WorkflowID, processID, content string) error
+
+	// InterruptProcess sends an urgent user message to a process of a running
+	// workflow. A working process has its current turn cancelled and receives
+	// the message as soon as it stops, ahead of any queued messages.
+	InterruptProcess(ctx context.Context, id WorkflowID, processID, content string) error
+
+	// RetireProcess gracefully retires a worker of a running workflow.
+	RetireProcess(ctx context.Context, id WorkflowID, processID, reason string) error
+
+	// ReplaceProcess retires a process of a running workflow and spawns a
+	// replacement with fresh context.
+	ReplaceProcess(ctx context.Context, id WorkflowID, processID, reason string) error
+
+	// === Landing ===
+
+	// PreviewLand returns the diff stat of a workflow's worktree branch against
+	// its base branch and the paths a merge would conflict on.
+	// Returns ErrNoWorktree if the workflow has no worktree.
+	PreviewLand(ctx context.Context, id WorkflowID) (*LandPreview, error)
+
+	// Land merges or squashes a workflow's worktree branch into its base branch,
+	// and removes the worktree and branch when opts.Cleanup is set.
+	// Returns ErrMergeConflicts when the branch does not merge cleanly and
+	// ErrUncommittedChanges when either side has uncommitted changes.
+	Land(ctx context.Context, id WorkflowID, opts LandOptions) (*LandResult, error)
+
+	// ResolveLandConflicts asks the coordinator of a running workflow to
+	// resolve the conflicts between its branch and the base branch.
+	ResolveLandConflicts(ctx context.Context, id WorkflowID) error
+
+	// === Approvals ===
+
+	// Approvals returns the approval requests of a running workflow that await
+	// a human decision, oldest first.
+	// Returns ErrWorkflowNotRunning if the workflow has no live infrastructure.
+	Approvals(ctx context.Context, id WorkflowID) ([]*repository.Approval, error)
+
+	// ResolveApproval approves or rejects a pending approval request, resuming
+	// the workers it paused and sending the decision and feedback to the
+	// coordinator. Feedback is required when rejecting.
+	ResolveApproval(ctx context.Context, id WorkflowID, approvalID string, approved bool, feedback string) error
+
 	// === Event Subscription ===
 
 	// Subscribe returns a channel of all control plane events.
@@ -113,6 +192,23 @@ type ControlPlaneConfig struct {
 	// HealthMonitor monitors workflow health (optional).
 	// If provided, it will be stopped during Shutdown.
 	HealthMonitor HealthMonitor
+	// Scheduler enforces resource limits across workflows (optional).
+	// If nil, an unlimited ResourceScheduler is used. It is stopped during Shutdown.
+	Scheduler ResourceScheduler
+	// BudgetThresholds are the soft thresholds, as fractions of a workflow's
+	// budget, at which the user is notified (optional).
+	// If nil, DefaultBudgetThresholds is used.
+	BudgetThresholds []float64
+	// SoundService plays budget alert sounds (optional).
+	// If nil, uses NoopSoundService (no audio).
+	SoundService sound.SoundService
+	// SpecBuilder builds workflows that depend on others and were created
+	// without a prompt once their upstream workflows complete (optional).
+	// If nil, such workflows fail when they become ready.
+	SpecBuilder SpecBuilder
+	// GitExecutorFactory creates GitExecutor instances for landing workflow
+	// worktrees (optional). If nil, Land and PreviewLand return an error.
+	GitExecutorFactory func(workDir string) appgit.GitExecutor
 }
 
 // Validate checks that all required fields are provided.
@@ -132,6 +228,21 @@ type defaultControlPlane struct {
 	supervisor    Supervisor
 	eventBus      *CrossWorkflowEventBus
 	healthMonitor HealthMonitor
+	scheduler     ResourceScheduler
+	soundService  sound.SoundService
+	specBuilder   SpecBuilder
+
+	// gitExecutorFactory creates git executors for landing workflow worktrees.
+	gitExecutorFactory func(workDir string) appgit.GitExecutor
+
+	// budgetThresholds are the soft budget thresholds that notify the user.
+	budgetThresholds []float64
+
+	// closing is set during Shutdown so released slots don't start queued workflows.
+	closing atomic.Bool
+
+	// dependencyMu serializes starting workflows whose upstream workflows completed.
+	dependencyMu sync.Mutex
 }
 
 // NewControlPlane creates a new ControlPlane with the given configuration.
@@ -145,16 +256,40 @@ func NewControlPlane(cfg ControlPlaneConfig) (ControlPlane, error) {
 		eventBus = NewCrossWorkflowEventBus()
 	}
 
+	scheduler := cfg.Scheduler
+	if scheduler == nil {
+		scheduler = NewResourceScheduler(ResourceSchedulerConfig{})
+	}
+
+	budgetThresholds := cfg.BudgetThresholds
+	if budgetThresholds == nil {
+		budgetThresholds = DefaultBudgetThresholds
+	}
+
+	var soundService sound.SoundService = sound.NoopSoundService{}
+	if cfg.SoundService != nil {
+		soundService = cfg.SoundService
+	}
+
 	cp := &defaultControlPlane{
-		registry:      cfg.Registry,
-		supervisor:    cfg.Supervisor,
-		eventBus:      eventBus,
-		healthMonitor: cfg.HealthMonitor,
+		registry:         cfg.Registry,
+		supervisor:       cfg.Supervisor,
+		eventBus:         eventBus,
+		healthMonitor:    cfg.HealthMonitor,
+		scheduler:        scheduler,
+		soundService:     soundService,
+		specBuilder:      cfg.SpecBuilder,
+		budgetThresholds: budgetThresholds,
+
+		gitExecutorFactory: cfg.GitExecutorFactory,
 	}
 
 	// Set up lifecycle callback to handle workflow state transitions
 	eventBus.SetLifecycleCallback(cp.handleLifecycleEvent)
 
+	// Set up usage callback to enforce per-workflow budgets
+	eventBus.SetUsageCallback(cp.handleUsage)
+
 	return cp, nil
 }
 
@@ -164,6 +299,9 @@ func (cp *defaultControlPlane) Create(ctx context.Context, spec WorkflowSpec) (W
 	if err := spec.Validate(); err != nil {
 		return "", fmt.Errorf("invalid spec: %w", err)
 	}
+	if err := cp.validateAfter(spec.After); err != nil {
+		return "", fmt.Errorf("invalid spec: %w", err)
+	}
 
 	// Create the workflow instance
 	inst, err := NewWorkflowInstance(&spec)
@@ -186,10 +324,17 @@ func (cp *defaultControlPlane) Create(ctx context.Context, spec WorkflowSpec) (W
 		Timestamp:    inst.CreatedAt,
 	})
 
+	// A dependent whose upstream workflows already completed won't be started
+	// by their completion, so start it now
+	if len(inst.After) > 0 {
+		cp.startIfReady(inst.ID)
+	}
+
 	return inst.ID, nil
 }
 
-// Start transitions a pending workflow to running.
+// Start transitions a pending workflow to running, or queues it when the
+// scheduler has no free workflow slot.
 func (cp *defaultControlPlane) Start(ctx context.Context, id WorkflowID) error {
 	// Get workflow from registry
 	inst, ok := cp.registry.Get(id)
@@ -197,6 +342,45 @@ func (cp *defaultControlPlane) Start(ctx context.Context, id WorkflowID) error {
 		return ErrWorkflowNotFound
 	}
 
+	// Reject running workflows before Acquire, which admits a workflow that
+	// already holds a slot; the error path would otherwise release that slot.
+	if inst.State != WorkflowPending {
+		return fmt.Errorf("%w: cannot start workflow in state %s", ErrInvalidState, inst.State)
+	}
+
+	// Workflows with dependencies are started once their upstream workflows
+	// complete, with the upstream outputs handed to them
+	if inst.IsWaiting() {
+		inputs, ready := cp.upstreamOutputs(inst)
+		if !ready {
+			return fmt.Errorf("%w: workflow is waiting for upstream workflows to complete", ErrInvalidState)
+		}
+		if err := cp.prepareDependent(inst, inputs); err != nil {
+			return err
+		}
+	}
+
+	admitted, err := cp.scheduler.Acquire(inst)
+	if err != nil {
+		return fmt.Errorf("acquiring workflow slot: %w", err)
+	}
+	if !admitted {
+		cp.publishQueued(inst)
+		return nil
+	}
+
+	if err := cp.start(ctx, inst); err != nil {
+		cp.releaseSlot(id)
+		return err
+	}
+	return nil
+}
+
+// start allocates resources and spawns the coordinator for a workflow that
+// holds a scheduler slot.
+func (cp *defaultControlPlane) start(ctx context.Context, inst *WorkflowInstance) error {
+	id := inst.ID
+
 	// Phase 1: Allocate resources (infrastructure, MCP server, session)
 	if err := cp.supervisor.AllocateResources(ctx, inst); err != nil {
 		return fmt.Errorf("allocating resources: %w", err)
@@ -256,6 +440,7 @@ func (cp *defaultControlPlane) Pause(ctx context.Context, id WorkflowID) error {
 		w.State = inst.State
 		w.PausedAt = inst.PausedAt
 		w.TokensUsed = inst.TokensUsed
+		w.CostUSD = inst.CostUSD
 		w.ActiveWorkers = inst.ActiveWorkers
 		w.LastHeartbeatAt = inst.LastHeartbeatAt
 		w.LastProgressAt = inst.LastProgressAt
@@ -265,6 +450,9 @@ func (cp *defaultControlPlane) Pause(ctx context.Context, id WorkflowID) error {
 		// that isn't in the runtime map
 	}
 
+	// A paused workflow runs no processes, so its slot goes to the next queued workflow
+	cp.releaseSlot(id)
+
 	// Emit workflow paused event
 	cp.eventBus.Publish(ControlPlaneEvent{
 		Type:         EventWorkflowPaused,
@@ -290,6 +478,31 @@ func (cp *defaultControlPlane) Resume(ctx context.Context, id WorkflowID) error
 		return ErrWorkflowNotFound
 	}
 
+	// Like Start, reject workflows that may hold a slot before Acquire
+	if inst.State != WorkflowPaused {
+		return fmt.Errorf("%w: cannot resume workflow in state %s", ErrInvalidState, inst.State)
+	}
+
+	admitted, err := cp.scheduler.Acquire(inst)
+	if err != nil {
+		return fmt.Errorf("acquiring workflow slot: %w", err)
+	}
+	if !admitted {
+		cp.publishQueued(inst)
+		return nil
+	}
+
+	if err := cp.resume(ctx, inst); err != nil {
+		cp.releaseSlot(id)
+		return err
+	}
+	return nil
+}
+
+// resume restarts a paused workflow that holds a scheduler slot.
+func (cp *defaultControlPlane) resume(ctx context.Context, inst *WorkflowInstance) error {
+	id := inst.ID
+
 	// Cold resume detection: workflow is Paused but has no Infrastructure
 	// This happens when a paused workflow is loaded from SQLite after app restart.
 	if inst.Infrastructure == nil {
@@ -354,18 +567,23 @@ func (cp *defaultControlPlane) Complete(ctx context.Context, id WorkflowID) erro
 		return fmt.Errorf("transitioning to completed: %w", err)
 	}
 	inst.CompletedAt = &now
+	inst.Outputs = workflowOutputs(inst)
 
 	// Persist the completed state to registry (for SQLite-backed registries)
 	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
 	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
 		w.State = inst.State
 		w.CompletedAt = inst.CompletedAt
+		w.Outputs = inst.Outputs
 		w.TokensUsed = inst.TokensUsed
+		w.CostUSD = inst.CostUSD
 		w.ActiveWorkers = inst.ActiveWorkers
 	}); err != nil {
 		// Log but don't fail - the in-memory state is already updated
 	}
 
+	cp.releaseSlot(id)
+
 	// Emit workflow completed event
 	cp.eventBus.Publish(ControlPlaneEvent{
 		Type:         EventWorkflowCompleted,
@@ -376,6 +594,9 @@ func (cp *defaultControlPlane) Complete(ctx context.Context, id WorkflowID) erro
 		Timestamp:    now,
 	})
 
+	// Starting dependents may build their specs and spawn coordinators
+	log.SafeGo("controlplane.startDependents", func() { cp.startDependents(id) })
+
 	return nil
 }
 
@@ -400,11 +621,14 @@ func (cp *defaultControlPlane) Fail(ctx context.Context, id WorkflowID) error {
 		w.State = inst.State
 		w.CompletedAt = inst.CompletedAt
 		w.TokensUsed = inst.TokensUsed
+		w.CostUSD = inst.CostUSD
 		w.ActiveWorkers = inst.ActiveWorkers
 	}); err != nil {
 		// Log but don't fail - the in-memory state is already updated
 	}
 
+	cp.releaseSlot(id)
+
 	// Emit workflow failed event
 	cp.eventBus.Publish(ControlPlaneEvent{
 		Type:         EventWorkflowFailed,
@@ -415,9 +639,55 @@ func (cp *defaultControlPlane) Fail(ctx context.Context, id WorkflowID) error {
 		Timestamp:    now,
 	})
 
+	cp.failDependents(id)
+
 	return nil
 }
 
+// releaseSlot returns a workflow's scheduler slot and starts the next queued
+// workflows in the background, since starting one spawns its coordinator.
+func (cp *defaultControlPlane) releaseSlot(id WorkflowID) {
+	cp.scheduler.Release(id)
+	if cp.closing.Load() {
+		return
+	}
+	log.SafeGo("controlplane.startQueued", cp.startQueued)
+}
+
+// startQueued starts queued workflows while the scheduler has free slots.
+// Paused workflows are resumed; pending workflows are started.
+func (cp *defaultControlPlane) startQueued() {
+	for !cp.closing.Load() {
+		inst, ok := cp.scheduler.Next()
+		if !ok {
+			return
+		}
+
+		var err error
+		if inst.State == WorkflowPaused {
+			err = cp.resume(context.Background(), inst)
+		} else {
+			err = cp.start(context.Background(), inst)
+		}
+		if err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to start queued workflow", err, "workflowID", inst.ID)
+			cp.scheduler.Release(inst.ID)
+		}
+	}
+}
+
+// publishQueued emits a workflow queued event so subscribers can show the queue.
+func (cp *defaultControlPlane) publishQueued(inst *WorkflowInstance) {
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowQueued,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Timestamp:    inst.QueuedAt,
+	})
+}
+
 // stopWorkflow terminates a workflow and releases all resources.
 // This transitions the workflow to Failed state, which is a terminal state.
 // For running workflows, it first pauses them to persist state for cold resume,
@@ -451,6 +721,78 @@ func (cp *defaultControlPlane) stopWorkflow(ctx context.Context, id WorkflowID,
 	return nil
 }
 
+// Stop terminates a workflow and releases all resources.
+// The workflow ends in the Failed state, which is persisted to the registry.
+func (cp *defaultControlPlane) Stop(ctx context.Context, id WorkflowID, opts StopOptions) error {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return ErrWorkflowNotFound
+	}
+
+	if err := cp.stopWorkflow(ctx, id, opts); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
+	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
+		w.State = WorkflowFailed
+		w.CompletedAt = &now
+	}); err != nil {
+		// Log but don't fail - the in-memory state is already updated
+	}
+
+	// Also drops the workflow from the start queue if it was waiting
+	cp.releaseSlot(id)
+
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowStopped,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        WorkflowFailed,
+		Timestamp:    now,
+		Payload:      StopPayload{Reason: opts.Reason, Force: opts.Force},
+	})
+
+	cp.failDependents(id)
+
+	return nil
+}
+
+// Delete removes a workflow that is not running or paused.
+func (cp *defaultControlPlane) Delete(ctx context.Context, id WorkflowID) error {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return ErrWorkflowNotFound
+	}
+
+	if inst.State == WorkflowRunning || inst.State == WorkflowPaused {
+		return fmt.Errorf("%w: cannot delete workflow in state %s, stop it first", ErrInvalidState, inst.State)
+	}
+
+	// A pending workflow may be waiting in the start queue
+	cp.scheduler.Release(id)
+
+	if err := cp.registry.Remove(id); err != nil {
+		return fmt.Errorf("removing workflow: %w", err)
+	}
+
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowDeleted,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Timestamp:    time.Now(),
+	})
+
+	// Workflows waiting on it can no longer start
+	cp.failDependents(id)
+
+	return nil
+}
+
 // handleLifecycleEvent handles lifecycle events from the event bus.
 // This is called synchronously when a lifecycle event is detected.
 func (cp *defaultControlPlane) handleLifecycleEvent(inst *WorkflowInstance, event ControlPlaneEvent) {
@@ -495,6 +837,11 @@ func (cp *defaultControlPlane) List(ctx context.Context, q ListQuery) ([]*Workfl
 	return cp.registry.List(q), nil
 }
 
+// Queue returns the workflows waiting for a workflow slot.
+func (cp *defaultControlPlane) Queue() []QueuedWorkflow {
+	return cp.scheduler.Queue()
+}
+
 // Registry returns the underlying workflow registry.
 func (cp *defaultControlPlane) Registry() Registry {
 	return cp.registry
@@ -502,6 +849,9 @@ func (cp *defaultControlPlane) Registry() Registry {
 
 // Archive marks a workflow as archived.
 func (cp *defaultControlPlane) Archive(ctx context.Context, id WorkflowID) error {
+	if _, ok := cp.registry.Get(id); !ok {
+		return ErrWorkflowNotFound
+	}
 	return cp.registry.Archive(id)
 }
 
@@ -610,9 +960,10 @@ func (cp *defaultControlPlane) GetHealthStatus(id WorkflowID) (HealthStatus, boo
 // 1. Stop the HealthMonitor (if configured)
 // 2. Stop all active workflows via stopWorkflow (handles pause + resource cleanup)
 // 3. Close the CrossWorkflowEventBus
-// 4. Release all scheduler resources (if configured)
+// 4. Stop the ResourceScheduler
 func (cp *defaultControlPlane) Shutdown(ctx context.Context) error {
 	var errs []error
+	cp.closing.Store(true)
 
 	// Step 1: Stop HealthMonitor
 	if cp.healthMonitor != nil {
@@ -643,6 +994,9 @@ func (cp *defaultControlPlane) Shutdown(ctx context.Context) error {
 		cp.eventBus.Close()
 	}
 
+	// Step 4: Stop tracking spend (queued workflows stay pending)
+	cp.scheduler.Stop()
+
 	// Return aggregated errors if any
 	if len(errs) > 0 {
 		return fmt.Errorf("shutdown completed with %d errors: %w", len(errs), errors.Join(errs...))
@@ -1156,6 +1156,103 @@ func TestControlPlane_Start_PropagatesInfrastructureError(t *testing.T) {
 // === Subscription Tests ===
 
 // newTestControlPlaneWithEventBus creates a ControlPlane with a custom event bus for testing subscriptions.
+// === Unit Tests: Stop and Delete ===
+
+func TestControlPlane_Stop_PendingWorkflowFailsAndEmitsEvent(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build", Name: "Test Workflow"})
+	require.NoError(t, err)
+
+	eventCh, unsubscribe := cp.Subscribe(ctx)
+	defer unsubscribe()
+
+	require.NoError(t, cp.Stop(ctx, id, StopOptions{Reason: "no longer needed", Force: true}))
+
+	inst, err := cp.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, inst.State)
+
+	select {
+	case received := <-eventCh:
+		require.Equal(t, EventWorkflowStopped, received.Type)
+		require.Equal(t, id, received.WorkflowID)
+		require.Equal(t, StopPayload{Reason: "no longer needed", Force: true}, received.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for EventWorkflowStopped event")
+	}
+}
+
+func TestControlPlane_Stop_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+
+	err := cp.Stop(context.Background(), "non-existent", StopOptions{})
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+}
+
+func TestControlPlane_Stop_ReturnsErrorFromTerminalState(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+	require.NoError(t, cp.Fail(ctx, id))
+
+	err = cp.Stop(ctx, id, StopOptions{})
+	require.ErrorIs(t, err, ErrInvalidState)
+}
+
+func TestControlPlane_Delete_RemovesFinishedWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+	require.NoError(t, cp.Fail(ctx, id))
+
+	eventCh, unsubscribe := cp.Subscribe(ctx)
+	defer unsubscribe()
+
+	require.NoError(t, cp.Delete(ctx, id))
+
+	_, err = cp.Get(ctx, id)
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+
+	select {
+	case received := <-eventCh:
+		require.Equal(t, EventWorkflowDeleted, received.Type)
+		require.Equal(t, id, received.WorkflowID)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for EventWorkflowDeleted event")
+	}
+}
+
+func TestControlPlane_Delete_RejectsActiveWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+
+	dcp := cp.(*defaultControlPlane)
+	inst, _ := dcp.registry.Get(id)
+	require.NoError(t, inst.TransitionTo(WorkflowRunning))
+
+	err = cp.Delete(ctx, id)
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	_, err = cp.Get(ctx, id)
+	require.NoError(t, err, "workflow should still exist")
+}
+
+func TestControlPlane_Delete_ReturnsErrorForNonExistentWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+
+	err := cp.Delete(context.Background(), "non-existent")
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+}
+
 func newTestControlPlaneWithEventBus(t *testing.T) (ControlPlane, *CrossWorkflowEventBus) {
 	t.Helper()
 
@@ -0,0 +1,589 @@
+package controlplane
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/schedules/domain"
+)
+
+// ErrScheduleNotFound is returned when a schedule does not exist.
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+// ErrSchedulesUnavailable is returned when schedules are not persisted in this process.
+var ErrSchedulesUnavailable = errors.New("schedules require session persistence")
+
+// ScheduleLabel is the workflow label holding the ID of the schedule that created it.
+const ScheduleLabel = "schedule"
+
+// ScheduleSpec describes a schedule to create.
+type ScheduleSpec struct {
+	// Name is the display name for the schedule (required).
+	Name string
+	// Cron is a five-field cron expression or macro such as "@daily" (required).
+	Cron string
+	// TemplateID is the workflow template to run (required).
+	TemplateID string
+	// TargetQuery is an optional BQL query. When set, each run creates a
+	// workflow for every matching issue not targeted before.
+	TargetQuery string
+	// Args are template argument values.
+	Args map[string]string
+	// Labels are applied to created workflows.
+	Labels map[string]string
+
+	// Workflow settings, as in WorkflowSpec.
+	WorktreeEnabled    bool
+	WorktreeBaseBranch string
+	Priority           int
+	BudgetUSD          float64
+}
+
+// SpecBuilder builds the WorkflowSpec for a workflow created from a template,
+// e.g. by creating its epic and assembling the coordinator prompt.
+type SpecBuilder interface {
+	BuildSpec(templateID, name string, args map[string]string) (WorkflowSpec, error)
+}
+
+// IssueDeleter deletes beads issues.
+type IssueDeleter interface {
+	DeleteIssues(issueIDs []string) error
+}
+
+// CronScheduler starts workflows at their requested times.
+//
+// It persists cron schedules that create workflows from a template, and starts
+// pending workflows whose ScheduledAt time has passed. Started workflows go
+// through ControlPlane.Start, so they queue when no workflow slot is free.
+//
+// The next run of a schedule without a target query is pre-created as a
+// pending workflow scheduled for that time, so it is visible before it starts.
+type CronScheduler interface {
+	// Start begins checking for due schedules and workflows.
+	Start(ctx context.Context) error
+
+	// Stop stops the check loop. It is safe to call Stop multiple times or before Start.
+	Stop()
+
+	// CreateSchedule validates and persists a new, enabled schedule.
+	CreateSchedule(ctx context.Context, spec ScheduleSpec) (*domain.Schedule, error)
+
+	// GetSchedule returns a schedule by ID.
+	// Returns ErrScheduleNotFound if the schedule does not exist.
+	GetSchedule(ctx context.Context, id string) (*domain.Schedule, error)
+
+	// ListSchedules returns all schedules ordered by creation time.
+	ListSchedules(ctx context.Context) ([]*domain.Schedule, error)
+
+	// DeleteSchedule deletes a schedule and its pending workflow, including
+	// the workflow's epic when the schedule created it.
+	// Returns ErrScheduleNotFound if the schedule does not exist.
+	DeleteSchedule(ctx context.Context, id string) error
+
+	// RunSchedule runs a schedule now without changing its next run time and
+	// returns the workflows it started.
+	// Returns ErrScheduleNotFound if the schedule does not exist.
+	RunSchedule(ctx context.Context, id string) ([]WorkflowID, error)
+}
+
+// CronSchedulerConfig configures the CronScheduler.
+type CronSchedulerConfig struct {
+	// Project scopes the schedules (required).
+	Project string
+	// Repository persists schedules (required).
+	Repository domain.ScheduleRepository
+	// ControlPlane creates and starts workflows (required).
+	ControlPlane ControlPlane
+	// SpecBuilder builds workflow specs from templates (required).
+	SpecBuilder SpecBuilder
+	// Executor runs schedule target queries (optional).
+	// If nil, schedules with a target query fail to run.
+	Executor bql.BQLExecutor
+	// Deleter removes the epics of pre-created workflows that are deleted
+	// before they run (optional). If nil, those epics are left in beads.
+	Deleter IssueDeleter
+	// CheckInterval is how often due schedules are checked.
+	// Defaults to 30 seconds if not specified.
+	CheckInterval time.Duration
+	// Clock is used for time operations (for testing).
+	// If nil, uses time.Now().
+	Clock Clock
+}
+
+// Validate checks that all required fields are provided.
+func (c *CronSchedulerConfig) Validate() error {
+	if c.Project == "" {
+		return fmt.Errorf("Project is required")
+	}
+	if c.Repository == nil {
+		return fmt.Errorf("Repository is required")
+	}
+	if c.ControlPlane == nil {
+		return fmt.Errorf("ControlPlane is required")
+	}
+	if c.SpecBuilder == nil {
+		return fmt.Errorf("SpecBuilder is required")
+	}
+	return nil
+}
+
+// defaultCronScheduler is the default implementation of CronScheduler.
+type defaultCronScheduler struct {
+	project  string
+	repo     domain.ScheduleRepository
+	cp       ControlPlane
+	builder  SpecBuilder
+	executor bql.BQLExecutor
+	deleter  IssueDeleter
+	interval time.Duration
+	clock    Clock
+
+	// runMu serializes schedule runs so a schedule is never run twice at once.
+	runMu sync.Mutex
+
+	// Lifecycle
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCronScheduler creates a new CronScheduler with the given configuration.
+func NewCronScheduler(cfg CronSchedulerConfig) (CronScheduler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	interval := cfg.CheckInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	clock := cfg.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	return &defaultCronScheduler{
+		project:  cfg.Project,
+		repo:     cfg.Repository,
+		cp:       cfg.ControlPlane,
+		builder:  cfg.SpecBuilder,
+		executor: cfg.Executor,
+		deleter:  cfg.Deleter,
+		interval: interval,
+		clock:    clock,
+	}, nil
+}
+
+// Start begins checking for due schedules and workflows.
+func (s *defaultCronScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.done != nil {
+		s.mu.Unlock()
+		return nil // Already started
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	done := s.done
+	s.mu.Unlock()
+
+	log.SafeGo("cronscheduler.checkLoop", func() {
+		defer close(done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.tick(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.tick(ctx)
+			}
+		}
+	})
+
+	return nil
+}
+
+// Stop stops the check loop.
+func (s *defaultCronScheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	done := s.done
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return // Not started
+	}
+	cancel()
+	<-done
+}
+
+// CreateSchedule validates and persists a new, enabled schedule.
+func (s *defaultCronScheduler) CreateSchedule(ctx context.Context, spec ScheduleSpec) (*domain.Schedule, error) {
+	now := s.clock.Now()
+	schedule := &domain.Schedule{
+		GUID:               newScheduleID(),
+		Project:            s.project,
+		Name:               spec.Name,
+		Cron:               spec.Cron,
+		TemplateID:         spec.TemplateID,
+		TargetQuery:        spec.TargetQuery,
+		Args:               maps.Clone(spec.Args),
+		Labels:             maps.Clone(spec.Labels),
+		WorktreeEnabled:    spec.WorktreeEnabled,
+		WorktreeBaseBranch: spec.WorktreeBaseBranch,
+		Priority:           spec.Priority,
+		BudgetUSD:          spec.BudgetUSD,
+		Enabled:            true,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	if schedule.TargetQuery != "" && s.executor == nil {
+		return nil, fmt.Errorf("target_query requires a beads database")
+	}
+	if err := schedule.ScheduleNext(now); err != nil {
+		return nil, err
+	}
+
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	if err := s.repo.Save(schedule); err != nil {
+		return nil, fmt.Errorf("saving schedule: %w", err)
+	}
+	s.preparePending(ctx, schedule)
+	if err := s.repo.Save(schedule); err != nil {
+		return nil, fmt.Errorf("saving schedule: %w", err)
+	}
+
+	log.Info(log.CatOrch, "Schedule created", "scheduleID", schedule.GUID, "name", schedule.Name,
+		"cron", schedule.Cron, "nextRunAt", schedule.NextRunAt)
+	return schedule, nil
+}
+
+// GetSchedule returns a schedule by ID.
+func (s *defaultCronScheduler) GetSchedule(_ context.Context, id string) (*domain.Schedule, error) {
+	schedule, err := s.repo.FindByGUID(s.project, id)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	return schedule, nil
+}
+
+// ListSchedules returns all schedules ordered by creation time.
+func (s *defaultCronScheduler) ListSchedules(_ context.Context) ([]*domain.Schedule, error) {
+	return s.repo.List(s.project)
+}
+
+// DeleteSchedule deletes a schedule and its pending workflow.
+func (s *defaultCronScheduler) DeleteSchedule(ctx context.Context, id string) error {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	schedule, err := s.repo.FindByGUID(s.project, id)
+	if err != nil {
+		return scheduleError(err)
+	}
+	s.removePending(ctx, schedule)
+
+	if err := s.repo.Delete(s.project, id); err != nil {
+		return scheduleError(err)
+	}
+	return nil
+}
+
+// RunSchedule runs a schedule now without changing its next run time.
+func (s *defaultCronScheduler) RunSchedule(ctx context.Context, id string) ([]WorkflowID, error) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	schedule, err := s.repo.FindByGUID(s.project, id)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	started, runErr := s.run(ctx, schedule, s.clock.Now())
+	s.preparePending(ctx, schedule)
+	if err := s.repo.Save(schedule); err != nil {
+		return started, fmt.Errorf("saving schedule: %w", err)
+	}
+	return started, runErr
+}
+
+// tick runs due schedules and starts pending workflows whose time has come.
+func (s *defaultCronScheduler) tick(ctx context.Context) {
+	now := s.clock.Now()
+	s.runDueSchedules(ctx, now)
+	s.startDueWorkflows(ctx, now)
+}
+
+// runDueSchedules runs every enabled schedule whose next run time has passed
+// and advances it to its following run.
+func (s *defaultCronScheduler) runDueSchedules(ctx context.Context, now time.Time) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	schedules, err := s.repo.List(s.project)
+	if err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to list schedules", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		if !schedule.IsDue(now) {
+			continue
+		}
+
+		if _, err := s.run(ctx, schedule, now); err != nil {
+			log.ErrorErr(log.CatOrch, "Scheduled run failed", err,
+				"scheduleID", schedule.GUID, "name", schedule.Name)
+		}
+		if err := schedule.ScheduleNext(now); err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to schedule next run", err, "scheduleID", schedule.GUID)
+		}
+		s.preparePending(ctx, schedule)
+		if err := s.repo.Save(schedule); err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to save schedule", err, "scheduleID", schedule.GUID)
+		}
+	}
+}
+
+// startDueWorkflows starts pending workflows whose ScheduledAt time has passed.
+func (s *defaultCronScheduler) startDueWorkflows(ctx context.Context, now time.Time) {
+	workflows, err := s.cp.List(ctx, ListQuery{States: []WorkflowState{WorkflowPending}})
+	if err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to list pending workflows", err)
+		return
+	}
+
+	for _, inst := range workflows {
+		if !inst.IsScheduled() || inst.ScheduledAt.After(now) || inst.IsLocked {
+			continue
+		}
+		if inst.InitialPrompt == "" {
+			// Saved before prompts were persisted; preparePending replaces it
+			log.Debug(log.CatOrch, "Skipping scheduled workflow without a prompt", "workflowID", inst.ID)
+			continue
+		}
+		if err := s.cp.Start(ctx, inst.ID); err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to start scheduled workflow", err, "workflowID", inst.ID)
+		}
+	}
+}
+
+// run starts the workflows for one run of a schedule. Must be called with runMu held.
+func (s *defaultCronScheduler) run(ctx context.Context, schedule *domain.Schedule, now time.Time) ([]WorkflowID, error) {
+	schedule.LastRunAt = &now
+	schedule.UpdatedAt = now
+
+	if schedule.TargetQuery != "" {
+		return s.runTargets(ctx, schedule)
+	}
+
+	// Start the pre-created workflow, creating one if it is gone
+	id := s.takePending(ctx, schedule)
+	if id == "" {
+		var err error
+		if id, err = s.createWorkflow(ctx, schedule, schedule.Args, nil); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.cp.Start(ctx, id); err != nil {
+		return nil, fmt.Errorf("starting workflow %s: %w", id, err)
+	}
+	log.Info(log.CatOrch, "Schedule started workflow", "scheduleID", schedule.GUID, "workflowID", id)
+	return []WorkflowID{id}, nil
+}
+
+// runTargets creates and starts a workflow for each issue matching the
+// schedule's target query that has not been targeted before.
+func (s *defaultCronScheduler) runTargets(ctx context.Context, schedule *domain.Schedule) ([]WorkflowID, error) {
+	if s.executor == nil {
+		return nil, fmt.Errorf("target query requires a beads database")
+	}
+	issues, err := s.executor.Execute(schedule.TargetQuery)
+	if err != nil {
+		return nil, fmt.Errorf("running target query: %w", err)
+	}
+
+	var started []WorkflowID
+	var errs []error
+	for _, issue := range issues {
+		done, err := s.repo.HasTarget(schedule.ID, issue.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if done {
+			continue
+		}
+
+		args := maps.Clone(schedule.Args)
+		if args == nil {
+			args = make(map[string]string, 1)
+		}
+		args["epic_id"] = issue.ID
+
+		id, err := s.createWorkflow(ctx, schedule, args, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", issue.ID, err))
+			continue
+		}
+		if err := s.repo.RecordTarget(schedule.ID, issue.ID, string(id)); err != nil {
+			errs = append(errs, err)
+		}
+		if err := s.cp.Start(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("starting workflow %s: %w", id, err))
+			continue
+		}
+		log.Info(log.CatOrch, "Schedule started workflow", "scheduleID", schedule.GUID,
+			"workflowID", id, "target", issue.ID)
+		started = append(started, id)
+	}
+	return started, errors.Join(errs...)
+}
+
+// preparePending pre-creates the pending workflow for the schedule's next run.
+// Schedules with a target query don't know their workflows in advance.
+// Must be called with runMu held.
+func (s *defaultCronScheduler) preparePending(ctx context.Context, schedule *domain.Schedule) {
+	if !schedule.Enabled || schedule.TargetQuery != "" || schedule.NextRunAt == nil || schedule.NextRunAt.IsZero() {
+		s.removePending(ctx, schedule)
+		return
+	}
+
+	if schedule.PendingWorkflowID != "" {
+		inst, err := s.cp.Get(ctx, WorkflowID(schedule.PendingWorkflowID))
+		if err == nil && inst.State == WorkflowPending && inst.InitialPrompt != "" {
+			return // Already prepared
+		}
+		s.removePending(ctx, schedule)
+	}
+
+	id, err := s.createWorkflow(ctx, schedule, schedule.Args, schedule.NextRunAt)
+	if err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to prepare scheduled workflow", err, "scheduleID", schedule.GUID)
+		return
+	}
+	schedule.PendingWorkflowID = string(id)
+}
+
+// takePending returns the schedule's pre-created workflow if it can still be
+// started, clearing it from the schedule. Must be called with runMu held.
+func (s *defaultCronScheduler) takePending(ctx context.Context, schedule *domain.Schedule) WorkflowID {
+	id := WorkflowID(schedule.PendingWorkflowID)
+	if id == "" {
+		return ""
+	}
+	inst, err := s.cp.Get(ctx, id)
+	if err != nil || inst.State != WorkflowPending {
+		schedule.PendingWorkflowID = ""
+		return ""
+	}
+	if inst.InitialPrompt == "" {
+		s.removePending(ctx, schedule)
+		return ""
+	}
+	schedule.PendingWorkflowID = ""
+	return id
+}
+
+// removePending deletes the schedule's pre-created workflow if it has not started.
+func (s *defaultCronScheduler) removePending(ctx context.Context, schedule *domain.Schedule) {
+	id := WorkflowID(schedule.PendingWorkflowID)
+	if id == "" {
+		return
+	}
+	schedule.PendingWorkflowID = ""
+
+	inst, err := s.cp.Get(ctx, id)
+	if err != nil || inst.State != WorkflowPending || inst.IsQueued() {
+		return // Already gone or started
+	}
+	if err := s.cp.Delete(ctx, id); err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to delete scheduled workflow", err, "workflowID", id)
+		return
+	}
+	s.discardEpic(schedule, inst.EpicID)
+}
+
+// discardEpic deletes the epic, and its tasks, that the spec builder created
+// for a pre-created workflow that was deleted before it ran. An epic passed in
+// the schedule's arguments belongs to the user and is kept.
+func (s *defaultCronScheduler) discardEpic(schedule *domain.Schedule, epicID string) {
+	if s.deleter == nil || epicID == "" || epicID == schedule.Args["epic_id"] {
+		return
+	}
+
+	ids := []string{epicID}
+	if s.executor != nil {
+		issues, err := s.executor.Execute(fmt.Sprintf(`id = "%s" expand down depth *`, epicID))
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to load scheduled epic's tasks", "epicID", epicID, "error", err)
+		}
+		for _, issue := range issues {
+			if issue.ID != epicID {
+				ids = append(ids, issue.ID)
+			}
+		}
+	}
+
+	if err := s.deleter.DeleteIssues(ids); err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to delete scheduled epic", err, "epicID", epicID)
+	}
+}
+
+// createWorkflow creates a pending workflow for the schedule.
+func (s *defaultCronScheduler) createWorkflow(ctx context.Context, schedule *domain.Schedule, args map[string]string, startAt *time.Time) (WorkflowID, error) {
+	spec, err := s.builder.BuildSpec(schedule.TemplateID, schedule.Name, args)
+	if err != nil {
+		return "", fmt.Errorf("building workflow spec: %w", err)
+	}
+
+	labels := maps.Clone(schedule.Labels)
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[ScheduleLabel] = schedule.GUID
+	spec.Labels = labels
+	spec.WorktreeEnabled = schedule.WorktreeEnabled
+	spec.WorktreeBaseBranch = schedule.WorktreeBaseBranch
+	spec.Priority = schedule.Priority
+	spec.BudgetUSD = schedule.BudgetUSD
+	if startAt != nil {
+		spec.StartAt = *startAt
+	}
+
+	return s.cp.Create(ctx, spec)
+}
+
+// scheduleError maps repository not-found errors to ErrScheduleNotFound.
+func scheduleError(err error) error {
+	var notFound *domain.ScheduleNotFoundError
+	if errors.As(err, &notFound) {
+		return fmt.Errorf("%w: %s", ErrScheduleNotFound, notFound.GUID)
+	}
+	return err
+}
+
+// newScheduleID returns a short random schedule ID such as "sch-1a2b3c4d".
+func newScheduleID() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return "sch-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,312 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// stubSpecBuilder builds specs without templates or beads.
+type stubSpecBuilder struct {
+	built []map[string]string
+	// epicID, when set, is used as the created epic for specs without an epic_id argument
+	epicID string
+}
+
+func (b *stubSpecBuilder) BuildSpec(templateID, name string, args map[string]string) (WorkflowSpec, error) {
+	b.built = append(b.built, args)
+	epicID := args["epic_id"]
+	if epicID == "" {
+		epicID = b.epicID
+	}
+	return WorkflowSpec{
+		TemplateID:    templateID,
+		Name:          name,
+		InitialPrompt: "Run " + templateID,
+		EpicID:        epicID,
+	}, nil
+}
+
+type cronSchedulerTest struct {
+	scheduler  *defaultCronScheduler
+	cp         ControlPlane
+	supervisor *stubSupervisor
+	builder    *stubSpecBuilder
+	clock      *mockClock
+	executor   *mocks.MockBQLExecutor
+}
+
+func newCronSchedulerTest(t *testing.T, limits ResourceLimits) *cronSchedulerTest {
+	t.Helper()
+	db, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+
+	cp, supervisor := newSchedulerTestControlPlane(t, limits)
+	builder := &stubSpecBuilder{}
+	// Thursday 10:30
+	clock := newMockClock(time.Date(2026, 1, 15, 10, 30, 0, 0, time.Local))
+	executor := mocks.NewMockBQLExecutor(t)
+
+	s, err := NewCronScheduler(CronSchedulerConfig{
+		Project:      "test-project",
+		Repository:   db.ScheduleRepository(),
+		ControlPlane: cp,
+		SpecBuilder:  builder,
+		Executor:     executor,
+		Clock:        clock,
+	})
+	require.NoError(t, err)
+
+	return &cronSchedulerTest{
+		scheduler:  s.(*defaultCronScheduler),
+		cp:         cp,
+		supervisor: supervisor,
+		builder:    builder,
+		clock:      clock,
+		executor:   executor,
+	}
+}
+
+func TestNewCronScheduler_RequiresConfig(t *testing.T) {
+	_, err := NewCronScheduler(CronSchedulerConfig{Project: "p"})
+	require.ErrorContains(t, err, "Repository is required")
+}
+
+func TestCronScheduler_CreateSchedule_PreparesNextRun(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{
+		Name:       "Nightly",
+		Cron:       "0 2 * * *",
+		TemplateID: "cook",
+		Labels:     map[string]string{"team": "core"},
+		Priority:   3,
+	})
+	require.NoError(t, err)
+	require.Regexp(t, `^sch-[0-9a-f]{8}$`, schedule.GUID)
+	require.True(t, schedule.Enabled)
+	nextRun := time.Date(2026, 1, 16, 2, 0, 0, 0, time.Local)
+	require.Equal(t, nextRun, *schedule.NextRunAt)
+
+	// The next run is visible as a scheduled pending workflow
+	inst, err := tt.cp.Get(ctx, WorkflowID(schedule.PendingWorkflowID))
+	require.NoError(t, err)
+	require.Equal(t, WorkflowPending, inst.State)
+	require.True(t, inst.IsScheduled())
+	require.Equal(t, nextRun, *inst.ScheduledAt)
+	require.Equal(t, schedule.GUID, inst.Labels[ScheduleLabel])
+	require.Equal(t, "core", inst.Labels["team"])
+	require.Equal(t, 3, inst.Priority)
+
+	got, err := tt.scheduler.GetSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Equal(t, schedule.PendingWorkflowID, got.PendingWorkflowID)
+}
+
+func TestCronScheduler_CreateSchedule_Invalid(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+
+	_, err := tt.scheduler.CreateSchedule(context.Background(), ScheduleSpec{Name: "Bad", Cron: "every night", TemplateID: "cook"})
+	require.Error(t, err)
+
+	schedules, err := tt.scheduler.ListSchedules(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+}
+
+func TestCronScheduler_Tick_RunsDueSchedule(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{Name: "Nightly", Cron: "0 2 * * *", TemplateID: "cook"})
+	require.NoError(t, err)
+	first := WorkflowID(schedule.PendingWorkflowID)
+
+	// Not due yet
+	tt.scheduler.tick(ctx)
+	inst, err := tt.cp.Get(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowPending, inst.State)
+
+	tt.clock.Set(time.Date(2026, 1, 16, 2, 0, 30, 0, time.Local))
+	tt.scheduler.tick(ctx)
+	tt.supervisor.requireRunning(t, first)
+
+	schedule, err = tt.scheduler.GetSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 17, 2, 0, 0, 0, time.Local), *schedule.NextRunAt)
+	require.NotNil(t, schedule.LastRunAt)
+	require.NotEmpty(t, schedule.PendingWorkflowID)
+	require.NotEqual(t, string(first), schedule.PendingWorkflowID, "the following run is prepared")
+}
+
+func TestCronScheduler_Tick_QueuesWhenNoSlot(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{MaxWorkflows: 1})
+	ctx := context.Background()
+
+	busy := createSchedulerTestWorkflow(t, tt.cp, "busy")
+	require.NoError(t, tt.cp.Start(ctx, busy))
+	tt.supervisor.requireRunning(t, busy)
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{Name: "Hourly", Cron: "@hourly", TemplateID: "cook"})
+	require.NoError(t, err)
+	pending := WorkflowID(schedule.PendingWorkflowID)
+
+	tt.clock.Advance(time.Hour)
+	tt.scheduler.tick(ctx)
+
+	queue := tt.cp.Queue()
+	require.Len(t, queue, 1)
+	require.Equal(t, pending, queue[0].ID)
+
+	// Once the slot frees up the scheduled workflow starts
+	require.NoError(t, tt.cp.Stop(ctx, busy, StopOptions{Force: true}))
+	tt.supervisor.requireRunning(t, pending)
+}
+
+func TestCronScheduler_Tick_StartsDelayedWorkflows(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+
+	id, err := tt.cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "cook",
+		InitialPrompt: "Build it",
+		StartAt:       tt.clock.Now().Add(10 * time.Minute),
+	})
+	require.NoError(t, err)
+	unscheduled := createSchedulerTestWorkflow(t, tt.cp, "manual")
+
+	tt.scheduler.tick(ctx)
+	inst, _ := tt.cp.Get(ctx, id)
+	require.Equal(t, WorkflowPending, inst.State)
+
+	tt.clock.Advance(10 * time.Minute)
+	tt.scheduler.tick(ctx)
+	tt.supervisor.requireRunning(t, id)
+
+	inst, _ = tt.cp.Get(ctx, unscheduled)
+	require.Equal(t, WorkflowPending, inst.State, "workflows without a start time wait to be started")
+}
+
+func TestCronScheduler_TargetQuery_StartsOncePerIssue(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+	query := "type = epic and label = needs-plan"
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{
+		Name:        "Plan epics",
+		Cron:        "0 2 * * *",
+		TemplateID:  "research_to_tasks",
+		TargetQuery: query,
+		Args:        map[string]string{"depth": "full"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, schedule.PendingWorkflowID, "targeted schedules don't pre-create workflows")
+
+	tt.executor.EXPECT().Execute(query).Return([]beads.Issue{{ID: "perles-abc1"}}, nil).Once()
+	started, err := tt.scheduler.RunSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Len(t, started, 1)
+	tt.supervisor.requireRunning(t, started[0])
+
+	inst, err := tt.cp.Get(ctx, started[0])
+	require.NoError(t, err)
+	require.Equal(t, "perles-abc1", inst.EpicID)
+	require.Equal(t, map[string]string{"depth": "full", "epic_id": "perles-abc1"}, tt.builder.built[0])
+
+	// The same issue is not targeted again; a new one is
+	tt.executor.EXPECT().Execute(query).Return([]beads.Issue{{ID: "perles-abc1"}, {ID: "perles-def2"}}, nil).Once()
+	started, err = tt.scheduler.RunSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Len(t, started, 1)
+	tt.supervisor.requireRunning(t, started[0])
+	inst, _ = tt.cp.Get(ctx, started[0])
+	require.Equal(t, "perles-def2", inst.EpicID)
+}
+
+func TestCronScheduler_RunSchedule_KeepsNextRun(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{Name: "Nightly", Cron: "0 2 * * *", TemplateID: "cook"})
+	require.NoError(t, err)
+	nextRun := *schedule.NextRunAt
+
+	started, err := tt.scheduler.RunSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Equal(t, []WorkflowID{WorkflowID(schedule.PendingWorkflowID)}, started)
+	tt.supervisor.requireRunning(t, started[0])
+
+	schedule, err = tt.scheduler.GetSchedule(ctx, schedule.GUID)
+	require.NoError(t, err)
+	require.Equal(t, nextRun, *schedule.NextRunAt)
+	require.NotEqual(t, string(started[0]), schedule.PendingWorkflowID)
+
+	_, err = tt.scheduler.RunSchedule(ctx, "sch-missing")
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+}
+
+func TestCronScheduler_DeleteSchedule_RemovesPendingWorkflow(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{Name: "Nightly", Cron: "0 2 * * *", TemplateID: "cook"})
+	require.NoError(t, err)
+
+	require.NoError(t, tt.scheduler.DeleteSchedule(ctx, schedule.GUID))
+
+	_, err = tt.cp.Get(ctx, WorkflowID(schedule.PendingWorkflowID))
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+	_, err = tt.scheduler.GetSchedule(ctx, schedule.GUID)
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+	require.ErrorIs(t, tt.scheduler.DeleteSchedule(ctx, schedule.GUID), ErrScheduleNotFound)
+}
+
+func TestCronScheduler_DeleteSchedule_DeletesCreatedEpic(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+	deleter := mocks.NewMockIssueExecutor(t)
+	tt.scheduler.deleter = deleter
+	tt.builder.epicID = "perles-epic"
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{Name: "Nightly", Cron: "0 2 * * *", TemplateID: "cook"})
+	require.NoError(t, err)
+
+	tt.executor.EXPECT().Execute(`id = "perles-epic" expand down depth *`).
+		Return([]beads.Issue{{ID: "perles-epic"}, {ID: "perles-epic.1"}, {ID: "perles-epic.2"}}, nil).Once()
+	deleter.EXPECT().DeleteIssues([]string{"perles-epic", "perles-epic.1", "perles-epic.2"}).Return(nil).Once()
+
+	require.NoError(t, tt.scheduler.DeleteSchedule(ctx, schedule.GUID))
+}
+
+func TestCronScheduler_DeleteSchedule_KeepsEpicFromArgs(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+	ctx := context.Background()
+	// No DeleteIssues expectation: deleting the user's epic fails the test
+	tt.scheduler.deleter = mocks.NewMockIssueExecutor(t)
+
+	schedule, err := tt.scheduler.CreateSchedule(ctx, ScheduleSpec{
+		Name:       "Nightly",
+		Cron:       "0 2 * * *",
+		TemplateID: "cook",
+		Args:       map[string]string{"epic_id": "perles-mine"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, tt.scheduler.DeleteSchedule(ctx, schedule.GUID))
+}
+
+func TestCronScheduler_StartStop(t *testing.T) {
+	tt := newCronSchedulerTest(t, ResourceLimits{})
+
+	require.NoError(t, tt.scheduler.Start(context.Background()))
+	require.NoError(t, tt.scheduler.Start(context.Background()), "starting twice is a no-op")
+	tt.scheduler.Stop()
+	tt.scheduler.Stop()
+}
@@ -0,0 +1,236 @@
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	fabricdomain "github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+)
+
+// Outputs a completed workflow hands to the workflows that depend on it.
+// They are added to the dependents' template arguments.
+const (
+	// OutputEpicID is the beads epic the workflow worked on or produced.
+	OutputEpicID = "epic_id"
+	// OutputArtifacts is a comma-separated list of the artifact files the
+	// workflow's agents attached in fabric.
+	OutputArtifacts = "artifacts"
+)
+
+// ErrUpstreamFailed is returned when creating a workflow that depends on a
+// workflow which already failed.
+var ErrUpstreamFailed = fmt.Errorf("upstream workflow failed")
+
+// validateAfter checks that the workflows a new workflow depends on exist and
+// can still complete.
+func (cp *defaultControlPlane) validateAfter(after []WorkflowID) error {
+	for _, upstreamID := range after {
+		upstream, ok := cp.registry.Get(upstreamID)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrWorkflowNotFound, upstreamID)
+		}
+		if upstream.State == WorkflowFailed {
+			return fmt.Errorf("%w: %s", ErrUpstreamFailed, upstreamID)
+		}
+	}
+	return nil
+}
+
+// workflowOutputs collects the results of a completing workflow.
+// Artifacts are read from the workflow's fabric while its infrastructure is live.
+func workflowOutputs(inst *WorkflowInstance) map[string]string {
+	outputs := make(map[string]string)
+	if inst.EpicID != "" {
+		outputs[OutputEpicID] = inst.EpicID
+	}
+	if paths := artifactPaths(inst); len(paths) > 0 {
+		outputs[OutputArtifacts] = strings.Join(paths, ",")
+	}
+	return outputs
+}
+
+// artifactPaths returns the files attached as fabric artifacts by a workflow.
+func artifactPaths(inst *WorkflowInstance) []string {
+	if inst.Infrastructure == nil || inst.Infrastructure.Core.FabricService == nil {
+		return nil
+	}
+
+	threads, _, _, _ := inst.Infrastructure.Core.FabricService.Repositories()
+	artifactType := fabricdomain.ThreadArtifact
+	artifacts, err := threads.List(fabricrepo.ListOptions{Type: &artifactType})
+	if err != nil {
+		log.Debug(log.CatOrch, "Failed to list workflow artifacts", "workflowID", inst.ID, "error", err)
+		return nil
+	}
+
+	var paths []string
+	for _, artifact := range artifacts {
+		path := strings.TrimPrefix(artifact.StorageURI, "file://")
+		if path != "" && !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// dependents returns the workflows waiting on the given workflow.
+func (cp *defaultControlPlane) dependents(id WorkflowID) []*WorkflowInstance {
+	var waiting []*WorkflowInstance
+	for _, inst := range cp.registry.List(ListQuery{States: []WorkflowState{WorkflowPending}}) {
+		if inst.IsWaiting() && slices.Contains(inst.After, id) {
+			waiting = append(waiting, inst)
+		}
+	}
+	return waiting
+}
+
+// startDependents starts the workflows waiting on a completed workflow whose
+// upstream workflows have now all completed. They go through Start, so they
+// queue when no workflow slot is free.
+func (cp *defaultControlPlane) startDependents(id WorkflowID) {
+	// Serialized so a workflow waiting on several upstreams that complete
+	// together is only started once
+	cp.dependencyMu.Lock()
+	defer cp.dependencyMu.Unlock()
+
+	for _, inst := range cp.dependents(id) {
+		if cp.closing.Load() {
+			return
+		}
+		if _, ready := cp.upstreamOutputs(inst); ready {
+			cp.startDependent(inst.ID)
+		}
+	}
+}
+
+// startIfReady starts a waiting workflow whose upstream workflows have all
+// completed, e.g. one created after they completed.
+func (cp *defaultControlPlane) startIfReady(id WorkflowID) {
+	cp.dependencyMu.Lock()
+	defer cp.dependencyMu.Unlock()
+
+	// Re-read under the lock: a concurrent startDependents may have started it
+	inst, ok := cp.registry.Get(id)
+	if !ok || !inst.IsWaiting() {
+		return
+	}
+	if _, ready := cp.upstreamOutputs(inst); ready {
+		cp.startDependent(id)
+	}
+}
+
+// startDependent starts a dependent workflow, failing it when it cannot
+// start. Must be called with dependencyMu held.
+func (cp *defaultControlPlane) startDependent(id WorkflowID) {
+	ctx := context.Background()
+	if err := cp.Start(ctx, id); err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to start dependent workflow", err, "workflowID", id)
+		if failErr := cp.Fail(ctx, id); failErr != nil {
+			log.ErrorErr(log.CatOrch, "Failed to fail dependent workflow", failErr, "workflowID", id)
+		}
+		return
+	}
+	log.Info(log.CatOrch, "Started dependent workflow", "workflowID", id)
+}
+
+// upstreamOutputs merges the outputs of a workflow's upstream workflows, in
+// After order. Returns false while any of them has not completed.
+func (cp *defaultControlPlane) upstreamOutputs(inst *WorkflowInstance) (map[string]string, bool) {
+	inputs := make(map[string]string)
+	for _, upstreamID := range inst.After {
+		upstream, ok := cp.registry.Get(upstreamID)
+		if !ok || upstream.State != WorkflowCompleted {
+			return nil, false
+		}
+		maps.Copy(inputs, upstream.Outputs)
+	}
+	return inputs, true
+}
+
+// prepareDependent hands the upstream outputs to a workflow about to start.
+// Arguments given when the workflow was created take precedence. A workflow
+// created without a prompt is built from its template with the combined
+// arguments; otherwise the outputs are appended to its prompt. A workflow
+// prepared by an earlier start attempt is left as is.
+func (cp *defaultControlPlane) prepareDependent(inst *WorkflowInstance, inputs map[string]string) error {
+	if inst.InitialPrompt != "" && hasAllKeys(inst.Args, inputs) {
+		return nil
+	}
+
+	args := inputs
+	maps.Copy(args, inst.Args)
+
+	prompt := inst.InitialPrompt
+	epicID := inst.EpicID
+	if prompt == "" {
+		if cp.specBuilder == nil {
+			return fmt.Errorf("no spec builder to build workflow from template %s", inst.TemplateID)
+		}
+		spec, err := cp.specBuilder.BuildSpec(inst.TemplateID, inst.Name, args)
+		if err != nil {
+			return fmt.Errorf("building workflow spec: %w", err)
+		}
+		prompt = spec.InitialPrompt
+		if epicID == "" {
+			epicID = spec.EpicID
+		}
+	} else if len(inputs) > 0 {
+		prompt += "\n\n" + upstreamSection(inputs)
+	}
+	if epicID == "" {
+		epicID = args[OutputEpicID]
+	}
+
+	inst.InitialPrompt = prompt
+	inst.EpicID = epicID
+	inst.Args = args
+	inst.UpdatedAt = time.Now()
+
+	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
+	if err := cp.registry.Update(inst.ID, func(w *WorkflowInstance) {
+		w.InitialPrompt = inst.InitialPrompt
+		w.EpicID = inst.EpicID
+		w.Args = inst.Args
+	}); err != nil {
+		// Log but don't fail - the in-memory state is already updated
+	}
+	return nil
+}
+
+// hasAllKeys reports whether m has every key of keys.
+func hasAllKeys(m, keys map[string]string) bool {
+	for key := range keys {
+		if _, ok := m[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// upstreamSection describes upstream outputs for a coordinator prompt.
+func upstreamSection(inputs map[string]string) string {
+	var b strings.Builder
+	b.WriteString("# Upstream Workflows\n\nThis workflow continues from workflows that completed with these outputs:\n")
+	for _, key := range slices.Sorted(maps.Keys(inputs)) {
+		fmt.Fprintf(&b, "\n- %s: %s", key, inputs[key])
+	}
+	return b.String()
+}
+
+// failDependents fails the workflows waiting on a workflow that failed, was
+// stopped or was deleted. Failing a dependent fails its own dependents in turn.
+func (cp *defaultControlPlane) failDependents(id WorkflowID) {
+	for _, inst := range cp.dependents(id) {
+		log.Info(log.CatOrch, "Failing dependent workflow after upstream failure",
+			"workflowID", inst.ID, "upstream", id)
+		if err := cp.Fail(context.Background(), inst.ID); err != nil {
+			log.ErrorErr(log.CatOrch, "Failed to fail dependent workflow", err, "workflowID", inst.ID)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDependencyTestControlPlane(t *testing.T) (ControlPlane, *stubSupervisor, *stubSpecBuilder) {
+	t.Helper()
+	supervisor := newStubSupervisor()
+	builder := &stubSpecBuilder{}
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:    NewInMemoryRegistry(),
+		Supervisor:  supervisor,
+		SpecBuilder: builder,
+	})
+	require.NoError(t, err)
+	return cp, supervisor, builder
+}
+
+func TestControlPlane_Create_ValidatesAfter(t *testing.T) {
+	cp, _, _ := newDependencyTestControlPlane(t)
+	ctx := context.Background()
+
+	_, err := cp.Create(ctx, WorkflowSpec{TemplateID: "cook", After: []WorkflowID{"missing"}})
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+
+	upstream := createSchedulerTestWorkflow(t, cp, "research")
+	require.NoError(t, cp.Fail(ctx, upstream))
+	_, err = cp.Create(ctx, WorkflowSpec{TemplateID: "cook", After: []WorkflowID{upstream}})
+	require.ErrorIs(t, err, ErrUpstreamFailed)
+
+	_, err = cp.Create(ctx, WorkflowSpec{TemplateID: "cook"})
+	require.ErrorContains(t, err, "initial_prompt is required", "only dependent workflows may omit the prompt")
+}
+
+func TestControlPlane_After_StartsDependentWithUpstreamOutputs(t *testing.T) {
+	cp, supervisor, builder := newDependencyTestControlPlane(t)
+	ctx := context.Background()
+
+	upstream, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "research_proposal",
+		InitialPrompt: "Research OAuth",
+		EpicID:        "perles-abc1",
+	})
+	require.NoError(t, err)
+	dependent, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID: "cook",
+		Name:       "Cook OAuth",
+		After:      []WorkflowID{upstream},
+		Args:       map[string]string{"reviewer": "alice"},
+	})
+	require.NoError(t, err)
+
+	inst, err := cp.Get(ctx, dependent)
+	require.NoError(t, err)
+	require.True(t, inst.IsWaiting())
+	require.ErrorIs(t, cp.Start(ctx, dependent), ErrInvalidState, "dependents start when their upstreams complete")
+
+	require.NoError(t, cp.Start(ctx, upstream))
+	supervisor.requireRunning(t, upstream)
+	require.NoError(t, cp.Complete(ctx, upstream))
+	supervisor.requireRunning(t, dependent)
+
+	inst, err = cp.Get(ctx, dependent)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowRunning, inst.State)
+	require.Equal(t, "Run cook", inst.InitialPrompt)
+	require.Equal(t, "perles-abc1", inst.EpicID)
+	require.Equal(t, []map[string]string{{OutputEpicID: "perles-abc1", "reviewer": "alice"}}, builder.built)
+}
+
+func TestControlPlane_After_WaitsForAllUpstreams(t *testing.T) {
+	cp, supervisor, _ := newDependencyTestControlPlane(t)
+	ctx := context.Background()
+
+	first := createSchedulerTestWorkflow(t, cp, "first")
+	second := createSchedulerTestWorkflow(t, cp, "second")
+	dependent, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Merge the results",
+		After:         []WorkflowID{first, second},
+	})
+	require.NoError(t, err)
+
+	for _, id := range []WorkflowID{first, second} {
+		require.NoError(t, cp.Start(ctx, id))
+		supervisor.requireRunning(t, id)
+	}
+
+	require.NoError(t, cp.Complete(ctx, first))
+	select {
+	case id := <-supervisor.running:
+		t.Fatalf("workflow %s started before all upstreams completed", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, cp.Complete(ctx, second))
+	supervisor.requireRunning(t, dependent)
+
+	inst, err := cp.Get(ctx, dependent)
+	require.NoError(t, err)
+	require.Equal(t, "Merge the results", inst.InitialPrompt, "prompts without upstream outputs are kept")
+}
+
+func TestControlPlane_After_StartsDependentOfCompletedUpstream(t *testing.T) {
+	cp, supervisor, builder := newDependencyTestControlPlane(t)
+	ctx := context.Background()
+
+	upstream, err := cp.Create(ctx, WorkflowSpec{
+		TemplateID:    "research_proposal",
+		InitialPrompt: "Research OAuth",
+		EpicID:        "perles-abc1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, cp.Start(ctx, upstream))
+	supervisor.requireRunning(t, upstream)
+	require.NoError(t, cp.Complete(ctx, upstream))
+
+	// Created after its upstream completed: started right away, from its template
+	dependent, err := cp.Create(ctx, WorkflowSpec{TemplateID: "cook", After: []WorkflowID{upstream}})
+	require.NoError(t, err)
+	supervisor.requireRunning(t, dependent)
+
+	inst, err := cp.Get(ctx, dependent)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowRunning, inst.State)
+	require.Equal(t, "Run cook", inst.InitialPrompt)
+	require.Equal(t, "perles-abc1", inst.EpicID)
+	require.Equal(t, []map[string]string{{OutputEpicID: "perles-abc1"}}, builder.built)
+}
+
+func TestControlPlane_After_FailsDependentsTransitively(t *testing.T) {
+	cp, _, _ := newDependencyTestControlPlane(t)
+	ctx := context.Background()
+
+	upstream := createSchedulerTestWorkflow(t, cp, "research")
+	middle, err := cp.Create(ctx, WorkflowSpec{TemplateID: "cook", After: []WorkflowID{upstream}})
+	require.NoError(t, err)
+	last, err := cp.Create(ctx, WorkflowSpec{TemplateID: "land", After: []WorkflowID{middle}})
+	require.NoError(t, err)
+
+	require.NoError(t, cp.Stop(ctx, upstream, StopOptions{}))
+
+	for _, id := range []WorkflowID{middle, last} {
+		inst, err := cp.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, WorkflowFailed, inst.State)
+	}
+}
+
+func TestUpstreamSection(t *testing.T) {
+	require.Equal(t,
+		"# Upstream Workflows\n\nThis workflow continues from workflows that completed with these outputs:\n"+
+			"\n- artifacts: docs/proposal.md\n- epic_id: perles-abc1",
+		upstreamSection(map[string]string{OutputEpicID: "perles-abc1", OutputArtifacts: "docs/proposal.md"}))
+}
@@ -219,16 +219,12 @@ func (r *DurableRegistry) Remove(id WorkflowID) error {
 	defer r.mu.Unlock()
 
 	// Remove from runtime
-	if _, ok := r.runtimes[id]; !ok {
-		return fmt.Errorf("workflow with ID %s not found", id)
-	}
+	_, inRuntime := r.runtimes[id]
 	delete(r.runtimes, id)
 
-	// Soft delete in SQLite
-	//nolint:staticcheck // SA9003: Intentionally ignoring error - session may not exist in DB
-	if err := r.sessionRepo.Delete(r.project, string(id)); err != nil {
-		// Log but don't fail if session doesn't exist in DB
-		// (could be a workflow that was never persisted)
+	// Soft delete in SQLite. Workflows loaded from a previous run only exist there.
+	if err := r.sessionRepo.Delete(r.project, string(id)); err != nil && !inRuntime {
+		return fmt.Errorf("workflow with ID %s not found", id)
 	}
 
 	return nil
@@ -332,15 +328,21 @@ func (r *DurableRegistry) updateSessionFromWorkflow(session *domain.Session, ins
 	session.SetTemplateID(inst.TemplateID)
 	session.SetEpicID(inst.EpicID)
 	session.SetWorkDir(inst.WorkDir)
+	session.SetInitialPrompt(inst.InitialPrompt)
 	session.SetLabels(inst.Labels)
+	session.SetDependencies(workflowIDStrings(inst.After), inst.Args, inst.Outputs)
 	session.SetWorktreeEnabled(inst.WorktreeEnabled)
+	session.SetWorkerWorktrees(inst.WorkerWorktrees)
 	session.SetWorktreeBaseBranch(inst.WorktreeBaseBranch)
 	session.SetWorktreeBranchName(inst.WorktreeBranchName)
 	session.SetWorktreePath(inst.WorktreePath)
 	session.SetWorktreeBranch(inst.WorktreeBranch)
 	session.SetSessionDir(inst.SessionDir)
 	session.SetTokensUsed(inst.TokensUsed)
+	session.SetCostUSD(inst.CostUSD)
+	session.SetBudget(inst.BudgetTokens, inst.BudgetUSD)
 	session.SetActiveWorkers(inst.ActiveWorkers)
+	session.SetScheduledAt(inst.ScheduledAt)
 
 	// Handle state transitions
 	switch inst.State {
@@ -368,7 +370,9 @@ func (r *DurableRegistry) sessionToWorkflow(session *domain.Session) *WorkflowIn
 		Name:               session.Name(),
 		WorkDir:            session.WorkDir(),
 		EpicID:             session.EpicID(),
+		InitialPrompt:      session.InitialPrompt(),
 		WorktreeEnabled:    session.WorktreeEnabled(),
+		WorkerWorktrees:    session.WorkerWorktrees(),
 		WorktreeBaseBranch: session.WorktreeBaseBranch(),
 		WorktreeBranchName: session.WorktreeBranchName(),
 		WorktreePath:       session.WorktreePath(),
@@ -379,8 +383,12 @@ func (r *DurableRegistry) sessionToWorkflow(session *domain.Session) *WorkflowIn
 		CreatedAt:          session.CreatedAt(),
 		StartedAt:          session.StartedAt(),
 		CompletedAt:        session.CompletedAt(),
+		ScheduledAt:        session.ScheduledAt(),
 		UpdatedAt:          session.UpdatedAt(),
 		TokensUsed:         session.TokensUsed(),
+		CostUSD:            session.CostUSD(),
+		BudgetTokens:       session.BudgetTokens(),
+		BudgetUSD:          session.BudgetUSD(),
 		ActiveWorkers:      session.ActiveWorkers(),
 	}
 
@@ -389,6 +397,11 @@ func (r *DurableRegistry) sessionToWorkflow(session *domain.Session) *WorkflowIn
 		inst.Labels = make(map[string]string, len(session.Labels()))
 		maps.Copy(inst.Labels, session.Labels())
 	}
+	for _, id := range session.After() {
+		inst.After = append(inst.After, WorkflowID(id))
+	}
+	inst.Args = maps.Clone(session.Args())
+	inst.Outputs = maps.Clone(session.Outputs())
 
 	// Handle PausedAt (Session uses *time.Time, WorkflowInstance uses time.Time)
 	if session.PausedAt() != nil {
@@ -474,3 +487,15 @@ func sessionStateToWorkflowState(state domain.SessionState) WorkflowState {
 
 // Ensure DurableRegistry implements Registry interface.
 var _ Registry = (*DurableRegistry)(nil)
+
+// workflowIDStrings converts workflow IDs to the session GUIDs they map to.
+func workflowIDStrings(ids []WorkflowID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	guids := make([]string, len(ids))
+	for i, id := range ids {
+		guids[i] = string(id)
+	}
+	return guids
+}
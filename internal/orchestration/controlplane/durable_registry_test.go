@@ -3,6 +3,7 @@ package controlplane
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -270,6 +271,81 @@ func TestDurableRegistry_AttachDetachRuntime(t *testing.T) {
 	require.True(t, registry.HasRuntime(inst.ID))
 }
 
+func TestDurableRegistry_ScheduledAt(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	registry := NewDurableRegistry("test-project", db.SessionRepository())
+
+	startAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Test prompt",
+		StartAt:       startAt,
+	})
+	require.NoError(t, err)
+	require.True(t, inst.IsScheduled())
+	require.NoError(t, registry.Put(inst))
+
+	// Reload from SQLite without the runtime entry
+	registry.DetachRuntime(inst.ID)
+	retrieved, found := registry.Get(inst.ID)
+	require.True(t, found)
+	require.NotNil(t, retrieved.ScheduledAt)
+	require.True(t, startAt.Equal(*retrieved.ScheduledAt))
+	require.True(t, retrieved.IsScheduled())
+	require.Equal(t, "Test prompt", retrieved.InitialPrompt, "scheduled workflows must be startable after a restart")
+}
+
+func TestDurableRegistry_Dependencies(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	registry := NewDurableRegistry("test-project", db.SessionRepository())
+
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID: "test-template",
+		After:      []WorkflowID{"wf-upstream"},
+		Args:       map[string]string{"reviewer": "alice"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, registry.Put(inst))
+	require.NoError(t, registry.Update(inst.ID, func(w *WorkflowInstance) {
+		w.Outputs = map[string]string{OutputEpicID: "perles-abc1"}
+	}))
+
+	// Reload from SQLite without the runtime entry
+	registry.DetachRuntime(inst.ID)
+	retrieved, found := registry.Get(inst.ID)
+	require.True(t, found)
+	require.Equal(t, []WorkflowID{"wf-upstream"}, retrieved.After)
+	require.True(t, retrieved.IsWaiting(), "dependents keep waiting after a restart")
+	require.Equal(t, map[string]string{"reviewer": "alice"}, retrieved.Args)
+	require.Equal(t, map[string]string{OutputEpicID: "perles-abc1"}, retrieved.Outputs)
+}
+
+func TestDurableRegistry_WorkerWorktrees(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	registry := NewDurableRegistry("test-project", db.SessionRepository())
+
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID:      "test-template",
+		InitialPrompt:   "Test prompt",
+		WorktreeEnabled: true,
+		WorkerWorktrees: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, registry.Put(inst))
+
+	// Reload from SQLite without the runtime entry
+	registry.DetachRuntime(inst.ID)
+	retrieved, found := registry.Get(inst.ID)
+	require.True(t, found)
+	require.True(t, retrieved.WorkerWorktrees, "resumed workflows keep per-worker worktrees")
+}
+
 func TestDurableRegistry_ProjectIsolation(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -24,6 +25,11 @@ import (
 // to take action (e.g., transitioning workflow state).
 type LifecycleCallback func(inst *WorkflowInstance, event ControlPlaneEvent)
 
+// UsageCallback is invoked after token usage has been added to a workflow.
+// prevBurn is the workflow's BudgetBurn before the usage was recorded, so the
+// control plane can detect budget thresholds being crossed.
+type UsageCallback func(inst *WorkflowInstance, prevBurn float64)
+
 type CrossWorkflowEventBus struct {
 	// broker is the central pub/sub broker for ControlPlaneEvents.
 	// All subscribers receive events from this broker.
@@ -37,6 +43,9 @@ type CrossWorkflowEventBus struct {
 	// This allows the control plane to react to events and update workflow state.
 	lifecycleCallback LifecycleCallback
 
+	// usageCallback is invoked after token usage events update a workflow's spend.
+	usageCallback UsageCallback
+
 	// mu protects subscriptions map.
 	mu sync.RWMutex
 }
@@ -57,6 +66,14 @@ func (b *CrossWorkflowEventBus) SetLifecycleCallback(cb LifecycleCallback) {
 	b.lifecycleCallback = cb
 }
 
+// SetUsageCallback sets the callback for token usage events.
+// The callback is invoked synchronously after the usage is recorded on the workflow.
+func (b *CrossWorkflowEventBus) SetUsageCallback(cb UsageCallback) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.usageCallback = cb
+}
+
 // AttachWorkflow subscribes to a workflow's internal event bus and republishes
 // events with workflow context attached. Events from the workflow's
 // Infrastructure.Core.EventBus are wrapped in ControlPlaneEvent envelopes
@@ -127,6 +144,21 @@ func (b *CrossWorkflowEventBus) forwardEvents(
 				}
 			}
 
+			// Record spend from token usage events. Cost-only events carry no
+			// tokens, so tokens and cost are added independently.
+			if pe, ok := event.Payload.(events.ProcessEvent); ok && pe.Type == events.ProcessTokenUsage && pe.Metrics != nil {
+				prevBurn := inst.BudgetBurn()
+				inst.AddTokens(int64(pe.Metrics.OutputTokens))
+				inst.AddCost(pe.Metrics.TotalCostUSD)
+
+				b.mu.RLock()
+				cb := b.usageCallback
+				b.mu.RUnlock()
+				if cb != nil {
+					cb(inst, prevBurn)
+				}
+			}
+
 			// Create ControlPlaneEvent with workflow context
 			cpEvent := ControlPlaneEvent{
 				Type:         eventType,
@@ -219,6 +251,42 @@ func (b *CrossWorkflowEventBus) Publish(event ControlPlaneEvent) {
 	b.broker.Publish(pubsub.UpdatedEvent, event)
 }
 
+// HealthEventPublisher returns a HealthEventCallback that republishes health
+// events on the bus so dashboards and API clients see them. The HealthEvent
+// is carried as the payload; workflow context is filled in from workflows
+// when it can be found.
+func (b *CrossWorkflowEventBus) HealthEventPublisher(workflows WorkflowProvider) HealthEventCallback {
+	return func(event HealthEvent) {
+		cpEvent := ControlPlaneEvent{
+			Type:       healthEventType(event.Type),
+			Timestamp:  event.Timestamp,
+			WorkflowID: event.WorkflowID,
+			ProcessID:  event.ProcessID,
+			Payload:    event,
+		}
+		if workflows != nil {
+			if inst, ok := workflows.Get(event.WorkflowID); ok {
+				cpEvent = cpEvent.WithWorkflow(inst)
+			}
+		}
+		b.Publish(cpEvent)
+	}
+}
+
+// healthEventType maps a health monitor event onto its control plane event type.
+func healthEventType(t HealthEventType) EventType {
+	switch t {
+	case HealthHeartbeatMissed:
+		return EventHealthUnhealthy
+	case HealthRecoveryStarted:
+		return EventHealthRecovering
+	case HealthRecoverySuccess:
+		return EventHealthRecovered
+	default:
+		return EventHealthStuck
+	}
+}
+
 // Subscribe returns a channel that receives all ControlPlaneEvents from
 // all attached workflows and directly published events. The channel is
 // automatically closed when the context is cancelled.
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
@@ -260,6 +261,35 @@ func TestCrossWorkflowEventBus_PublishSetsTimestamp(t *testing.T) {
 	}
 }
 
+func TestCrossWorkflowEventBus_HealthEventPublisher(t *testing.T) {
+	bus := NewCrossWorkflowEventBus()
+	defer bus.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch := bus.Subscribe(ctx)
+
+	provider := newMockWorkflowProvider()
+	inst := createTestWorkflow("wf-1", WorkflowRunning)
+	inst.Name = "Auth"
+	provider.Put(inst)
+
+	health := NewHealthEvent(HealthWorkerStuck, "wf-1").WithProcess("worker-2")
+	bus.HealthEventPublisher(provider)(health)
+
+	select {
+	case received := <-ch:
+		require.Equal(t, EventHealthStuck, received.Payload.Type)
+		require.True(t, received.Payload.Type.IsHealthEvent())
+		require.Equal(t, "Auth", received.Payload.WorkflowName)
+		require.Equal(t, "worker-2", received.Payload.ProcessID)
+		require.Equal(t, health, received.Payload.Payload)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for event")
+	}
+}
+
 func TestCrossWorkflowEventBus_MultipleSubscribers(t *testing.T) {
 	bus := NewCrossWorkflowEventBus()
 	defer bus.Close()
@@ -619,3 +649,45 @@ func TestCrossWorkflowEventBus_ActiveWorkerCount(t *testing.T) {
 	}
 	require.Equal(t, 0, inst.ActiveWorkers, "ActiveWorkers should be 0 after all retired")
 }
+
+func TestCrossWorkflowEventBus_RecordsTokenUsage(t *testing.T) {
+	bus := NewCrossWorkflowEventBus()
+	defer bus.Close()
+
+	var prevBurns []float64
+	bus.SetUsageCallback(func(_ *WorkflowInstance, prevBurn float64) {
+		prevBurns = append(prevBurns, prevBurn)
+	})
+
+	inst := createTestWorkflowWithEventBus(WorkflowID("wf-usage"), "Usage Test")
+	inst.BudgetUSD = 1
+	bus.AttachWorkflow(inst)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch := bus.Subscribe(ctx)
+
+	publish := func(m *metrics.TokenMetrics) {
+		inst.Infrastructure.Core.EventBus.Publish(pubsub.UpdatedEvent, events.ProcessEvent{
+			Type:      events.ProcessTokenUsage,
+			Role:      events.RoleWorker,
+			ProcessID: "worker-1",
+			Metrics:   m,
+		})
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for event")
+		}
+	}
+
+	publish(&metrics.TokenMetrics{TokensUsed: 40000, OutputTokens: 500, TotalCostUSD: 0.25})
+	publish(&metrics.TokenMetrics{TotalCostUSD: 0.5}) // Cost-only result event
+
+	require.Equal(t, int64(500), inst.TokensUsed)
+	require.InDelta(t, 0.75, inst.CostUSD, 1e-9)
+	require.Len(t, prevBurns, 2)
+	require.InDelta(t, 0.0, prevBurns[0], 1e-9)
+	require.InDelta(t, 0.25, prevBurns[1], 1e-9)
+}
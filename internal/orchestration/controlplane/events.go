@@ -21,6 +21,10 @@ const (
 	EventWorkflowResumed   EventType = "workflow.resumed"
 	EventWorkflowCompleted EventType = "workflow.completed"
 	EventWorkflowFailed    EventType = "workflow.failed"
+	EventWorkflowQueued    EventType = "workflow.queued"
+	EventWorkflowStopped   EventType = "workflow.stopped"
+	EventWorkflowDeleted   EventType = "workflow.deleted"
+	EventWorkflowLanded    EventType = "workflow.landed"
 
 	// Coordinator events
 	EventCoordinatorSpawned  EventType = "coordinator.spawned"
@@ -46,12 +50,26 @@ const (
 	// User notification events
 	EventUserNotification EventType = "user.notification"
 
+	// Approval events
+	EventApprovalRequested EventType = "approval.requested"
+	EventApprovalResolved  EventType = "approval.resolved"
+
+	// Policy events
+	EventPolicyViolation EventType = "policy.violation"
+
+	// Context handoff events
+	EventContextHandoff EventType = "context.handoff"
+
 	// Health events
 	EventHealthUnhealthy  EventType = "health.unhealthy"
 	EventHealthStuck      EventType = "health.stuck"
 	EventHealthRecovering EventType = "health.recovering"
 	EventHealthRecovered  EventType = "health.recovered"
 
+	// Budget events
+	EventBudgetWarning  EventType = "budget.warning"
+	EventBudgetExceeded EventType = "budget.exceeded"
+
 	// Command log events (for debug mode)
 	EventCommandLog EventType = "command.log"
 
@@ -123,6 +141,24 @@ type WorkflowPausedPayload struct {
 	TriggeredBy string
 }
 
+// BudgetPayload contains a workflow's spend when a budget threshold is crossed.
+type BudgetPayload struct {
+	// Threshold is the budget fraction that was crossed (1 for the hard limit).
+	Threshold float64
+	// Burn is the fraction of the budget spent (see WorkflowInstance.BudgetBurn).
+	Burn         float64
+	TokensUsed   int64
+	CostUSD      float64
+	BudgetTokens int64
+	BudgetUSD    float64
+}
+
+// StopPayload describes why a workflow was stopped.
+type StopPayload struct {
+	Reason string
+	Force  bool
+}
+
 // ClassifyEvent maps a v2 ProcessEvent, CommandLogEvent, or fabric.Event to the appropriate ControlPlane EventType.
 // It inspects the event's Type and Role to determine the correct classification.
 // Unknown events are mapped to EventUnknown.
@@ -207,6 +243,18 @@ func ClassifyEvent(v2Event any) EventType {
 	case events.ProcessUserNotification:
 		return EventUserNotification
 
+	case events.ProcessApprovalRequested:
+		return EventApprovalRequested
+
+	case events.ProcessApprovalResolved:
+		return EventApprovalResolved
+
+	case events.ProcessPolicyViolation:
+		return EventPolicyViolation
+
+	case events.ProcessContextHandoff:
+		return EventContextHandoff
+
 	case events.ProcessIncoming:
 		switch processEvent.Role {
 		case events.RoleCoordinator:
@@ -230,7 +278,11 @@ func (t EventType) IsLifecycleEvent() bool {
 		EventWorkflowPaused,
 		EventWorkflowResumed,
 		EventWorkflowCompleted,
-		EventWorkflowFailed:
+		EventWorkflowFailed,
+		EventWorkflowQueued,
+		EventWorkflowStopped,
+		EventWorkflowDeleted,
+		EventWorkflowLanded:
 		return true
 	default:
 		return false
@@ -299,6 +351,11 @@ func (t EventType) IsHealthEvent() bool {
 	}
 }
 
+// IsBudgetEvent returns true if the event type is a budget event.
+func (t EventType) IsBudgetEvent() bool {
+	return t == EventBudgetWarning || t == EventBudgetExceeded
+}
+
 // IsFabricEvent returns true if the event type is a fabric event.
 func (t EventType) IsFabricEvent() bool {
 	return t == EventFabricPosted
@@ -42,6 +42,15 @@ func TestEventTypeConstants(t *testing.T) {
 		{"HealthStuck", EventHealthStuck, "health.stuck"},
 		{"HealthRecovering", EventHealthRecovering, "health.recovering"},
 		{"HealthRecovered", EventHealthRecovered, "health.recovered"},
+		// Approval events
+		{"ApprovalRequested", EventApprovalRequested, "approval.requested"},
+		{"ApprovalResolved", EventApprovalResolved, "approval.resolved"},
+		// Policy events
+		{"PolicyViolation", EventPolicyViolation, "policy.violation"},
+		{"ContextHandoff", EventContextHandoff, "context.handoff"},
+		// Budget events
+		{"BudgetWarning", EventBudgetWarning, "budget.warning"},
+		{"BudgetExceeded", EventBudgetExceeded, "budget.exceeded"},
 		// Command log events
 		{"CommandLog", EventCommandLog, "command.log"},
 		// Fabric events
@@ -241,6 +250,38 @@ func TestClassifyEvent_WorkflowComplete(t *testing.T) {
 	require.Equal(t, EventWorkflowCompleted, result)
 }
 
+func TestClassifyEvent_Approvals(t *testing.T) {
+	requested := events.ProcessEvent{
+		Type: events.ProcessApprovalRequested,
+		Role: events.RoleCoordinator,
+	}
+	require.Equal(t, EventApprovalRequested, ClassifyEvent(requested))
+
+	resolved := events.ProcessEvent{
+		Type: events.ProcessApprovalResolved,
+		Role: events.RoleCoordinator,
+	}
+	require.Equal(t, EventApprovalResolved, ClassifyEvent(resolved))
+}
+
+func TestClassifyEvent_PolicyViolation(t *testing.T) {
+	event := events.ProcessEvent{
+		Type: events.ProcessPolicyViolation,
+		Role: events.RoleWorker,
+	}
+	require.Equal(t, EventPolicyViolation, ClassifyEvent(event))
+}
+
+func TestClassifyEvent_ContextHandoff(t *testing.T) {
+	for _, role := range []events.ProcessRole{events.RoleCoordinator, events.RoleWorker} {
+		event := events.ProcessEvent{
+			Type: events.ProcessContextHandoff,
+			Role: role,
+		}
+		require.Equal(t, EventContextHandoff, ClassifyEvent(event))
+	}
+}
+
 func TestClassifyEvent_CommandLogEvent(t *testing.T) {
 	event := processor.CommandLogEvent{
 		CommandID:   "cmd-123",
@@ -415,6 +456,10 @@ func TestIsLifecycleEvent(t *testing.T) {
 		EventWorkflowResumed,
 		EventWorkflowCompleted,
 		EventWorkflowFailed,
+		EventWorkflowQueued,
+		EventWorkflowStopped,
+		EventWorkflowDeleted,
+		EventWorkflowLanded,
 	}
 
 	for _, e := range lifecycleEvents {
@@ -519,6 +564,13 @@ func TestIsTaskEvent(t *testing.T) {
 	}
 }
 
+func TestIsBudgetEvent(t *testing.T) {
+	require.True(t, EventBudgetWarning.IsBudgetEvent())
+	require.True(t, EventBudgetExceeded.IsBudgetEvent())
+	require.False(t, EventHealthStuck.IsBudgetEvent())
+	require.False(t, EventWorkflowPaused.IsBudgetEvent())
+}
+
 func TestIsHealthEvent(t *testing.T) {
 	healthEvents := []EventType{
 		EventHealthUnhealthy,
@@ -0,0 +1,71 @@
+package controlplane
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/workflow"
+)
+
+// HealthPolicyFromConfig applies configured health settings over a base policy.
+// Zero durations keep the base values, and a configured escalation ladder
+// replaces the base ladder.
+func HealthPolicyFromConfig(base HealthPolicy, cfg config.HealthConfig) (HealthPolicy, error) {
+	policy := base
+	if cfg.HeartbeatTimeout > 0 {
+		policy.HeartbeatTimeout = cfg.HeartbeatTimeout
+	}
+	if cfg.ProgressTimeout > 0 {
+		policy.ProgressTimeout = cfg.ProgressTimeout
+	}
+	if cfg.RecoveryBackoff > 0 {
+		policy.RecoveryBackoff = cfg.RecoveryBackoff
+	}
+	if cfg.WorkerTimeout > 0 {
+		policy.WorkerTimeout = cfg.WorkerTimeout
+	}
+	if cfg.WorkerNudges != nil {
+		policy.WorkerNudges = *cfg.WorkerNudges
+	}
+
+	if len(cfg.Escalation) > 0 {
+		policy.Escalation = make([]EscalationStep, 0, len(cfg.Escalation))
+		for i, step := range cfg.Escalation {
+			action, err := ParseRecoveryAction(step.Action)
+			if err != nil {
+				return HealthPolicy{}, fmt.Errorf("escalation[%d]: %w", i, err)
+			}
+			policy.Escalation = append(policy.Escalation, EscalationStep{
+				Action:  action,
+				Message: step.Message,
+				Repeat:  step.Repeat,
+			})
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return HealthPolicy{}, err
+	}
+	return policy, nil
+}
+
+// TemplateHealthPolicies builds the policy overrides for workflow templates
+// with a health block in their frontmatter, keyed by the template key that
+// workflows record as their TemplateID. Templates with an invalid health block
+// are logged and fall back to the base policy.
+func TemplateHealthPolicies(base HealthPolicy, workflows []workflow.Workflow) map[string]HealthPolicy {
+	policies := make(map[string]HealthPolicy)
+	for _, wf := range workflows {
+		if wf.Health == nil {
+			continue
+		}
+		policy, err := HealthPolicyFromConfig(base, *wf.Health)
+		if err != nil {
+			log.ErrorErr(log.CatOrch, "Ignoring invalid workflow health policy", err, "templateID", wf.ID)
+			continue
+		}
+		policies[wf.TemplateKey()] = policy
+	}
+	return policies
+}
@@ -0,0 +1,92 @@
+package controlplane
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/orchestration/workflow"
+	appreg "github.com/zjrosen/perles/internal/registry/application"
+	"github.com/zjrosen/perles/internal/templates"
+)
+
+func TestHealthPolicyFromConfig(t *testing.T) {
+	base := DefaultHealthPolicy()
+
+	policy, err := HealthPolicyFromConfig(base, config.HealthConfig{})
+	require.NoError(t, err)
+	require.Equal(t, base, policy, "an empty config keeps the base policy")
+
+	policy, err = HealthPolicyFromConfig(base, config.HealthConfig{
+		ProgressTimeout: 10 * time.Minute,
+		Escalation: []config.EscalationStepConfig{
+			{Action: "nudge", Message: "Check on your workers", Repeat: 2},
+			{Action: "replace_coordinator"},
+			{Action: "fail"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Minute, policy.ProgressTimeout)
+	require.Equal(t, base.HeartbeatTimeout, policy.HeartbeatTimeout)
+	require.Equal(t, []EscalationStep{
+		{Action: RecoveryNudge, Message: "Check on your workers", Repeat: 2},
+		{Action: RecoveryReplace},
+		{Action: RecoveryFail},
+	}, policy.Escalation)
+
+	noNudges := 0
+	policy, err = HealthPolicyFromConfig(base, config.HealthConfig{WorkerTimeout: 20 * time.Minute, WorkerNudges: &noNudges})
+	require.NoError(t, err)
+	require.Equal(t, 20*time.Minute, policy.WorkerTimeout)
+	require.Equal(t, 0, policy.WorkerNudges, "an explicit zero replaces stuck workers without nudging")
+
+	_, err = HealthPolicyFromConfig(base, config.HealthConfig{
+		Escalation: []config.EscalationStepConfig{{Action: "restart"}},
+	})
+	require.ErrorContains(t, err, "escalation[0]")
+}
+
+func TestTemplateHealthPolicies(t *testing.T) {
+	base := DefaultHealthPolicy()
+
+	policies := TemplateHealthPolicies(base, []workflow.Workflow{
+		{ID: "cook", Health: &config.HealthConfig{ProgressTimeout: 15 * time.Minute}},
+		{ID: "debate"},
+		{ID: "broken", Health: &config.HealthConfig{Escalation: []config.EscalationStepConfig{{Action: "restart"}}}},
+	})
+
+	require.Len(t, policies, 1)
+	require.Equal(t, 15*time.Minute, policies["cook"].ProgressTimeout)
+	require.Equal(t, base.Escalation, policies["cook"].Escalation)
+}
+
+func TestTemplateHealthPolicies_ResolvesTemplateID(t *testing.T) {
+	base := DefaultHealthPolicy()
+
+	// Workflows record the registration key (quick-plan) as their TemplateID,
+	// while the frontmatter comes from quick_plan.md
+	registryService, err := appreg.NewRegistryService(templates.RegistryFS(), t.TempDir())
+	require.NoError(t, err)
+	reg, err := registryService.GetByKey("workflow", "quick-plan")
+	require.NoError(t, err)
+	inst, err := NewWorkflowInstance(&WorkflowSpec{TemplateID: reg.Key(), InitialPrompt: "Plan it"})
+	require.NoError(t, err)
+
+	workflows, err := workflow.LoadBuiltinWorkflows()
+	require.NoError(t, err)
+	found := false
+	for i := range workflows {
+		if workflows[i].ID == "quick_plan" {
+			workflows[i].Health = &config.HealthConfig{ProgressTimeout: 25 * time.Minute}
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	policies := TemplateHealthPolicies(base, workflows)
+	policy, ok := policies[inst.TemplateID]
+	require.True(t, ok, "policy must be found by the workflow's TemplateID %q", inst.TemplateID)
+	require.Equal(t, 25*time.Minute, policy.ProgressTimeout)
+}
@@ -58,6 +58,10 @@ type HealthMonitorConfig struct {
 	// Policy defines health monitoring thresholds.
 	Policy HealthPolicy
 
+	// TemplatePolicies overrides Policy for workflows created from the keyed
+	// template IDs.
+	TemplatePolicies map[string]HealthPolicy
+
 	// CheckInterval is how often the monitor runs health checks.
 	// Defaults to 10 seconds if not specified.
 	CheckInterval time.Duration
@@ -74,6 +78,10 @@ type HealthMonitorConfig struct {
 	// If nil, no automatic recovery is performed (events still emitted).
 	RecoveryExecutor RecoveryExecutor
 
+	// WorkflowProvider looks up workflows to suspend stuck detection while
+	// they wait for a human approval. If nil, stuck detection never pauses.
+	WorkflowProvider WorkflowProvider
+
 	// Clock is used for time operations (for testing).
 	// If nil, uses time.Now().
 	Clock Clock
@@ -96,11 +104,20 @@ type defaultHealthMonitor struct {
 	statuses map[WorkflowID]*HealthStatus
 	clock    Clock
 
+	// Per-template policy overrides, applied by the template ID seen on each
+	// workflow's events
+	templatePolicies map[string]HealthPolicy
+	templates        map[WorkflowID]string
+
+	// Per-worker liveness, keyed by workflow then process ID
+	workers map[WorkflowID]map[string]*WorkerHealth
+
 	// Check loop state
 	checkInterval    time.Duration
 	eventBus         *pubsub.Broker[ControlPlaneEvent]
 	onHealthEvent    HealthEventCallback
 	recoveryExecutor RecoveryExecutor
+	workflows        WorkflowProvider
 
 	// Lifecycle
 	ctx    context.Context
@@ -124,11 +141,15 @@ func NewHealthMonitor(cfg HealthMonitorConfig) HealthMonitor {
 	return &defaultHealthMonitor{
 		policy:           cfg.Policy,
 		statuses:         make(map[WorkflowID]*HealthStatus),
+		templatePolicies: cfg.TemplatePolicies,
+		templates:        make(map[WorkflowID]string),
+		workers:          make(map[WorkflowID]map[string]*WorkerHealth),
 		clock:            clock,
 		checkInterval:    checkInterval,
 		eventBus:         cfg.EventBus,
 		onHealthEvent:    cfg.OnHealthEvent,
 		recoveryExecutor: cfg.RecoveryExecutor,
+		workflows:        cfg.WorkflowProvider,
 	}
 }
 
@@ -265,6 +286,25 @@ func (m *defaultHealthMonitor) UntrackWorkflow(id WorkflowID) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	delete(m.statuses, id)
+	delete(m.templates, id)
+	delete(m.workers, id)
+}
+
+// setTemplate records the template a workflow was created from so its
+// template policy override applies.
+func (m *defaultHealthMonitor) setTemplate(id WorkflowID, templateID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.templates[id] = templateID
+}
+
+// policyFor returns the policy for a workflow, honoring template overrides.
+// Must be called with mu held.
+func (m *defaultHealthMonitor) policyFor(id WorkflowID) HealthPolicy {
+	if policy, ok := m.templatePolicies[m.templates[id]]; ok {
+		return policy
+	}
+	return m.policy
 }
 
 // createStatus creates a new HealthStatus for the given workflow ID.
@@ -315,9 +355,19 @@ func (m *defaultHealthMonitor) runHealthCheck() {
 	defer m.mu.Unlock()
 
 	now := m.clock.Now()
-	policy := m.policy
 
 	for id, status := range m.statuses {
+		// A workflow waiting for a human is not stuck; the wait counts as
+		// progress so detection restarts from the decision.
+		if m.awaitingApproval(id) {
+			status.LastProgressAt = now
+			for _, worker := range m.workers[id] {
+				worker.LastActivityAt = now
+			}
+			continue
+		}
+
+		policy := m.policyFor(id)
 		timeSinceHeartbeat := now.Sub(status.LastHeartbeatAt)
 		timeSinceProgress := now.Sub(status.LastProgressAt)
 
@@ -350,6 +400,75 @@ func (m *defaultHealthMonitor) runHealthCheck() {
 			// Trigger recovery if needed
 			m.triggerRecoveryIfNeeded(id, status, policy, now)
 		}
+
+		m.checkWorkers(id, policy, now)
+	}
+}
+
+// awaitingApproval reports whether a workflow has approvals pending.
+func (m *defaultHealthMonitor) awaitingApproval(id WorkflowID) bool {
+	if m.workflows == nil {
+		return false
+	}
+	inst, ok := m.workflows.Get(id)
+	if !ok || inst.Infrastructure == nil || inst.Infrastructure.Repositories.ApprovalRepo == nil {
+		return false
+	}
+	return len(inst.Infrastructure.Repositories.ApprovalRepo.Pending()) > 0
+}
+
+// checkWorkers nudges workers that have been working without activity for
+// longer than the worker timeout, and replaces them once the nudges run out.
+// Must be called with mu held.
+func (m *defaultHealthMonitor) checkWorkers(id WorkflowID, policy HealthPolicy, now time.Time) {
+	for processID, worker := range m.workers[id] {
+		if !worker.IsStuckAt(policy, now) {
+			continue
+		}
+
+		stuckFor := now.Sub(worker.LastActivityAt)
+		m.emitEvent(NewHealthEvent(HealthWorkerStuck, id).
+			WithProcess(processID).
+			WithDetails(fmt.Sprintf("%s has produced no output for %s", processID, stuckFor.Truncate(time.Second))))
+
+		if m.recoveryExecutor == nil {
+			// Rate-limit the event to once per timeout
+			lastRecovery := now
+			worker.LastRecoveryAt = &lastRecovery
+			continue
+		}
+
+		action := RecoveryReplaceWorker
+		if worker.Nudges < policy.WorkerNudges {
+			action = RecoveryNudge
+			worker.Nudges++
+			lastRecovery := now
+			worker.LastRecoveryAt = &lastRecovery
+		} else {
+			// The replacement starts fresh; the old worker is retired
+			delete(m.workers[id], processID)
+		}
+
+		log.Warn(log.CatOrch, "Worker stuck",
+			"workflow_id", id,
+			"process_id", processID,
+			"task_id", worker.TaskID,
+			"stuck_for", stuckFor.Truncate(time.Second),
+			"action", action)
+
+		req := RecoveryRequest{
+			Step:      EscalationStep{Action: action},
+			Attempt:   worker.Nudges,
+			StuckFor:  stuckFor,
+			ProcessID: processID,
+		}
+		log.SafeGo("healthmonitor.recoverWorker", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			// Recovery executor will emit its own events
+			_ = m.recoveryExecutor.Recover(ctx, id, req)
+		})
 	}
 }
 
@@ -372,7 +491,7 @@ func (m *defaultHealthMonitor) triggerRecoveryIfNeeded(id WorkflowID, status *He
 		"workflow_id", id,
 		"needs_recovery", needsRecovery,
 		"recovery_count", status.RecoveryCount,
-		"max_recoveries", policy.MaxRecoveries,
+		"max_recoveries", policy.recoveryLimit(),
 		"time_since_last_recovery", timeSinceLastRecovery.Truncate(time.Second),
 		"recovery_backoff", policy.RecoveryBackoff)
 
@@ -380,14 +499,14 @@ func (m *defaultHealthMonitor) triggerRecoveryIfNeeded(id WorkflowID, status *He
 		return
 	}
 
-	// Determine the appropriate recovery action
-	action := DetermineRecoveryActionAt(status, policy, now)
+	// Determine the next escalation step
+	step, ok := NextEscalationStepAt(status, policy, now)
 	log.Debug(log.CatOrch, "Recovery action determined",
 		"workflow_id", id,
-		"action", action,
-		"action_valid", action >= 0)
+		"action", step.Action,
+		"action_valid", ok)
 
-	if action < 0 {
+	if !ok {
 		// No recovery action available - emit "still stuck" event periodically
 		// to provide visibility into limbo state (once per backoff period)
 		if status.LastRecoveryAt != nil {
@@ -407,6 +526,11 @@ func (m *defaultHealthMonitor) triggerRecoveryIfNeeded(id WorkflowID, status *He
 
 	// Record the recovery attempt (increment count and timestamp using our clock)
 	status.RecordRecoveryAttemptAt(now)
+	req := RecoveryRequest{
+		Step:     step,
+		Attempt:  status.RecoveryCount,
+		StuckFor: now.Sub(status.LastProgressAt),
+	}
 
 	// Execute recovery asynchronously to avoid blocking the check loop (with panic recovery)
 	log.SafeGo("healthmonitor.executeRecovery", func() {
@@ -414,7 +538,7 @@ func (m *defaultHealthMonitor) triggerRecoveryIfNeeded(id WorkflowID, status *He
 		defer cancel()
 
 		// Recovery executor will emit its own events
-		_ = m.recoveryExecutor.ExecuteRecovery(ctx, id, action)
+		_ = m.recoveryExecutor.Recover(ctx, id, req)
 	})
 }
 
@@ -469,6 +593,14 @@ func (m *defaultHealthMonitor) processEvent(event pubsub.Event[ControlPlaneEvent
 		return
 	}
 
+	if cpEvent.TemplateID != "" && len(m.templatePolicies) > 0 {
+		m.setTemplate(workflowID, cpEvent.TemplateID)
+	}
+
+	if processEvent.Role == events.RoleWorker && processEvent.ProcessID != "" {
+		m.recordWorkerActivity(workflowID, processEvent)
+	}
+
 	if isProgressEvent(processEvent) {
 		m.RecordProgress(workflowID)
 	} else {
@@ -477,6 +609,50 @@ func (m *defaultHealthMonitor) processEvent(event pubsub.Event[ControlPlaneEvent
 	}
 }
 
+// recordWorkerActivity updates a worker's liveness from one of its events.
+// Output and token usage count as activity; messages delivered to the worker
+// do not, so a nudge cannot make a hung worker look alive.
+func (m *defaultHealthMonitor) recordWorkerActivity(id WorkflowID, event events.ProcessEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if event.Status.IsTerminal() {
+		delete(m.workers[id], event.ProcessID)
+		return
+	}
+
+	workers, ok := m.workers[id]
+	if !ok {
+		workers = make(map[string]*WorkerHealth)
+		m.workers[id] = workers
+	}
+	worker, ok := workers[event.ProcessID]
+	if !ok {
+		worker = &WorkerHealth{ProcessID: event.ProcessID, LastActivityAt: m.clock.Now()}
+		workers[event.ProcessID] = worker
+	}
+
+	if event.TaskID != "" {
+		worker.TaskID = event.TaskID
+	}
+
+	working := event.Type == events.ProcessWorking || event.Status == events.ProcessStatusWorking
+	switch {
+	case working:
+		if !worker.Working {
+			worker.Working = true
+			worker.markActive(m.clock.Now())
+		}
+	case event.Status != "" || event.Type == events.ProcessReady:
+		worker.Working = false
+	}
+
+	switch event.Type {
+	case events.ProcessOutput, events.ProcessTokenUsage, events.ProcessReady:
+		worker.markActive(m.clock.Now())
+	}
+}
+
 // isProgressEvent determines if an event represents forward progress.
 // Progress events indicate meaningful workflow advancement and reset the stuck timer.
 // Only worker output counts as progress - coordinator output could be a nudge response.
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -704,3 +706,219 @@ func TestHealthMonitor_EventBus_CoordinatorOutputDoesNotResetRecovery(t *testing
 
 	monitor.Stop()
 }
+
+// recordingRecoveryExecutor records the recovery requests it receives.
+type recordingRecoveryExecutor struct {
+	mu       sync.Mutex
+	requests map[WorkflowID][]RecoveryRequest
+}
+
+func (e *recordingRecoveryExecutor) ExecuteRecovery(ctx context.Context, id WorkflowID, action RecoveryAction) error {
+	return e.Recover(ctx, id, RecoveryRequest{Step: EscalationStep{Action: action}})
+}
+
+func (e *recordingRecoveryExecutor) Recover(_ context.Context, id WorkflowID, req RecoveryRequest) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests[id] = append(e.requests[id], req)
+	return nil
+}
+
+func (e *recordingRecoveryExecutor) get(id WorkflowID) []RecoveryRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]RecoveryRequest(nil), e.requests[id]...)
+}
+
+func TestHealthMonitor_TemplatePolicies_OverrideEscalation(t *testing.T) {
+	clock := newMockClock(time.Now())
+	eventBus := pubsub.NewBroker[ControlPlaneEvent]()
+	defer eventBus.Close()
+
+	policy := HealthPolicy{
+		HeartbeatTimeout: time.Minute,
+		ProgressTimeout:  time.Minute,
+		MaxRecoveries:    3,
+		Escalation:       []EscalationStep{{Action: RecoveryNudge}},
+	}
+	override := policy
+	override.Escalation = []EscalationStep{{Action: RecoveryNotify, Message: "Cook is stuck"}}
+
+	executor := &recordingRecoveryExecutor{requests: make(map[WorkflowID][]RecoveryRequest)}
+	monitor := NewHealthMonitor(HealthMonitorConfig{
+		Policy:           policy,
+		TemplatePolicies: map[string]HealthPolicy{"cook": override},
+		CheckInterval:    10 * time.Millisecond,
+		Clock:            clock,
+		EventBus:         eventBus,
+		RecoveryExecutor: executor,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, monitor.Start(ctx))
+	defer monitor.Stop()
+	time.Sleep(10 * time.Millisecond)
+
+	for id, templateID := range map[WorkflowID]string{"wf-cook": "cook", "wf-debate": "debate"} {
+		eventBus.Publish(pubsub.UpdatedEvent, ControlPlaneEvent{
+			WorkflowID: id,
+			TemplateID: templateID,
+			Payload:    events.ProcessEvent{Type: events.ProcessOutput, ProcessID: "coordinator", Role: events.RoleCoordinator},
+		})
+	}
+	require.Eventually(t, func() bool { return len(monitor.GetAllStatuses()) == 2 }, time.Second, 5*time.Millisecond)
+
+	clock.Advance(2 * time.Minute)
+
+	require.Eventually(t, func() bool {
+		return len(executor.get("wf-cook")) == 1 && len(executor.get("wf-debate")) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	cook := executor.get("wf-cook")[0]
+	require.Equal(t, EscalationStep{Action: RecoveryNotify, Message: "Cook is stuck"}, cook.Step)
+	require.Equal(t, 1, cook.Attempt)
+	require.Equal(t, 2*time.Minute, cook.StuckFor)
+	require.Equal(t, RecoveryNudge, executor.get("wf-debate")[0].Step.Action)
+}
+
+func TestHealthMonitor_StuckWorker_NudgedThenReplaced(t *testing.T) {
+	clock := newMockClock(time.Now())
+	executor := &recordingRecoveryExecutor{requests: make(map[WorkflowID][]RecoveryRequest)}
+	monitor := NewHealthMonitor(HealthMonitorConfig{
+		Policy: HealthPolicy{
+			HeartbeatTimeout: time.Hour,
+			ProgressTimeout:  time.Hour,
+			RecoveryBackoff:  time.Minute,
+			MaxRecoveries:    3,
+			WorkerTimeout:    5 * time.Minute,
+			WorkerNudges:     1,
+		},
+		Clock:            clock,
+		RecoveryExecutor: executor,
+	}).(*defaultHealthMonitor)
+
+	publish := func(event events.ProcessEvent) {
+		monitor.processEvent(pubsub.Event[ControlPlaneEvent]{Payload: ControlPlaneEvent{WorkflowID: "wf-1", Payload: event}})
+	}
+	publish(events.ProcessEvent{Type: events.ProcessWorking, ProcessID: "worker-1", Role: events.RoleWorker, TaskID: "perles-abc.1", Status: events.ProcessStatusWorking})
+	publish(events.ProcessEvent{Type: events.ProcessWorking, ProcessID: "worker-2", Role: events.RoleWorker, Status: events.ProcessStatusWorking})
+
+	clock.Advance(4 * time.Minute)
+	publish(events.ProcessEvent{Type: events.ProcessOutput, ProcessID: "worker-2", Role: events.RoleWorker, Output: "Running tests"})
+	clock.Advance(2 * time.Minute)
+	// Coordinator chatter and messages delivered to the worker are not worker activity
+	publish(events.ProcessEvent{Type: events.ProcessOutput, ProcessID: "coordinator", Role: events.RoleCoordinator})
+	publish(events.ProcessEvent{Type: events.ProcessIncoming, ProcessID: "worker-1", Role: events.RoleWorker})
+	monitor.runHealthCheck()
+
+	require.Eventually(t, func() bool { return len(executor.get("wf-1")) == 1 }, time.Second, 5*time.Millisecond)
+	nudge := executor.get("wf-1")[0]
+	require.Equal(t, RecoveryNudge, nudge.Step.Action)
+	require.Equal(t, "worker-1", nudge.ProcessID, "only the silent worker is recovered")
+	require.Equal(t, 6*time.Minute, nudge.StuckFor)
+
+	// The nudge gives the worker a full timeout to respond
+	publish(events.ProcessEvent{Type: events.ProcessReady, ProcessID: "worker-2", Role: events.RoleWorker, Status: events.ProcessStatusReady})
+	clock.Advance(4 * time.Minute)
+	monitor.runHealthCheck()
+	time.Sleep(20 * time.Millisecond)
+	require.Len(t, executor.get("wf-1"), 1)
+
+	clock.Advance(2 * time.Minute)
+	monitor.runHealthCheck()
+	require.Eventually(t, func() bool { return len(executor.get("wf-1")) == 2 }, time.Second, 5*time.Millisecond)
+	replace := executor.get("wf-1")[1]
+	require.Equal(t, RecoveryReplaceWorker, replace.Step.Action)
+	require.Equal(t, "worker-1", replace.ProcessID)
+
+	monitor.mu.RLock()
+	_, tracked := monitor.workers["wf-1"]["worker-1"]
+	monitor.mu.RUnlock()
+	require.False(t, tracked, "replaced workers are no longer tracked")
+}
+
+func TestHealthMonitor_PendingApproval_SuspendsStuckDetection(t *testing.T) {
+	clock := newMockClock(time.Now())
+	executor := &recordingRecoveryExecutor{requests: make(map[WorkflowID][]RecoveryRequest)}
+	approvals := repository.NewMemoryApprovalRepository()
+	require.NoError(t, approvals.Save(&repository.Approval{ID: "approval-1", TaskIDs: []string{"perles-abc.1"}}))
+	provider := newMockWorkflowProvider()
+	inst := createTestWorkflow("wf-1", WorkflowRunning)
+	inst.Infrastructure = &v2.Infrastructure{Repositories: v2.RepositoryComponents{ApprovalRepo: approvals}}
+	provider.Put(inst)
+
+	monitor := NewHealthMonitor(HealthMonitorConfig{
+		Policy: HealthPolicy{
+			HeartbeatTimeout: time.Hour,
+			ProgressTimeout:  10 * time.Minute,
+			RecoveryBackoff:  time.Minute,
+			MaxRecoveries:    3,
+			WorkerTimeout:    5 * time.Minute,
+			WorkerNudges:     1,
+		},
+		Clock:            clock,
+		RecoveryExecutor: executor,
+		WorkflowProvider: provider,
+	}).(*defaultHealthMonitor)
+
+	monitor.processEvent(pubsub.Event[ControlPlaneEvent]{Payload: ControlPlaneEvent{WorkflowID: "wf-1", Payload: events.ProcessEvent{
+		Type: events.ProcessWorking, ProcessID: "worker-1", Role: events.RoleWorker, TaskID: "perles-abc.2", Status: events.ProcessStatusWorking,
+	}}})
+
+	// Waiting on a human is neither a stuck workflow nor a stuck worker
+	clock.Advance(20 * time.Minute)
+	monitor.runHealthCheck()
+	time.Sleep(20 * time.Millisecond)
+	require.Empty(t, executor.get("wf-1"))
+	status, ok := monitor.GetStatus("wf-1")
+	require.True(t, ok)
+	require.False(t, status.IsStuckAt(monitor.policy, clock.Now()))
+
+	// Detection resumes from the decision
+	require.NoError(t, approvals.Delete("approval-1"))
+	clock.Advance(4 * time.Minute)
+	monitor.runHealthCheck()
+	time.Sleep(20 * time.Millisecond)
+	require.Empty(t, executor.get("wf-1"))
+
+	clock.Advance(2 * time.Minute)
+	monitor.runHealthCheck()
+	require.Eventually(t, func() bool { return len(executor.get("wf-1")) == 1 }, time.Second, 5*time.Millisecond)
+	require.Equal(t, RecoveryNudge, executor.get("wf-1")[0].Step.Action)
+}
+
+func TestHealthMonitor_WorkerActivity_ResetsNudges(t *testing.T) {
+	clock := newMockClock(time.Now())
+	monitor := NewHealthMonitor(HealthMonitorConfig{
+		Policy: HealthPolicy{WorkerTimeout: 5 * time.Minute, WorkerNudges: 1},
+		Clock:  clock,
+	}).(*defaultHealthMonitor)
+
+	publish := func(event events.ProcessEvent) {
+		monitor.processEvent(pubsub.Event[ControlPlaneEvent]{Payload: ControlPlaneEvent{WorkflowID: "wf-1", Payload: event}})
+	}
+	publish(events.ProcessEvent{Type: events.ProcessWorking, ProcessID: "worker-1", Role: events.RoleWorker, Status: events.ProcessStatusWorking})
+	monitor.mu.Lock()
+	worker := monitor.workers["wf-1"]["worker-1"]
+	worker.Nudges = 1
+	monitor.mu.Unlock()
+
+	clock.Advance(time.Minute)
+	publish(events.ProcessEvent{Type: events.ProcessOutput, ProcessID: "worker-1", Role: events.RoleWorker})
+	monitor.mu.RLock()
+	require.Equal(t, 0, worker.Nudges)
+	require.Equal(t, clock.Now(), worker.LastActivityAt)
+	monitor.mu.RUnlock()
+
+	publish(events.ProcessEvent{Type: events.ProcessStatusChange, ProcessID: "worker-1", Role: events.RoleWorker, Status: events.ProcessStatusReady})
+	clock.Advance(time.Hour)
+	monitor.mu.RLock()
+	require.False(t, worker.IsStuckAt(monitor.policy, clock.Now()), "idle workers are never stuck")
+	monitor.mu.RUnlock()
+
+	publish(events.ProcessEvent{Type: events.ProcessStatusChange, ProcessID: "worker-1", Role: events.RoleWorker, Status: events.ProcessStatusRetired})
+	monitor.mu.RLock()
+	require.Empty(t, monitor.workers["wf-1"])
+	monitor.mu.RUnlock()
+}
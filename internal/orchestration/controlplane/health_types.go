@@ -47,6 +47,34 @@ type HealthPolicy struct {
 	// are exhausted. When false (default), workflows enter limbo state instead
 	// of failing, emitting HealthStillStuck events periodically.
 	EnableAutoFail bool
+
+	// Escalation is an explicit recovery ladder. When set, it replaces the
+	// MaxRecoveries, MaxNudges and EnableAuto* settings: each recovery attempt
+	// takes the next step, and once the ladder is exhausted the workflow stays
+	// stuck, emitting HealthStillStuck events.
+	Escalation []EscalationStep
+
+	// WorkerTimeout is how long a working worker may go without output before
+	// it is considered stuck, even while the rest of the workflow progresses.
+	// Zero disables worker monitoring.
+	WorkerTimeout time.Duration
+
+	// WorkerNudges is how many times a stuck worker is nudged before it is
+	// replaced and its task reassigned.
+	WorkerNudges int
+}
+
+// EscalationStep is one rung of a recovery escalation ladder.
+type EscalationStep struct {
+	// Action is the recovery action to take.
+	Action RecoveryAction
+
+	// Message is sent by nudge and notify actions. Empty uses the default message.
+	Message string
+
+	// Repeat is how many times the step is attempted before escalating to the
+	// next one. Zero means once.
+	Repeat int
 }
 
 // DefaultHealthPolicy returns a HealthPolicy with sensible defaults.
@@ -61,6 +89,8 @@ func DefaultHealthPolicy() HealthPolicy {
 		EnableAutoReplace: false,
 		EnableAutoPause:   false,
 		EnableAutoFail:    false,
+		WorkerTimeout:     10 * time.Minute,
+		WorkerNudges:      1,
 	}
 }
 
@@ -79,9 +109,42 @@ func (p *HealthPolicy) Validate() error {
 	if p.RecoveryBackoff < 0 {
 		return fmt.Errorf("recovery_backoff cannot be negative: %v", p.RecoveryBackoff)
 	}
+	if p.WorkerTimeout < 0 {
+		return fmt.Errorf("worker_timeout cannot be negative: %v", p.WorkerTimeout)
+	}
+	if p.WorkerNudges < 0 {
+		return fmt.Errorf("worker_nudges cannot be negative: %d", p.WorkerNudges)
+	}
+	for i, step := range p.Escalation {
+		if !step.Action.IsValid() {
+			return fmt.Errorf("escalation[%d]: invalid action %s", i, step.Action)
+		}
+		if step.Repeat < 0 {
+			return fmt.Errorf("escalation[%d]: repeat cannot be negative: %d", i, step.Repeat)
+		}
+	}
 	return nil
 }
 
+// escalationLadder expands the Escalation steps into one entry per recovery attempt.
+func (p HealthPolicy) escalationLadder() []EscalationStep {
+	var ladder []EscalationStep
+	for _, step := range p.Escalation {
+		for range max(step.Repeat, 1) {
+			ladder = append(ladder, step)
+		}
+	}
+	return ladder
+}
+
+// recoveryLimit is the number of recovery attempts the policy allows.
+func (p HealthPolicy) recoveryLimit() int {
+	if len(p.Escalation) > 0 {
+		return len(p.escalationLadder())
+	}
+	return p.MaxRecoveries
+}
+
 // HealthStatus tracks the health of a single workflow instance.
 type HealthStatus struct {
 	// WorkflowID identifies the workflow being tracked.
@@ -151,7 +214,7 @@ func (s *HealthStatus) NeedsRecoveryAt(policy HealthPolicy, now time.Time) bool
 	}
 	// Note: We check >= because once we hit max recoveries, the next action should be to fail
 	// which is handled by DetermineRecoveryAction
-	if s.RecoveryCount > policy.MaxRecoveries {
+	if s.RecoveryCount > policy.recoveryLimit() {
 		return false
 	}
 	if s.LastRecoveryAt != nil {
@@ -191,6 +254,48 @@ func (s *HealthStatus) RecordRecoveryAttemptAt(now time.Time) {
 	s.LastRecoveryAt = &now
 }
 
+// WorkerHealth tracks the liveness of a single worker process.
+type WorkerHealth struct {
+	// ProcessID identifies the worker.
+	ProcessID string
+
+	// TaskID is the task the worker was last seen working on.
+	TaskID string
+
+	// Working is true while the worker is in the middle of a turn.
+	Working bool
+
+	// LastActivityAt is when the worker last produced output or started a turn.
+	LastActivityAt time.Time
+
+	// Nudges is the number of nudges sent since the worker's last activity.
+	Nudges int
+
+	// LastRecoveryAt is when the worker was last nudged. Nil if never.
+	LastRecoveryAt *time.Time
+}
+
+// IsStuckAt returns true if the worker has been working without activity
+// for longer than the policy's WorkerTimeout. Time since the last nudge
+// counts from the nudge, giving the worker a full timeout to respond.
+func (w *WorkerHealth) IsStuckAt(policy HealthPolicy, now time.Time) bool {
+	if policy.WorkerTimeout <= 0 || !w.Working {
+		return false
+	}
+	since := w.LastActivityAt
+	if w.LastRecoveryAt != nil && w.LastRecoveryAt.After(since) {
+		since = *w.LastRecoveryAt
+	}
+	return now.Sub(since) > policy.WorkerTimeout
+}
+
+// markActive records worker activity and clears its nudge history.
+func (w *WorkerHealth) markActive(now time.Time) {
+	w.LastActivityAt = now
+	w.Nudges = 0
+	w.LastRecoveryAt = nil
+}
+
 // HealthEventType categorizes health-related events.
 type HealthEventType string
 
@@ -218,6 +323,10 @@ const (
 	// action is available (e.g., max nudges reached and escalation disabled).
 	// Emitted periodically to provide visibility into limbo state.
 	HealthStillStuck HealthEventType = "health.still.stuck"
+
+	// HealthWorkerStuck indicates a working worker has produced no output
+	// within the worker timeout.
+	HealthWorkerStuck HealthEventType = "health.worker.stuck"
 )
 
 // HealthEvent represents a health-related event for a workflow.
@@ -237,6 +346,10 @@ type HealthEvent struct {
 	// RecoveryAction describes the recovery action taken (if any).
 	// Examples: "nudge", "replace", "pause", "fail"
 	RecoveryAction string
+
+	// ProcessID identifies the worker for worker-level events. Empty for
+	// workflow-level events.
+	ProcessID string
 }
 
 // NewHealthEvent creates a new HealthEvent with the given type and workflow ID.
@@ -254,6 +367,12 @@ func (e HealthEvent) WithDetails(details string) HealthEvent {
 	return e
 }
 
+// WithProcess adds the affected worker to the health event.
+func (e HealthEvent) WithProcess(processID string) HealthEvent {
+	e.ProcessID = processID
+	return e
+}
+
 // WithRecoveryAction adds recovery action information to the health event.
 func (e HealthEvent) WithRecoveryAction(action string) HealthEvent {
 	e.RecoveryAction = action
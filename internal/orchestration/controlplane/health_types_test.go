@@ -126,6 +126,18 @@ func TestHealthPolicy_Validate_AcceptsZeroRecoveryBackoff(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHealthPolicy_Validate_RejectsNegativeWorkerTimeout(t *testing.T) {
+	policy := HealthPolicy{
+		HeartbeatTimeout: 2 * time.Minute,
+		ProgressTimeout:  5 * time.Minute,
+		WorkerTimeout:    -1 * time.Second,
+	}
+
+	err := policy.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "worker_timeout cannot be negative")
+}
+
 func TestDefaultHealthPolicy(t *testing.T) {
 	policy := DefaultHealthPolicy()
 
@@ -138,6 +150,8 @@ func TestDefaultHealthPolicy(t *testing.T) {
 	require.False(t, policy.EnableAutoReplace)
 	require.False(t, policy.EnableAutoPause)
 	require.False(t, policy.EnableAutoFail)
+	require.Equal(t, 10*time.Minute, policy.WorkerTimeout)
+	require.Equal(t, 1, policy.WorkerNudges)
 
 	err := policy.Validate()
 	require.NoError(t, err, "default policy should be valid")
@@ -294,6 +308,23 @@ func TestNewHealthStatus(t *testing.T) {
 	require.WithinDuration(t, time.Now(), status.LastProgressAt, time.Second)
 }
 
+func TestWorkerHealth_IsStuckAt(t *testing.T) {
+	now := time.Now()
+	policy := HealthPolicy{WorkerTimeout: 5 * time.Minute}
+	worker := &WorkerHealth{ProcessID: "worker-1", Working: true, LastActivityAt: now.Add(-6 * time.Minute)}
+
+	require.True(t, worker.IsStuckAt(policy, now))
+	require.False(t, worker.IsStuckAt(HealthPolicy{}, now), "zero timeout disables worker monitoring")
+
+	nudged := now.Add(-time.Minute)
+	worker.LastRecoveryAt = &nudged
+	require.False(t, worker.IsStuckAt(policy, now), "timeout restarts after a nudge")
+
+	worker.LastRecoveryAt = nil
+	worker.Working = false
+	require.False(t, worker.IsStuckAt(policy, now), "idle workers are never stuck")
+}
+
 func TestHealthEventType_Constants(t *testing.T) {
 	// Verify the enum values are as expected (for documentation/stability)
 	require.Equal(t, HealthEventType("health.heartbeat.missed"), HealthHeartbeatMissed)
@@ -301,6 +332,7 @@ func TestHealthEventType_Constants(t *testing.T) {
 	require.Equal(t, HealthEventType("health.recovery.started"), HealthRecoveryStarted)
 	require.Equal(t, HealthEventType("health.recovery.succeeded"), HealthRecoverySuccess)
 	require.Equal(t, HealthEventType("health.recovery.failed"), HealthRecoveryFailed)
+	require.Equal(t, HealthEventType("health.worker.stuck"), HealthWorkerStuck)
 }
 
 func TestNewHealthEvent(t *testing.T) {
@@ -0,0 +1,293 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Sentinel errors for landing workflows.
+var (
+	// ErrNoWorktree is returned when landing a workflow that has no worktree.
+	ErrNoWorktree = errors.New("workflow has no worktree")
+
+	// ErrMergeConflicts is returned when a workflow's branch does not merge
+	// cleanly into its base branch. The conflicts can be handed to the
+	// coordinator with ResolveLandConflicts.
+	ErrMergeConflicts = errors.New("branch has merge conflicts")
+)
+
+// LandOptions configures how a workflow's branch is landed.
+type LandOptions struct {
+	// Squash commits the branch's changes as a single commit instead of a merge commit.
+	Squash bool
+	// Message is the commit message. If empty, a message naming the workflow is used.
+	Message string
+	// Cleanup removes the worktree and deletes the branch after landing.
+	// Only allowed once the workflow is no longer running or paused.
+	Cleanup bool
+}
+
+// LandFileStat is the diff stat of a single file changed on a workflow's branch.
+type LandFileStat struct {
+	Path      string
+	Additions int
+	Deletions int
+}
+
+// LandPreview describes what landing a workflow would merge into its base branch.
+type LandPreview struct {
+	Branch     string
+	BaseBranch string
+	WorkDir    string
+	Files      []LandFileStat
+	// Conflicts lists the paths that conflict with the base branch, empty when
+	// the branch merges cleanly.
+	Conflicts []string
+}
+
+// Totals returns the added and deleted line counts across all files.
+func (p LandPreview) Totals() (additions, deletions int) {
+	for _, f := range p.Files {
+		additions += f.Additions
+		deletions += f.Deletions
+	}
+	return additions, deletions
+}
+
+// LandResult describes a landed workflow.
+type LandResult struct {
+	Branch     string
+	BaseBranch string
+	Squashed   bool
+	CleanedUp  bool
+}
+
+// PreviewLand returns the diff stat of a workflow's branch against its base
+// branch and the paths a merge would conflict on, without changing anything.
+func (cp *defaultControlPlane) PreviewLand(ctx context.Context, id WorkflowID) (*LandPreview, error) {
+	inst, gitExec, err := cp.landableWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := landBaseBranch(inst, gitExec)
+	if err != nil {
+		return nil, err
+	}
+
+	numstat, err := gitExec.GetDiffStat(base + "...HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("getting diff stat: %w", err)
+	}
+	conflicts, err := gitExec.CheckMerge(base, inst.WorktreeBranch)
+	if err != nil {
+		return nil, fmt.Errorf("checking merge: %w", err)
+	}
+
+	return &LandPreview{
+		Branch:     inst.WorktreeBranch,
+		BaseBranch: base,
+		WorkDir:    inst.WorktreePath,
+		Files:      parseNumstat(numstat),
+		Conflicts:  conflicts,
+	}, nil
+}
+
+// Land merges a workflow's branch into its base branch and optionally removes
+// the worktree and branch.
+func (cp *defaultControlPlane) Land(ctx context.Context, id WorkflowID, opts LandOptions) (*LandResult, error) {
+	inst, gitExec, err := cp.landableWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Cleanup && (inst.State == WorkflowRunning || inst.State == WorkflowPaused) {
+		return nil, fmt.Errorf("%w: cannot remove the worktree of a %s workflow, stop it first", ErrInvalidState, inst.State)
+	}
+
+	dirty, err := gitExec.HasUncommittedChanges()
+	if err != nil {
+		return nil, fmt.Errorf("checking worktree changes: %w", err)
+	}
+	if dirty {
+		return nil, fmt.Errorf("%w: commit the workflow's changes before landing", ErrUncommittedChanges)
+	}
+
+	base, err := landBaseBranch(inst, gitExec)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := gitExec.CheckMerge(base, inst.WorktreeBranch)
+	if err != nil {
+		return nil, fmt.Errorf("checking merge: %w", err)
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMergeConflicts, strings.Join(conflicts, ", "))
+	}
+
+	// The merge runs in the worktree that has the base branch checked out,
+	// usually the main repository.
+	baseExec, err := cp.baseBranchExecutor(gitExec, base)
+	if err != nil {
+		return nil, err
+	}
+
+	message := opts.Message
+	if message == "" {
+		message = fmt.Sprintf("Land workflow %s (%s)", inst.Name, inst.WorktreeBranch)
+	}
+	if err := baseExec.Merge(inst.WorktreeBranch, message, opts.Squash); err != nil {
+		if errors.Is(err, domaingit.ErrMergeConflict) {
+			return nil, fmt.Errorf("%w: %w", ErrMergeConflicts, err)
+		}
+		return nil, fmt.Errorf("merging %s into %s: %w", inst.WorktreeBranch, base, err)
+	}
+
+	log.Info(log.CatOrch, "Workflow landed", "workflowID", id,
+		"branch", inst.WorktreeBranch, "base", base, "squash", opts.Squash)
+
+	result := &LandResult{Branch: inst.WorktreeBranch, BaseBranch: base, Squashed: opts.Squash}
+	if opts.Cleanup {
+		if err := cp.cleanupWorktree(inst, baseExec, opts.Squash); err != nil {
+			return nil, err
+		}
+		result.CleanedUp = true
+	}
+
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowLanded,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Timestamp:    time.Now(),
+		Payload:      *result,
+	})
+
+	return result, nil
+}
+
+// ResolveLandConflicts sends the coordinator of a running workflow a task to
+// resolve the conflicts between its branch and the base branch.
+func (cp *defaultControlPlane) ResolveLandConflicts(ctx context.Context, id WorkflowID) error {
+	preview, err := cp.PreviewLand(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(preview.Conflicts) == 0 {
+		return fmt.Errorf("%w: branch %s merges cleanly into %s", ErrInvalidState, preview.Branch, preview.BaseBranch)
+	}
+
+	content := landConflictTask(preview)
+	return cp.submitProcessCommand(ctx, id,
+		command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, content))
+}
+
+// landableWorkflow returns a workflow with an existing worktree and a git
+// executor for it.
+func (cp *defaultControlPlane) landableWorkflow(id WorkflowID) (*WorkflowInstance, appgit.GitExecutor, error) {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return nil, nil, ErrWorkflowNotFound
+	}
+	if inst.WorktreePath == "" || inst.WorktreeBranch == "" {
+		return nil, nil, ErrNoWorktree
+	}
+	if info, err := os.Stat(inst.WorktreePath); err != nil || !info.IsDir() {
+		return nil, nil, fmt.Errorf("%w: %s no longer exists", ErrNoWorktree, inst.WorktreePath)
+	}
+	if cp.gitExecutorFactory == nil {
+		return nil, nil, fmt.Errorf("landing is not supported: no git executor factory configured")
+	}
+	return inst, cp.gitExecutorFactory(inst.WorktreePath), nil
+}
+
+// baseBranchExecutor returns a git executor for the worktree that has base
+// checked out. The merge is refused when that worktree has uncommitted changes.
+func (cp *defaultControlPlane) baseBranchExecutor(gitExec appgit.GitExecutor, base string) (appgit.GitExecutor, error) {
+	worktrees, err := gitExec.ListWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("listing worktrees: %w", err)
+	}
+	for _, wt := range worktrees {
+		if wt.Branch != base {
+			continue
+		}
+		baseExec := cp.gitExecutorFactory(wt.Path)
+		dirty, err := baseExec.HasUncommittedChanges()
+		if err != nil {
+			return nil, fmt.Errorf("checking %s changes: %w", wt.Path, err)
+		}
+		if dirty {
+			return nil, fmt.Errorf("%w: %s has %s checked out", ErrUncommittedChanges, wt.Path, base)
+		}
+		return baseExec, nil
+	}
+	return nil, fmt.Errorf("%w: %s is not checked out in any worktree", ErrInvalidState, base)
+}
+
+// cleanupWorktree removes a landed workflow's worktree and branch. A squashed
+// branch is never merged in git's eyes, so its deletion is forced.
+func (cp *defaultControlPlane) cleanupWorktree(inst *WorkflowInstance, baseExec appgit.GitExecutor, squashed bool) error {
+	if err := baseExec.RemoveWorktree(inst.WorktreePath); err != nil {
+		return fmt.Errorf("removing worktree: %w", err)
+	}
+	if err := baseExec.DeleteBranch(inst.WorktreeBranch, squashed); err != nil {
+		return fmt.Errorf("deleting branch: %w", err)
+	}
+	return cp.registry.Update(inst.ID, func(wf *WorkflowInstance) {
+		wf.WorktreePath = ""
+	})
+}
+
+// landBaseBranch returns the branch a workflow's worktree was created from,
+// falling back to the repository's main branch.
+func landBaseBranch(inst *WorkflowInstance, gitExec appgit.GitExecutor) (string, error) {
+	if inst.WorktreeBaseBranch != "" {
+		return inst.WorktreeBaseBranch, nil
+	}
+	base, err := gitExec.GetMainBranch()
+	if err != nil {
+		return "", fmt.Errorf("determining base branch: %w", err)
+	}
+	return base, nil
+}
+
+// parseNumstat parses git diff --numstat output. Binary files have no line
+// counts and are reported with zero additions and deletions.
+func parseNumstat(output string) []LandFileStat {
+	var files []LandFileStat
+	for line := range strings.SplitSeq(strings.TrimSpace(output), "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		additions, _ := strconv.Atoi(fields[0])
+		deletions, _ := strconv.Atoi(fields[1])
+		files = append(files, LandFileStat{Path: fields[2], Additions: additions, Deletions: deletions})
+	}
+	return files
+}
+
+// landConflictTask builds the coordinator message asking it to resolve merge conflicts.
+func landConflictTask(preview *LandPreview) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[SYSTEM] The user wants to land branch %s into %s, but it conflicts with %s in:\n",
+		preview.Branch, preview.BaseBranch, preview.BaseBranch)
+	for _, path := range preview.Conflicts {
+		fmt.Fprintf(&b, "- %s\n", path)
+	}
+	fmt.Fprintf(&b, "\nMerge %s into the workflow branch, resolve the conflicts, commit the result, "+
+		"and report back when the branch merges cleanly.", preview.BaseBranch)
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// newLandTestControlPlane creates a control plane holding a workflow in state
+// whose worktree is served by worktreeExec and whose base branch is checked
+// out in a repository served by baseExec.
+func newLandTestControlPlane(t *testing.T, state WorkflowState) (ControlPlane, *WorkflowInstance, *mocks.MockGitExecutor, *mocks.MockGitExecutor) {
+	t.Helper()
+
+	repoDir, worktreeDir := t.TempDir(), t.TempDir()
+	worktreeExec := mocks.NewMockGitExecutor(t)
+	baseExec := mocks.NewMockGitExecutor(t)
+
+	registry := NewInMemoryRegistry()
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:   registry,
+		Supervisor: newStubSupervisor(),
+		GitExecutorFactory: func(workDir string) appgit.GitExecutor {
+			if workDir == repoDir {
+				return baseExec
+			}
+			return worktreeExec
+		},
+	})
+	require.NoError(t, err)
+
+	inst, err := NewWorkflowInstance(&WorkflowSpec{TemplateID: "cook", Name: "Auth", InitialPrompt: "Build auth"})
+	require.NoError(t, err)
+	inst.State = state
+	inst.WorktreePath = worktreeDir
+	inst.WorktreeBranch = "perles-auth"
+	inst.WorktreeBaseBranch = "main"
+	require.NoError(t, registry.Put(inst))
+
+	worktreeExec.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: repoDir, Branch: "main"},
+		{Path: worktreeDir, Branch: "perles-auth"},
+	}, nil).Maybe()
+	return cp, inst, worktreeExec, baseExec
+}
+
+func TestControlPlane_PreviewLand(t *testing.T) {
+	cp, inst, worktreeExec, _ := newLandTestControlPlane(t, WorkflowRunning)
+	worktreeExec.EXPECT().GetDiffStat("main...HEAD").Return("10\t2\tauth.go\n-\t-\tlogo.png\n", nil)
+	worktreeExec.EXPECT().CheckMerge("main", "perles-auth").Return([]string{"go.mod"}, nil)
+
+	preview, err := cp.PreviewLand(context.Background(), inst.ID)
+
+	require.NoError(t, err)
+	require.Equal(t, &LandPreview{
+		Branch:     "perles-auth",
+		BaseBranch: "main",
+		WorkDir:    inst.WorktreePath,
+		Files:      []LandFileStat{{Path: "auth.go", Additions: 10, Deletions: 2}, {Path: "logo.png"}},
+		Conflicts:  []string{"go.mod"},
+	}, preview)
+}
+
+func TestControlPlane_PreviewLand_NoWorktree(t *testing.T) {
+	cp, inst, _, _ := newLandTestControlPlane(t, WorkflowRunning)
+	require.NoError(t, cp.Registry().Update(inst.ID, func(wf *WorkflowInstance) { wf.WorktreePath = "" }))
+
+	_, err := cp.PreviewLand(context.Background(), inst.ID)
+	require.ErrorIs(t, err, ErrNoWorktree)
+}
+
+func TestControlPlane_Land_SquashAndCleanup(t *testing.T) {
+	cp, inst, worktreeExec, baseExec := newLandTestControlPlane(t, WorkflowCompleted)
+	worktreeDir := inst.WorktreePath
+	worktreeExec.EXPECT().HasUncommittedChanges().Return(false, nil)
+	worktreeExec.EXPECT().CheckMerge("main", "perles-auth").Return(nil, nil)
+	baseExec.EXPECT().HasUncommittedChanges().Return(false, nil)
+	baseExec.EXPECT().Merge("perles-auth", "Land workflow Auth (perles-auth)", true).Return(nil)
+	baseExec.EXPECT().RemoveWorktree(worktreeDir).Return(nil)
+	baseExec.EXPECT().DeleteBranch("perles-auth", true).Return(nil)
+
+	result, err := cp.Land(context.Background(), inst.ID, LandOptions{Squash: true, Cleanup: true})
+
+	require.NoError(t, err)
+	require.Equal(t, &LandResult{Branch: "perles-auth", BaseBranch: "main", Squashed: true, CleanedUp: true}, result)
+	landed, err := cp.Get(context.Background(), inst.ID)
+	require.NoError(t, err)
+	require.Empty(t, landed.WorktreePath)
+}
+
+func TestControlPlane_Land_RefusesConflictsAndDirtyWorktrees(t *testing.T) {
+	cp, inst, worktreeExec, baseExec := newLandTestControlPlane(t, WorkflowRunning)
+	ctx := context.Background()
+
+	_, err := cp.Land(ctx, inst.ID, LandOptions{Cleanup: true})
+	require.ErrorIs(t, err, ErrInvalidState, "running workflows keep their worktree")
+
+	worktreeExec.EXPECT().HasUncommittedChanges().Return(true, nil).Once()
+	_, err = cp.Land(ctx, inst.ID, LandOptions{})
+	require.ErrorIs(t, err, ErrUncommittedChanges)
+
+	worktreeExec.EXPECT().HasUncommittedChanges().Return(false, nil)
+	worktreeExec.EXPECT().CheckMerge("main", "perles-auth").Return([]string{"go.mod"}, nil).Once()
+	_, err = cp.Land(ctx, inst.ID, LandOptions{})
+	require.ErrorIs(t, err, ErrMergeConflicts)
+	require.ErrorContains(t, err, "go.mod")
+
+	worktreeExec.EXPECT().CheckMerge("main", "perles-auth").Return(nil, nil)
+	baseExec.EXPECT().HasUncommittedChanges().Return(true, nil)
+	_, err = cp.Land(ctx, inst.ID, LandOptions{})
+	require.ErrorIs(t, err, ErrUncommittedChanges, "the base branch checkout must be clean too")
+}
+
+func TestLandConflictTask(t *testing.T) {
+	task := landConflictTask(&LandPreview{Branch: "perles-auth", BaseBranch: "main", Conflicts: []string{"go.mod", "auth.go"}})
+
+	require.Equal(t, "[SYSTEM] The user wants to land branch perles-auth into main, but it conflicts with main in:\n"+
+		"- go.mod\n- auth.go\n\n"+
+		"Merge main into the workflow branch, resolve the conflicts, commit the result, "+
+		"and report back when the branch merges cleanly.", task)
+}
@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
 	controlplane "github.com/zjrosen/perles/internal/orchestration/controlplane"
+	events "github.com/zjrosen/perles/internal/orchestration/events"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 // MockControlPlane is an autogenerated mock type for the ControlPlane type
@@ -22,6 +26,65 @@ func (_m *MockControlPlane) EXPECT() *MockControlPlane_Expecter {
 	return &MockControlPlane_Expecter{mock: &_m.Mock}
 }
 
+// Approvals provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) Approvals(ctx context.Context, id controlplane.WorkflowID) ([]*events.Approval, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Approvals")
+	}
+
+	var r0 []*events.Approval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) ([]*events.Approval, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) []*events.Approval); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*events.Approval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_Approvals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Approvals'
+type MockControlPlane_Approvals_Call struct {
+	*mock.Call
+}
+
+// Approvals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) Approvals(ctx interface{}, id interface{}) *MockControlPlane_Approvals_Call {
+	return &MockControlPlane_Approvals_Call{Call: _e.mock.On("Approvals", ctx, id)}
+}
+
+func (_c *MockControlPlane_Approvals_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Approvals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Approvals_Call) Return(_a0 []*events.Approval, _a1 error) *MockControlPlane_Approvals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_Approvals_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) ([]*events.Approval, error)) *MockControlPlane_Approvals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Archive provides a mock function with given fields: ctx, id
 func (_m *MockControlPlane) Archive(ctx context.Context, id controlplane.WorkflowID) error {
 	ret := _m.Called(ctx, id)
@@ -173,6 +236,53 @@ func (_c *MockControlPlane_Create_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) Delete(ctx context.Context, id controlplane.WorkflowID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockControlPlane_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) Delete(ctx interface{}, id interface{}) *MockControlPlane_Delete_Call {
+	return &MockControlPlane_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockControlPlane_Delete_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Delete_Call) Return(_a0 error) *MockControlPlane_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_Delete_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) error) *MockControlPlane_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fail provides a mock function with given fields: ctx, id
 func (_m *MockControlPlane) Fail(ctx context.Context, id controlplane.WorkflowID) error {
 	ret := _m.Called(ctx, id)
@@ -335,6 +445,115 @@ func (_c *MockControlPlane_GetHealthStatus_Call) RunAndReturn(run func(controlpl
 	return _c
 }
 
+// InterruptProcess provides a mock function with given fields: ctx, id, processID, content
+func (_m *MockControlPlane) InterruptProcess(ctx context.Context, id controlplane.WorkflowID, processID string, content string) error {
+	ret := _m.Called(ctx, id, processID, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterruptProcess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, string, string) error); ok {
+		r0 = rf(ctx, id, processID, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_InterruptProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InterruptProcess'
+type MockControlPlane_InterruptProcess_Call struct {
+	*mock.Call
+}
+
+// InterruptProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - processID string
+//   - content string
+func (_e *MockControlPlane_Expecter) InterruptProcess(ctx interface{}, id interface{}, processID interface{}, content interface{}) *MockControlPlane_InterruptProcess_Call {
+	return &MockControlPlane_InterruptProcess_Call{Call: _e.mock.On("InterruptProcess", ctx, id, processID, content)}
+}
+
+func (_c *MockControlPlane_InterruptProcess_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, processID string, content string)) *MockControlPlane_InterruptProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_InterruptProcess_Call) Return(_a0 error) *MockControlPlane_InterruptProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_InterruptProcess_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, string, string) error) *MockControlPlane_InterruptProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Land provides a mock function with given fields: ctx, id, opts
+func (_m *MockControlPlane) Land(ctx context.Context, id controlplane.WorkflowID, opts controlplane.LandOptions) (*controlplane.LandResult, error) {
+	ret := _m.Called(ctx, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Land")
+	}
+
+	var r0 *controlplane.LandResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, controlplane.LandOptions) (*controlplane.LandResult, error)); ok {
+		return rf(ctx, id, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, controlplane.LandOptions) *controlplane.LandResult); ok {
+		r0 = rf(ctx, id, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*controlplane.LandResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID, controlplane.LandOptions) error); ok {
+		r1 = rf(ctx, id, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_Land_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Land'
+type MockControlPlane_Land_Call struct {
+	*mock.Call
+}
+
+// Land is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - opts controlplane.LandOptions
+func (_e *MockControlPlane_Expecter) Land(ctx interface{}, id interface{}, opts interface{}) *MockControlPlane_Land_Call {
+	return &MockControlPlane_Land_Call{Call: _e.mock.On("Land", ctx, id, opts)}
+}
+
+func (_c *MockControlPlane_Land_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, opts controlplane.LandOptions)) *MockControlPlane_Land_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(controlplane.LandOptions))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Land_Call) Return(_a0 *controlplane.LandResult, _a1 error) *MockControlPlane_Land_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_Land_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, controlplane.LandOptions) (*controlplane.LandResult, error)) *MockControlPlane_Land_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, q
 func (_m *MockControlPlane) List(ctx context.Context, q controlplane.ListQuery) ([]*controlplane.WorkflowInstance, error) {
 	ret := _m.Called(ctx, q)
@@ -394,7 +613,219 @@ func (_c *MockControlPlane_List_Call) RunAndReturn(run func(context.Context, con
 	return _c
 }
 
-// Registry provides a mock function with given fields:
+// Pause provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) Pause(ctx context.Context, id controlplane.WorkflowID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type MockControlPlane_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) Pause(ctx interface{}, id interface{}) *MockControlPlane_Pause_Call {
+	return &MockControlPlane_Pause_Call{Call: _e.mock.On("Pause", ctx, id)}
+}
+
+func (_c *MockControlPlane_Pause_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Pause_Call) Return(_a0 error) *MockControlPlane_Pause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_Pause_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) error) *MockControlPlane_Pause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewLand provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) PreviewLand(ctx context.Context, id controlplane.WorkflowID) (*controlplane.LandPreview, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewLand")
+	}
+
+	var r0 *controlplane.LandPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) (*controlplane.LandPreview, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) *controlplane.LandPreview); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*controlplane.LandPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_PreviewLand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewLand'
+type MockControlPlane_PreviewLand_Call struct {
+	*mock.Call
+}
+
+// PreviewLand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) PreviewLand(ctx interface{}, id interface{}) *MockControlPlane_PreviewLand_Call {
+	return &MockControlPlane_PreviewLand_Call{Call: _e.mock.On("PreviewLand", ctx, id)}
+}
+
+func (_c *MockControlPlane_PreviewLand_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_PreviewLand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_PreviewLand_Call) Return(_a0 *controlplane.LandPreview, _a1 error) *MockControlPlane_PreviewLand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_PreviewLand_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) (*controlplane.LandPreview, error)) *MockControlPlane_PreviewLand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Processes provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) Processes(ctx context.Context, id controlplane.WorkflowID) ([]*repository.Process, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Processes")
+	}
+
+	var r0 []*repository.Process
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) ([]*repository.Process, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID) []*repository.Process); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.Process)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.WorkflowID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockControlPlane_Processes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Processes'
+type MockControlPlane_Processes_Call struct {
+	*mock.Call
+}
+
+// Processes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+func (_e *MockControlPlane_Expecter) Processes(ctx interface{}, id interface{}) *MockControlPlane_Processes_Call {
+	return &MockControlPlane_Processes_Call{Call: _e.mock.On("Processes", ctx, id)}
+}
+
+func (_c *MockControlPlane_Processes_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Processes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Processes_Call) Return(_a0 []*repository.Process, _a1 error) *MockControlPlane_Processes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockControlPlane_Processes_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) ([]*repository.Process, error)) *MockControlPlane_Processes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Queue provides a mock function with no fields
+func (_m *MockControlPlane) Queue() []controlplane.QueuedWorkflow {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queue")
+	}
+
+	var r0 []controlplane.QueuedWorkflow
+	if rf, ok := ret.Get(0).(func() []controlplane.QueuedWorkflow); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]controlplane.QueuedWorkflow)
+		}
+	}
+
+	return r0
+}
+
+// MockControlPlane_Queue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Queue'
+type MockControlPlane_Queue_Call struct {
+	*mock.Call
+}
+
+// Queue is a helper method to define mock.On call
+func (_e *MockControlPlane_Expecter) Queue() *MockControlPlane_Queue_Call {
+	return &MockControlPlane_Queue_Call{Call: _e.mock.On("Queue")}
+}
+
+func (_c *MockControlPlane_Queue_Call) Run(run func()) *MockControlPlane_Queue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Queue_Call) Return(_a0 []controlplane.QueuedWorkflow) *MockControlPlane_Queue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_Queue_Call) RunAndReturn(run func() []controlplane.QueuedWorkflow) *MockControlPlane_Queue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Registry provides a mock function with no fields
 func (_m *MockControlPlane) Registry() controlplane.Registry {
 	ret := _m.Called()
 
@@ -441,12 +872,111 @@ func (_c *MockControlPlane_Registry_Call) RunAndReturn(run func() controlplane.R
 	return _c
 }
 
-// Pause provides a mock function with given fields: ctx, id
-func (_m *MockControlPlane) Pause(ctx context.Context, id controlplane.WorkflowID) error {
+// ReplaceProcess provides a mock function with given fields: ctx, id, processID, reason
+func (_m *MockControlPlane) ReplaceProcess(ctx context.Context, id controlplane.WorkflowID, processID string, reason string) error {
+	ret := _m.Called(ctx, id, processID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceProcess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, string, string) error); ok {
+		r0 = rf(ctx, id, processID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_ReplaceProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProcess'
+type MockControlPlane_ReplaceProcess_Call struct {
+	*mock.Call
+}
+
+// ReplaceProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - processID string
+//   - reason string
+func (_e *MockControlPlane_Expecter) ReplaceProcess(ctx interface{}, id interface{}, processID interface{}, reason interface{}) *MockControlPlane_ReplaceProcess_Call {
+	return &MockControlPlane_ReplaceProcess_Call{Call: _e.mock.On("ReplaceProcess", ctx, id, processID, reason)}
+}
+
+func (_c *MockControlPlane_ReplaceProcess_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, processID string, reason string)) *MockControlPlane_ReplaceProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_ReplaceProcess_Call) Return(_a0 error) *MockControlPlane_ReplaceProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_ReplaceProcess_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, string, string) error) *MockControlPlane_ReplaceProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveApproval provides a mock function with given fields: ctx, id, approvalID, approved, feedback
+func (_m *MockControlPlane) ResolveApproval(ctx context.Context, id controlplane.WorkflowID, approvalID string, approved bool, feedback string) error {
+	ret := _m.Called(ctx, id, approvalID, approved, feedback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveApproval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, string, bool, string) error); ok {
+		r0 = rf(ctx, id, approvalID, approved, feedback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_ResolveApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveApproval'
+type MockControlPlane_ResolveApproval_Call struct {
+	*mock.Call
+}
+
+// ResolveApproval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - approvalID string
+//   - approved bool
+//   - feedback string
+func (_e *MockControlPlane_Expecter) ResolveApproval(ctx interface{}, id interface{}, approvalID interface{}, approved interface{}, feedback interface{}) *MockControlPlane_ResolveApproval_Call {
+	return &MockControlPlane_ResolveApproval_Call{Call: _e.mock.On("ResolveApproval", ctx, id, approvalID, approved, feedback)}
+}
+
+func (_c *MockControlPlane_ResolveApproval_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, approvalID string, approved bool, feedback string)) *MockControlPlane_ResolveApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(string), args[3].(bool), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_ResolveApproval_Call) Return(_a0 error) *MockControlPlane_ResolveApproval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_ResolveApproval_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, string, bool, string) error) *MockControlPlane_ResolveApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveLandConflicts provides a mock function with given fields: ctx, id
+func (_m *MockControlPlane) ResolveLandConflicts(ctx context.Context, id controlplane.WorkflowID) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Pause")
+		panic("no return value specified for ResolveLandConflicts")
 	}
 
 	var r0 error
@@ -459,31 +989,31 @@ func (_m *MockControlPlane) Pause(ctx context.Context, id controlplane.WorkflowI
 	return r0
 }
 
-// MockControlPlane_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
-type MockControlPlane_Pause_Call struct {
+// MockControlPlane_ResolveLandConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveLandConflicts'
+type MockControlPlane_ResolveLandConflicts_Call struct {
 	*mock.Call
 }
 
-// Pause is a helper method to define mock.On call
+// ResolveLandConflicts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id controlplane.WorkflowID
-func (_e *MockControlPlane_Expecter) Pause(ctx interface{}, id interface{}) *MockControlPlane_Pause_Call {
-	return &MockControlPlane_Pause_Call{Call: _e.mock.On("Pause", ctx, id)}
+func (_e *MockControlPlane_Expecter) ResolveLandConflicts(ctx interface{}, id interface{}) *MockControlPlane_ResolveLandConflicts_Call {
+	return &MockControlPlane_ResolveLandConflicts_Call{Call: _e.mock.On("ResolveLandConflicts", ctx, id)}
 }
 
-func (_c *MockControlPlane_Pause_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_Pause_Call {
+func (_c *MockControlPlane_ResolveLandConflicts_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID)) *MockControlPlane_ResolveLandConflicts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(controlplane.WorkflowID))
 	})
 	return _c
 }
 
-func (_c *MockControlPlane_Pause_Call) Return(_a0 error) *MockControlPlane_Pause_Call {
+func (_c *MockControlPlane_ResolveLandConflicts_Call) Return(_a0 error) *MockControlPlane_ResolveLandConflicts_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockControlPlane_Pause_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) error) *MockControlPlane_Pause_Call {
+func (_c *MockControlPlane_ResolveLandConflicts_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID) error) *MockControlPlane_ResolveLandConflicts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -535,6 +1065,104 @@ func (_c *MockControlPlane_Resume_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
+// RetireProcess provides a mock function with given fields: ctx, id, processID, reason
+func (_m *MockControlPlane) RetireProcess(ctx context.Context, id controlplane.WorkflowID, processID string, reason string) error {
+	ret := _m.Called(ctx, id, processID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetireProcess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, string, string) error); ok {
+		r0 = rf(ctx, id, processID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_RetireProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetireProcess'
+type MockControlPlane_RetireProcess_Call struct {
+	*mock.Call
+}
+
+// RetireProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - processID string
+//   - reason string
+func (_e *MockControlPlane_Expecter) RetireProcess(ctx interface{}, id interface{}, processID interface{}, reason interface{}) *MockControlPlane_RetireProcess_Call {
+	return &MockControlPlane_RetireProcess_Call{Call: _e.mock.On("RetireProcess", ctx, id, processID, reason)}
+}
+
+func (_c *MockControlPlane_RetireProcess_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, processID string, reason string)) *MockControlPlane_RetireProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_RetireProcess_Call) Return(_a0 error) *MockControlPlane_RetireProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_RetireProcess_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, string, string) error) *MockControlPlane_RetireProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendToProcess provides a mock function with given fields: ctx, id, processID, content
+func (_m *MockControlPlane) SendToProcess(ctx context.Context, id controlplane.WorkflowID, processID string, content string) error {
+	ret := _m.Called(ctx, id, processID, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendToProcess")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, string, string) error); ok {
+		r0 = rf(ctx, id, processID, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_SendToProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendToProcess'
+type MockControlPlane_SendToProcess_Call struct {
+	*mock.Call
+}
+
+// SendToProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - processID string
+//   - content string
+func (_e *MockControlPlane_Expecter) SendToProcess(ctx interface{}, id interface{}, processID interface{}, content interface{}) *MockControlPlane_SendToProcess_Call {
+	return &MockControlPlane_SendToProcess_Call{Call: _e.mock.On("SendToProcess", ctx, id, processID, content)}
+}
+
+func (_c *MockControlPlane_SendToProcess_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, processID string, content string)) *MockControlPlane_SendToProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_SendToProcess_Call) Return(_a0 error) *MockControlPlane_SendToProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_SendToProcess_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, string, string) error) *MockControlPlane_SendToProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Shutdown provides a mock function with given fields: ctx
 func (_m *MockControlPlane) Shutdown(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -628,6 +1256,54 @@ func (_c *MockControlPlane_Start_Call) RunAndReturn(run func(context.Context, co
 	return _c
 }
 
+// Stop provides a mock function with given fields: ctx, id, opts
+func (_m *MockControlPlane) Stop(ctx context.Context, id controlplane.WorkflowID, opts controlplane.StopOptions) error {
+	ret := _m.Called(ctx, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.WorkflowID, controlplane.StopOptions) error); ok {
+		r0 = rf(ctx, id, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockControlPlane_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockControlPlane_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id controlplane.WorkflowID
+//   - opts controlplane.StopOptions
+func (_e *MockControlPlane_Expecter) Stop(ctx interface{}, id interface{}, opts interface{}) *MockControlPlane_Stop_Call {
+	return &MockControlPlane_Stop_Call{Call: _e.mock.On("Stop", ctx, id, opts)}
+}
+
+func (_c *MockControlPlane_Stop_Call) Run(run func(ctx context.Context, id controlplane.WorkflowID, opts controlplane.StopOptions)) *MockControlPlane_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.WorkflowID), args[2].(controlplane.StopOptions))
+	})
+	return _c
+}
+
+func (_c *MockControlPlane_Stop_Call) Return(_a0 error) *MockControlPlane_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockControlPlane_Stop_Call) RunAndReturn(run func(context.Context, controlplane.WorkflowID, controlplane.StopOptions) error) *MockControlPlane_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Subscribe provides a mock function with given fields: ctx
 func (_m *MockControlPlane) Subscribe(ctx context.Context) (<-chan controlplane.ControlPlaneEvent, func()) {
 	ret := _m.Called(ctx)
@@ -0,0 +1,399 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	controlplane "github.com/zjrosen/perles/internal/orchestration/controlplane"
+	domain "github.com/zjrosen/perles/internal/schedules/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCronScheduler is an autogenerated mock type for the CronScheduler type
+type MockCronScheduler struct {
+	mock.Mock
+}
+
+type MockCronScheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCronScheduler) EXPECT() *MockCronScheduler_Expecter {
+	return &MockCronScheduler_Expecter{mock: &_m.Mock}
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, spec
+func (_m *MockCronScheduler) CreateSchedule(ctx context.Context, spec controlplane.ScheduleSpec) (*domain.Schedule, error) {
+	ret := _m.Called(ctx, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedule")
+	}
+
+	var r0 *domain.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.ScheduleSpec) (*domain.Schedule, error)); ok {
+		return rf(ctx, spec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, controlplane.ScheduleSpec) *domain.Schedule); ok {
+		r0 = rf(ctx, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, controlplane.ScheduleSpec) error); ok {
+		r1 = rf(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCronScheduler_CreateSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedule'
+type MockCronScheduler_CreateSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec controlplane.ScheduleSpec
+func (_e *MockCronScheduler_Expecter) CreateSchedule(ctx interface{}, spec interface{}) *MockCronScheduler_CreateSchedule_Call {
+	return &MockCronScheduler_CreateSchedule_Call{Call: _e.mock.On("CreateSchedule", ctx, spec)}
+}
+
+func (_c *MockCronScheduler_CreateSchedule_Call) Run(run func(ctx context.Context, spec controlplane.ScheduleSpec)) *MockCronScheduler_CreateSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(controlplane.ScheduleSpec))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_CreateSchedule_Call) Return(_a0 *domain.Schedule, _a1 error) *MockCronScheduler_CreateSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCronScheduler_CreateSchedule_Call) RunAndReturn(run func(context.Context, controlplane.ScheduleSpec) (*domain.Schedule, error)) *MockCronScheduler_CreateSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSchedule provides a mock function with given fields: ctx, id
+func (_m *MockCronScheduler) DeleteSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCronScheduler_DeleteSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSchedule'
+type MockCronScheduler_DeleteSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCronScheduler_Expecter) DeleteSchedule(ctx interface{}, id interface{}) *MockCronScheduler_DeleteSchedule_Call {
+	return &MockCronScheduler_DeleteSchedule_Call{Call: _e.mock.On("DeleteSchedule", ctx, id)}
+}
+
+func (_c *MockCronScheduler_DeleteSchedule_Call) Run(run func(ctx context.Context, id string)) *MockCronScheduler_DeleteSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_DeleteSchedule_Call) Return(_a0 error) *MockCronScheduler_DeleteSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCronScheduler_DeleteSchedule_Call) RunAndReturn(run func(context.Context, string) error) *MockCronScheduler_DeleteSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSchedule provides a mock function with given fields: ctx, id
+func (_m *MockCronScheduler) GetSchedule(ctx context.Context, id string) (*domain.Schedule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchedule")
+	}
+
+	var r0 *domain.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Schedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Schedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCronScheduler_GetSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedule'
+type MockCronScheduler_GetSchedule_Call struct {
+	*mock.Call
+}
+
+// GetSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCronScheduler_Expecter) GetSchedule(ctx interface{}, id interface{}) *MockCronScheduler_GetSchedule_Call {
+	return &MockCronScheduler_GetSchedule_Call{Call: _e.mock.On("GetSchedule", ctx, id)}
+}
+
+func (_c *MockCronScheduler_GetSchedule_Call) Run(run func(ctx context.Context, id string)) *MockCronScheduler_GetSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_GetSchedule_Call) Return(_a0 *domain.Schedule, _a1 error) *MockCronScheduler_GetSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCronScheduler_GetSchedule_Call) RunAndReturn(run func(context.Context, string) (*domain.Schedule, error)) *MockCronScheduler_GetSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSchedules provides a mock function with given fields: ctx
+func (_m *MockCronScheduler) ListSchedules(ctx context.Context) ([]*domain.Schedule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchedules")
+	}
+
+	var r0 []*domain.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Schedule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Schedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCronScheduler_ListSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchedules'
+type MockCronScheduler_ListSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCronScheduler_Expecter) ListSchedules(ctx interface{}) *MockCronScheduler_ListSchedules_Call {
+	return &MockCronScheduler_ListSchedules_Call{Call: _e.mock.On("ListSchedules", ctx)}
+}
+
+func (_c *MockCronScheduler_ListSchedules_Call) Run(run func(ctx context.Context)) *MockCronScheduler_ListSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_ListSchedules_Call) Return(_a0 []*domain.Schedule, _a1 error) *MockCronScheduler_ListSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCronScheduler_ListSchedules_Call) RunAndReturn(run func(context.Context) ([]*domain.Schedule, error)) *MockCronScheduler_ListSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunSchedule provides a mock function with given fields: ctx, id
+func (_m *MockCronScheduler) RunSchedule(ctx context.Context, id string) ([]controlplane.WorkflowID, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSchedule")
+	}
+
+	var r0 []controlplane.WorkflowID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]controlplane.WorkflowID, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []controlplane.WorkflowID); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]controlplane.WorkflowID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCronScheduler_RunSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSchedule'
+type MockCronScheduler_RunSchedule_Call struct {
+	*mock.Call
+}
+
+// RunSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCronScheduler_Expecter) RunSchedule(ctx interface{}, id interface{}) *MockCronScheduler_RunSchedule_Call {
+	return &MockCronScheduler_RunSchedule_Call{Call: _e.mock.On("RunSchedule", ctx, id)}
+}
+
+func (_c *MockCronScheduler_RunSchedule_Call) Run(run func(ctx context.Context, id string)) *MockCronScheduler_RunSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_RunSchedule_Call) Return(_a0 []controlplane.WorkflowID, _a1 error) *MockCronScheduler_RunSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCronScheduler_RunSchedule_Call) RunAndReturn(run func(context.Context, string) ([]controlplane.WorkflowID, error)) *MockCronScheduler_RunSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockCronScheduler) Start(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCronScheduler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockCronScheduler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCronScheduler_Expecter) Start(ctx interface{}) *MockCronScheduler_Start_Call {
+	return &MockCronScheduler_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockCronScheduler_Start_Call) Run(run func(ctx context.Context)) *MockCronScheduler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_Start_Call) Return(_a0 error) *MockCronScheduler_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCronScheduler_Start_Call) RunAndReturn(run func(context.Context) error) *MockCronScheduler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockCronScheduler) Stop() {
+	_m.Called()
+}
+
+// MockCronScheduler_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockCronScheduler_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockCronScheduler_Expecter) Stop() *MockCronScheduler_Stop_Call {
+	return &MockCronScheduler_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockCronScheduler_Stop_Call) Run(run func()) *MockCronScheduler_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCronScheduler_Stop_Call) Return() *MockCronScheduler_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCronScheduler_Stop_Call) RunAndReturn(run func()) *MockCronScheduler_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockCronScheduler creates a new instance of MockCronScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCronScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCronScheduler {
+	mock := &MockCronScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
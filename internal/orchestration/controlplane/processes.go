@@ -0,0 +1,78 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ErrWorkflowNotRunning is returned when a process operation targets a workflow
+// without live infrastructure (pending, paused or finished).
+var ErrWorkflowNotRunning = errors.New("workflow not running")
+
+// Processes returns the coordinator and workers of a running workflow.
+func (cp *defaultControlPlane) Processes(ctx context.Context, id WorkflowID) ([]*repository.Process, error) {
+	inst, err := cp.runningWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+	return inst.Infrastructure.Repositories.ProcessRepo.List(), nil
+}
+
+// SendToProcess sends a user message to a process of a running workflow.
+func (cp *defaultControlPlane) SendToProcess(ctx context.Context, id WorkflowID, processID, content string) error {
+	return cp.submitProcessCommand(ctx, id, command.NewSendToProcessCommand(command.SourceUser, processID, content))
+}
+
+// InterruptProcess sends a user message to a process of a running workflow,
+// cancelling its current turn to deliver it immediately.
+func (cp *defaultControlPlane) InterruptProcess(ctx context.Context, id WorkflowID, processID, content string) error {
+	return cp.submitProcessCommand(ctx, id,
+		command.NewSendToProcessCommand(command.SourceUser, processID, content, command.WithInterrupt()))
+}
+
+// RetireProcess gracefully retires a worker of a running workflow.
+func (cp *defaultControlPlane) RetireProcess(ctx context.Context, id WorkflowID, processID, reason string) error {
+	if processID == repository.CoordinatorID {
+		return fmt.Errorf("%w: cannot retire the coordinator, replace it instead", ErrInvalidState)
+	}
+	return cp.submitProcessCommand(ctx, id, command.NewRetireProcessCommand(command.SourceUser, processID, reason))
+}
+
+// ReplaceProcess retires a process of a running workflow and spawns a replacement.
+func (cp *defaultControlPlane) ReplaceProcess(ctx context.Context, id WorkflowID, processID, reason string) error {
+	return cp.submitProcessCommand(ctx, id, command.NewReplaceProcessCommand(command.SourceUser, processID, reason))
+}
+
+// runningWorkflow returns a workflow that has live infrastructure.
+func (cp *defaultControlPlane) runningWorkflow(id WorkflowID) (*WorkflowInstance, error) {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return nil, ErrWorkflowNotFound
+	}
+	if inst.State != WorkflowRunning || inst.Infrastructure == nil {
+		return nil, fmt.Errorf("%w: workflow is %s", ErrWorkflowNotRunning, inst.State)
+	}
+	return inst, nil
+}
+
+// submitProcessCommand submits cmd to a running workflow and waits for the result,
+// so callers see validation and handler errors such as an unknown process ID.
+func (cp *defaultControlPlane) submitProcessCommand(ctx context.Context, id WorkflowID, cmd command.Command) error {
+	inst, err := cp.runningWorkflow(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := inst.Infrastructure.Core.Processor.SubmitAndWait(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("submitting %s command: %w", cmd.Type(), err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%s command failed: %w", cmd.Type(), result.Error)
+	}
+	return nil
+}
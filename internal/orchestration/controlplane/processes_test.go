@@ -0,0 +1,139 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// recordingProcessHandler records the commands it handles and fails for
+// processes missing from the repository, like the real process handlers.
+type recordingProcessHandler struct {
+	processRepo repository.ProcessRepository
+	handled     []command.Command
+}
+
+func (h *recordingProcessHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	h.handled = append(h.handled, cmd)
+
+	var processID string
+	switch c := cmd.(type) {
+	case *command.SendToProcessCommand:
+		processID = c.ProcessID
+	case *command.RetireProcessCommand:
+		processID = c.ProcessID
+	case *command.ReplaceProcessCommand:
+		processID = c.ProcessID
+	}
+	if _, err := h.processRepo.Get(processID); err != nil {
+		return nil, err
+	}
+	return &command.CommandResult{Success: true}, nil
+}
+
+// newRunningProcessTestWorkflow creates a running workflow whose infrastructure
+// holds a coordinator and one worker.
+func newRunningProcessTestWorkflow(t *testing.T) (ControlPlane, WorkflowID, *recordingProcessHandler) {
+	t.Helper()
+
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+
+	processRepo := repository.NewMemoryProcessRepository()
+	require.NoError(t, processRepo.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator}))
+	require.NoError(t, processRepo.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker}))
+
+	h := &recordingProcessHandler{processRepo: processRepo}
+	infra := createTestInfrastructure(t)
+	infra.Repositories.ProcessRepo = processRepo
+	infra.Core.Processor.RegisterHandler(command.CmdSendToProcess, h)
+	infra.Core.Processor.RegisterHandler(command.CmdRetireProcess, h)
+	infra.Core.Processor.RegisterHandler(command.CmdReplaceProcess, h)
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	inst, _ := cp.(*defaultControlPlane).registry.Get(id)
+	inst.Infrastructure = infra
+	require.NoError(t, inst.TransitionTo(WorkflowRunning))
+
+	return cp, id, h
+}
+
+func TestControlPlane_Processes_ListsCoordinatorAndWorkers(t *testing.T) {
+	cp, id, _ := newRunningProcessTestWorkflow(t)
+
+	processes, err := cp.Processes(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, processes, 2)
+}
+
+func TestControlPlane_Processes_RequiresRunningWorkflow(t *testing.T) {
+	cp, _ := newTestControlPlaneWithEventBus(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build"})
+	require.NoError(t, err)
+
+	_, err = cp.Processes(ctx, id)
+	require.ErrorIs(t, err, ErrWorkflowNotRunning)
+
+	_, err = cp.Processes(ctx, "non-existent")
+	require.ErrorIs(t, err, ErrWorkflowNotFound)
+}
+
+func TestControlPlane_SendToProcess_SubmitsCommand(t *testing.T) {
+	cp, id, h := newRunningProcessTestWorkflow(t)
+
+	require.NoError(t, cp.SendToProcess(context.Background(), id, repository.CoordinatorID, "Focus on the tests"))
+
+	require.Len(t, h.handled, 1)
+	sent := h.handled[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, sent.ProcessID)
+	require.Equal(t, "Focus on the tests", sent.Content)
+	require.Equal(t, command.SourceUser, sent.Source())
+}
+
+func TestControlPlane_InterruptProcess_SubmitsInterruptCommand(t *testing.T) {
+	cp, id, h := newRunningProcessTestWorkflow(t)
+
+	require.NoError(t, cp.InterruptProcess(context.Background(), id, "worker-1", "STOP, wrong file"))
+
+	require.Len(t, h.handled, 1)
+	sent := h.handled[0].(*command.SendToProcessCommand)
+	require.Equal(t, "worker-1", sent.ProcessID)
+	require.Equal(t, "STOP, wrong file", sent.Content)
+	require.True(t, sent.Interrupt)
+	require.Equal(t, repository.PriorityInterrupt, sent.MessagePriority)
+	require.Equal(t, command.SourceUser, sent.Source())
+}
+
+func TestControlPlane_RetireProcess(t *testing.T) {
+	cp, id, h := newRunningProcessTestWorkflow(t)
+	ctx := context.Background()
+
+	require.NoError(t, cp.RetireProcess(ctx, id, "worker-1", "done"))
+	require.Len(t, h.handled, 1)
+	require.Equal(t, command.CmdRetireProcess, h.handled[0].Type())
+
+	err := cp.RetireProcess(ctx, id, repository.CoordinatorID, "done")
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	err = cp.RetireProcess(ctx, id, "worker-9", "done")
+	require.ErrorIs(t, err, repository.ErrProcessNotFound)
+}
+
+func TestControlPlane_ReplaceProcess(t *testing.T) {
+	cp, id, h := newRunningProcessTestWorkflow(t)
+
+	require.NoError(t, cp.ReplaceProcess(context.Background(), id, repository.CoordinatorID, "stuck"))
+	require.Len(t, h.handled, 1)
+	require.Equal(t, command.CmdReplaceProcess, h.handled[0].Type())
+}
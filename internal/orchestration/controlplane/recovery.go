@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/session"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
@@ -29,6 +33,13 @@ const (
 	// RecoveryFail terminates the workflow with a Failed state.
 	// This is the final recovery action after max retries are exhausted.
 	RecoveryFail
+
+	// RecoveryReplaceWorker replaces the worker that has gone longest without
+	// completing a turn while still working.
+	RecoveryReplaceWorker
+
+	// RecoveryNotify alerts the user that the workflow is stuck.
+	RecoveryNotify
 )
 
 // String returns the string representation of a RecoveryAction.
@@ -42,6 +53,10 @@ func (a RecoveryAction) String() string {
 		return "pause"
 	case RecoveryFail:
 		return "fail"
+	case RecoveryReplaceWorker:
+		return "replace_worker"
+	case RecoveryNotify:
+		return "notify"
 	default:
 		return fmt.Sprintf("unknown(%d)", a)
 	}
@@ -49,7 +64,28 @@ func (a RecoveryAction) String() string {
 
 // IsValid returns true if this is a recognized RecoveryAction value.
 func (a RecoveryAction) IsValid() bool {
-	return a >= RecoveryNudge && a <= RecoveryFail
+	return a >= RecoveryNudge && a <= RecoveryNotify
+}
+
+// ParseRecoveryAction parses a recovery action name as used in config.
+// "replace_coordinator" is accepted as an alias for "replace".
+func ParseRecoveryAction(name string) (RecoveryAction, error) {
+	switch name {
+	case "nudge":
+		return RecoveryNudge, nil
+	case "replace", "replace_coordinator":
+		return RecoveryReplace, nil
+	case "replace_worker":
+		return RecoveryReplaceWorker, nil
+	case "pause":
+		return RecoveryPause, nil
+	case "notify":
+		return RecoveryNotify, nil
+	case "fail":
+		return RecoveryFail, nil
+	default:
+		return 0, fmt.Errorf("unknown recovery action %q (expected nudge, replace_coordinator, replace_worker, pause, notify or fail)", name)
+	}
 }
 
 // RecoveryResult contains the outcome of a recovery action.
@@ -64,12 +100,29 @@ type RecoveryResult struct {
 	Timestamp time.Time
 }
 
+// RecoveryRequest describes a recovery attempt chosen by the HealthMonitor.
+type RecoveryRequest struct {
+	// Step is the escalation step to execute.
+	Step EscalationStep
+	// Attempt is the 1-based recovery attempt for the current stuck period.
+	Attempt int
+	// StuckFor is how long the workflow has made no progress.
+	StuckFor time.Duration
+	// ProcessID targets a specific worker with nudge and replace_worker steps.
+	// Empty targets the coordinator for nudges and picks the worker to replace.
+	ProcessID string
+}
+
 // RecoveryExecutor executes recovery actions for stuck workflows.
 // It bridges the HealthMonitor's stuck detection to concrete recovery operations.
 type RecoveryExecutor interface {
 	// ExecuteRecovery performs the specified recovery action for a workflow.
 	// Returns an error if the action could not be executed.
 	ExecuteRecovery(ctx context.Context, id WorkflowID, action RecoveryAction) error
+
+	// Recover executes an escalation step for a workflow and records it to the
+	// workflow's session.
+	Recover(ctx context.Context, id WorkflowID, req RecoveryRequest) error
 }
 
 // CommandSubmitter abstracts command submission for recovery operations.
@@ -86,6 +139,18 @@ type WorkflowProvider interface {
 	Get(id WorkflowID) (*WorkflowInstance, bool)
 }
 
+// ProcessLister lists a workflow's processes for worker recovery.
+type ProcessLister func(inst *WorkflowInstance) []*repository.Process
+
+// InfrastructureProcesses lists the processes in the workflow's infrastructure.
+// This is the default lister for production use.
+func InfrastructureProcesses(inst *WorkflowInstance) []*repository.Process {
+	if inst.Infrastructure == nil || inst.Infrastructure.Repositories.ProcessRepo == nil {
+		return nil
+	}
+	return inst.Infrastructure.Repositories.ProcessRepo.List()
+}
+
 // CommandSubmitterFactory creates a CommandSubmitter for a given workflow.
 // This allows tests to inject mock command submitters while production code
 // uses the Infrastructure's processor.
@@ -113,6 +178,9 @@ type RecoveryExecutorConfig struct {
 	// CommandSubmitterFactory creates command submitters for workflows.
 	// If nil, uses InfrastructureCommandSubmitter.
 	CommandSubmitterFactory CommandSubmitterFactory
+	// ProcessLister lists workflow processes when replacing a stuck worker.
+	// If nil, uses InfrastructureProcesses.
+	ProcessLister ProcessLister
 	// Clock is used for timestamps (for testing).
 	// If nil, uses time.Now().
 	Clock Clock
@@ -124,6 +192,7 @@ type defaultRecoveryExecutor struct {
 	supervisor              Supervisor
 	onHealthEvent           HealthEventCallback
 	commandSubmitterFactory CommandSubmitterFactory
+	processLister           ProcessLister
 	clock                   Clock
 }
 
@@ -143,17 +212,30 @@ func NewRecoveryExecutor(cfg RecoveryExecutorConfig) (RecoveryExecutor, error) {
 		cmdSubmitterFactory = InfrastructureCommandSubmitter
 	}
 
+	processLister := cfg.ProcessLister
+	if processLister == nil {
+		processLister = InfrastructureProcesses
+	}
+
 	return &defaultRecoveryExecutor{
 		workflowProvider:        cfg.WorkflowProvider,
 		supervisor:              cfg.Supervisor,
 		onHealthEvent:           cfg.OnHealthEvent,
 		commandSubmitterFactory: cmdSubmitterFactory,
+		processLister:           processLister,
 		clock:                   clock,
 	}, nil
 }
 
 // ExecuteRecovery performs the specified recovery action for a workflow.
 func (e *defaultRecoveryExecutor) ExecuteRecovery(ctx context.Context, id WorkflowID, action RecoveryAction) error {
+	return e.Recover(ctx, id, RecoveryRequest{Step: EscalationStep{Action: action}})
+}
+
+// Recover executes an escalation step for a workflow.
+func (e *defaultRecoveryExecutor) Recover(ctx context.Context, id WorkflowID, req RecoveryRequest) error {
+	action := req.Step.Action
+
 	// Validate the action
 	if !action.IsValid() {
 		return fmt.Errorf("invalid recovery action: %d", action)
@@ -168,15 +250,22 @@ func (e *defaultRecoveryExecutor) ExecuteRecovery(ctx context.Context, id Workfl
 	// Emit recovery started event
 	e.emitEvent(NewHealthEvent(HealthRecoveryStarted, id).
 		WithRecoveryAction(action.String()).
+		WithProcess(req.ProcessID).
 		WithDetails(fmt.Sprintf("Starting %s recovery", action)))
 
 	// Execute the recovery action
 	var err error
+	processID := ""
 	switch action {
 	case RecoveryNudge:
-		err = e.executeNudge(ctx, inst)
+		processID, err = e.executeNudge(ctx, inst, req)
 	case RecoveryReplace:
+		processID = repository.CoordinatorID
 		err = e.executeReplace(ctx, inst)
+	case RecoveryReplaceWorker:
+		processID, err = e.executeReplaceWorker(ctx, inst, req.ProcessID)
+	case RecoveryNotify:
+		err = e.executeNotify(ctx, inst, req)
 	case RecoveryPause:
 		err = e.executePause(ctx, inst)
 	case RecoveryFail:
@@ -185,36 +274,58 @@ func (e *defaultRecoveryExecutor) ExecuteRecovery(ctx context.Context, id Workfl
 		err = fmt.Errorf("unhandled recovery action: %s", action)
 	}
 
+	e.recordRecovery(inst, req, processID, err)
+
 	// Emit success or failure event
 	if err != nil {
 		e.emitEvent(NewHealthEvent(HealthRecoveryFailed, id).
 			WithRecoveryAction(action.String()).
+			WithProcess(req.ProcessID).
 			WithDetails(fmt.Sprintf("Recovery %s failed: %v", action, err)))
 		return err
 	}
 
 	e.emitEvent(NewHealthEvent(HealthRecoverySuccess, id).
 		WithRecoveryAction(action.String()).
+		WithProcess(req.ProcessID).
 		WithDetails(fmt.Sprintf("Recovery %s succeeded", action)))
 
 	return nil
 }
 
-// executeNudge sends a gentle reminder message to the coordinator.
-func (e *defaultRecoveryExecutor) executeNudge(ctx context.Context, inst *WorkflowInstance) error {
-	// Workflow must be running to nudge
-	if inst.State != WorkflowRunning {
-		return fmt.Errorf("cannot nudge workflow in state %s", inst.State)
+// recordRecovery appends the outcome of a recovery action to the workflow's
+// session so escalation ladders can be tuned from real runs.
+func (e *defaultRecoveryExecutor) recordRecovery(inst *WorkflowInstance, req RecoveryRequest, processID string, err error) {
+	if inst.Session == nil {
+		return
 	}
 
-	// Get command submitter
-	cmdSubmitter := e.commandSubmitterFactory(inst)
-	if cmdSubmitter == nil {
-		return fmt.Errorf("workflow infrastructure not available")
+	record := session.RecoveryRecord{
+		Timestamp:    e.clock.Now(),
+		Action:       req.Step.Action.String(),
+		Step:         req.Attempt,
+		ProcessID:    processID,
+		Message:      req.Step.Message,
+		StuckSeconds: int(req.StuckFor.Seconds()),
+		Success:      err == nil,
 	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if writeErr := inst.Session.WriteRecovery(record); writeErr != nil {
+		log.Debug(log.CatOrch, "Failed to record recovery", "workflowID", inst.ID, "error", writeErr)
+	}
+}
 
-	// Create nudge message with actionable guidance
-	nudgeMessage := `[SYSTEM] Automatic System Health Check
+// workerNudgeMessage is sent to a stuck worker when a nudge step has no message.
+const workerNudgeMessage = `[SYSTEM] Automatic Worker Health Check
+
+You have produced no output for %s while working.
+
+If you are still working on your task, continue. If you are blocked or waiting on something, report to the coordinator with what you need, then end your turn.`
+
+// defaultNudgeMessage is sent to the coordinator when a nudge step has no message.
+const defaultNudgeMessage = `[SYSTEM] Automatic System Health Check
 
 There has been no worker output detected recently.
 
@@ -229,22 +340,42 @@ Based on what you find:
 
 If you are still unsure how to proceed then you MUST call the notify_user tool and summarize your findings so the user can help unblock you.`
 
-	// Submit send-to-process command for the coordinator
-	cmd := command.NewSendToProcessCommand(
-		command.SourceInternal,
-		repository.CoordinatorID,
-		nudgeMessage,
-	)
+// executeNudge sends a reminder message to the coordinator, or to the worker
+// the request targets. Returns the nudged process ID.
+func (e *defaultRecoveryExecutor) executeNudge(ctx context.Context, inst *WorkflowInstance, req RecoveryRequest) (string, error) {
+	processID := repository.CoordinatorID
+	defaultMessage := defaultNudgeMessage
+	if req.ProcessID != "" {
+		processID = req.ProcessID
+		defaultMessage = fmt.Sprintf(workerNudgeMessage, req.StuckFor.Truncate(time.Second))
+	}
+
+	// Workflow must be running to nudge
+	if inst.State != WorkflowRunning {
+		return processID, fmt.Errorf("cannot nudge workflow in state %s", inst.State)
+	}
+
+	// Get command submitter
+	cmdSubmitter := e.commandSubmitterFactory(inst)
+	if cmdSubmitter == nil {
+		return processID, fmt.Errorf("workflow infrastructure not available")
+	}
+
+	nudgeMessage := req.Step.Message
+	if nudgeMessage == "" {
+		nudgeMessage = defaultMessage
+	}
 
+	cmd := command.NewSendToProcessCommand(command.SourceInternal, processID, nudgeMessage)
 	result, err := cmdSubmitter.SubmitAndWait(ctx, cmd)
 	if err != nil {
-		return fmt.Errorf("submitting nudge command: %w", err)
+		return processID, fmt.Errorf("submitting nudge command: %w", err)
 	}
 	if !result.Success {
-		return fmt.Errorf("nudge command failed: %w", result.Error)
+		return processID, fmt.Errorf("nudge command failed: %w", result.Error)
 	}
 
-	return nil
+	return processID, nil
 }
 
 // executeReplace terminates the coordinator and spawns a replacement.
@@ -278,6 +409,165 @@ func (e *defaultRecoveryExecutor) executeReplace(ctx context.Context, inst *Work
 	return nil
 }
 
+// executeReplaceWorker replaces a stuck worker, reassigns its task to the
+// replacement and tells the coordinator. Without a target it replaces the
+// working worker whose last completed turn is oldest. Returns the replaced
+// worker's ID.
+func (e *defaultRecoveryExecutor) executeReplaceWorker(ctx context.Context, inst *WorkflowInstance, processID string) (string, error) {
+	if inst.State != WorkflowRunning {
+		return processID, fmt.Errorf("cannot replace worker in workflow state %s", inst.State)
+	}
+
+	cmdSubmitter := e.commandSubmitterFactory(inst)
+	if cmdSubmitter == nil {
+		return processID, fmt.Errorf("workflow infrastructure not available")
+	}
+
+	processes := e.processLister(inst)
+	var stuck *repository.Process
+	for _, proc := range processes {
+		if !proc.IsWorker() || !proc.RetiredAt.IsZero() || proc.Status.IsTerminal() {
+			continue
+		}
+		if processID != "" {
+			if proc.ID == processID {
+				stuck = proc
+				break
+			}
+			continue
+		}
+		if proc.Status != repository.StatusWorking {
+			continue
+		}
+		if stuck == nil || proc.LastActivityAt.Before(stuck.LastActivityAt) {
+			stuck = proc
+		}
+	}
+	if stuck == nil {
+		if processID != "" {
+			return processID, fmt.Errorf("worker %s not found", processID)
+		}
+		return "", fmt.Errorf("no working worker to replace")
+	}
+
+	cmd := command.NewReplaceProcessCommand(
+		command.SourceInternal,
+		stuck.ID,
+		"Worker replaced due to stuck workflow recovery",
+	)
+
+	result, err := cmdSubmitter.SubmitAndWait(ctx, cmd)
+	if err != nil {
+		return stuck.ID, fmt.Errorf("submitting replace command: %w", err)
+	}
+	if !result.Success {
+		return stuck.ID, fmt.Errorf("replace command failed: %w", result.Error)
+	}
+
+	replacement := ""
+	if replaced, ok := result.Data.(*handler.ReplaceProcessResult); ok {
+		replacement = replaced.NewProcessID
+	}
+	e.handOffTask(ctx, cmdSubmitter, stuck, replacement, processes)
+
+	return stuck.ID, nil
+}
+
+// handOffTask reassigns a replaced worker's task to its replacement and tells
+// the coordinator what happened. A stuck reviewer's replacement re-runs the
+// review of the implementer's work instead of taking over the task. Failures
+// are reported to the coordinator rather than failing the recovery: the worker
+// has already been replaced.
+func (e *defaultRecoveryExecutor) handOffTask(ctx context.Context, cmdSubmitter CommandSubmitter, stuck *repository.Process, replacement string, processes []*repository.Process) {
+	message := fmt.Sprintf("[SYSTEM] %s stopped responding and was replaced", stuck.ID)
+	if replacement != "" {
+		message += " by " + replacement
+	}
+	message += "."
+
+	if stuck.TaskID != "" {
+		reviewing := stuck.Phase != nil && *stuck.Phase == events.ProcessPhaseReviewing
+		reassigned := false
+		if replacement != "" {
+			var cmd command.Command
+			if reviewing {
+				if implementer := taskImplementer(processes, stuck); implementer != "" {
+					cmd = command.NewAssignReviewCommand(command.SourceInternal, replacement, stuck.TaskID, implementer, command.ReviewTypeComplex)
+				}
+			} else {
+				cmd = command.NewAssignTaskCommand(command.SourceInternal, replacement, stuck.TaskID,
+					fmt.Sprintf("Continue task %s: the previous worker (%s) stopped responding. Check the task thread and the working tree for progress already made.", stuck.TaskID, stuck.ID), "")
+			}
+			if cmd != nil {
+				result, err := cmdSubmitter.SubmitAndWait(ctx, cmd)
+				if err == nil && !result.Success {
+					err = result.Error
+				}
+				if err != nil {
+					log.ErrorErr(log.CatOrch, "Failed to reassign task of replaced worker", err,
+						"taskID", stuck.TaskID, "worker", replacement)
+				} else {
+					reassigned = true
+				}
+			}
+		}
+		switch {
+		case reassigned && reviewing:
+			message += fmt.Sprintf(" The review of task %s was reassigned to %s.", stuck.TaskID, replacement)
+		case reassigned:
+			message += fmt.Sprintf(" Task %s was reassigned to %s.", stuck.TaskID, replacement)
+		case reviewing:
+			message += fmt.Sprintf(" Its review of task %s could not be reassigned automatically; assign the review to a ready worker.", stuck.TaskID)
+		default:
+			message += fmt.Sprintf(" Its task %s could not be reassigned automatically; assign it to a ready worker.", stuck.TaskID)
+		}
+	}
+
+	notify := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, message)
+	if _, err := cmdSubmitter.SubmitAndWait(ctx, notify); err != nil {
+		log.ErrorErr(log.CatOrch, "Failed to tell coordinator about replaced worker", err, "worker", stuck.ID)
+	}
+}
+
+// taskImplementer returns the worker implementing the task a stuck reviewer
+// was reviewing, or "" when none is found.
+func taskImplementer(processes []*repository.Process, reviewer *repository.Process) string {
+	for _, proc := range processes {
+		if proc.ID == reviewer.ID || !proc.IsWorker() || proc.TaskID != reviewer.TaskID {
+			continue
+		}
+		if proc.Phase != nil && *proc.Phase == events.ProcessPhaseReviewing {
+			continue
+		}
+		return proc.ID
+	}
+	return ""
+}
+
+// executeNotify alerts the user that the workflow is stuck.
+func (e *defaultRecoveryExecutor) executeNotify(ctx context.Context, inst *WorkflowInstance, req RecoveryRequest) error {
+	cmdSubmitter := e.commandSubmitterFactory(inst)
+	if cmdSubmitter == nil {
+		return fmt.Errorf("workflow infrastructure not available")
+	}
+
+	message := req.Step.Message
+	if message == "" {
+		message = fmt.Sprintf("Workflow %q has made no progress for %s", inst.Name, req.StuckFor.Truncate(time.Second))
+	}
+
+	cmd := command.NewNotifyUserCommand(command.SourceInternal, message, "health", "")
+	result, err := cmdSubmitter.SubmitAndWait(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("submitting notify command: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("notify command failed: %w", result.Error)
+	}
+
+	return nil
+}
+
 // executePause suspends the workflow by delegating to Supervisor.Pause().
 func (e *defaultRecoveryExecutor) executePause(ctx context.Context, inst *WorkflowInstance) error {
 	// If no supervisor is configured, fall back to legacy behavior
@@ -339,7 +629,7 @@ func (e *defaultRecoveryExecutor) emitEvent(event HealthEvent) {
 // the current health status and policy.
 // Returns the action to take, or -1 if no recovery is needed.
 //
-// Recovery escalation:
+// Recovery escalation follows the policy's Escalation ladder when set, otherwise:
 // 1. Nudge (up to MaxNudges times, default 2)
 // 2. Replace (once, if enabled)
 // 3. Pause (once, if enabled)
@@ -351,6 +641,14 @@ func DetermineRecoveryAction(status *HealthStatus, policy HealthPolicy) Recovery
 // DetermineRecoveryActionAt determines the appropriate recovery action at the given time.
 // This variant allows testing with a mock clock.
 func DetermineRecoveryActionAt(status *HealthStatus, policy HealthPolicy, now time.Time) RecoveryAction {
+	if len(policy.Escalation) > 0 {
+		step, ok := NextEscalationStepAt(status, policy, now)
+		if !ok {
+			return RecoveryAction(-1)
+		}
+		return step.Action
+	}
+
 	// No recovery needed if not stuck (computed from LastProgressAt vs ProgressTimeout)
 	if !status.IsStuckAt(policy, now) {
 		return RecoveryAction(-1)
@@ -407,3 +705,24 @@ func DetermineRecoveryActionAt(status *HealthStatus, policy HealthPolicy, now ti
 	}
 	return RecoveryAction(-1)
 }
+
+// NextEscalationStepAt returns the escalation step for the next recovery
+// attempt at the given time, or false if no recovery should be attempted.
+// Policies without an Escalation ladder use the legacy escalation from
+// DetermineRecoveryActionAt.
+func NextEscalationStepAt(status *HealthStatus, policy HealthPolicy, now time.Time) (EscalationStep, bool) {
+	if len(policy.Escalation) == 0 {
+		action := DetermineRecoveryActionAt(status, policy, now)
+		return EscalationStep{Action: action}, action >= 0
+	}
+
+	if !status.IsStuckAt(policy, now) {
+		return EscalationStep{}, false
+	}
+	ladder := policy.escalationLadder()
+	if status.RecoveryCount >= len(ladder) {
+		// Ladder exhausted - enter limbo state (will emit HealthStillStuck)
+		return EscalationStep{}, false
+	}
+	return ladder[status.RecoveryCount], true
+}
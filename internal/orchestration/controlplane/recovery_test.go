@@ -2,14 +2,21 @@ package controlplane
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/session"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
@@ -93,6 +100,8 @@ func TestRecoveryAction_String(t *testing.T) {
 		{RecoveryReplace, "replace"},
 		{RecoveryPause, "pause"},
 		{RecoveryFail, "fail"},
+		{RecoveryReplaceWorker, "replace_worker"},
+		{RecoveryNotify, "notify"},
 		{RecoveryAction(99), "unknown(99)"},
 	}
 
@@ -112,6 +121,7 @@ func TestRecoveryAction_IsValid(t *testing.T) {
 		{RecoveryReplace, true},
 		{RecoveryPause, true},
 		{RecoveryFail, true},
+		{RecoveryNotify, true},
 		{RecoveryAction(-1), false},
 		{RecoveryAction(99), false},
 	}
@@ -893,3 +903,295 @@ func filterRecoveryEvents(events []HealthEvent) []HealthEvent {
 	}
 	return result
 }
+
+func TestParseRecoveryAction(t *testing.T) {
+	for name, want := range map[string]RecoveryAction{
+		"nudge":               RecoveryNudge,
+		"replace":             RecoveryReplace,
+		"replace_coordinator": RecoveryReplace,
+		"replace_worker":      RecoveryReplaceWorker,
+		"pause":               RecoveryPause,
+		"notify":              RecoveryNotify,
+		"fail":                RecoveryFail,
+	} {
+		action, err := ParseRecoveryAction(name)
+		require.NoError(t, err, name)
+		require.Equal(t, want, action, name)
+	}
+
+	_, err := ParseRecoveryAction("restart")
+	require.ErrorContains(t, err, `unknown recovery action "restart"`)
+}
+
+func TestNextEscalationStepAt_Ladder(t *testing.T) {
+	now := time.Now()
+	policy := DefaultHealthPolicy()
+	policy.Escalation = []EscalationStep{
+		{Action: RecoveryNudge, Message: "Check on your workers", Repeat: 2},
+		{Action: RecoveryReplaceWorker},
+		{Action: RecoveryNotify},
+	}
+	status := &HealthStatus{LastProgressAt: now.Add(-policy.ProgressTimeout - time.Minute)}
+
+	var actions []RecoveryAction
+	for {
+		step, ok := NextEscalationStepAt(status, policy, now)
+		if !ok {
+			break
+		}
+		actions = append(actions, step.Action)
+		if step.Action == RecoveryNudge {
+			require.Equal(t, "Check on your workers", step.Message)
+		}
+		status.RecoveryCount++
+	}
+
+	require.Equal(t, []RecoveryAction{RecoveryNudge, RecoveryNudge, RecoveryReplaceWorker, RecoveryNotify}, actions)
+	require.Equal(t, RecoveryAction(-1), DetermineRecoveryActionAt(status, policy, now), "exhausted ladder leaves the workflow in limbo")
+	require.Equal(t, 4, policy.recoveryLimit())
+
+	status.LastProgressAt = now
+	status.RecoveryCount = 0
+	_, ok := NextEscalationStepAt(status, policy, now)
+	require.False(t, ok, "no recovery for workflows making progress")
+}
+
+func TestRecoveryExecutor_Recover_CustomNudgeMessage(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	provider.Put(createTestWorkflow("wf-1", WorkflowRunning))
+
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+	})
+	require.NoError(t, err)
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{
+		Step: EscalationStep{Action: RecoveryNudge, Message: "Check on your workers"},
+	})
+	require.NoError(t, err)
+
+	commands := mockSubmitter.GetCommands()
+	require.Len(t, commands, 1)
+	sendCmd, ok := commands[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "Check on your workers", sendCmd.Content)
+}
+
+func TestRecoveryExecutor_Recover_ReplaceWorker(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	provider.Put(createTestWorkflow("wf-1", WorkflowRunning))
+
+	now := time.Now()
+	processes := []*repository.Process{
+		{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking},
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, LastActivityAt: now.Add(-time.Minute)},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, LastActivityAt: now.Add(-10 * time.Minute)},
+		{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusReady, LastActivityAt: now.Add(-time.Hour)},
+	}
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+		ProcessLister: func(inst *WorkflowInstance) []*repository.Process {
+			return processes
+		},
+	})
+	require.NoError(t, err)
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{Step: EscalationStep{Action: RecoveryReplaceWorker}})
+	require.NoError(t, err)
+
+	commands := mockSubmitter.GetCommands()
+	require.Len(t, commands, 2)
+	replaceCmd, ok := commands[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-2", replaceCmd.ProcessID, "the working worker idle longest is replaced")
+	notifyCmd, ok := commands[1].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, repository.CoordinatorID, notifyCmd.ProcessID)
+
+	processes = processes[:1]
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{Step: EscalationStep{Action: RecoveryReplaceWorker}})
+	require.ErrorContains(t, err, "no working worker to replace")
+}
+
+func TestRecoveryExecutor_Recover_ReplaceWorker_ReassignsTask(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	mockSubmitter.SetResult(&command.CommandResult{
+		Success: true,
+		Data:    &handler.ReplaceProcessResult{OldProcessID: "worker-1", NewProcessID: "worker-4", Role: repository.RoleWorker},
+	}, nil)
+	provider.Put(createTestWorkflow("wf-1", WorkflowRunning))
+
+	processes := []*repository.Process{
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "perles-abc.1"},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking},
+	}
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+		ProcessLister: func(inst *WorkflowInstance) []*repository.Process {
+			return processes
+		},
+	})
+	require.NoError(t, err)
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{
+		Step:      EscalationStep{Action: RecoveryReplaceWorker},
+		ProcessID: "worker-1",
+	})
+	require.NoError(t, err)
+
+	commands := mockSubmitter.GetCommands()
+	require.Len(t, commands, 3)
+	replaceCmd, ok := commands[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-1", replaceCmd.ProcessID, "the targeted worker is replaced")
+	assignCmd, ok := commands[1].(*command.AssignTaskCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-4", assignCmd.WorkerID)
+	require.Equal(t, "perles-abc.1", assignCmd.TaskID)
+	notifyCmd, ok := commands[2].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, repository.CoordinatorID, notifyCmd.ProcessID)
+	require.Contains(t, notifyCmd.Content, "Task perles-abc.1 was reassigned to worker-4")
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{
+		Step:      EscalationStep{Action: RecoveryReplaceWorker},
+		ProcessID: "worker-9",
+	})
+	require.ErrorContains(t, err, "worker worker-9 not found")
+}
+
+func TestRecoveryExecutor_Recover_ReplaceWorker_ReassignsReview(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	mockSubmitter.SetResult(&command.CommandResult{
+		Success: true,
+		Data:    &handler.ReplaceProcessResult{OldProcessID: "worker-2", NewProcessID: "worker-4", Role: repository.RoleWorker},
+	}, nil)
+	provider.Put(createTestWorkflow("wf-1", WorkflowRunning))
+
+	awaitingReview := events.ProcessPhaseAwaitingReview
+	reviewing := events.ProcessPhaseReviewing
+	processes := []*repository.Process{
+		{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, TaskID: "perles-abc.1", Phase: &awaitingReview},
+		{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "perles-abc.1", Phase: &reviewing},
+	}
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+		ProcessLister: func(inst *WorkflowInstance) []*repository.Process {
+			return processes
+		},
+	})
+	require.NoError(t, err)
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{
+		Step:      EscalationStep{Action: RecoveryReplaceWorker},
+		ProcessID: "worker-2",
+	})
+	require.NoError(t, err)
+
+	commands := mockSubmitter.GetCommands()
+	require.Len(t, commands, 3)
+	reviewCmd, ok := commands[1].(*command.AssignReviewCommand)
+	require.True(t, ok, "a stuck reviewer's replacement re-runs the review")
+	require.Equal(t, "worker-4", reviewCmd.ReviewerID)
+	require.Equal(t, "perles-abc.1", reviewCmd.TaskID)
+	require.Equal(t, "worker-1", reviewCmd.ImplementerID)
+	notifyCmd, ok := commands[2].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Contains(t, notifyCmd.Content, "The review of task perles-abc.1 was reassigned to worker-4")
+}
+
+func TestRecoveryExecutor_Recover_NudgeWorker(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	provider.Put(createTestWorkflow("wf-1", WorkflowRunning))
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+	})
+	require.NoError(t, err)
+
+	err = executor.Recover(context.Background(), "wf-1", RecoveryRequest{
+		Step:      EscalationStep{Action: RecoveryNudge},
+		ProcessID: "worker-2",
+		StuckFor:  12 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	commands := mockSubmitter.GetCommands()
+	require.Len(t, commands, 1)
+	nudgeCmd, ok := commands[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-2", nudgeCmd.ProcessID)
+	require.Contains(t, nudgeCmd.Content, "no output for 12m0s")
+}
+
+func TestRecoveryExecutor_Recover_RecordsToSession(t *testing.T) {
+	provider := newMockWorkflowProvider()
+	mockSubmitter := newMockCommandSubmitter()
+	inst := createTestWorkflow("wf-1", WorkflowRunning)
+	inst.Name = "Auth"
+	sess, err := session.New("wf-1", t.TempDir())
+	require.NoError(t, err)
+	inst.Session = sess
+	provider.Put(inst)
+
+	executor, err := NewRecoveryExecutor(RecoveryExecutorConfig{
+		WorkflowProvider: provider,
+		CommandSubmitterFactory: func(inst *WorkflowInstance) CommandSubmitter {
+			return mockSubmitter
+		},
+		ProcessLister: func(inst *WorkflowInstance) []*repository.Process { return nil },
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, executor.Recover(ctx, "wf-1", RecoveryRequest{
+		Step:     EscalationStep{Action: RecoveryNotify},
+		Attempt:  1,
+		StuckFor: 5 * time.Minute,
+	}))
+	require.Error(t, executor.Recover(ctx, "wf-1", RecoveryRequest{
+		Step:    EscalationStep{Action: RecoveryReplaceWorker},
+		Attempt: 2,
+	}))
+
+	notifyCmd, ok := mockSubmitter.GetCommands()[0].(*command.NotifyUserCommand)
+	require.True(t, ok)
+	require.Equal(t, `Workflow "Auth" has made no progress for 5m0s`, notifyCmd.Message)
+	require.Equal(t, "health", notifyCmd.Phase)
+
+	require.NoError(t, sess.Close(session.StatusCompleted))
+	data, err := os.ReadFile(filepath.Join(sess.Dir, "recoveries.jsonl"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var notified, replaced session.RecoveryRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &notified))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &replaced))
+	require.Equal(t, "notify", notified.Action)
+	require.Equal(t, 1, notified.Step)
+	require.Equal(t, 300, notified.StuckSeconds)
+	require.True(t, notified.Success)
+	require.Equal(t, "replace_worker", replaced.Action)
+	require.False(t, replaced.Success)
+	require.Equal(t, "no working worker to replace", replaced.Error)
+}
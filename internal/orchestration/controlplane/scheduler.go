@@ -0,0 +1,519 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// ErrBudgetExceeded is returned when a token or USD budget has been spent.
+var ErrBudgetExceeded = errors.New("budget exceeded")
+
+// ErrWorkerLimit is returned when spawning a worker would exceed the global worker limit.
+var ErrWorkerLimit = errors.New("worker limit reached")
+
+// QueuePolicy determines the order in which queued workflows are started.
+type QueuePolicy string
+
+const (
+	// QueueFIFO starts queued workflows in the order they were queued.
+	QueueFIFO QueuePolicy = "fifo"
+	// QueuePriority starts the highest-priority queued workflow first,
+	// falling back to queue order between equal priorities.
+	QueuePriority QueuePolicy = "priority"
+)
+
+// IsValid returns true if this is a recognized QueuePolicy value.
+// The empty policy is valid and means FIFO.
+func (p QueuePolicy) IsValid() bool {
+	return p == "" || p == QueueFIFO || p == QueuePriority
+}
+
+// ResourceLimits bounds what all workflows in this process may consume.
+// A zero value means unlimited.
+type ResourceLimits struct {
+	// MaxWorkflows is the maximum number of concurrently running workflows.
+	MaxWorkflows int
+	// MaxWorkers is the maximum number of live workers across all workflows.
+	MaxWorkers int
+	// MaxWorkflowTokens is the output token budget of a single workflow.
+	MaxWorkflowTokens int64
+	// MaxWorkflowCostUSD is the USD budget of a single workflow.
+	MaxWorkflowCostUSD float64
+	// MaxTotalTokens is the output token budget shared by all workflows.
+	MaxTotalTokens int64
+	// MaxTotalCostUSD is the USD budget shared by all workflows.
+	MaxTotalCostUSD float64
+}
+
+// ResourceUsage is the spend recorded for a workflow (or all workflows).
+type ResourceUsage struct {
+	// Tokens is the cumulative number of output tokens.
+	Tokens int64
+	// CostUSD is the cumulative cost in USD.
+	CostUSD float64
+}
+
+// QueuedWorkflow describes a workflow waiting for a slot.
+type QueuedWorkflow struct {
+	ID       WorkflowID
+	Name     string
+	Priority int
+	QueuedAt time.Time
+	// EstimatedStartAt is when the workflow is expected to get a slot, based on
+	// how long recent workflows held theirs (zero if there is no history yet).
+	EstimatedStartAt time.Time
+}
+
+// slotHistorySize is the number of recent slot hold times used to estimate queue ETAs.
+const slotHistorySize = 20
+
+// ResourceScheduler enforces global resource limits across workflows.
+//
+// Running workflows hold a lease on a workflow slot. When no slot is free,
+// workflows are queued (FIFO or by priority) instead of being rejected, and
+// are handed out by Next as slots are released. Worker spawns are gated via
+// WorkerAdmitter, and token/USD spend is accumulated from TokenMetrics on
+// process token usage events.
+type ResourceScheduler interface {
+	// Start subscribes to the event bus to track spend.
+	Start(ctx context.Context) error
+
+	// Stop stops tracking spend. It is safe to call Stop multiple times or before Start.
+	Stop()
+
+	// Acquire takes a workflow slot for inst. It returns false when no slot is
+	// free and the workflow was queued instead. Returns ErrBudgetExceeded if the
+	// global or workflow budget has already been spent.
+	Acquire(inst *WorkflowInstance) (bool, error)
+
+	// Release returns the workflow's slot (if any) and removes it from the queue.
+	Release(id WorkflowID)
+
+	// Next pops the next queued workflow if a slot is free, taking the slot on
+	// its behalf. Returns false when nothing can be started.
+	Next() (*WorkflowInstance, bool)
+
+	// Queue returns the queued workflows in the order they will be started,
+	// with estimated start times.
+	Queue() []QueuedWorkflow
+
+	// WorkerAdmitter returns the admitter used to gate worker spawns in a workflow.
+	WorkerAdmitter(id WorkflowID) handler.WorkerAdmitter
+
+	// RecordUsage adds spend to a workflow's usage.
+	RecordUsage(id WorkflowID, tokens int64, costUSD float64)
+
+	// Usage returns the spend recorded for a workflow.
+	Usage(id WorkflowID) ResourceUsage
+
+	// TotalUsage returns the spend recorded across all workflows.
+	TotalUsage() ResourceUsage
+
+	// Limits returns the configured limits.
+	Limits() ResourceLimits
+}
+
+// ResourceSchedulerConfig configures the ResourceScheduler.
+type ResourceSchedulerConfig struct {
+	// Limits bounds resource usage. Zero values are unlimited.
+	Limits ResourceLimits
+
+	// Policy orders the workflow queue. Defaults to QueueFIFO.
+	Policy QueuePolicy
+
+	// EventBus is the control plane event bus to subscribe to for token usage.
+	// If nil, spend is only tracked through RecordUsage.
+	EventBus *pubsub.Broker[ControlPlaneEvent]
+}
+
+// lease is a workflow slot held by a running workflow.
+type lease struct {
+	inst       *WorkflowInstance
+	acquiredAt time.Time
+}
+
+// queueEntry is a workflow waiting for a slot.
+type queueEntry struct {
+	inst     *WorkflowInstance
+	queuedAt time.Time
+}
+
+// defaultResourceScheduler is the default implementation of ResourceScheduler.
+type defaultResourceScheduler struct {
+	mu       sync.Mutex
+	limits   ResourceLimits
+	policy   QueuePolicy
+	leases   map[WorkflowID]*lease
+	queue    []queueEntry
+	pending  map[WorkflowID]int // Admitted workers not yet saved to their process repository
+	usage    map[WorkflowID]ResourceUsage
+	total    ResourceUsage
+	held     []time.Duration // Recent slot hold times, oldest first
+	eventBus *pubsub.Broker[ControlPlaneEvent]
+
+	// Lifecycle
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewResourceScheduler creates a new ResourceScheduler with the given configuration.
+func NewResourceScheduler(cfg ResourceSchedulerConfig) ResourceScheduler {
+	policy := cfg.Policy
+	if policy == "" {
+		policy = QueueFIFO
+	}
+
+	return &defaultResourceScheduler{
+		limits:   cfg.Limits,
+		policy:   policy,
+		leases:   make(map[WorkflowID]*lease),
+		pending:  make(map[WorkflowID]int),
+		usage:    make(map[WorkflowID]ResourceUsage),
+		eventBus: cfg.EventBus,
+	}
+}
+
+// Start subscribes to the event bus to track spend.
+func (s *defaultResourceScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.done != nil || s.eventBus == nil {
+		s.mu.Unlock()
+		return nil // Already started or nothing to subscribe to
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	done := s.done
+	s.mu.Unlock()
+
+	sub := s.eventBus.Subscribe(ctx)
+	log.SafeGo("scheduler.eventLoop", func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-sub:
+				if !ok {
+					return
+				}
+				s.handleEvent(event.Payload)
+			}
+		}
+	})
+
+	return nil
+}
+
+// Stop stops tracking spend.
+func (s *defaultResourceScheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	done := s.done
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return // Not started
+	}
+	cancel()
+	<-done
+}
+
+// handleEvent records spend from token usage events.
+// Cost-only events carry TotalCostUSD with no tokens, so both are added independently.
+func (s *defaultResourceScheduler) handleEvent(event ControlPlaneEvent) {
+	if event.WorkflowID == "" {
+		return
+	}
+	pe, ok := event.Payload.(events.ProcessEvent)
+	if !ok || pe.Type != events.ProcessTokenUsage || pe.Metrics == nil {
+		return
+	}
+	s.RecordUsage(event.WorkflowID, int64(pe.Metrics.OutputTokens), pe.Metrics.TotalCostUSD)
+}
+
+// Acquire takes a workflow slot for inst, or queues it.
+func (s *defaultResourceScheduler) Acquire(inst *WorkflowInstance) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.leases[inst.ID]; ok {
+		return true, nil
+	}
+	if err := s.checkBudgetLocked(inst.ID); err != nil {
+		return false, err
+	}
+
+	// Only take a free slot directly when nobody is waiting, so new workflows
+	// cannot jump the queue.
+	if len(s.queue) == 0 && s.hasSlotLocked() {
+		s.leases[inst.ID] = &lease{inst: inst, acquiredAt: time.Now()}
+		return true, nil
+	}
+
+	if !slices.ContainsFunc(s.queue, func(e queueEntry) bool { return e.inst.ID == inst.ID }) {
+		now := time.Now()
+		inst.QueuedAt = now
+		s.queue = append(s.queue, queueEntry{inst: inst, queuedAt: now})
+		log.Info(log.CatOrch, "Workflow queued", "workflowID", inst.ID, "name", inst.Name,
+			"position", len(s.queue), "running", len(s.leases), "maxWorkflows", s.limits.MaxWorkflows)
+	}
+	return false, nil
+}
+
+// Release returns the workflow's slot and removes it from the queue.
+func (s *defaultResourceScheduler) Release(id WorkflowID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l, ok := s.leases[id]; ok {
+		delete(s.leases, id)
+		s.held = append(s.held, time.Since(l.acquiredAt))
+		if len(s.held) > slotHistorySize {
+			s.held = s.held[len(s.held)-slotHistorySize:]
+		}
+	}
+	s.queue = slices.DeleteFunc(s.queue, func(e queueEntry) bool {
+		if e.inst.ID == id {
+			e.inst.QueuedAt = time.Time{}
+			return true
+		}
+		return false
+	})
+}
+
+// Next pops the next queued workflow if a slot is free.
+func (s *defaultResourceScheduler) Next() (*WorkflowInstance, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queue) == 0 || !s.hasSlotLocked() {
+		return nil, false
+	}
+
+	i := s.nextIndexLocked()
+	entry := s.queue[i]
+	s.queue = slices.Delete(s.queue, i, i+1)
+	entry.inst.QueuedAt = time.Time{}
+	s.leases[entry.inst.ID] = &lease{inst: entry.inst, acquiredAt: time.Now()}
+	return entry.inst, true
+}
+
+// Queue returns the queued workflows in start order.
+func (s *defaultResourceScheduler) Queue() []QueuedWorkflow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := slices.Clone(s.queue)
+	if s.policy == QueuePriority {
+		slices.SortStableFunc(entries, func(a, b queueEntry) int {
+			return b.inst.Priority - a.inst.Priority
+		})
+	}
+
+	etas := s.estimateStartsLocked(len(entries), time.Now())
+	result := make([]QueuedWorkflow, len(entries))
+	for i, e := range entries {
+		result[i] = QueuedWorkflow{
+			ID:       e.inst.ID,
+			Name:     e.inst.Name,
+			Priority: e.inst.Priority,
+			QueuedAt: e.queuedAt,
+		}
+		if etas != nil {
+			result[i].EstimatedStartAt = etas[i]
+		}
+	}
+	return result
+}
+
+// estimateStartsLocked estimates when each of the first n queued workflows gets
+// a slot, assuming every workflow holds its slot for the average recent hold
+// time. Returns nil when there is no history or no workflow limit.
+// Must be called with mu held.
+func (s *defaultResourceScheduler) estimateStartsLocked(n int, now time.Time) []time.Time {
+	if len(s.held) == 0 || s.limits.MaxWorkflows <= 0 {
+		return nil
+	}
+	var total time.Duration
+	for _, d := range s.held {
+		total += d
+	}
+	avg := total / time.Duration(len(s.held))
+
+	// When each slot frees up; overdue leases are expected to free up now
+	free := make([]time.Time, 0, s.limits.MaxWorkflows)
+	for _, l := range s.leases {
+		free = append(free, later(l.acquiredAt.Add(avg), now))
+	}
+	for len(free) < s.limits.MaxWorkflows {
+		free = append(free, now)
+	}
+
+	etas := make([]time.Time, n)
+	for i := range etas {
+		slot := 0
+		for j := range free {
+			if free[j].Before(free[slot]) {
+				slot = j
+			}
+		}
+		etas[i] = free[slot]
+		free[slot] = free[slot].Add(avg)
+	}
+	return etas
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// WorkerAdmitter returns the admitter used to gate worker spawns in a workflow.
+func (s *defaultResourceScheduler) WorkerAdmitter(id WorkflowID) handler.WorkerAdmitter {
+	return &workerAdmitter{scheduler: s, workflowID: id}
+}
+
+// RecordUsage adds spend to a workflow's usage.
+func (s *defaultResourceScheduler) RecordUsage(id WorkflowID, tokens int64, costUSD float64) {
+	if tokens == 0 && costUSD == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.usage[id]
+	u.Tokens += tokens
+	u.CostUSD += costUSD
+	s.usage[id] = u
+
+	s.total.Tokens += tokens
+	s.total.CostUSD += costUSD
+}
+
+// Usage returns the spend recorded for a workflow.
+func (s *defaultResourceScheduler) Usage(id WorkflowID) ResourceUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage[id]
+}
+
+// TotalUsage returns the spend recorded across all workflows.
+func (s *defaultResourceScheduler) TotalUsage() ResourceUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.total
+}
+
+// Limits returns the configured limits.
+func (s *defaultResourceScheduler) Limits() ResourceLimits {
+	return s.limits
+}
+
+// hasSlotLocked reports whether another workflow may run. Must be called with mu held.
+func (s *defaultResourceScheduler) hasSlotLocked() bool {
+	return s.limits.MaxWorkflows <= 0 || len(s.leases) < s.limits.MaxWorkflows
+}
+
+// nextIndexLocked returns the queue index of the next workflow to start.
+// Must be called with mu held and a non-empty queue.
+func (s *defaultResourceScheduler) nextIndexLocked() int {
+	if s.policy != QueuePriority {
+		return 0
+	}
+	next := 0
+	for i, e := range s.queue {
+		if e.inst.Priority > s.queue[next].inst.Priority {
+			next = i
+		}
+	}
+	return next
+}
+
+// checkBudgetLocked returns ErrBudgetExceeded if the global budget or the
+// workflow's budget has been spent. Must be called with mu held.
+func (s *defaultResourceScheduler) checkBudgetLocked(id WorkflowID) error {
+	l := s.limits
+	if l.MaxTotalTokens > 0 && s.total.Tokens >= l.MaxTotalTokens {
+		return fmt.Errorf("%w: %d of %d total tokens used", ErrBudgetExceeded, s.total.Tokens, l.MaxTotalTokens)
+	}
+	if l.MaxTotalCostUSD > 0 && s.total.CostUSD >= l.MaxTotalCostUSD {
+		return fmt.Errorf("%w: $%.2f of $%.2f total spent", ErrBudgetExceeded, s.total.CostUSD, l.MaxTotalCostUSD)
+	}
+
+	u := s.usage[id]
+	if l.MaxWorkflowTokens > 0 && u.Tokens >= l.MaxWorkflowTokens {
+		return fmt.Errorf("%w: %d of %d workflow tokens used", ErrBudgetExceeded, u.Tokens, l.MaxWorkflowTokens)
+	}
+	if l.MaxWorkflowCostUSD > 0 && u.CostUSD >= l.MaxWorkflowCostUSD {
+		return fmt.Errorf("%w: $%.2f of $%.2f workflow budget spent", ErrBudgetExceeded, u.CostUSD, l.MaxWorkflowCostUSD)
+	}
+	return nil
+}
+
+// liveWorkersLocked counts live workers across leased workflows plus admitted
+// workers that are not yet saved. Must be called with mu held.
+func (s *defaultResourceScheduler) liveWorkersLocked() int {
+	count := 0
+	for _, l := range s.leases {
+		if infra := l.inst.Infrastructure; infra != nil && infra.Repositories.ProcessRepo != nil {
+			count += len(infra.Repositories.ProcessRepo.ActiveWorkers())
+		}
+	}
+	for _, n := range s.pending {
+		count += n
+	}
+	return count
+}
+
+// admitWorker reserves a worker for the workflow.
+func (s *defaultResourceScheduler) admitWorker(id WorkflowID) (func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkBudgetLocked(id); err != nil {
+		return nil, err
+	}
+	if s.limits.MaxWorkers > 0 {
+		if live := s.liveWorkersLocked(); live >= s.limits.MaxWorkers {
+			return nil, fmt.Errorf("%w: %d of %d workers are running across all workflows; retire a worker before spawning another",
+				ErrWorkerLimit, live, s.limits.MaxWorkers)
+		}
+	}
+
+	s.pending[id]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.pending[id]--; s.pending[id] <= 0 {
+				delete(s.pending, id)
+			}
+		})
+	}, nil
+}
+
+// workerAdmitter adapts the scheduler to handler.WorkerAdmitter for one workflow.
+type workerAdmitter struct {
+	scheduler  *defaultResourceScheduler
+	workflowID WorkflowID
+}
+
+// AdmitWorker reserves room for one new worker in the workflow.
+func (a *workerAdmitter) AdmitWorker() (func(), error) {
+	return a.scheduler.admitWorker(a.workflowID)
+}
@@ -0,0 +1,434 @@
+package controlplane
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+func newSchedulerTestInstance(t *testing.T, name string, priority int) *WorkflowInstance {
+	t.Helper()
+	inst, err := NewWorkflowInstance(&WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Build a feature",
+		Name:          name,
+		Priority:      priority,
+	})
+	require.NoError(t, err)
+	return inst
+}
+
+func TestResourceScheduler_QueuesOverWorkflowLimit(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkflows: 1}})
+	a := newSchedulerTestInstance(t, "a", 0)
+	b := newSchedulerTestInstance(t, "b", 0)
+	c := newSchedulerTestInstance(t, "c", 0)
+
+	admitted, err := s.Acquire(a)
+	require.NoError(t, err)
+	require.True(t, admitted)
+
+	for _, inst := range []*WorkflowInstance{b, c} {
+		admitted, err = s.Acquire(inst)
+		require.NoError(t, err)
+		require.False(t, admitted)
+		require.True(t, inst.IsQueued())
+	}
+
+	// Re-acquiring is idempotent for both leased and queued workflows
+	admitted, _ = s.Acquire(a)
+	require.True(t, admitted)
+	_, _ = s.Acquire(b)
+	require.Len(t, s.Queue(), 2)
+
+	_, ok := s.Next()
+	require.False(t, ok, "no slot is free while a runs")
+
+	s.Release(a.ID)
+	next, ok := s.Next()
+	require.True(t, ok)
+	require.Equal(t, b.ID, next.ID)
+	require.False(t, b.IsQueued())
+
+	queue := s.Queue()
+	require.Len(t, queue, 1)
+	require.Equal(t, c.ID, queue[0].ID)
+}
+
+func TestResourceScheduler_NewWorkflowsDoNotJumpTheQueue(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkflows: 1}})
+	a := newSchedulerTestInstance(t, "a", 0)
+	b := newSchedulerTestInstance(t, "b", 0)
+	c := newSchedulerTestInstance(t, "c", 0)
+
+	_, _ = s.Acquire(a)
+	_, _ = s.Acquire(b)
+	s.Release(a.ID)
+
+	admitted, err := s.Acquire(c)
+	require.NoError(t, err)
+	require.False(t, admitted, "b is still waiting for the freed slot")
+
+	next, ok := s.Next()
+	require.True(t, ok)
+	require.Equal(t, b.ID, next.ID)
+}
+
+func TestResourceScheduler_PriorityPolicy(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{
+		Limits: ResourceLimits{MaxWorkflows: 1},
+		Policy: QueuePriority,
+	})
+	running := newSchedulerTestInstance(t, "running", 0)
+	low := newSchedulerTestInstance(t, "low", 0)
+	high := newSchedulerTestInstance(t, "high", 5)
+	alsoHigh := newSchedulerTestInstance(t, "also-high", 5)
+
+	_, _ = s.Acquire(running)
+	_, _ = s.Acquire(low)
+	_, _ = s.Acquire(high)
+	_, _ = s.Acquire(alsoHigh)
+
+	queue := s.Queue()
+	require.Equal(t, []string{"high", "also-high", "low"},
+		[]string{queue[0].Name, queue[1].Name, queue[2].Name})
+
+	s.Release(running.ID)
+	next, ok := s.Next()
+	require.True(t, ok)
+	require.Equal(t, high.ID, next.ID)
+}
+
+func TestResourceScheduler_ReleaseRemovesQueuedWorkflow(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkflows: 1}})
+	a := newSchedulerTestInstance(t, "a", 0)
+	b := newSchedulerTestInstance(t, "b", 0)
+
+	_, _ = s.Acquire(a)
+	_, _ = s.Acquire(b)
+	s.Release(b.ID)
+
+	require.Empty(t, s.Queue())
+	require.False(t, b.IsQueued())
+}
+
+func TestResourceScheduler_QueueEstimatesStartTimes(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkflows: 2}}).(*defaultResourceScheduler)
+	a := newSchedulerTestInstance(t, "a", 0)
+	b := newSchedulerTestInstance(t, "b", 0)
+	c := newSchedulerTestInstance(t, "c", 0)
+	d := newSchedulerTestInstance(t, "d", 0)
+	e := newSchedulerTestInstance(t, "e", 0)
+
+	_, _ = s.Acquire(a)
+	_, _ = s.Acquire(b)
+	_, _ = s.Acquire(c)
+	require.True(t, s.Queue()[0].EstimatedStartAt.IsZero(), "no estimate without history")
+
+	// a held its slot for an hour
+	s.leases[a.ID].acquiredAt = time.Now().Add(-time.Hour)
+	s.Release(a.ID)
+	next, ok := s.Next()
+	require.True(t, ok)
+	require.Equal(t, c.ID, next.ID)
+
+	now := time.Now()
+	s.leases[b.ID].acquiredAt = now.Add(-50 * time.Minute)
+	s.leases[c.ID].acquiredAt = now.Add(-20 * time.Minute)
+	_, _ = s.Acquire(d)
+	_, _ = s.Acquire(e)
+
+	queue := s.Queue()
+	require.Len(t, queue, 2)
+	// d takes b's slot in ~10m, e takes c's slot in ~40m
+	require.WithinDuration(t, now.Add(10*time.Minute), queue[0].EstimatedStartAt, 5*time.Second)
+	require.WithinDuration(t, now.Add(40*time.Minute), queue[1].EstimatedStartAt, 5*time.Second)
+}
+
+func TestResourceScheduler_Budgets(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits ResourceLimits
+	}{
+		{"workflow tokens", ResourceLimits{MaxWorkflowTokens: 1000}},
+		{"workflow cost", ResourceLimits{MaxWorkflowCostUSD: 1}},
+		{"total tokens", ResourceLimits{MaxTotalTokens: 1000}},
+		{"total cost", ResourceLimits{MaxTotalCostUSD: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewResourceScheduler(ResourceSchedulerConfig{Limits: tt.limits})
+			inst := newSchedulerTestInstance(t, "a", 0)
+
+			admitted, err := s.Acquire(inst)
+			require.NoError(t, err)
+			require.True(t, admitted)
+
+			s.RecordUsage(inst.ID, 1000, 1.5)
+
+			_, err = s.WorkerAdmitter(inst.ID).AdmitWorker()
+			require.ErrorIs(t, err, ErrBudgetExceeded)
+
+			s.Release(inst.ID)
+			_, err = s.Acquire(inst)
+			require.ErrorIs(t, err, ErrBudgetExceeded)
+		})
+	}
+}
+
+func TestResourceScheduler_WorkflowBudgetIsPerWorkflow(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkflowCostUSD: 1}})
+	spent := newSchedulerTestInstance(t, "spent", 0)
+	fresh := newSchedulerTestInstance(t, "fresh", 0)
+
+	s.RecordUsage(spent.ID, 0, 2)
+
+	_, err := s.Acquire(fresh)
+	require.NoError(t, err)
+	_, err = s.WorkerAdmitter(fresh.ID).AdmitWorker()
+	require.NoError(t, err)
+	require.Equal(t, ResourceUsage{CostUSD: 2}, s.TotalUsage())
+}
+
+func TestResourceScheduler_WorkerLimitCountsAllWorkflows(t *testing.T) {
+	s := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxWorkers: 3}})
+
+	// Workflow a already runs two workers
+	a := newSchedulerTestInstance(t, "a", 0)
+	processRepo := repository.NewMemoryProcessRepository()
+	a.Infrastructure = &v2.Infrastructure{
+		Repositories: v2.RepositoryComponents{ProcessRepo: processRepo},
+	}
+	for _, id := range []string{"worker-1", "worker-2"} {
+		require.NoError(t, processRepo.Save(&repository.Process{
+			ID: id, Role: repository.RoleWorker, Status: repository.StatusReady,
+		}))
+	}
+	_, _ = s.Acquire(a)
+
+	b := newSchedulerTestInstance(t, "b", 0)
+	_, _ = s.Acquire(b)
+
+	// b may spawn one worker; a reservation counts until it is released
+	release, err := s.WorkerAdmitter(b.ID).AdmitWorker()
+	require.NoError(t, err)
+	_, err = s.WorkerAdmitter(b.ID).AdmitWorker()
+	require.ErrorIs(t, err, ErrWorkerLimit)
+
+	// Retiring one of a's workers frees room
+	release()
+	require.NoError(t, processRepo.Save(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusRetired,
+	}))
+	_, err = s.WorkerAdmitter(b.ID).AdmitWorker()
+	require.NoError(t, err)
+}
+
+func TestResourceScheduler_TracksUsageFromEvents(t *testing.T) {
+	broker := pubsub.NewBroker[ControlPlaneEvent]()
+	defer broker.Close()
+
+	s := NewResourceScheduler(ResourceSchedulerConfig{EventBus: broker})
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Stop()
+
+	id := NewWorkflowID()
+	publish := func(m *metrics.TokenMetrics) {
+		broker.Publish(pubsub.UpdatedEvent, ControlPlaneEvent{
+			Type:       EventWorkerOutput,
+			WorkflowID: id,
+			Payload: events.ProcessEvent{
+				Type:    events.ProcessTokenUsage,
+				Role:    events.RoleWorker,
+				Metrics: m,
+			},
+		})
+	}
+
+	// Wait for the subscription to be registered before publishing
+	require.Eventually(t, func() bool { return broker.SubscriberCount() == 1 }, time.Second, 5*time.Millisecond)
+
+	publish(&metrics.TokenMetrics{TokensUsed: 20000, OutputTokens: 300, TotalCostUSD: 0.25})
+	publish(&metrics.TokenMetrics{TotalCostUSD: 0.5}) // Cost-only result event
+
+	require.Eventually(t, func() bool {
+		return s.Usage(id) == ResourceUsage{Tokens: 300, CostUSD: 0.75}
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, s.Usage(id), s.TotalUsage())
+}
+
+// stubSupervisor transitions workflow state without creating infrastructure
+// and reports each workflow it moves to running.
+type stubSupervisor struct {
+	running chan WorkflowID
+}
+
+func newStubSupervisor() *stubSupervisor {
+	return &stubSupervisor{running: make(chan WorkflowID, 10)}
+}
+
+func (s *stubSupervisor) AllocateResources(context.Context, *WorkflowInstance) error { return nil }
+
+func (s *stubSupervisor) SpawnCoordinator(_ context.Context, inst *WorkflowInstance) error {
+	if err := inst.TransitionTo(WorkflowRunning); err != nil {
+		return err
+	}
+	s.running <- inst.ID
+	return nil
+}
+
+func (s *stubSupervisor) Pause(_ context.Context, inst *WorkflowInstance) error {
+	return inst.TransitionTo(WorkflowPaused)
+}
+
+func (s *stubSupervisor) Resume(_ context.Context, inst *WorkflowInstance) error {
+	if err := inst.TransitionTo(WorkflowRunning); err != nil {
+		return err
+	}
+	s.running <- inst.ID
+	return nil
+}
+
+func (s *stubSupervisor) Shutdown(context.Context, *WorkflowInstance, StopOptions) error { return nil }
+
+// requireRunning waits for the supervisor to move the given workflow to running.
+func (s *stubSupervisor) requireRunning(t *testing.T, id WorkflowID) {
+	t.Helper()
+	select {
+	case got := <-s.running:
+		require.Equal(t, id, got)
+	case <-time.After(time.Second):
+		t.Fatalf("timeout waiting for workflow %s to run", id)
+	}
+}
+
+func newSchedulerTestControlPlane(t *testing.T, limits ResourceLimits) (ControlPlane, *stubSupervisor) {
+	t.Helper()
+	supervisor := newStubSupervisor()
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:   NewInMemoryRegistry(),
+		Supervisor: supervisor,
+		Scheduler:  NewResourceScheduler(ResourceSchedulerConfig{Limits: limits}),
+	})
+	require.NoError(t, err)
+	return cp, supervisor
+}
+
+func createSchedulerTestWorkflow(t *testing.T, cp ControlPlane, name string) WorkflowID {
+	t.Helper()
+	id, err := cp.Create(context.Background(), WorkflowSpec{
+		TemplateID:    "test-template",
+		InitialPrompt: "Build a feature",
+		Name:          name,
+	})
+	require.NoError(t, err)
+	return id
+}
+
+func TestControlPlane_Start_QueuesOverWorkflowLimit(t *testing.T) {
+	cp, supervisor := newSchedulerTestControlPlane(t, ResourceLimits{MaxWorkflows: 1})
+	ctx := context.Background()
+
+	events, unsubscribe := cp.SubscribeFiltered(ctx, EventFilter{Types: []EventType{EventWorkflowQueued}})
+	defer unsubscribe()
+
+	first := createSchedulerTestWorkflow(t, cp, "first")
+	second := createSchedulerTestWorkflow(t, cp, "second")
+
+	require.NoError(t, cp.Start(ctx, first))
+	supervisor.requireRunning(t, first)
+	require.NoError(t, cp.Start(ctx, second), "queued workflows are not rejected")
+
+	inst, err := cp.Get(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowPending, inst.State)
+	require.True(t, inst.IsQueued())
+
+	select {
+	case event := <-events:
+		require.Equal(t, second, event.WorkflowID)
+	case <-time.After(time.Second):
+		t.Fatal("expected workflow queued event")
+	}
+
+	// Completing the first workflow starts the queued one
+	require.NoError(t, cp.Complete(ctx, first))
+	supervisor.requireRunning(t, second)
+	require.False(t, inst.IsQueued())
+}
+
+func TestControlPlane_PauseReleasesSlotAndResumeQueues(t *testing.T) {
+	cp, supervisor := newSchedulerTestControlPlane(t, ResourceLimits{MaxWorkflows: 1})
+	ctx := context.Background()
+
+	first := createSchedulerTestWorkflow(t, cp, "first")
+	second := createSchedulerTestWorkflow(t, cp, "second")
+
+	require.NoError(t, cp.Start(ctx, first))
+	supervisor.requireRunning(t, first)
+	require.NoError(t, cp.Start(ctx, second))
+
+	// Pausing the first workflow hands its slot to the queued one
+	require.NoError(t, cp.Pause(ctx, first))
+	supervisor.requireRunning(t, second)
+
+	// Resuming the first workflow now waits for a slot
+	require.NoError(t, cp.Resume(ctx, first))
+	inst, err := cp.Get(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowPaused, inst.State)
+	require.True(t, inst.IsQueued())
+
+	require.NoError(t, cp.Fail(ctx, second))
+	supervisor.requireRunning(t, first)
+}
+
+func TestControlPlane_StartOrResumeRunningWorkflowKeepsSlot(t *testing.T) {
+	cp, supervisor := newSchedulerTestControlPlane(t, ResourceLimits{MaxWorkflows: 1})
+	ctx := context.Background()
+
+	first := createSchedulerTestWorkflow(t, cp, "first")
+	second := createSchedulerTestWorkflow(t, cp, "second")
+
+	require.NoError(t, cp.Start(ctx, first))
+	supervisor.requireRunning(t, first)
+	require.NoError(t, cp.Start(ctx, second))
+
+	require.ErrorIs(t, cp.Start(ctx, first), ErrInvalidState)
+	require.ErrorIs(t, cp.Resume(ctx, first), ErrInvalidState)
+
+	// The running workflow keeps its slot, so the queued one stays queued
+	inst, err := cp.Get(ctx, second)
+	require.NoError(t, err)
+	require.True(t, inst.IsQueued())
+	select {
+	case id := <-supervisor.running:
+		t.Fatalf("workflow %s started over the workflow limit", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestControlPlane_Start_RefusesWhenBudgetSpent(t *testing.T) {
+	scheduler := NewResourceScheduler(ResourceSchedulerConfig{Limits: ResourceLimits{MaxTotalCostUSD: 1}})
+	cp, err := NewControlPlane(ControlPlaneConfig{
+		Registry:   NewInMemoryRegistry(),
+		Supervisor: newStubSupervisor(),
+		Scheduler:  scheduler,
+	})
+	require.NoError(t, err)
+
+	scheduler.RecordUsage(NewWorkflowID(), 0, 1)
+
+	id := createSchedulerTestWorkflow(t, cp, "over budget")
+	err = cp.Start(context.Background(), id)
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+}
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
@@ -154,6 +156,47 @@ type SupervisorConfig struct {
 	// BeadsDir is the resolved path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
+
+	// Scheduler gates worker spawns against limits shared across workflows.
+	// Optional - if nil, workers are spawned without limits.
+	Scheduler ResourceScheduler
+
+	// RepositoryStore provides durable process, task and queue repositories,
+	// so orchestration state survives a crash exactly.
+	// Optional - if nil, state is kept in memory and rebuilt from session files on resume.
+	RepositoryStore RepositoryStore
+
+	// Policies restricts the tools of agents by role or agent type. Workflow
+	// templates with a policies block replace the configured policy per role.
+	// Optional - if nil, only the default policies apply.
+	Policies map[string]config.PolicyConfig
+
+	// ContextHandoffThreshold is the context window usage, in percent, at which
+	// a process is asked for a handoff summary and replaced.
+	// Optional - if 0, processes are not handed off.
+	ContextHandoffThreshold float64
+
+	// Autoscale configures the worker autoscaler for workflows with an epic.
+	// Ignored unless enabled and TaskQuerier is set.
+	Autoscale config.AutoscaleConfig
+
+	// TaskQuerier runs the BQL queries the autoscaler uses to find an epic's
+	// ready tasks. Optional - if nil, workers are not autoscaled.
+	TaskQuerier bql.BQLExecutor
+
+	// Retry configures task retries. Rate limit cooldowns are shared by all
+	// workflows of the supervisor.
+	// Optional - if max_attempts is 0 or 1, failed tasks are not retried.
+	Retry config.RetryConfig
+}
+
+// RepositoryStore creates durable v2 repositories scoped to a workflow,
+// loaded with the state persisted for it. Implemented by sqlite.DB.
+type RepositoryStore interface {
+	ProcessRepository(workflowID string) (repository.ProcessRepository, error)
+	TaskRepository(workflowID string) (repository.TaskRepository, error)
+	QueueRepository(workflowID string, maxSize int) (repository.QueueRepository, error)
+	ApprovalRepository(workflowID string) (repository.ApprovalRepository, error)
 }
 
 // defaultSupervisor is the default implementation of Supervisor.
@@ -168,6 +211,14 @@ type defaultSupervisor struct {
 	sessionFactory        *session.Factory
 	soundService          sound.SoundService
 	beadsDir              string
+	scheduler             ResourceScheduler
+	repositoryStore       RepositoryStore
+	policies              map[string]config.PolicyConfig
+	contextHandoff        float64
+	autoscale             config.AutoscaleConfig
+	taskQuerier           bql.BQLExecutor
+	retryPolicy           handler.RetryPolicy
+	providerCooldowns     *handler.ProviderCooldowns
 }
 
 // NewSupervisor creates a new Supervisor with the given configuration.
@@ -210,9 +261,30 @@ func NewSupervisor(cfg SupervisorConfig) (Supervisor, error) {
 		sessionFactory:        cfg.SessionFactory,
 		soundService:          cfg.SoundService,
 		beadsDir:              cfg.BeadsDir,
+		scheduler:             cfg.Scheduler,
+		repositoryStore:       cfg.RepositoryStore,
+		policies:              cfg.Policies,
+		contextHandoff:        cfg.ContextHandoffThreshold,
+		autoscale:             cfg.Autoscale,
+		taskQuerier:           cfg.TaskQuerier,
+		retryPolicy:           retryPolicy(cfg.Retry),
+		providerCooldowns:     handler.NewProviderCooldowns(),
 	}, nil
 }
 
+// retryPolicy converts the task retry configuration into a handler retry policy.
+func retryPolicy(cfg config.RetryConfig) handler.RetryPolicy {
+	policy := handler.RetryPolicy{
+		MaxAttempts:       cfg.MaxAttempts,
+		Backoff:           cfg.Backoff,
+		RateLimitCooldown: cfg.RateLimitCooldown,
+	}
+	for _, kind := range cfg.RetryOn {
+		policy.RetryOn = append(policy.RetryOn, repository.FailureKind(kind))
+	}
+	return policy
+}
+
 // AllocateResources prepares a workflow for execution.
 // Creates infrastructure, MCP server, session, and stores resources on the instance.
 // After this call succeeds, inst.Infrastructure is set and event buses can be attached.
@@ -400,6 +472,34 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		CommandPersistenceProvider: func() processor.CommandWriter {
 			return sess
 		},
+		ToolPolicies:            config.ToolPolicies(s.policies, s.templatePolicies(inst.TemplateID)),
+		ContextHandoffThreshold: s.contextHandoff,
+		RetryPolicy:             s.retryPolicy,
+		ProviderCooldowns:       s.providerCooldowns,
+	}
+	if s.autoscale.Enabled && s.taskQuerier != nil && inst.EpicID != "" {
+		infraCfg.Autoscale = &v2.AutoscaleConfig{
+			MinWorkers:   s.autoscale.MinWorkers,
+			MaxWorkers:   s.autoscale.MaxWorkers,
+			IdleCooldown: s.autoscale.IdleCooldown,
+			Interval:     s.autoscale.Interval,
+		}
+		infraCfg.EpicID = inst.EpicID
+		infraCfg.TaskQuerier = s.taskQuerier
+	}
+	if s.scheduler != nil {
+		infraCfg.WorkerAdmitter = s.scheduler.WorkerAdmitter(inst.ID)
+	}
+	if inst.WorkerWorktrees && inst.WorktreePath != "" && s.gitExecutorFactory != nil {
+		infraCfg.WorkerWorktrees = newGitWorkerWorktrees(inst.WorktreePath, inst.WorktreeBranch, inst.SessionDir, s.gitExecutorFactory, s.worktreeTimeout)
+	}
+	if s.repositoryStore != nil {
+		repos, err := s.openRepositories(inst.ID.String())
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("opening repositories: %w", err)
+		}
+		infraCfg.Repositories = repos
 	}
 
 	// Step 5: Create Infrastructure
@@ -690,14 +790,16 @@ func (s *defaultSupervisor) Resume(ctx context.Context, inst *WorkflowInstance)
 	// Resume workers first, then coordinator, so coordinator can see worker availability
 	if inst.Infrastructure != nil && inst.Infrastructure.Repositories.ProcessRepo != nil {
 		processes := inst.Infrastructure.Repositories.ProcessRepo.List()
+		gated := gatedProcesses(inst.Infrastructure.Repositories.ApprovalRepo)
 
 		// Resume workers first (non-coordinator)
 		for _, proc := range processes {
 			if proc.ID == repository.CoordinatorID {
 				continue // Resume coordinator last
 			}
-			// Only resume paused processes
-			if proc.Status != repository.StatusPaused {
+			// Only resume paused processes. Workers held by a pending approval
+			// stay paused until it is resolved.
+			if proc.Status != repository.StatusPaused || gated[proc.ID] {
 				continue
 			}
 
@@ -754,16 +856,26 @@ func (s *defaultSupervisor) restoreProcessStateFromSession(inst *WorkflowInstanc
 		}
 	}
 
-	// Restore ProcessRepository (used by command handlers)
-	if inst.Infrastructure.Repositories.ProcessRepo != nil {
-		if err := session.RestoreProcessRepository(inst.Infrastructure.Repositories.ProcessRepo, resumableSession); err != nil {
-			return fmt.Errorf("restoring process repository: %w", err)
+	// Restore ProcessRepository (used by command handlers). Durable repositories
+	// already hold the exact state, so only the processes that were live are reset.
+	if processRepo := inst.Infrastructure.Repositories.ProcessRepo; processRepo != nil {
+		if _, err := processRepo.GetCoordinator(); err == nil {
+			if err := resetLiveProcesses(processRepo, gatedProcesses(inst.Infrastructure.Repositories.ApprovalRepo)); err != nil {
+				return fmt.Errorf("resetting persisted processes: %w", err)
+			}
+			log.Debug(log.CatOrch, "Restored ProcessRepository from durable state",
+				"subsystem", "supervisor", "workflowID", inst.ID,
+				"processes", len(processRepo.List()))
+		} else {
+			if err := session.RestoreProcessRepository(processRepo, resumableSession); err != nil {
+				return fmt.Errorf("restoring process repository: %w", err)
+			}
+			log.Debug(log.CatOrch, "Restored ProcessRepository from session",
+				"subsystem", "supervisor", "workflowID", inst.ID,
+				"coordinatorSessionRef", metadata.CoordinatorSessionRef,
+				"activeWorkers", len(resumableSession.ActiveWorkers),
+				"retiredWorkers", len(resumableSession.RetiredWorkers))
 		}
-		log.Debug(log.CatOrch, "Restored ProcessRepository from session",
-			"subsystem", "supervisor", "workflowID", inst.ID,
-			"coordinatorSessionRef", metadata.CoordinatorSessionRef,
-			"activeWorkers", len(resumableSession.ActiveWorkers),
-			"retiredWorkers", len(resumableSession.RetiredWorkers))
 	}
 
 	// Restore ProcessRegistry (used by delivery handler to find live processes)
@@ -791,6 +903,75 @@ func (s *defaultSupervisor) restoreProcessStateFromSession(inst *WorkflowInstanc
 	return nil
 }
 
+// openRepositories opens the durable repositories of a workflow.
+func (s *defaultSupervisor) openRepositories(workflowID string) (*v2.RepositoryComponents, error) {
+	processRepo, err := s.repositoryStore.ProcessRepository(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	taskRepo, err := s.repositoryStore.TaskRepository(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	queueRepo, err := s.repositoryStore.QueueRepository(workflowID, repository.DefaultQueueMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	approvalRepo, err := s.repositoryStore.ApprovalRepository(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	return &v2.RepositoryComponents{
+		ProcessRepo:  processRepo,
+		TaskRepo:     taskRepo,
+		QueueRepo:    queueRepo,
+		ApprovalRepo: approvalRepo,
+	}, nil
+}
+
+// resetLiveProcesses marks every persisted process that was not terminal as
+// Ready, since none of them is running after a restart. Gated workers, held by
+// a pending approval, stay Paused. Phases, task assignments and session refs
+// are kept as persisted.
+func resetLiveProcesses(processRepo repository.ProcessRepository, gated map[string]bool) error {
+	for _, proc := range processRepo.List() {
+		if proc.Status.IsTerminal() || proc.Status == repository.StatusReady {
+			continue
+		}
+		if gated[proc.ID] {
+			if proc.Status == repository.StatusPaused {
+				continue
+			}
+			restored := *proc
+			restored.Status = repository.StatusPaused
+			if err := processRepo.Save(&restored); err != nil {
+				return err
+			}
+			continue
+		}
+		restored := *proc
+		restored.Status = repository.StatusReady
+		if err := processRepo.Save(&restored); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gatedProcesses returns the workers paused by pending approvals.
+func gatedProcesses(approvals repository.ApprovalRepository) map[string]bool {
+	gated := make(map[string]bool)
+	if approvals == nil {
+		return gated
+	}
+	for _, approval := range approvals.Pending() {
+		for _, processID := range approval.PausedProcessIDs {
+			gated[processID] = true
+		}
+	}
+	return gated
+}
+
 // restoreFabricState loads and replays persisted Fabric events to restore messaging state.
 // This restores channels, messages, artifacts, subscriptions, and acks from fabric_events.jsonl.
 func (s *defaultSupervisor) restoreFabricState(inst *WorkflowInstance) error {
@@ -1081,3 +1262,19 @@ func (c *workerServerCache) getOrCreate(workerID string) *mcp.WorkerServer {
 	log.Debug(log.CatOrch, "Created worker server", "subsystem", "supervisor", "workerID", workerID)
 	return ws
 }
+
+// templatePolicies returns the tool policies of a workflow template,
+// or nil if the template has none or isn't registered. Workflows record the
+// template key as their TemplateID, which differs from the registry ID for
+// templates with underscores in their file name.
+func (s *defaultSupervisor) templatePolicies(templateID string) map[string]config.PolicyConfig {
+	if s.workflowRegistry == nil || templateID == "" {
+		return nil
+	}
+	for _, wf := range s.workflowRegistry.List() {
+		if wf.TemplateKey() == templateID {
+			return wf.Policies
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -12,11 +13,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
@@ -27,6 +30,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -2111,3 +2115,204 @@ func createMinimalInfrastructureWithFabric(t *testing.T) *v2.Infrastructure {
 
 	return infra
 }
+
+// === Durable Repositories ===
+
+// stubRepositoryStore is a RepositoryStore handing out in-memory repositories.
+type stubRepositoryStore struct {
+	processRepo *repository.MemoryProcessRepository
+	workflowIDs []string
+	err         error
+}
+
+func (s *stubRepositoryStore) ProcessRepository(workflowID string) (repository.ProcessRepository, error) {
+	s.workflowIDs = append(s.workflowIDs, workflowID)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.processRepo, nil
+}
+
+func (s *stubRepositoryStore) TaskRepository(string) (repository.TaskRepository, error) {
+	return repository.NewMemoryTaskRepository(), nil
+}
+
+func (s *stubRepositoryStore) QueueRepository(_ string, maxSize int) (repository.QueueRepository, error) {
+	return repository.NewMemoryQueueRepository(maxSize), nil
+}
+
+func (s *stubRepositoryStore) ApprovalRepository(string) (repository.ApprovalRepository, error) {
+	return repository.NewMemoryApprovalRepository(), nil
+}
+
+func TestSupervisor_AllocateResources_UsesRepositoryStore(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	store := &stubRepositoryStore{processRepo: repository.NewMemoryProcessRepository()}
+	cfg.RepositoryStore = store
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	cleanupSessionOnTestEnd(t, inst)
+	setupAgentProviderMock(t, mockProvider)
+	mockFactory.On("Create", mock.MatchedBy(func(infraCfg v2.InfrastructureConfig) bool {
+		return infraCfg.Repositories != nil && infraCfg.Repositories.ProcessRepo == store.processRepo
+	})).Return(createMinimalInfrastructure(t), nil)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	require.Equal(t, []string{inst.ID.String()}, store.workflowIDs)
+	mockFactory.AssertExpectations(t)
+}
+
+func TestSupervisor_AllocateResources_RepositoryStoreError(t *testing.T) {
+	cfg, _, mockFactory := newTestSupervisorConfig(t)
+	cfg.RepositoryStore = &stubRepositoryStore{err: errors.New("database is locked")}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	cleanupSessionOnTestEnd(t, inst)
+
+	err = supervisor.AllocateResources(context.Background(), inst)
+	require.ErrorContains(t, err, "opening repositories: database is locked")
+	require.Equal(t, WorkflowPending, inst.State)
+	mockFactory.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+// === Tool Policies ===
+
+func TestSupervisor_AllocateResources_LayersTemplatePolicies(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	registry := workflow.NewRegistry()
+	registry.Add(workflow.Workflow{
+		ID:       "locked_down",
+		Policies: map[string]config.PolicyConfig{"worker": {Network: "deny"}},
+	})
+	cfg.WorkflowRegistry = registry
+	cfg.Policies = map[string]config.PolicyConfig{"worker": {ProtectedPaths: []string{".env"}}}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.TemplateID = "locked-down" // the template key of locked_down.md
+	cleanupSessionOnTestEnd(t, inst)
+	setupAgentProviderMock(t, mockProvider)
+	mockFactory.On("Create", mock.MatchedBy(func(infraCfg v2.InfrastructureConfig) bool {
+		worker := infraCfg.ToolPolicies[client.PolicyWorker]
+		// The template replaces the configured worker policy, reviewers keep the default
+		return worker.DenyNetwork && len(worker.ProtectedPaths) == 0 &&
+			infraCfg.ToolPolicies["reviewer"].ReadOnly
+	})).Return(createMinimalInfrastructure(t), nil)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	mockFactory.AssertExpectations(t)
+}
+
+// === Autoscaling ===
+
+func TestSupervisor_AllocateResources_AutoscalesEpicWorkflows(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	querier := mocks.NewMockBQLExecutor(t)
+	cfg.Autoscale = config.AutoscaleConfig{Enabled: true, MaxWorkers: 3, IdleCooldown: time.Minute}
+	cfg.TaskQuerier = querier
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.EpicID = "perles-abc1"
+	cleanupSessionOnTestEnd(t, inst)
+	setupAgentProviderMock(t, mockProvider)
+	mockFactory.On("Create", mock.MatchedBy(func(infraCfg v2.InfrastructureConfig) bool {
+		return infraCfg.Autoscale != nil &&
+			infraCfg.Autoscale.MaxWorkers == 3 &&
+			infraCfg.Autoscale.IdleCooldown == time.Minute &&
+			infraCfg.EpicID == "perles-abc1" &&
+			infraCfg.TaskQuerier == querier
+	})).Return(createMinimalInfrastructure(t), nil)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	mockFactory.AssertExpectations(t)
+}
+
+func TestSupervisor_AllocateResources_NoAutoscaleWithoutEpic(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.Autoscale = config.AutoscaleConfig{Enabled: true}
+	cfg.TaskQuerier = mocks.NewMockBQLExecutor(t)
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	cleanupSessionOnTestEnd(t, inst)
+	setupAgentProviderMock(t, mockProvider)
+	mockFactory.On("Create", mock.MatchedBy(func(infraCfg v2.InfrastructureConfig) bool {
+		return infraCfg.Autoscale == nil
+	})).Return(createMinimalInfrastructure(t), nil)
+
+	require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	mockFactory.AssertExpectations(t)
+}
+
+// === Task Retries ===
+
+func TestSupervisor_AllocateResources_SharesProviderCooldowns(t *testing.T) {
+	cfg, mockProvider, mockFactory := newTestSupervisorConfig(t)
+	cfg.Retry = config.RetryConfig{MaxAttempts: 3, Backoff: time.Minute, RetryOn: []string{"rate_limit"}}
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	var cooldowns []*handler.ProviderCooldowns
+	setupAgentProviderMock(t, mockProvider)
+	mockFactory.On("Create", mock.MatchedBy(func(infraCfg v2.InfrastructureConfig) bool {
+		return infraCfg.RetryPolicy.MaxAttempts == 3 &&
+			infraCfg.RetryPolicy.Backoff == time.Minute &&
+			slices.Equal(infraCfg.RetryPolicy.RetryOn, []repository.FailureKind{repository.FailureRateLimit}) &&
+			infraCfg.ProviderCooldowns != nil
+	})).Run(func(args mock.Arguments) {
+		cooldowns = append(cooldowns, args.Get(0).(v2.InfrastructureConfig).ProviderCooldowns)
+	}).Return(createMinimalInfrastructure(t), nil).Twice()
+
+	for _, name := range []string{"workflow-a", "workflow-b"} {
+		inst := newTestInstance(t, name)
+		cleanupSessionOnTestEnd(t, inst)
+		require.NoError(t, supervisor.AllocateResources(context.Background(), inst))
+	}
+
+	mockFactory.AssertExpectations(t)
+	require.Len(t, cooldowns, 2)
+	require.Same(t, cooldowns[0], cooldowns[1], "a rate limit in one workflow cools the provider down for all")
+}
+
+func TestResetLiveProcesses_KeepsPersistedState(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	reviewing := events.ProcessPhaseReviewing
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking, SessionID: "coord-session"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing, TaskID: "perles-abc1.2"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusRetired})
+	processRepo.AddProcess(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusPaused, TaskID: "perles-abc1.3"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "perles-abc1.3"})
+	approvals := repository.NewMemoryApprovalRepository()
+	require.NoError(t, approvals.Save(&repository.Approval{ID: "approval-1", TaskIDs: []string{"perles-abc1.3"}, PausedProcessIDs: []string{"worker-3", "worker-4"}}))
+
+	require.NoError(t, resetLiveProcesses(processRepo, gatedProcesses(approvals)))
+
+	coordinator, err := processRepo.GetCoordinator()
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusReady, coordinator.Status)
+	require.Equal(t, "coord-session", coordinator.SessionID)
+
+	worker, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusReady, worker.Status)
+	require.Equal(t, &reviewing, worker.Phase)
+	require.Equal(t, "perles-abc1.2", worker.TaskID)
+
+	retired, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusRetired, retired.Status, "terminal processes stay terminal")
+
+	for _, id := range []string{"worker-3", "worker-4"} {
+		gated, err := processRepo.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, repository.StatusPaused, gated.Status, "workers held by a pending approval stay paused")
+	}
+}
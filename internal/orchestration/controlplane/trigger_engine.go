@@ -0,0 +1,388 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// DefaultTriggerLabel is added to issues a rule has started a workflow for.
+const DefaultTriggerLabel = "perles-started"
+
+// TriggerLabel is the workflow label holding the name of the rule that started it.
+const TriggerLabel = "trigger"
+
+// TriggerRule starts a workflow for every issue matching a BQL query.
+type TriggerRule struct {
+	// Name identifies the rule. Defaults to the template ID.
+	Name string
+	// When is the BQL query selecting issues (required).
+	When string
+	// TemplateID is the workflow template to run (required).
+	// The matched issue is passed as the epic_id argument.
+	TemplateID string
+	// Args are additional template argument values.
+	Args map[string]string
+	// Label is added to matched issues so the rule fires once per issue.
+	// Defaults to DefaultTriggerLabel.
+	Label string
+
+	// Workflow settings, as in WorkflowSpec.
+	WorktreeEnabled    bool
+	WorktreeBaseBranch string
+	Priority           int
+	BudgetUSD          float64
+}
+
+// TriggerRulesFromConfig converts the configured automation rules.
+func TriggerRulesFromConfig(triggers config.TriggersConfig) []TriggerRule {
+	rules := make([]TriggerRule, 0, len(triggers.Rules))
+	for _, rule := range triggers.Rules {
+		rules = append(rules, TriggerRule{
+			Name:               rule.Name,
+			When:               rule.When,
+			TemplateID:         rule.Template,
+			Args:               rule.Args,
+			Label:              rule.Label,
+			WorktreeEnabled:    rule.Worktree,
+			WorktreeBaseBranch: rule.BaseBranch,
+			Priority:           rule.Priority,
+			BudgetUSD:          rule.BudgetUSD,
+		})
+	}
+	return rules
+}
+
+// name returns the rule's display name.
+func (r TriggerRule) name() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.TemplateID
+}
+
+// label returns the label marking issues the rule has fired for.
+func (r TriggerRule) label() string {
+	if r.Label != "" {
+		return r.Label
+	}
+	return DefaultTriggerLabel
+}
+
+// TriggerMatch is an issue a rule starts, or would start, a workflow for.
+type TriggerMatch struct {
+	Rule       string
+	TemplateID string
+	IssueID    string
+	Title      string
+}
+
+// IssueLabeler sets the labels of a beads issue.
+type IssueLabeler interface {
+	SetLabels(issueID string, labels []string) error
+}
+
+// MatchTriggers returns the issues each rule would start a workflow for:
+// those matching the rule's query that don't carry its label yet.
+// Rules whose query fails are reported in the returned error.
+func MatchTriggers(rules []TriggerRule, executor bql.BQLExecutor) ([]TriggerMatch, error) {
+	var matches []TriggerMatch
+	var errs []error
+	for _, rule := range rules {
+		issues, err := executor.Execute(rule.When)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", rule.name(), err))
+			continue
+		}
+		for _, issue := range issues {
+			if slices.Contains(issue.Labels, rule.label()) {
+				continue
+			}
+			matches = append(matches, TriggerMatch{
+				Rule:       rule.name(),
+				TemplateID: rule.TemplateID,
+				IssueID:    issue.ID,
+				Title:      issue.TitleText,
+			})
+		}
+	}
+	return matches, errors.Join(errs...)
+}
+
+// TriggerEngine starts workflows for issues matching automation rules.
+//
+// Rules are evaluated when the engine starts and after every beads database
+// change reported through HandleDBChanged. Each matching issue is labeled
+// before its workflow is created so the rule doesn't fire for it again.
+// In dry-run mode matches are only logged.
+type TriggerEngine interface {
+	// Start evaluates the rules and begins handling database changes.
+	Start(ctx context.Context) error
+
+	// Stop stops the engine. It is safe to call Stop multiple times or before Start.
+	Stop()
+
+	// HandleDBChanged requests a re-evaluation of the rules. It does not block;
+	// changes arriving during an evaluation are coalesced into one more run.
+	HandleDBChanged()
+
+	// Rules returns the configured rules.
+	Rules() []TriggerRule
+
+	// DryRun returns what the rules would start now without starting anything.
+	DryRun(ctx context.Context) ([]TriggerMatch, error)
+}
+
+// TriggerEngineConfig holds configuration for creating a TriggerEngine.
+type TriggerEngineConfig struct {
+	// Rules are the automation rules to evaluate.
+	Rules []TriggerRule
+	// DryRun logs matches instead of starting workflows.
+	DryRun bool
+	// Executor runs the rule queries (required).
+	Executor bql.BQLExecutor
+	// Labeler labels matched issues (required).
+	Labeler IssueLabeler
+	// ControlPlane creates and starts workflows (required).
+	ControlPlane ControlPlane
+	// SpecBuilder builds workflow specs from templates (required).
+	SpecBuilder SpecBuilder
+}
+
+// Validate checks that all required fields are provided.
+func (c *TriggerEngineConfig) Validate() error {
+	if c.Executor == nil {
+		return fmt.Errorf("Executor is required")
+	}
+	if c.Labeler == nil {
+		return fmt.Errorf("Labeler is required")
+	}
+	if c.ControlPlane == nil {
+		return fmt.Errorf("ControlPlane is required")
+	}
+	if c.SpecBuilder == nil {
+		return fmt.Errorf("SpecBuilder is required")
+	}
+	for i, rule := range c.Rules {
+		if rule.When == "" || rule.TemplateID == "" {
+			return fmt.Errorf("rule %d: When and TemplateID are required", i)
+		}
+	}
+	return nil
+}
+
+// defaultTriggerEngine is the default implementation of TriggerEngine.
+type defaultTriggerEngine struct {
+	rules    []TriggerRule
+	dryRun   bool
+	executor bql.BQLExecutor
+	labeler  IssueLabeler
+	cp       ControlPlane
+	builder  SpecBuilder
+
+	// changed holds at most one pending re-evaluation request.
+	changed chan struct{}
+
+	// evalMu serializes evaluations; fired guards against firing twice for an
+	// issue while a stale query result still lacks the label, and reported
+	// keeps dry-run matches from being logged on every change.
+	evalMu   sync.Mutex
+	fired    map[string]bool
+	reported map[string]bool
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTriggerEngine creates a new TriggerEngine.
+func NewTriggerEngine(cfg TriggerEngineConfig) (TriggerEngine, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return &defaultTriggerEngine{
+		rules:    slices.Clone(cfg.Rules),
+		dryRun:   cfg.DryRun,
+		executor: cfg.Executor,
+		labeler:  cfg.Labeler,
+		cp:       cfg.ControlPlane,
+		builder:  cfg.SpecBuilder,
+		changed:  make(chan struct{}, 1),
+		fired:    make(map[string]bool),
+		reported: make(map[string]bool),
+	}, nil
+}
+
+// Start evaluates the rules and begins handling database changes.
+func (e *defaultTriggerEngine) Start(ctx context.Context) error {
+	e.mu.Lock()
+	if e.done != nil {
+		e.mu.Unlock()
+		return nil // Already started
+	}
+
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+	done := e.done
+	e.mu.Unlock()
+
+	log.SafeGo("triggerengine.loop", func() {
+		defer close(done)
+
+		e.evaluate(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.changed:
+				e.evaluate(ctx)
+			}
+		}
+	})
+
+	return nil
+}
+
+// Stop stops the engine.
+func (e *defaultTriggerEngine) Stop() {
+	e.mu.Lock()
+	cancel := e.cancel
+	done := e.done
+	e.cancel = nil
+	e.mu.Unlock()
+
+	if cancel == nil {
+		return // Not started
+	}
+	cancel()
+	<-done
+}
+
+// HandleDBChanged requests a re-evaluation of the rules.
+func (e *defaultTriggerEngine) HandleDBChanged() {
+	select {
+	case e.changed <- struct{}{}:
+	default:
+		// An evaluation is already pending
+	}
+}
+
+// Rules returns the configured rules.
+func (e *defaultTriggerEngine) Rules() []TriggerRule {
+	return slices.Clone(e.rules)
+}
+
+// DryRun returns what the rules would start now.
+func (e *defaultTriggerEngine) DryRun(_ context.Context) ([]TriggerMatch, error) {
+	e.evalMu.Lock()
+	defer e.evalMu.Unlock()
+
+	matches, err := MatchTriggers(e.rules, e.executor)
+	return slices.DeleteFunc(matches, func(m TriggerMatch) bool {
+		return e.fired[firedKey(m)]
+	}), err
+}
+
+// evaluate runs every rule and fires it for new matches.
+func (e *defaultTriggerEngine) evaluate(ctx context.Context) {
+	if len(e.rules) == 0 {
+		return
+	}
+
+	e.evalMu.Lock()
+	defer e.evalMu.Unlock()
+
+	for _, rule := range e.rules {
+		if ctx.Err() != nil {
+			return
+		}
+		issues, err := e.executor.Execute(rule.When)
+		if err != nil {
+			log.ErrorErr(log.CatOrch, "Trigger query failed", err, "rule", rule.name(), "query", rule.When)
+			continue
+		}
+
+		for _, issue := range issues {
+			match := TriggerMatch{Rule: rule.name(), TemplateID: rule.TemplateID, IssueID: issue.ID, Title: issue.TitleText}
+			if slices.Contains(issue.Labels, rule.label()) || e.fired[firedKey(match)] {
+				continue
+			}
+
+			if e.dryRun {
+				if e.reported[firedKey(match)] {
+					continue
+				}
+				e.reported[firedKey(match)] = true
+				log.Info(log.CatOrch, "Trigger would start workflow (dry run)",
+					"rule", match.Rule, "template", match.TemplateID, "issue", match.IssueID)
+				continue
+			}
+
+			id, err := e.fire(ctx, rule, issue)
+			if err != nil {
+				log.ErrorErr(log.CatOrch, "Trigger failed to start workflow", err,
+					"rule", match.Rule, "issue", match.IssueID)
+				continue
+			}
+			log.Info(log.CatOrch, "Trigger started workflow",
+				"rule", match.Rule, "issue", match.IssueID, "workflowID", id)
+		}
+	}
+}
+
+// fire labels the issue, then creates and starts its workflow. The label is
+// added first so a failure later on cannot make the rule fire repeatedly.
+// Must be called with evalMu held.
+func (e *defaultTriggerEngine) fire(ctx context.Context, rule TriggerRule, issue beads.Issue) (WorkflowID, error) {
+	labels := append(slices.Clone(issue.Labels), rule.label())
+	if err := e.labeler.SetLabels(issue.ID, labels); err != nil {
+		return "", fmt.Errorf("labeling issue: %w", err)
+	}
+	e.fired[firedKey(TriggerMatch{Rule: rule.name(), IssueID: issue.ID})] = true
+
+	args := maps.Clone(rule.Args)
+	if args == nil {
+		args = make(map[string]string, 1)
+	}
+	args["epic_id"] = issue.ID
+
+	spec, err := e.builder.BuildSpec(rule.TemplateID, issue.TitleText, args)
+	if err != nil {
+		return "", fmt.Errorf("building workflow spec: %w", err)
+	}
+	if spec.Name == "" {
+		spec.Name = rule.name()
+	}
+	if spec.EpicID == "" {
+		// Epic-driven templates already use the issue; others may create their own epic
+		spec.EpicID = issue.ID
+	}
+	spec.Labels = map[string]string{TriggerLabel: rule.name()}
+	spec.WorktreeEnabled = rule.WorktreeEnabled
+	spec.WorktreeBaseBranch = rule.WorktreeBaseBranch
+	spec.Priority = rule.Priority
+	spec.BudgetUSD = rule.BudgetUSD
+
+	id, err := e.cp.Create(ctx, spec)
+	if err != nil {
+		return "", fmt.Errorf("creating workflow: %w", err)
+	}
+	if err := e.cp.Start(ctx, id); err != nil {
+		return id, fmt.Errorf("starting workflow %s: %w", id, err)
+	}
+	return id, nil
+}
+
+// firedKey identifies a rule firing for an issue.
+func firedKey(m TriggerMatch) string {
+	return m.Rule + "\x00" + m.IssueID
+}
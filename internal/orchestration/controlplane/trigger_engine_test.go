@@ -0,0 +1,217 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+const testTriggerQuery = "type = epic and label = auto-cook and status = open"
+
+type triggerEngineTest struct {
+	engine     *defaultTriggerEngine
+	cp         ControlPlane
+	supervisor *stubSupervisor
+	builder    *stubSpecBuilder
+	executor   *mocks.MockBQLExecutor
+	labeler    *mocks.MockIssueExecutor
+}
+
+func newTriggerEngineTest(t *testing.T, dryRun bool, rules ...TriggerRule) *triggerEngineTest {
+	t.Helper()
+	cp, supervisor := newSchedulerTestControlPlane(t, ResourceLimits{})
+	builder := &stubSpecBuilder{}
+	executor := mocks.NewMockBQLExecutor(t)
+	labeler := mocks.NewMockIssueExecutor(t)
+
+	e, err := NewTriggerEngine(TriggerEngineConfig{
+		Rules:        rules,
+		DryRun:       dryRun,
+		Executor:     executor,
+		Labeler:      labeler,
+		ControlPlane: cp,
+		SpecBuilder:  builder,
+	})
+	require.NoError(t, err)
+
+	return &triggerEngineTest{
+		engine:     e.(*defaultTriggerEngine),
+		cp:         cp,
+		supervisor: supervisor,
+		builder:    builder,
+		executor:   executor,
+		labeler:    labeler,
+	}
+}
+
+func cookRule() TriggerRule {
+	return TriggerRule{
+		Name:               "auto-cook",
+		When:               testTriggerQuery,
+		TemplateID:         "cook",
+		WorktreeEnabled:    true,
+		WorktreeBaseBranch: "main",
+	}
+}
+
+func TestNewTriggerEngine_RequiresConfig(t *testing.T) {
+	_, err := NewTriggerEngine(TriggerEngineConfig{})
+	require.ErrorContains(t, err, "Executor is required")
+
+	tt := newTriggerEngineTest(t, false)
+	_, err = NewTriggerEngine(TriggerEngineConfig{
+		Rules:        []TriggerRule{{TemplateID: "cook"}},
+		Executor:     tt.executor,
+		Labeler:      tt.labeler,
+		ControlPlane: tt.cp,
+		SpecBuilder:  tt.builder,
+	})
+	require.ErrorContains(t, err, "When and TemplateID are required")
+}
+
+func TestTriggerEngine_Evaluate_StartsWorkflowAndLabelsIssue(t *testing.T) {
+	tt := newTriggerEngineTest(t, false, cookRule())
+	ctx := context.Background()
+
+	tt.executor.EXPECT().Execute(testTriggerQuery).Return([]beads.Issue{
+		{ID: "perles-abc1", TitleText: "Add OAuth", Labels: []string{"auto-cook"}},
+		{ID: "perles-def2", TitleText: "Already done", Labels: []string{"auto-cook", DefaultTriggerLabel}},
+	}, nil).Once()
+	tt.labeler.EXPECT().SetLabels("perles-abc1", []string{"auto-cook", DefaultTriggerLabel}).Return(nil).Once()
+
+	tt.engine.evaluate(ctx)
+
+	workflows, err := tt.cp.List(ctx, ListQuery{})
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+	inst := workflows[0]
+	tt.supervisor.requireRunning(t, inst.ID)
+	require.Equal(t, "perles-abc1", inst.EpicID)
+	require.Equal(t, "Add OAuth", inst.Name)
+	require.Equal(t, "auto-cook", inst.Labels[TriggerLabel])
+	require.True(t, inst.WorktreeEnabled)
+	require.Equal(t, "main", inst.WorktreeBaseBranch)
+	require.Equal(t, "perles-abc1", tt.builder.built[0]["epic_id"])
+
+	// A stale query result without the label does not fire the rule again
+	tt.executor.EXPECT().Execute(testTriggerQuery).Return([]beads.Issue{
+		{ID: "perles-abc1", TitleText: "Add OAuth", Labels: []string{"auto-cook"}},
+	}, nil).Once()
+	tt.engine.evaluate(ctx)
+
+	workflows, err = tt.cp.List(ctx, ListQuery{})
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+}
+
+func TestTriggerEngine_Evaluate_LabelFailureSkipsIssue(t *testing.T) {
+	tt := newTriggerEngineTest(t, false, cookRule())
+	ctx := context.Background()
+
+	tt.executor.EXPECT().Execute(testTriggerQuery).Return([]beads.Issue{{ID: "perles-abc1"}}, nil).Once()
+	tt.labeler.EXPECT().SetLabels("perles-abc1", mock.Anything).Return(errors.New("bd failed")).Once()
+
+	tt.engine.evaluate(ctx)
+
+	workflows, err := tt.cp.List(ctx, ListQuery{})
+	require.NoError(t, err)
+	require.Empty(t, workflows, "no workflow starts for an issue that could not be labeled")
+}
+
+func TestTriggerEngine_Evaluate_DryRunOnlyLogs(t *testing.T) {
+	tt := newTriggerEngineTest(t, true, cookRule())
+	ctx := context.Background()
+
+	tt.executor.EXPECT().Execute(testTriggerQuery).Return([]beads.Issue{{ID: "perles-abc1"}}, nil).Times(2)
+
+	tt.engine.evaluate(ctx)
+
+	workflows, err := tt.cp.List(ctx, ListQuery{})
+	require.NoError(t, err)
+	require.Empty(t, workflows)
+
+	matches, err := tt.engine.DryRun(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []TriggerMatch{{Rule: "auto-cook", TemplateID: "cook", IssueID: "perles-abc1"}}, matches)
+}
+
+func TestMatchTriggers(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	rules := []TriggerRule{
+		{When: "label = needs-plan", TemplateID: "research_to_tasks", Label: "planned"},
+		{Name: "broken", When: "status = ???", TemplateID: "cook"},
+	}
+	executor.EXPECT().Execute("label = needs-plan").Return([]beads.Issue{
+		{ID: "perles-abc1", TitleText: "Plan me"},
+		{ID: "perles-def2", Labels: []string{"planned"}},
+	}, nil)
+	executor.EXPECT().Execute("status = ???").Return(nil, errors.New("parse error"))
+
+	matches, err := MatchTriggers(rules, executor)
+	require.ErrorContains(t, err, "rule broken: parse error")
+	require.Equal(t, []TriggerMatch{
+		{Rule: "research_to_tasks", TemplateID: "research_to_tasks", IssueID: "perles-abc1", Title: "Plan me"},
+	}, matches)
+}
+
+func TestTriggerEngine_HandleDBChanged_Reevaluates(t *testing.T) {
+	tt := newTriggerEngineTest(t, false, cookRule())
+
+	evaluated := make(chan struct{}, 4)
+	tt.executor.EXPECT().Execute(testTriggerQuery).RunAndReturn(func(string) ([]beads.Issue, error) {
+		evaluated <- struct{}{}
+		return nil, nil
+	})
+
+	require.NoError(t, tt.engine.Start(context.Background()))
+	defer tt.engine.Stop()
+
+	waitEvaluated := func() {
+		t.Helper()
+		select {
+		case <-evaluated:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for rule evaluation")
+		}
+	}
+	waitEvaluated() // On start
+
+	tt.engine.HandleDBChanged()
+	waitEvaluated()
+}
+
+func TestTriggerRulesFromConfig(t *testing.T) {
+	rules := TriggerRulesFromConfig(config.TriggersConfig{
+		Rules: []config.TriggerRuleConfig{{
+			Name:       "auto-cook",
+			When:       testTriggerQuery,
+			Template:   "cook",
+			Args:       map[string]string{"goal": "ship"},
+			Label:      "cooking",
+			Worktree:   true,
+			BaseBranch: "main",
+			Priority:   2,
+			BudgetUSD:  5,
+		}},
+	})
+
+	require.Equal(t, []TriggerRule{{
+		Name:               "auto-cook",
+		When:               testTriggerQuery,
+		TemplateID:         "cook",
+		Args:               map[string]string{"goal": "ship"},
+		Label:              "cooking",
+		WorktreeEnabled:    true,
+		WorktreeBaseBranch: "main",
+		Priority:           2,
+		BudgetUSD:          5,
+	}}, rules)
+}
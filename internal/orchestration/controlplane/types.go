@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -137,7 +138,8 @@ type WorkflowSpec struct {
 	TemplateID string
 
 	// InitialPrompt is the initial prompt for the coordinator.
-	// This is required and provides the starting context for the workflow.
+	// This is required and provides the starting context for the workflow,
+	// unless the workflow depends on others (see After).
 	InitialPrompt string
 
 	// Name is the display name for the workflow.
@@ -167,6 +169,38 @@ type WorkflowSpec struct {
 	// WorktreeBranchName is an optional custom branch name for the worktree.
 	// If empty, a branch name will be auto-generated based on workflow ID.
 	WorktreeBranchName string
+
+	// WorkerWorktrees gives each worker its own worktree branched from the
+	// workflow branch. Approved work is merged back into the workflow branch.
+	// Requires WorktreeEnabled.
+	WorkerWorktrees bool
+
+	// Priority orders the workflow among queued workflows when the scheduler
+	// uses the priority queue policy. Higher values start first; defaults to 0.
+	Priority int
+
+	// BudgetTokens caps the output tokens the workflow may spend (0 = no budget).
+	// The user is notified at soft thresholds and the workflow is paused when reached.
+	BudgetTokens int64
+
+	// BudgetUSD caps the USD the workflow may spend (0 = no budget).
+	// The user is notified at soft thresholds and the workflow is paused when reached.
+	BudgetUSD float64
+
+	// StartAt delays the start of the workflow until the given time (zero = start on request).
+	// The workflow stays pending until the CronScheduler starts it.
+	StartAt time.Time
+
+	// After lists workflows that must complete before this one starts.
+	// The workflow stays pending until they have all completed and is then
+	// started by the control plane; it fails when any of them fails.
+	After []WorkflowID
+
+	// Args are the template argument values the workflow is created with.
+	// When a workflow with After has no InitialPrompt, its spec is built from
+	// the template once the upstream workflows complete, with their outputs
+	// (see WorkflowInstance.Outputs) added to Args.
+	Args map[string]string
 }
 
 // Validate checks that the WorkflowSpec has all required fields
@@ -175,9 +209,21 @@ func (s *WorkflowSpec) Validate() error {
 	if s.TemplateID == "" {
 		return fmt.Errorf("template_id is required")
 	}
-	if s.InitialPrompt == "" {
+	if s.InitialPrompt == "" && len(s.After) == 0 {
 		return fmt.Errorf("initial_prompt is required")
 	}
+	if len(s.After) > 0 && !s.StartAt.IsZero() {
+		return fmt.Errorf("start_at cannot be combined with after")
+	}
+	if s.WorkerWorktrees && !s.WorktreeEnabled {
+		return fmt.Errorf("worker_worktrees requires worktree_enabled")
+	}
+	if s.BudgetTokens < 0 {
+		return fmt.Errorf("budget_tokens must not be negative")
+	}
+	if s.BudgetUSD < 0 {
+		return fmt.Errorf("budget_usd must not be negative")
+	}
 	return nil
 }
 
@@ -199,6 +245,7 @@ type WorkflowInstance struct {
 	WorktreeEnabled    bool   // Whether worktree was requested
 	WorktreeBaseBranch string // Branch to base worktree on
 	WorktreeBranchName string // Custom branch name (may be empty)
+	WorkerWorktrees    bool   // Whether each worker gets its own worktree
 
 	// Worktree state (set by Supervisor.AllocateResources() when worktree is created)
 	WorktreePath   string // Path to created worktree (empty if not using worktree)
@@ -210,8 +257,9 @@ type WorkflowInstance struct {
 	SessionDir string
 
 	// State
-	State  WorkflowState
-	Labels map[string]string
+	State    WorkflowState
+	Labels   map[string]string
+	Priority int // Queue priority (higher starts first)
 
 	// Timestamps
 	CreatedAt   time.Time
@@ -219,6 +267,13 @@ type WorkflowInstance struct {
 	PausedAt    time.Time  // When workflow was paused (zero if never paused)
 	CompletedAt *time.Time // When workflow was completed (nil if not completed)
 	UpdatedAt   time.Time
+	QueuedAt    time.Time  // When the workflow was queued waiting for a slot (zero if not queued)
+	ScheduledAt *time.Time // Requested start time for a pending workflow (nil if not scheduled)
+
+	// Dependencies (from WorkflowSpec)
+	After   []WorkflowID      // Workflows that must complete before this one starts
+	Args    map[string]string // Template arguments, extended with upstream outputs when started
+	Outputs map[string]string // Results handed to dependent workflows (set on completion)
 
 	// Runtime (owned by this instance, set when workflow is started)
 	Infrastructure *v2.Infrastructure
@@ -232,9 +287,14 @@ type WorkflowInstance struct {
 
 	// Resource tracking
 	MCPPort       int
-	TokensUsed    int64
+	TokensUsed    int64   // Output tokens spent by all processes
+	CostUSD       float64 // USD spent by all processes
 	ActiveWorkers int
 
+	// Budget (from WorkflowSpec, 0 = no budget)
+	BudgetTokens int64
+	BudgetUSD    float64
+
 	// Health tracking
 	LastHeartbeatAt time.Time
 	LastProgressAt  time.Time
@@ -277,11 +337,25 @@ func NewWorkflowInstance(spec *WorkflowSpec) (*WorkflowInstance, error) {
 		WorktreeEnabled:    spec.WorktreeEnabled,
 		WorktreeBaseBranch: spec.WorktreeBaseBranch,
 		WorktreeBranchName: spec.WorktreeBranchName,
+		WorkerWorktrees:    spec.WorkerWorktrees,
 		State:              WorkflowPending,
 		Labels:             labels,
+		Priority:           spec.Priority,
+		BudgetTokens:       spec.BudgetTokens,
+		BudgetUSD:          spec.BudgetUSD,
 		CreatedAt:          now,
 		UpdatedAt:          now,
 	}
+	if !spec.StartAt.IsZero() {
+		startAt := spec.StartAt
+		inst.ScheduledAt = &startAt
+	}
+	if len(spec.After) > 0 {
+		inst.After = slices.Clone(spec.After)
+	}
+	if len(spec.Args) > 0 {
+		inst.Args = maps.Clone(spec.Args)
+	}
 
 	return inst, nil
 }
@@ -324,6 +398,23 @@ func (w *WorkflowInstance) IsPaused() bool {
 	return w.State == WorkflowPaused
 }
 
+// IsQueued returns true if the workflow is waiting for the ResourceScheduler
+// to free a workflow slot.
+func (w *WorkflowInstance) IsQueued() bool {
+	return !w.QueuedAt.IsZero()
+}
+
+// IsScheduled returns true if the workflow is pending with a requested start time.
+func (w *WorkflowInstance) IsScheduled() bool {
+	return w.State == WorkflowPending && w.ScheduledAt != nil && !w.IsQueued()
+}
+
+// IsWaiting returns true if the workflow is pending until the workflows it
+// depends on complete.
+func (w *WorkflowInstance) IsWaiting() bool {
+	return w.State == WorkflowPending && len(w.After) > 0 && !w.IsQueued()
+}
+
 // RecordHeartbeat updates the last heartbeat timestamp.
 // This should be called when any activity is detected from the workflow.
 func (w *WorkflowInstance) RecordHeartbeat() {
@@ -347,9 +438,35 @@ func (w *WorkflowInstance) AddTokens(tokens int64) {
 	w.UpdatedAt = time.Now()
 }
 
+// AddCost adds USD to the spend counter.
+func (w *WorkflowInstance) AddCost(usd float64) {
+	w.CostUSD += usd
+	w.UpdatedAt = time.Now()
+}
+
+// HasBudget returns true if a token or USD budget is set.
+func (w *WorkflowInstance) HasBudget() bool {
+	return w.BudgetTokens > 0 || w.BudgetUSD > 0
+}
+
+// BudgetBurn returns the fraction of the budget spent, taking whichever of the
+// token and USD budgets is closer to its limit. Returns 0 when no budget is set;
+// values of 1 or more mean the budget is exhausted.
+func (w *WorkflowInstance) BudgetBurn() float64 {
+	var burn float64
+	if w.BudgetTokens > 0 {
+		burn = float64(w.TokensUsed) / float64(w.BudgetTokens)
+	}
+	if w.BudgetUSD > 0 {
+		burn = max(burn, w.CostUSD/w.BudgetUSD)
+	}
+	return burn
+}
+
 // TokenMetrics returns token usage metrics for this workflow.
 func (w *WorkflowInstance) TokenMetrics() *metrics.TokenMetrics {
 	return &metrics.TokenMetrics{
-		TotalTokens: int(w.TokensUsed),
+		TotalTokens:  int(w.TokensUsed),
+		TotalCostUSD: w.CostUSD,
 	}
 }
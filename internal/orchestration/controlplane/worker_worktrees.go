@@ -0,0 +1,224 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+)
+
+// workerWorktreesFile is the session file recording which worktree each
+// worker runs in, so a resumed workflow finds them again.
+const workerWorktreesFile = "worker_worktrees.json"
+
+// gitWorkerWorktrees implements handler.WorkerWorktrees for one workflow.
+// Each worker gets a sibling worktree of the workflow worktree, on a branch
+// named after the workflow branch and the worker (e.g. perles-auth-worker-1).
+type gitWorkerWorktrees struct {
+	workDir            string // Path of the workflow worktree
+	branch             string // Workflow branch, checked out in workDir
+	gitExecutorFactory func(workDir string) appgit.GitExecutor
+	timeout            time.Duration
+	statePath          string // File the worktrees are persisted to (empty = not persisted)
+
+	mu        sync.Mutex
+	worktrees map[string]handler.WorkerWorktree
+
+	// integrateMu serializes integrations, so branches land in approval order.
+	integrateMu sync.Mutex
+}
+
+// newGitWorkerWorktrees creates the worker worktrees of a workflow whose
+// branch is checked out in workDir. With a sessionDir, the worktrees are
+// persisted there and the ones recorded by a previous run are loaded.
+func newGitWorkerWorktrees(workDir, branch, sessionDir string, factory func(workDir string) appgit.GitExecutor, timeout time.Duration) *gitWorkerWorktrees {
+	w := &gitWorkerWorktrees{
+		workDir:            workDir,
+		branch:             branch,
+		gitExecutorFactory: factory,
+		timeout:            timeout,
+		worktrees:          make(map[string]handler.WorkerWorktree),
+	}
+	if sessionDir != "" {
+		w.statePath = filepath.Join(sessionDir, workerWorktreesFile)
+		if err := w.load(); err != nil {
+			log.Warn(log.CatOrch, "Failed to load worker worktrees", "subsystem", "worktrees",
+				"path", w.statePath, "error", err)
+		}
+	}
+	return w
+}
+
+// load reads the persisted worktrees. A missing file means none were created.
+func (w *gitWorkerWorktrees) load() error {
+	data, err := os.ReadFile(w.statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &w.worktrees)
+}
+
+// saveLocked persists the worktrees. Callers must hold mu. Failures are
+// logged: the worktrees keep working, they are only lost on restart.
+func (w *gitWorkerWorktrees) saveLocked() {
+	if w.statePath == "" {
+		return
+	}
+	data, err := json.MarshalIndent(w.worktrees, "", "  ")
+	if err == nil {
+		tmpPath := w.statePath + ".tmp"
+		if err = os.WriteFile(tmpPath, data, 0600); err == nil {
+			err = os.Rename(tmpPath, w.statePath)
+		}
+	}
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to persist worker worktrees", "subsystem", "worktrees",
+			"path", w.statePath, "error", err)
+	}
+}
+
+// Create creates the worktree of a worker, branched from the tip of the
+// workflow branch. A worktree left behind for the worker is reused.
+func (w *gitWorkerWorktrees) Create(ctx context.Context, workerID string) (string, error) {
+	wt := handler.WorkerWorktree{
+		Path:       w.workDir + "-" + workerID,
+		Branch:     w.branch + "-" + workerID,
+		BaseBranch: w.branch,
+	}
+
+	gitExec := w.gitExecutorFactory(w.workDir)
+	existing, err := gitExec.ListWorktrees()
+	if err != nil {
+		return "", fmt.Errorf("listing worktrees: %w", err)
+	}
+	reused := false
+	for _, info := range existing {
+		if info.Path == wt.Path && info.Branch == wt.Branch {
+			reused = true
+			break
+		}
+	}
+
+	if !reused {
+		createCtx, cancel := context.WithTimeout(ctx, w.timeout)
+		err := gitExec.CreateWorktreeWithContext(createCtx, wt.Path, wt.Branch, w.branch)
+		cancel()
+		if err != nil {
+			return "", fmt.Errorf("creating worktree for %s: %w", workerID, err)
+		}
+	}
+
+	w.mu.Lock()
+	w.worktrees[workerID] = wt
+	w.saveLocked()
+	w.mu.Unlock()
+
+	log.Debug(log.CatOrch, "Worker worktree ready", "subsystem", "worktrees",
+		"workerID", workerID, "path", wt.Path, "branch", wt.Branch, "reused", reused)
+	return wt.Path, nil
+}
+
+// Get returns the worktree of a worker, or false if it has none.
+func (w *gitWorkerWorktrees) Get(workerID string) (handler.WorkerWorktree, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	wt, ok := w.worktrees[workerID]
+	return wt, ok
+}
+
+// Transfer hands the worktree of a worker over to its replacement, with the
+// changes it holds. Returns the worktree's path, or false if the worker has
+// none.
+func (w *gitWorkerWorktrees) Transfer(fromWorkerID, toWorkerID string) (string, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	wt, ok := w.worktrees[fromWorkerID]
+	if !ok {
+		return "", false
+	}
+	delete(w.worktrees, fromWorkerID)
+	w.worktrees[toWorkerID] = wt
+	w.saveLocked()
+	return wt.Path, true
+}
+
+// Sync points the worker's branch at the tip of the workflow branch.
+// Uncommitted changes in the worker's worktree are never discarded.
+func (w *gitWorkerWorktrees) Sync(workerID string) error {
+	wt, ok := w.Get(workerID)
+	if !ok {
+		return fmt.Errorf("worker %s has no worktree", workerID)
+	}
+	if err := w.gitExecutorFactory(wt.Path).ResetBranch(wt.BaseBranch); err != nil {
+		return fmt.Errorf("resetting %s to %s: %w", wt.Branch, wt.BaseBranch, err)
+	}
+	return nil
+}
+
+// Integrate commits the worker's outstanding changes and merges its branch
+// into the workflow branch.
+func (w *gitWorkerWorktrees) Integrate(workerID, message string) error {
+	wt, ok := w.Get(workerID)
+	if !ok {
+		return fmt.Errorf("worker %s has no worktree", workerID)
+	}
+
+	w.integrateMu.Lock()
+	defer w.integrateMu.Unlock()
+
+	workerExec := w.gitExecutorFactory(wt.Path)
+	dirty, err := workerExec.HasUncommittedChanges()
+	if err != nil {
+		return fmt.Errorf("checking %s for changes: %w", wt.Path, err)
+	}
+	if dirty {
+		if err := workerExec.CommitAll(message); err != nil {
+			return fmt.Errorf("committing changes of %s: %w", workerID, err)
+		}
+	}
+
+	mergeMessage := fmt.Sprintf("Merge %s into %s", wt.Branch, wt.BaseBranch)
+	if err := w.gitExecutorFactory(w.workDir).Merge(wt.Branch, mergeMessage, false); err != nil {
+		return err
+	}
+
+	log.Info(log.CatOrch, "Integrated worker branch", "subsystem", "worktrees",
+		"workerID", workerID, "branch", wt.Branch, "base", wt.BaseBranch)
+	return nil
+}
+
+// Remove removes the worker's worktree. Its branch is deleted only when it
+// has been integrated, so unapproved work stays reachable.
+func (w *gitWorkerWorktrees) Remove(workerID string) error {
+	wt, ok := w.Get(workerID)
+	if !ok {
+		return nil
+	}
+
+	gitExec := w.gitExecutorFactory(w.workDir)
+	if err := gitExec.RemoveWorktree(wt.Path); err != nil {
+		return fmt.Errorf("removing worktree %s: %w", wt.Path, err)
+	}
+
+	w.mu.Lock()
+	delete(w.worktrees, workerID)
+	w.saveLocked()
+	w.mu.Unlock()
+
+	if err := gitExec.DeleteBranch(wt.Branch, false); err != nil {
+		log.Debug(log.CatOrch, "Keeping unintegrated worker branch", "subsystem", "worktrees",
+			"workerID", workerID, "branch", wt.Branch, "error", err)
+	}
+	return nil
+}
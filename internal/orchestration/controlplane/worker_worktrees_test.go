@@ -0,0 +1,128 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+)
+
+// newTestWorkerWorktrees creates the worker worktrees of a workflow on branch
+// perles-auth in /wt/auth, served by workflowExec, with every worker
+// worktree served by workerExec.
+func newTestWorkerWorktrees(t *testing.T) (*gitWorkerWorktrees, *mocks.MockGitExecutor, *mocks.MockGitExecutor) {
+	t.Helper()
+	workflowExec := mocks.NewMockGitExecutor(t)
+	workerExec := mocks.NewMockGitExecutor(t)
+	worktrees := newGitWorkerWorktrees("/wt/auth", "perles-auth", "", func(workDir string) appgit.GitExecutor {
+		if workDir == "/wt/auth" {
+			return workflowExec
+		}
+		return workerExec
+	}, time.Second)
+	return worktrees, workflowExec, workerExec
+}
+
+func TestGitWorkerWorktrees_CreateAndReuse(t *testing.T) {
+	worktrees, workflowExec, _ := newTestWorkerWorktrees(t)
+	workflowExec.EXPECT().ListWorktrees().Return([]domaingit.WorktreeInfo{
+		{Path: "/wt/auth", Branch: "perles-auth"},
+		{Path: "/wt/auth-worker-2", Branch: "perles-auth-worker-2"},
+	}, nil)
+	workflowExec.EXPECT().CreateWorktreeWithContext(mock.Anything, "/wt/auth-worker-1", "perles-auth-worker-1", "perles-auth").Return(nil).Once()
+
+	path, err := worktrees.Create(context.Background(), "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, "/wt/auth-worker-1", path)
+
+	path, err = worktrees.Create(context.Background(), "worker-2")
+	require.NoError(t, err)
+	require.Equal(t, "/wt/auth-worker-2", path, "a worktree left behind is reused")
+
+	wt, ok := worktrees.Get("worker-1")
+	require.True(t, ok)
+	require.Equal(t, handler.WorkerWorktree{Path: "/wt/auth-worker-1", Branch: "perles-auth-worker-1", BaseBranch: "perles-auth"}, wt)
+	_, ok = worktrees.Get("worker-3")
+	require.False(t, ok)
+}
+
+func TestGitWorkerWorktrees_SyncAndIntegrate(t *testing.T) {
+	worktrees, workflowExec, workerExec := newTestWorkerWorktrees(t)
+	workflowExec.EXPECT().ListWorktrees().Return(nil, nil)
+	workflowExec.EXPECT().CreateWorktreeWithContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	_, err := worktrees.Create(context.Background(), "worker-1")
+	require.NoError(t, err)
+
+	workerExec.EXPECT().ResetBranch("perles-auth").Return(nil).Once()
+	require.NoError(t, worktrees.Sync("worker-1"))
+
+	workerExec.EXPECT().HasUncommittedChanges().Return(true, nil).Once()
+	workerExec.EXPECT().CommitAll("perles-abc.1: approved changes").Return(nil).Once()
+	workflowExec.EXPECT().Merge("perles-auth-worker-1", "Merge perles-auth-worker-1 into perles-auth", false).Return(nil).Once()
+	require.NoError(t, worktrees.Integrate("worker-1", "perles-abc.1: approved changes"))
+
+	workerExec.EXPECT().HasUncommittedChanges().Return(false, nil).Once()
+	workflowExec.EXPECT().Merge("perles-auth-worker-1", mock.Anything, false).Return(domaingit.ErrMergeConflict).Once()
+	err = worktrees.Integrate("worker-1", "perles-abc.2: approved changes")
+	require.ErrorIs(t, err, domaingit.ErrMergeConflict)
+
+	require.ErrorContains(t, worktrees.Sync("worker-9"), "worker worker-9 has no worktree")
+}
+
+func TestGitWorkerWorktrees_RemoveKeepsUnintegratedBranch(t *testing.T) {
+	worktrees, workflowExec, _ := newTestWorkerWorktrees(t)
+	workflowExec.EXPECT().ListWorktrees().Return(nil, nil)
+	workflowExec.EXPECT().CreateWorktreeWithContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	_, err := worktrees.Create(context.Background(), "worker-1")
+	require.NoError(t, err)
+
+	workflowExec.EXPECT().RemoveWorktree("/wt/auth-worker-1").Return(nil).Once()
+	workflowExec.EXPECT().DeleteBranch("perles-auth-worker-1", false).Return(errors.New("not fully merged")).Once()
+
+	require.NoError(t, worktrees.Remove("worker-1"))
+	_, ok := worktrees.Get("worker-1")
+	require.False(t, ok)
+	require.NoError(t, worktrees.Remove("worker-1"), "removing twice is a no-op")
+}
+
+func TestGitWorkerWorktrees_PersistsTransfers(t *testing.T) {
+	sessionDir := t.TempDir()
+	workflowExec := mocks.NewMockGitExecutor(t)
+	factory := func(workDir string) appgit.GitExecutor { return workflowExec }
+	workflowExec.EXPECT().ListWorktrees().Return(nil, nil)
+	workflowExec.EXPECT().CreateWorktreeWithContext(mock.Anything, "/wt/auth-worker-1", "perles-auth-worker-1", "perles-auth").Return(nil).Once()
+
+	worktrees := newGitWorkerWorktrees("/wt/auth", "perles-auth", sessionDir, factory, time.Second)
+	_, err := worktrees.Create(context.Background(), "worker-1")
+	require.NoError(t, err)
+
+	path, ok := worktrees.Transfer("worker-1", "worker-2")
+	require.True(t, ok)
+	require.Equal(t, "/wt/auth-worker-1", path, "the replacement keeps the worktree and its changes")
+	_, ok = worktrees.Transfer("worker-1", "worker-3")
+	require.False(t, ok)
+
+	// A resumed workflow finds the worktrees again
+	restored := newGitWorkerWorktrees("/wt/auth", "perles-auth", sessionDir, factory, time.Second)
+	_, ok = restored.Get("worker-1")
+	require.False(t, ok)
+	wt, ok := restored.Get("worker-2")
+	require.True(t, ok)
+	require.Equal(t, handler.WorkerWorktree{Path: "/wt/auth-worker-1", Branch: "perles-auth-worker-1", BaseBranch: "perles-auth"}, wt)
+}
+
+func TestWorkflowSpec_WorkerWorktreesRequiresWorktree(t *testing.T) {
+	spec := &WorkflowSpec{TemplateID: "cook", InitialPrompt: "Build auth", WorkerWorktrees: true}
+	require.ErrorContains(t, spec.Validate(), "worker_worktrees requires worktree_enabled")
+
+	spec.WorktreeEnabled = true
+	require.NoError(t, spec.Validate())
+}
@@ -0,0 +1,33 @@
+package events
+
+import "time"
+
+// Approval is a request for a human to approve work before the workflow
+// continues, e.g. a change that touches production. The workers of its tasks
+// are paused until the approval is resolved.
+type Approval struct {
+	// ID identifies the approval (e.g., "approval-1a2b3c4d").
+	ID string
+	// Summary describes what needs approval and why.
+	Summary string
+	// Diff is the change under review, usually unified diff output.
+	Diff string
+	// Artifacts are paths or links the reviewer should look at.
+	Artifacts []string
+	// TaskIDs are the tasks held until the approval is resolved.
+	TaskIDs []string
+	// PausedProcessIDs are the workers paused for the approval.
+	PausedProcessIDs []string
+	// InterruptedProcessIDs are the paused workers whose turn was cut short.
+	// They are told to continue their task when the approval is resolved.
+	InterruptedProcessIDs []string
+	// RequestedAt is when the coordinator requested the approval.
+	RequestedAt time.Time
+
+	// Approved reports the decision once the approval is resolved.
+	Approved bool
+	// Feedback is the reviewer's comment, required when rejecting.
+	Feedback string
+	// ResolvedAt is when the approval was resolved, zero while it is pending.
+	ResolvedAt time.Time
+}
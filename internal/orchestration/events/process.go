@@ -35,6 +35,18 @@ const (
 	// ProcessUserNotification is emitted when the coordinator requests user attention.
 	// This is used for human checkpoints in DAG workflows (e.g., clarification review).
 	ProcessUserNotification ProcessEventType = "user_notification"
+	// ProcessApprovalRequested is emitted when the coordinator asks a human to
+	// approve work before the workflow continues. Approval carries the request.
+	ProcessApprovalRequested ProcessEventType = "approval_requested"
+	// ProcessApprovalResolved is emitted when a human approves or rejects a
+	// pending approval. Approval carries the request and the decision.
+	ProcessApprovalResolved ProcessEventType = "approval_resolved"
+	// ProcessPolicyViolation is emitted when a worker reports an action its tool
+	// policy forbids. Output describes the action and the rule it breaks.
+	ProcessPolicyViolation ProcessEventType = "policy_violation"
+	// ProcessContextHandoff is emitted when a process crosses the context handoff
+	// threshold and when it is replaced. Output describes the transition.
+	ProcessContextHandoff ProcessEventType = "context_handoff"
 )
 
 // ProcessRole identifies what kind of process this is.
@@ -117,6 +129,8 @@ type ProcessEvent struct {
 	RawJSON []byte `json:"raw_json,omitempty"`
 	// QueueCount contains pending messages in queue.
 	QueueCount int `json:"queue_count,omitempty"`
+	// Approval contains the approval request for approval events.
+	Approval *Approval `json:"approval,omitempty"`
 }
 
 // IsCoordinator returns true if this event is from the coordinator.
@@ -15,6 +15,7 @@ type MCPServerConfig struct {
 	URL     string            `json:"url,omitempty"`     // URL for HTTP transport (Claude) or SSE (Gemini)
 	HTTPUrl string            `json:"httpUrl,omitempty"` // URL for streamable HTTP transport (Gemini)
 	Headers map[string]string `json:"headers,omitempty"` // HTTP headers (optional)
+	Trust   bool              `json:"trust,omitempty"`   // Skip tool call confirmations (Gemini)
 }
 
 // MCPConfig represents the full MCP configuration with multiple servers.
@@ -50,11 +51,13 @@ func GenerateCoordinatorConfigHTTP(port int) (string, error) {
 
 // GenerateCoordinatorConfigGemini creates an MCP config for Gemini CLI.
 // Gemini CLI uses "httpUrl" for streamable HTTP transport (not "url" which is SSE).
+// The server is trusted so its tools run without confirmation when --yolo is off.
 func GenerateCoordinatorConfigGemini(port int) (string, error) {
 	config := MCPConfig{
 		MCPServers: map[string]MCPServerConfig{
 			"perles-orchestrator": {
 				HTTPUrl: fmt.Sprintf("http://localhost:%d/mcp", port),
+				Trust:   true,
 			},
 		},
 	}
@@ -113,12 +116,14 @@ func GenerateWorkerConfigHTTP(port int, workerID string) (string, error) {
 }
 
 // GenerateWorkerConfigGemini creates an MCP config for a worker using Gemini CLI format.
-// Gemini CLI uses "httpUrl" for streamable HTTP transport.
+// Gemini CLI uses "httpUrl" for streamable HTTP transport. The server is trusted
+// so its tools run without confirmation when --yolo is off.
 func GenerateWorkerConfigGemini(port int, workerID string) (string, error) {
 	config := MCPConfig{
 		MCPServers: map[string]MCPServerConfig{
 			"perles-worker": {
 				HTTPUrl: fmt.Sprintf("http://localhost:%d/worker/%s", port, workerID),
+				Trust:   true,
 			},
 		},
 	}
@@ -248,6 +253,7 @@ func GenerateObserverConfigGemini(port int) (string, error) {
 		MCPServers: map[string]MCPServerConfig{
 			"perles-observer": {
 				HTTPUrl: fmt.Sprintf("http://localhost:%d/observer", port),
+				Trust:   true,
 			},
 		},
 	}
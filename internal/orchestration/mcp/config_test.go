@@ -127,6 +127,7 @@ func TestGenerateCoordinatorConfigGemini(t *testing.T) {
 	// Gemini uses httpUrl, not url or type
 	require.Empty(t, server.Type, "Type should be empty for Gemini")
 	require.Empty(t, server.URL, "URL should be empty for Gemini")
+	require.True(t, server.Trust, "Gemini servers are trusted")
 	require.Equal(t, "http://localhost:9000/mcp", server.HTTPUrl, "HTTPUrl mismatch")
 }
 
@@ -141,6 +142,7 @@ func TestGenerateWorkerConfigGemini(t *testing.T) {
 	// Gemini uses httpUrl, not url or type
 	require.Empty(t, server.Type, "Type should be empty for Gemini")
 	require.Empty(t, server.URL, "URL should be empty for Gemini")
+	require.True(t, server.Trust, "Gemini servers are trusted")
 	require.Equal(t, "http://localhost:9000/worker/WORKER.1", server.HTTPUrl, "HTTPUrl mismatch")
 }
 
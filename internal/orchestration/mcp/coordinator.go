@@ -455,6 +455,50 @@ func (cs *CoordinatorServer) registerTools() {
 			Required: []string{"message"},
 		},
 	}, cs.handleNotifyUser)
+
+	cs.RegisterTool(Tool{
+		Name:        "require_approval",
+		Description: "Block tasks until a human approves them. Use this before production-touching work and for approval gate tasks. Pauses the workers on the given tasks, shows the summary, diff and artifacts on the dashboard, and plays a sound. The user's decision and feedback are sent to you as a message; end your turn after calling this.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"summary": {
+					Type:        "string",
+					Description: "What the user is approving and why it needs a human decision",
+				},
+				"diff": {
+					Type:        "string",
+					Description: "Optional: Unified diff of the change to approve",
+				},
+				"artifacts": {
+					Type:        "array",
+					Description: "Optional: Paths of supporting files the user should review (plans, migration scripts, reports)",
+					Items:       &PropertySchema{Type: "string"},
+				},
+				"task_ids": {
+					Type:        "array",
+					Description: "Optional: Task IDs held until the approval is resolved; their workers are paused",
+					Items:       &PropertySchema{Type: "string"},
+				},
+			},
+			Required: []string{"summary"},
+		},
+	}, cs.handleRequireApproval)
+
+	cs.RegisterTool(Tool{
+		Name:        "submit_handoff",
+		Description: "Submit a handoff summary when perles asks for one because your context window is nearly full. A fresh coordinator session continues orchestrating seeded with this summary. Only call this when asked, then end your turn.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"summary": {
+					Type:        "string",
+					Description: "Workflow progress, active workers and their tasks, pending decisions and what to do next",
+				},
+			},
+			Required: []string{"summary"},
+		},
+	}, cs.handleSubmitHandoff)
 }
 
 // Tool argument structs for JSON parsing.
@@ -696,3 +740,19 @@ func (cs *CoordinatorServer) handleNotifyUser(ctx context.Context, rawArgs json.
 	}
 	return cs.v2Adapter.HandleNotifyUser(ctx, rawArgs)
 }
+
+// handleRequireApproval pauses tasks until the user approves or rejects them.
+func (cs *CoordinatorServer) handleRequireApproval(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	if cs.v2Adapter == nil {
+		return nil, fmt.Errorf("v2Adapter required for require_approval")
+	}
+	return cs.v2Adapter.HandleRequireApproval(ctx, rawArgs)
+}
+
+// handleSubmitHandoff saves the coordinator's handoff summary for its replacement.
+func (cs *CoordinatorServer) handleSubmitHandoff(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	if cs.v2Adapter == nil {
+		return nil, fmt.Errorf("v2Adapter required for submit_handoff")
+	}
+	return cs.v2Adapter.HandleSubmitHandoff(ctx, rawArgs, repository.CoordinatorID)
+}
@@ -82,6 +82,8 @@ func TestCoordinatorServer_RegistersAllTools(t *testing.T) {
 		"generate_accountability_summary",
 		"signal_workflow_complete",
 		"notify_user",
+		"require_approval",
+		"submit_handoff",
 	}
 
 	for _, toolName := range expectedTools {
@@ -222,6 +222,34 @@ func (ws *WorkerServer) registerTools() {
 		},
 	}, ws.handleReportReviewVerdict)
 
+	// report_policy_violation - Report an action blocked by the worker's tool policy
+	ws.RegisterTool(Tool{
+		Name:        "report_policy_violation",
+		Description: "Report that your task needs an action your tool policy forbids, e.g. editing a file as a read-only reviewer. The coordinator decides how to continue. Never work around a policy rule; report it and end your turn.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"action":  {Type: "string", Description: "The action you need to take, e.g. 'edit internal/app/app.go'"},
+				"rule":    {Type: "string", Description: "The tool policy rule that forbids it"},
+				"details": {Type: "string", Description: "Why the action is needed (optional)"},
+			},
+			Required: []string{"action", "rule"},
+		},
+	}, ws.handleReportPolicyViolation)
+
+	// submit_handoff - Submit a handoff summary before a context handoff
+	ws.RegisterTool(Tool{
+		Name:        "submit_handoff",
+		Description: "Submit a handoff summary when perles asks for one because your context window is nearly full. A fresh worker takes over your task seeded with this summary. Only call this when asked, then end your turn.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"summary": {Type: "string", Description: "What is done, what remains, decisions made and where your replacement should look first"},
+			},
+			Required: []string{"summary"},
+		},
+	}, ws.handleSubmitHandoff)
+
 	// post_accountability_summary - Save worker accountability summary to session directory
 	ws.RegisterTool(Tool{
 		Name:        "post_accountability_summary",
@@ -400,6 +428,38 @@ func (ws *WorkerServer) handleReportReviewVerdict(ctx context.Context, rawArgs j
 	return mcptypes.SuccessResult(result.Message), nil
 }
 
+// handleReportPolicyViolation reports an action blocked by the worker's tool policy.
+// The coordinator receives the violation as a message and the user is notified.
+func (ws *WorkerServer) handleReportPolicyViolation(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	result, err := ws.v2Adapter.HandleReportPolicyViolation(ctx, rawArgs, ws.workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record tool call for turn completion enforcement
+	// Always record even when result indicates an error (processor error, not adapter error)
+	if ws.enforcer != nil {
+		ws.enforcer.RecordToolCall(ws.workerID, "report_policy_violation")
+	}
+
+	return result, nil
+}
+
+// handleSubmitHandoff saves the worker's handoff summary for its replacement.
+func (ws *WorkerServer) handleSubmitHandoff(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	result, err := ws.v2Adapter.HandleSubmitHandoff(ctx, rawArgs, ws.workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record tool call for turn completion enforcement
+	if ws.enforcer != nil {
+		ws.enforcer.RecordToolCall(ws.workerID, "submit_handoff")
+	}
+
+	return result, nil
+}
+
 // validateAccountabilitySummaryArgs validates the arguments for the post_accountability_summary tool.
 // It checks task_id format (to prevent path traversal), summary length bounds,
 // and total content length.
@@ -102,7 +102,7 @@ func (m *mockMessageStore) Append(from, to, content string, msgType message.Mess
 	return &entry, nil
 }
 
-// TestWorkerServer_RegistersAllTools verifies all 4 worker tools are registered.
+// TestWorkerServer_RegistersAllTools verifies all 6 worker tools are registered.
 func TestWorkerServer_RegistersAllTools(t *testing.T) {
 	ws := NewWorkerServer("WORKER.1")
 
@@ -110,6 +110,8 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 		"signal_ready",
 		"report_implementation_complete",
 		"report_review_verdict",
+		"report_policy_violation",
+		"submit_handoff",
 		"post_accountability_summary",
 	}
 
@@ -521,6 +523,27 @@ func TestWorkerServer_ReportReviewVerdictSchema(t *testing.T) {
 	require.True(t, ok, "'comments' property should be defined")
 }
 
+// TestWorkerServer_ReportPolicyViolationSchema verifies tool schema.
+func TestWorkerServer_ReportPolicyViolationSchema(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+
+	tool, ok := ws.tools["report_policy_violation"]
+	require.True(t, ok, "report_policy_violation tool not registered")
+
+	require.ElementsMatch(t, []string{"action", "rule"}, tool.InputSchema.Required)
+	_, ok = tool.InputSchema.Properties["details"]
+	require.True(t, ok, "'details' property should be defined")
+}
+
+// TestWorkerServer_SubmitHandoffSchema verifies tool schema.
+func TestWorkerServer_SubmitHandoffSchema(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+
+	tool, ok := ws.tools["submit_handoff"]
+	require.True(t, ok, "submit_handoff tool not registered")
+	require.Equal(t, []string{"summary"}, tool.InputSchema.Required)
+}
+
 // ============================================================================
 // Tests for validateAccountabilitySummaryArgs
 // ============================================================================
@@ -63,6 +63,52 @@ func LoadObserverNotes(sessionDir string) (string, error) {
 	return string(data), nil
 }
 
+// LoadCommandEvents loads the v2 command log from commands.jsonl in recording order.
+// Returns an empty slice if the file doesn't exist (no commands were processed).
+// Malformed JSON lines are skipped gracefully, e.g. a final line cut short by a crash.
+func LoadCommandEvents(sessionDir string) ([]CommandEvent, error) {
+	path := filepath.Join(sessionDir, commandsFile)
+	file, err := os.Open(path) //nolint:gosec // path is constructed internally from session directory
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []CommandEvent{}, nil
+		}
+		return nil, fmt.Errorf("opening commands file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var commands []CommandEvent
+	scanner := bufio.NewScanner(file)
+
+	// Increase buffer size for potentially long lines
+	buf := make([]byte, maxLineSize)
+	scanner.Buffer(buf, maxLineSize)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue // Skip empty lines
+		}
+
+		var event CommandEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			continue
+		}
+		commands = append(commands, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning commands file: %w", err)
+	}
+
+	// Ensure we return an empty slice, not nil
+	if commands == nil {
+		commands = []CommandEvent{}
+	}
+
+	return commands, nil
+}
+
 // loadMessagesJSONL is the internal implementation for loading chat messages from a JSONL file.
 // Returns an empty slice if the file doesn't exist.
 // Malformed JSON lines are skipped gracefully to provide resilience against partial writes.
@@ -1021,3 +1021,51 @@ func TestLoadResumableSession_MetadataNotFound(t *testing.T) {
 	require.Nil(t, session)
 	require.Contains(t, err.Error(), "loading metadata")
 }
+
+// --- LoadCommandEvents Tests ---
+
+func TestLoadCommandEvents_HappyPath(t *testing.T) {
+	dir := t.TempDir()
+
+	lines := []string{
+		`{"command_id":"cmd-1","command_type":"spawn_process","source":"internal","success":true,"payload":{"Role":"worker"}}`,
+		`{"command_id":"cmd-2","command_type":"assign_task","source":"mcp_tool","success":false,"error":"worker busy"}`,
+	}
+	writeJSONLFile(t, filepath.Join(dir, commandsFile), lines)
+
+	commands, err := LoadCommandEvents(dir)
+	require.NoError(t, err)
+	require.Len(t, commands, 2)
+
+	require.Equal(t, "cmd-1", commands[0].CommandID)
+	require.Equal(t, "spawn_process", commands[0].CommandType)
+	require.True(t, commands[0].Success)
+	require.JSONEq(t, `{"Role":"worker"}`, string(commands[0].Payload))
+
+	require.Equal(t, "cmd-2", commands[1].CommandID)
+	require.False(t, commands[1].Success)
+	require.Equal(t, "worker busy", commands[1].Error)
+}
+
+func TestLoadCommandEvents_FileNotExist(t *testing.T) {
+	commands, err := LoadCommandEvents(t.TempDir())
+	require.NoError(t, err)
+	require.NotNil(t, commands)
+	require.Empty(t, commands)
+}
+
+func TestLoadCommandEvents_TruncatedFinalLine(t *testing.T) {
+	dir := t.TempDir()
+
+	// A crash mid-write leaves the last line incomplete
+	lines := []string{
+		`{"command_id":"cmd-1","command_type":"spawn_process","success":true}`,
+		`{"command_id":"cmd-2","command_type":"assign_ta`,
+	}
+	writeJSONLFile(t, filepath.Join(dir, commandsFile), lines)
+
+	commands, err := LoadCommandEvents(dir)
+	require.NoError(t, err)
+	require.Len(t, commands, 1)
+	require.Equal(t, "cmd-1", commands[0].CommandID)
+}
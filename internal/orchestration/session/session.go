@@ -65,6 +65,7 @@ type Session struct {
 	messageLog       *BufferedWriter            // messages.jsonl (inter-agent messages)
 	mcpLog           *BufferedWriter            // mcp_requests.jsonl
 	commandLog       *BufferedWriter            // commands.jsonl (V2 command processor events)
+	recoveryLog      *BufferedWriter            // recoveries.jsonl (created on first recovery)
 
 	// Metadata for tracking workers and token usage.
 	workers               []WorkerMetadata
@@ -152,6 +153,7 @@ const (
 	chatMessagesFile          = "messages.jsonl" // Chat messages (coordinator/worker directories)
 	mcpRequestsFile           = "mcp_requests.jsonl"
 	commandsFile              = "commands.jsonl"
+	recoveriesFile            = "recoveries.jsonl"
 	summaryFile               = "summary.md"
 	accountabilitySummaryFile = "accountability_summary.md"
 )
@@ -172,6 +174,7 @@ const (
 //	├── workers/                     # Worker directories created on demand
 //	├── messages.jsonl               # Inter-agent message log
 //	├── mcp_requests.jsonl           # MCP tool call requests/responses
+//	├── recoveries.jsonl             # Health recovery actions (created on demand)
 //	└── summary.md                   # Post-session summary (created on close)
 func New(id, dir string, opts ...SessionOption) (*Session, error) {
 	// Create the main session directory
@@ -644,6 +647,49 @@ func (s *Session) WriteCommandEvent(event processor.CommandEvent) error {
 	return s.commandLog.Write(data)
 }
 
+// RecoveryRecord describes a health recovery action taken on a stuck workflow.
+type RecoveryRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	// Step is the 1-based position in the escalation ladder.
+	Step      int    `json:"step"`
+	ProcessID string `json:"process_id,omitempty"`
+	Message   string `json:"message,omitempty"`
+	// StuckSeconds is how long the workflow had made no progress.
+	StuckSeconds int    `json:"stuck_seconds"`
+	Success      bool   `json:"success"`
+	Error        string `json:"error,omitempty"`
+}
+
+// WriteRecovery appends a recovery record to recoveries.jsonl in JSONL format.
+// The file is created with the first record.
+func (s *Session) WriteRecovery(record RecoveryRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return os.ErrClosed
+	}
+
+	if s.recoveryLog == nil {
+		path := filepath.Join(s.Dir, recoveriesFile)
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed from trusted dir parameter
+		if err != nil {
+			return fmt.Errorf("creating recoveries.jsonl: %w", err)
+		}
+		s.recoveryLog = NewBufferedWriter(file)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshaling recovery record: %w", err)
+	}
+
+	// Append newline for JSONL format
+	data = append(data, '\n')
+	return s.recoveryLog.Write(data)
+}
+
 // Close finalizes the session, flushes all BufferedWriters, updates metadata, and closes file handles.
 // After Close returns, no more writes are accepted.
 func (s *Session) Close(status Status) error {
@@ -697,6 +743,11 @@ func (s *Session) Close(status Status) error {
 	if err := s.commandLog.Close(); err != nil && firstErr == nil {
 		firstErr = err
 	}
+	if s.recoveryLog != nil {
+		if err := s.recoveryLog.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
 
 	// Update metadata with end time, final status, workers, and token usage
 	meta, err := Load(s.Dir)
@@ -1687,6 +1687,51 @@ func TestSession_WriteCommandEvent(t *testing.T) {
 	require.Equal(t, "task not found", parsed2.Error)
 }
 
+func TestSession_WriteRecovery(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := New("test-recovery", sessionDir)
+	require.NoError(t, err)
+
+	recoveriesPath := filepath.Join(sessionDir, "recoveries.jsonl")
+	_, err = os.Stat(recoveriesPath)
+	require.True(t, os.IsNotExist(err), "recoveries.jsonl is created with the first record")
+
+	timestamp := time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)
+	require.NoError(t, session.WriteRecovery(RecoveryRecord{
+		Timestamp:    timestamp,
+		Action:       "nudge",
+		Step:         1,
+		ProcessID:    "coordinator",
+		Message:      "Check on your workers",
+		StuckSeconds: 300,
+		Success:      true,
+	}))
+	require.NoError(t, session.WriteRecovery(RecoveryRecord{
+		Timestamp: timestamp.Add(time.Minute),
+		Action:    "replace_worker",
+		Step:      2,
+		Error:     "no working worker to replace",
+	}))
+	require.NoError(t, session.Close(StatusCompleted))
+	require.ErrorIs(t, session.WriteRecovery(RecoveryRecord{Action: "fail"}), os.ErrClosed)
+
+	data, err := os.ReadFile(recoveriesPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var first, second RecoveryRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	require.Equal(t, "nudge", first.Action)
+	require.Equal(t, "Check on your workers", first.Message)
+	require.Equal(t, 300, first.StuckSeconds)
+	require.True(t, first.Success)
+	require.Equal(t, "replace_worker", second.Action)
+	require.Equal(t, "no working worker to replace", second.Error)
+	require.False(t, second.Success)
+}
+
 // Tests for Close
 
 func TestSession_Close(t *testing.T) {
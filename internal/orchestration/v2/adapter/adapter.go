@@ -171,6 +171,18 @@ type reportReviewVerdictArgs struct {
 	Comments string `json:"comments,omitempty"`
 }
 
+// reportPolicyViolationArgs holds arguments for report_policy_violation tool.
+type reportPolicyViolationArgs struct {
+	Action  string `json:"action"`
+	Rule    string `json:"rule"`
+	Details string `json:"details,omitempty"`
+}
+
+// submitHandoffArgs holds arguments for submit_handoff tool.
+type submitHandoffArgs struct {
+	Summary string `json:"summary"`
+}
+
 // spawnWorkerArgs holds arguments for spawn_worker tool.
 type spawnWorkerArgs struct {
 	AgentType string `json:"agent_type,omitempty"`
@@ -753,6 +765,60 @@ func (a *V2Adapter) HandleReportReviewVerdict(ctx context.Context, args json.Raw
 	}, nil
 }
 
+// HandleReportPolicyViolation handles the report_policy_violation MCP tool call.
+// The coordinator is told about the blocked action and the user is notified.
+// Routes through the v2 command processor using CmdReportPolicyViolation.
+func (a *V2Adapter) HandleReportPolicyViolation(ctx context.Context, args json.RawMessage, workerID string) (*mcptypes.ToolCallResult, error) {
+	var parsed reportPolicyViolationArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewReportPolicyViolationCommand(command.SourceMCPTool, workerID, parsed.Action, parsed.Rule, parsed.Details)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("report_policy_violation command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("report_policy_violation command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	return mcptypes.SuccessResult("Policy violation reported to the coordinator. " +
+		"Do not work around the rule; end your turn and wait for instructions."), nil
+}
+
+// HandleSubmitHandoff handles the submit_handoff MCP tool call.
+// The summary seeds the process that replaces the caller once its turn ends.
+// Routes through the v2 command processor using CmdSubmitHandoff.
+func (a *V2Adapter) HandleSubmitHandoff(ctx context.Context, args json.RawMessage, processID string) (*mcptypes.ToolCallResult, error) {
+	var parsed submitHandoffArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewSubmitHandoffCommand(command.SourceMCPTool, processID, parsed.Summary)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("submit_handoff command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("submit_handoff command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	return mcptypes.SuccessResult("Handoff summary saved. End your turn now; " +
+		"a fresh session will continue your work."), nil
+}
+
 // ===========================================================================
 // BD Integration Handlers (Batch 6)
 // ===========================================================================
@@ -821,7 +887,11 @@ func (a *V2Adapter) HandleMarkTaskFailed(ctx context.Context, args json.RawMessa
 		return mcptypes.ErrorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Task %s marked as failed with comment: %s", parsed.TaskID, parsed.Reason)), nil
+	message := fmt.Sprintf("Task %s marked as failed with comment: %s", parsed.TaskID, parsed.Reason)
+	if v, ok := result.Data.(retryNoticer); ok && v.RetryNotice() != "" {
+		message += "\n\n" + v.RetryNotice()
+	}
+	return mcptypes.SuccessResult(message), nil
 }
 
 // ===========================================================================
@@ -977,6 +1047,48 @@ type notifyUserArgs struct {
 	TaskID  string `json:"task_id,omitempty"`
 }
 
+// HandleRequireApproval handles the require_approval MCP tool call.
+// This pauses the workers of the given tasks until the user approves or rejects
+// the request from the dashboard.
+// Routes through the v2 command processor using CmdRequireApproval.
+func (a *V2Adapter) HandleRequireApproval(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed requireApprovalArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	// Validate required fields
+	if parsed.Summary == "" {
+		return nil, fmt.Errorf("summary is required")
+	}
+
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool, parsed.Summary, parsed.Diff, parsed.Artifacts, parsed.TaskIDs)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("require_approval command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("require_approval command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	msg := fmt.Sprintf("Approval %s requested from the user. Workers on the held tasks are paused until the user decides. "+
+		"End your turn now; the decision will be sent to you as a message.", cmd.ApprovalID)
+	return mcptypes.SuccessResult(msg), nil
+}
+
+// requireApprovalArgs represents arguments for the require_approval MCP tool.
+type requireApprovalArgs struct {
+	Summary   string   `json:"summary"`
+	Diff      string   `json:"diff,omitempty"`
+	Artifacts []string `json:"artifacts,omitempty"`
+	TaskIDs   []string `json:"task_ids,omitempty"`
+}
+
 // ===========================================================================
 // Helper Methods
 // ===========================================================================
@@ -1005,6 +1117,11 @@ type processIDExtractor interface {
 	GetProcessID() string
 }
 
+// retryNoticer is an interface for results that may schedule a task retry.
+type retryNoticer interface {
+	RetryNotice() string
+}
+
 // extractProcessID extracts a process ID from command result data.
 // Supports SpawnProcessResult structs and raw string values.
 func extractProcessID(data any) string {
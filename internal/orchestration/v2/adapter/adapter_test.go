@@ -101,6 +101,9 @@ func testAdapter(t *testing.T, opts ...Option) (*V2Adapter, *mockHandler, func()
 		command.CmdStopProcess,
 		command.CmdSignalWorkflowComplete,
 		command.CmdNotifyUser,
+		command.CmdRequireApproval,
+		command.CmdReportPolicyViolation,
+		command.CmdSubmitHandoff,
 	} {
 		p.RegisterHandler(cmdType, handler)
 	}
@@ -1083,8 +1086,35 @@ func TestHandleMarkTaskFailed(t *testing.T) {
 		assert.True(t, result.IsError)
 		assert.Contains(t, result.Content[0].Text, "database error")
 	})
+
+	t.Run("retry_scheduled", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    retryNoticeResult("The task will be retried on a fresh worker in 30s."),
+		}
+
+		args := toJSON(t, map[string]string{
+			"task_id": "perles-xyz9",
+			"reason":  "Tests failed",
+		})
+
+		result, err := adapter.HandleMarkTaskFailed(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "marked as failed with comment: Tests failed")
+		assert.Contains(t, result.Content[0].Text, "retried on a fresh worker in 30s")
+	})
 }
 
+// retryNoticeResult is a command result that schedules a task retry.
+type retryNoticeResult string
+
+func (r retryNoticeResult) RetryNotice() string { return string(r) }
+
 // ===========================================================================
 // Timeout Tests
 // ===========================================================================
@@ -2733,3 +2763,167 @@ func TestHandleNotifyUser(t *testing.T) {
 		assert.Contains(t, result.Content[0].Text, "notification failed")
 	})
 }
+
+// ===========================================================================
+// HandleRequireApproval Tests
+// ===========================================================================
+
+func TestHandleRequireApproval(t *testing.T) {
+	t.Run("success_with_all_fields", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"summary":   "Apply the billing migration to prod",
+			"diff":      "+ALTER TABLE invoices ADD COLUMN due_at",
+			"artifacts": []string{"docs/migration.md"},
+			"task_ids":  []string{"perles-abc.2"},
+		})
+
+		result, err := adapter.HandleRequireApproval(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "End your turn now")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		approvalCmd, ok := cmds[0].(*command.RequireApprovalCommand)
+		require.True(t, ok)
+		assert.Contains(t, result.Content[0].Text, approvalCmd.ApprovalID)
+		assert.Equal(t, "Apply the billing migration to prod", approvalCmd.Summary)
+		assert.Equal(t, "+ALTER TABLE invoices ADD COLUMN due_at", approvalCmd.Diff)
+		assert.Equal(t, []string{"docs/migration.md"}, approvalCmd.Artifacts)
+		assert.Equal(t, []string{"perles-abc.2"}, approvalCmd.TaskIDs)
+	})
+
+	t.Run("missing_summary", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"task_ids": []string{"perles-abc.2"},
+		})
+
+		result, err := adapter.HandleRequireApproval(context.Background(), args)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "summary is required")
+	})
+
+	t.Run("invalid_task_id", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"summary":  "Deploy",
+			"task_ids": []string{"not a task"},
+		})
+
+		result, err := adapter.HandleRequireApproval(context.Background(), args)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "validation failed")
+	})
+
+	t.Run("result_not_success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: false,
+			Error:   errors.New("task is awaiting human approval"),
+		}
+
+		args := toJSON(t, map[string]any{
+			"summary":  "Deploy",
+			"task_ids": []string{"perles-abc.2"},
+		})
+
+		result, err := adapter.HandleRequireApproval(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "awaiting human approval")
+	})
+}
+
+func TestHandleReportPolicyViolation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"action":  "fix the typo in main.go",
+			"rule":    "Never create, edit or delete files",
+			"details": "found while reviewing",
+		})
+
+		result, err := adapter.HandleReportPolicyViolation(context.Background(), args, "worker-2")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "end your turn")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		violationCmd, ok := cmds[0].(*command.ReportPolicyViolationCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-2", violationCmd.ProcessID)
+		assert.Equal(t, "fix the typo in main.go", violationCmd.Action)
+		assert.Equal(t, "Never create, edit or delete files", violationCmd.Rule)
+		assert.Equal(t, "found while reviewing", violationCmd.Details)
+	})
+
+	t.Run("missing_rule", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{"action": "curl the docs"})
+
+		result, err := adapter.HandleReportPolicyViolation(context.Background(), args, "worker-2")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "rule is required")
+	})
+}
+
+func TestHandleSubmitHandoff(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{"summary": "Parser done, tests left"})
+
+		result, err := adapter.HandleSubmitHandoff(context.Background(), args, "coordinator")
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "End your turn")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		handoffCmd, ok := cmds[0].(*command.SubmitHandoffCommand)
+		require.True(t, ok)
+		assert.Equal(t, "coordinator", handoffCmd.ProcessID)
+		assert.Equal(t, "Parser done, tests left", handoffCmd.Summary)
+	})
+
+	t.Run("missing_summary", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleSubmitHandoff(context.Background(), toJSON(t, map[string]any{}), "worker-1")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "summary is required")
+	})
+}
@@ -0,0 +1,296 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Autoscaler defaults applied to zero AutoscaleConfig fields.
+const (
+	DefaultAutoscaleMaxWorkers   = config.DefaultAutoscaleMaxWorkers
+	DefaultAutoscaleIdleCooldown = 5 * time.Minute
+	DefaultAutoscaleInterval     = 30 * time.Second
+)
+
+// AutoscaleReason is the RetireProcessCommand reason for autoscaler retirements.
+const AutoscaleReason = "autoscale_idle"
+
+// AutoscaleConfig configures the worker autoscaler.
+type AutoscaleConfig struct {
+	// MinWorkers is the number of workers the autoscaler never retires below.
+	MinWorkers int
+	// MaxWorkers caps the workers the autoscaler spawns up to.
+	// Defaults to DefaultAutoscaleMaxWorkers.
+	MaxWorkers int
+	// IdleCooldown is how long a worker must sit idle before it is retired.
+	// Defaults to DefaultAutoscaleIdleCooldown.
+	IdleCooldown time.Duration
+	// Interval is how often the autoscaler evaluates the worker pool.
+	// Defaults to DefaultAutoscaleInterval.
+	Interval time.Duration
+}
+
+// withDefaults returns the config with zero fields set to their defaults.
+func (c AutoscaleConfig) withDefaults() AutoscaleConfig {
+	if c.MaxWorkers == 0 {
+		c.MaxWorkers = DefaultAutoscaleMaxWorkers
+	}
+	if c.IdleCooldown == 0 {
+		c.IdleCooldown = DefaultAutoscaleIdleCooldown
+	}
+	if c.Interval == 0 {
+		c.Interval = DefaultAutoscaleInterval
+	}
+	return c
+}
+
+// commandExecutor submits a command and waits for its result.
+// Satisfied by *processor.CommandProcessor.
+type commandExecutor interface {
+	SubmitAndWait(ctx context.Context, cmd command.Command) (*command.CommandResult, error)
+}
+
+// Autoscaler sizes the worker pool to the ready tasks of an epic.
+//
+// On every interval it compares the epic's ready tasks against the workers
+// without a task. When tasks outnumber those workers it spawns workers, up to
+// MaxWorkers. When workers outnumber the tasks it retires the ones idle for
+// longer than IdleCooldown, down to MinWorkers. Each decision is sent to the
+// coordinator as a system message.
+type Autoscaler struct {
+	cfg         AutoscaleConfig
+	epicID      string
+	querier     bql.BQLExecutor
+	processRepo repository.ProcessRepository
+	executor    commandExecutor
+	now         func() time.Time
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewAutoscaler creates an autoscaler for the ready tasks of epicID.
+func NewAutoscaler(
+	cfg AutoscaleConfig,
+	epicID string,
+	querier bql.BQLExecutor,
+	processRepo repository.ProcessRepository,
+	executor commandExecutor,
+) *Autoscaler {
+	return &Autoscaler{
+		cfg:         cfg.withDefaults(),
+		epicID:      epicID,
+		querier:     querier,
+		processRepo: processRepo,
+		executor:    executor,
+		now:         time.Now,
+	}
+}
+
+// Start begins evaluating the worker pool every interval until ctx is
+// cancelled or Stop is called.
+func (a *Autoscaler) Start(ctx context.Context) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.cancel != nil {
+		return
+	}
+
+	ctx, a.cancel = context.WithCancel(ctx)
+	done := make(chan struct{})
+	a.done = done
+	log.SafeGo("autoscaler.loop", func() { a.run(ctx, done) })
+}
+
+// Stop stops the autoscaler and waits for an in-flight evaluation to finish.
+// It is safe to call Stop multiple times or before Start.
+func (a *Autoscaler) Stop() {
+	a.mu.Lock()
+	cancel, done := a.cancel, a.done
+	a.cancel, a.done = nil, nil
+	a.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (a *Autoscaler) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(a.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.Evaluate(ctx)
+		}
+	}
+}
+
+// Evaluate runs one scaling decision. Nothing is done until the coordinator
+// is running, since it is the one that assigns tasks to the workers.
+func (a *Autoscaler) Evaluate(ctx context.Context) {
+	coord, err := a.processRepo.GetCoordinator()
+	if err != nil || !coord.Status.IsActive() {
+		return
+	}
+
+	ready, err := a.readyTasks()
+	if err != nil {
+		log.Warn(log.CatOrch, "Autoscaler failed to query ready tasks", "epicID", a.epicID, "error", err)
+		return
+	}
+
+	var pool, unassigned []*repository.Process
+	for _, proc := range a.processRepo.ActiveWorkers() {
+		if proc.Status == repository.StatusRetiring {
+			continue
+		}
+		pool = append(pool, proc)
+		if isUnassigned(proc) {
+			unassigned = append(unassigned, proc)
+		}
+	}
+
+	switch {
+	case ready > len(unassigned):
+		a.scaleUp(ctx, min(ready-len(unassigned), a.cfg.MaxWorkers-len(pool)), ready)
+	case ready < len(unassigned):
+		a.scaleDown(ctx, min(len(unassigned)-ready, len(pool)-a.cfg.MinWorkers), ready)
+	}
+}
+
+// readyTasks counts the ready issues under the epic, excluding nested epics
+// and approval gates, which are never assigned to workers. The ready query is scoped to the epic's issues so its cost doesn't grow
+// with the rest of the database.
+func (a *Autoscaler) readyTasks() (int, error) {
+	tree, err := a.querier.Execute(fmt.Sprintf(`id = "%s" expand down depth *`, a.epicID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to query epic: %w", err)
+	}
+	var ids []string
+	for _, issue := range tree {
+		if issue.ID != a.epicID && issue.Type != beads.TypeEpic && !issue.IsApprovalGate() {
+			ids = append(ids, issue.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	ready, err := a.querier.Execute("ready = true and " + bql.BuildIDQuery(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to query ready issues: %w", err)
+	}
+	return len(ready), nil
+}
+
+// scaleUp spawns up to n workers and tells the coordinator about them.
+func (a *Autoscaler) scaleUp(ctx context.Context, n, ready int) {
+	var spawned []string
+	for range n {
+		cmd := command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker)
+		result, err := a.executor.SubmitAndWait(ctx, cmd)
+		if err == nil && !result.Success {
+			err = result.Error
+		}
+		if err != nil {
+			// Usually a worker limit shared across workflows; try again next interval
+			log.Warn(log.CatOrch, "Autoscaler failed to spawn worker", "epicID", a.epicID, "error", err)
+			break
+		}
+		if res, ok := result.Data.(*handler.SpawnProcessResult); ok {
+			spawned = append(spawned, res.ProcessID)
+		}
+	}
+	if len(spawned) == 0 {
+		return
+	}
+
+	log.Info(log.CatOrch, "Autoscaler spawned workers",
+		"epicID", a.epicID, "workers", spawned, "readyTasks", ready)
+	a.notifyCoordinator(ctx, prompt.AutoscaleSpawnMessage(spawned, ready, a.epicID))
+}
+
+// scaleDown retires up to n workers idle past the cooldown, longest idle
+// first, and tells the coordinator about them.
+func (a *Autoscaler) scaleDown(ctx context.Context, n, ready int) {
+	if n <= 0 {
+		return
+	}
+
+	now := a.now()
+	var idle []*repository.Process
+	for _, proc := range a.processRepo.ReadyWorkers() {
+		if proc.TaskID == "" && now.Sub(idleSince(proc)) >= a.cfg.IdleCooldown {
+			idle = append(idle, proc)
+		}
+	}
+	slices.SortFunc(idle, func(x, y *repository.Process) int {
+		return idleSince(x).Compare(idleSince(y))
+	})
+
+	var retired []string
+	for _, proc := range idle[:min(n, len(idle))] {
+		cmd := command.NewRetireProcessCommand(command.SourceInternal, proc.ID, AutoscaleReason)
+		result, err := a.executor.SubmitAndWait(ctx, cmd)
+		if err == nil && !result.Success {
+			err = result.Error
+		}
+		if err != nil {
+			log.Warn(log.CatOrch, "Autoscaler failed to retire worker",
+				"epicID", a.epicID, "processID", proc.ID, "error", err)
+			continue
+		}
+		retired = append(retired, proc.ID)
+	}
+	if len(retired) == 0 {
+		return
+	}
+
+	log.Info(log.CatOrch, "Autoscaler retired idle workers",
+		"epicID", a.epicID, "workers", retired, "readyTasks", ready)
+	a.notifyCoordinator(ctx, prompt.AutoscaleRetireMessage(retired, ready, a.epicID))
+}
+
+// notifyCoordinator queues a system message for the coordinator.
+func (a *Autoscaler) notifyCoordinator(ctx context.Context, content string) {
+	cmd := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, content)
+	if _, err := a.executor.SubmitAndWait(ctx, cmd); err != nil {
+		log.Warn(log.CatOrch, "Autoscaler failed to notify coordinator", "error", err)
+	}
+}
+
+// isUnassigned reports whether a worker has no task and is not working on
+// anything else, including workers that are still starting up.
+func isUnassigned(proc *repository.Process) bool {
+	return proc.TaskID == "" && (proc.Phase == nil || *proc.Phase == events.ProcessPhaseIdle)
+}
+
+// idleSince returns when the worker last finished a turn, or when it was
+// created if it has not finished one yet.
+func idleSince(proc *repository.Process) time.Time {
+	if proc.LastActivityAt.IsZero() {
+		return proc.CreatedAt
+	}
+	return proc.LastActivityAt
+}
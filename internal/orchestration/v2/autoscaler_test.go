@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+const testEpicQuery = `id = "perles-abc" expand down depth *`
+
+// fakeCommandExecutor records submitted commands. Spawns succeed with
+// sequential worker IDs until spawnErr is set.
+type fakeCommandExecutor struct {
+	commands []command.Command
+	spawned  int
+	spawnErr error
+}
+
+func (f *fakeCommandExecutor) SubmitAndWait(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	f.commands = append(f.commands, cmd)
+	switch cmd.(type) {
+	case *command.SpawnProcessCommand:
+		if f.spawnErr != nil {
+			return &command.CommandResult{Success: false, Error: f.spawnErr}, nil
+		}
+		f.spawned++
+		return handler.SuccessResult(&handler.SpawnProcessResult{
+			ProcessID: fmt.Sprintf("worker-%d", 10+f.spawned),
+			Role:      repository.RoleWorker,
+		}), nil
+	default:
+		return handler.SuccessResult(nil), nil
+	}
+}
+
+func (f *fakeCommandExecutor) ofType(cmdType command.CommandType) []command.Command {
+	var result []command.Command
+	for _, cmd := range f.commands {
+		if cmd.Type() == cmdType {
+			result = append(result, cmd)
+		}
+	}
+	return result
+}
+
+type autoscalerFixture struct {
+	autoscaler  *Autoscaler
+	querier     *mocks.MockBQLExecutor
+	processRepo *repository.MemoryProcessRepository
+	executor    *fakeCommandExecutor
+	now         time.Time
+}
+
+func newAutoscalerFixture(t *testing.T, cfg AutoscaleConfig) *autoscalerFixture {
+	t.Helper()
+	f := &autoscalerFixture{
+		querier:     mocks.NewMockBQLExecutor(t),
+		processRepo: repository.NewMemoryProcessRepository(),
+		executor:    &fakeCommandExecutor{},
+		now:         time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.processRepo.AddProcess(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking,
+	})
+	f.autoscaler = NewAutoscaler(cfg, "perles-abc", f.querier, f.processRepo, f.executor)
+	f.autoscaler.now = func() time.Time { return f.now }
+	return f
+}
+
+// readyTasks makes the epic contain n ready tasks, plus a nested epic, an
+// approval gate and a blocked task. Only the epic's tasks that workers can be
+// assigned are queried for readiness.
+func (f *autoscalerFixture) readyTasks(n int) {
+	tree := []beads.Issue{
+		{ID: "perles-abc", Type: beads.TypeEpic},
+		{ID: "perles-abc.0", Type: beads.TypeEpic},
+		{ID: "perles-abc.gate", Type: beads.TypeTask, Labels: []string{beads.LabelApprovalGate}},
+		{ID: "perles-abc.blocked", Type: beads.TypeTask},
+	}
+	ids := []string{"perles-abc.blocked"}
+	var ready []beads.Issue
+	for i := range n {
+		task := beads.Issue{ID: fmt.Sprintf("perles-abc.%d", i+1), Type: beads.TypeTask}
+		tree = append(tree, task)
+		ids = append(ids, task.ID)
+		ready = append(ready, task)
+	}
+	f.querier.EXPECT().Execute(testEpicQuery).Return(tree, nil).Once()
+	f.querier.EXPECT().Execute("ready = true and "+bql.BuildIDQuery(ids)).Return(ready, nil).Once()
+}
+
+func (f *autoscalerFixture) addWorker(id string, status repository.ProcessStatus, taskID string, idleFor time.Duration) {
+	phase := events.ProcessPhaseIdle
+	if taskID != "" {
+		phase = events.ProcessPhaseImplementing
+	}
+	f.processRepo.AddProcess(&repository.Process{
+		ID:             id,
+		Role:           repository.RoleWorker,
+		Status:         status,
+		Phase:          &phase,
+		TaskID:         taskID,
+		CreatedAt:      f.now.Add(-time.Hour),
+		LastActivityAt: f.now.Add(-idleFor),
+	})
+}
+
+func TestAutoscaler_SpawnsWorkersForReadyTasks(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{MaxWorkers: 4})
+	f.addWorker("worker-1", repository.StatusReady, "", time.Minute)
+	f.readyTasks(3)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Len(t, f.executor.ofType(command.CmdSpawnProcess), 2)
+	sends := f.executor.ofType(command.CmdSendToProcess)
+	require.Len(t, sends, 1)
+	send := sends[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Equal(t, command.SourceInternal, send.Source())
+	require.Contains(t, send.Content, "[AUTOSCALER] Spawned worker-11, worker-12")
+	require.Contains(t, send.Content, "3 ready task(s) in epic perles-abc")
+}
+
+func TestAutoscaler_SpawnsUpToMaxWorkers(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{MaxWorkers: 3})
+	f.addWorker("worker-1", repository.StatusWorking, "perles-abc.9", 0)
+	f.addWorker("worker-2", repository.StatusWorking, "perles-abc.8", 0)
+	f.readyTasks(5)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Len(t, f.executor.ofType(command.CmdSpawnProcess), 1)
+}
+
+func TestAutoscaler_CountsStartingWorkersAsUnassigned(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{})
+	f.processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusStarting})
+	f.readyTasks(1)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Empty(t, f.executor.commands)
+}
+
+func TestAutoscaler_StopsSpawningWhenNotAdmitted(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{})
+	f.executor.spawnErr = errors.New("worker limit reached")
+	f.readyTasks(3)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Len(t, f.executor.ofType(command.CmdSpawnProcess), 1)
+	require.Empty(t, f.executor.ofType(command.CmdSendToProcess))
+}
+
+func TestAutoscaler_RetiresIdleWorkersAfterCooldown(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{IdleCooldown: 5 * time.Minute})
+	f.addWorker("worker-1", repository.StatusReady, "", 10*time.Minute)
+	f.addWorker("worker-2", repository.StatusReady, "", 20*time.Minute)
+	f.addWorker("worker-3", repository.StatusReady, "", time.Minute)
+	f.readyTasks(1)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	// Two workers are surplus but worker-3 is still within the cooldown, and
+	// the longest idle worker goes first
+	retires := f.executor.ofType(command.CmdRetireProcess)
+	require.Len(t, retires, 2)
+	require.Equal(t, "worker-2", retires[0].(*command.RetireProcessCommand).ProcessID)
+	require.Equal(t, "worker-1", retires[1].(*command.RetireProcessCommand).ProcessID)
+	require.Equal(t, AutoscaleReason, retires[0].(*command.RetireProcessCommand).Reason)
+
+	sends := f.executor.ofType(command.CmdSendToProcess)
+	require.Len(t, sends, 1)
+	require.Contains(t, sends[0].(*command.SendToProcessCommand).Content, "[AUTOSCALER] Retired idle worker-2, worker-1")
+}
+
+func TestAutoscaler_KeepsMinWorkers(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{MinWorkers: 1, IdleCooldown: time.Minute})
+	f.addWorker("worker-1", repository.StatusReady, "", time.Hour)
+	f.addWorker("worker-2", repository.StatusReady, "", time.Hour)
+	f.readyTasks(0)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Len(t, f.executor.ofType(command.CmdRetireProcess), 1)
+}
+
+func TestAutoscaler_IgnoresRetiringWorkers(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{MaxWorkers: 1})
+	f.addWorker("worker-1", repository.StatusRetiring, "", time.Hour)
+	f.readyTasks(1)
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Len(t, f.executor.ofType(command.CmdSpawnProcess), 1)
+}
+
+func TestAutoscaler_WaitsForCoordinator(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{})
+	coord, err := f.processRepo.GetCoordinator()
+	require.NoError(t, err)
+	coord.Status = repository.StatusPaused
+	require.NoError(t, f.processRepo.Save(coord))
+
+	// No queries expected: the mock fails the test on unexpected calls
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Empty(t, f.executor.commands)
+}
+
+func TestAutoscaler_QueryErrorSkipsEvaluation(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{})
+	f.querier.EXPECT().Execute(testEpicQuery).Return(nil, errors.New("database is locked")).Once()
+
+	f.autoscaler.Evaluate(context.Background())
+
+	require.Empty(t, f.executor.commands)
+}
+
+func TestAutoscaler_StopIsIdempotent(t *testing.T) {
+	f := newAutoscalerFixture(t, AutoscaleConfig{Interval: time.Hour})
+
+	f.autoscaler.Stop()
+	f.autoscaler.Start(context.Background())
+	f.autoscaler.Stop()
+	f.autoscaler.Stop()
+}
+
+func TestAutoscaleConfig_Defaults(t *testing.T) {
+	cfg := AutoscaleConfig{MinWorkers: 1}.withDefaults()
+
+	require.Equal(t, 1, cfg.MinWorkers)
+	require.Equal(t, DefaultAutoscaleMaxWorkers, cfg.MaxWorkers)
+	require.Equal(t, DefaultAutoscaleIdleCooldown, cfg.IdleCooldown)
+	require.Equal(t, DefaultAutoscaleInterval, cfg.Interval)
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/validation"
+)
+
+// ===========================================================================
+// Approval Commands
+// ===========================================================================
+
+// RequireApprovalCommand asks a human to approve work before the workflow
+// continues. The workers of TaskIDs are paused until the approval is resolved.
+type RequireApprovalCommand struct {
+	*BaseCommand
+	ApprovalID string   // Generated: identifies the approval for its resolution
+	Summary    string   // Required: what needs approval and why
+	Diff       string   // Optional: the change under review
+	Artifacts  []string // Optional: paths or links for the reviewer
+	TaskIDs    []string // Optional: tasks held until the approval is resolved
+}
+
+// NewRequireApprovalCommand creates a new RequireApprovalCommand.
+func NewRequireApprovalCommand(source CommandSource, summary, diff string, artifacts, taskIDs []string) *RequireApprovalCommand {
+	base := NewBaseCommand(CmdRequireApproval, source)
+	return &RequireApprovalCommand{
+		BaseCommand: &base,
+		ApprovalID:  "approval-" + base.id[:8],
+		Summary:     summary,
+		Diff:        diff,
+		Artifacts:   artifacts,
+		TaskIDs:     taskIDs,
+	}
+}
+
+// Validate checks that ApprovalID and Summary are provided and TaskIDs are valid.
+func (c *RequireApprovalCommand) Validate() error {
+	if c.ApprovalID == "" {
+		return fmt.Errorf("approval_id is required")
+	}
+	if c.Summary == "" {
+		return fmt.Errorf("summary is required")
+	}
+	for _, taskID := range c.TaskIDs {
+		if !validation.IsValidTaskID(taskID) {
+			return fmt.Errorf("invalid task_id format: %s", taskID)
+		}
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *RequireApprovalCommand) String() string {
+	return fmt.Sprintf("RequireApproval{id=%s, tasks=[%s], summary=%q}",
+		c.ApprovalID, strings.Join(c.TaskIDs, ","), truncate(c.Summary, 50))
+}
+
+// ResolveApprovalCommand records a human's decision on a pending approval.
+// Paused workers are resumed and the coordinator is told the outcome.
+type ResolveApprovalCommand struct {
+	*BaseCommand
+	ApprovalID string // Required: the approval being resolved
+	Approved   bool   // true = approve, false = reject
+	Feedback   string // Required when rejecting: what must change
+}
+
+// NewResolveApprovalCommand creates a new ResolveApprovalCommand.
+func NewResolveApprovalCommand(source CommandSource, approvalID string, approved bool, feedback string) *ResolveApprovalCommand {
+	base := NewBaseCommand(CmdResolveApproval, source)
+	return &ResolveApprovalCommand{
+		BaseCommand: &base,
+		ApprovalID:  approvalID,
+		Approved:    approved,
+		Feedback:    feedback,
+	}
+}
+
+// Validate checks that ApprovalID is provided and a rejection has feedback.
+func (c *ResolveApprovalCommand) Validate() error {
+	if c.ApprovalID == "" {
+		return fmt.Errorf("approval_id is required")
+	}
+	if !c.Approved && strings.TrimSpace(c.Feedback) == "" {
+		return fmt.Errorf("feedback is required when rejecting")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *ResolveApprovalCommand) String() string {
+	return fmt.Sprintf("ResolveApproval{id=%s, approved=%t}", c.ApprovalID, c.Approved)
+}
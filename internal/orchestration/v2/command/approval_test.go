@@ -0,0 +1,81 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// Approval Command Tests
+// ===========================================================================
+
+func TestNewRequireApprovalCommand(t *testing.T) {
+	cmd := NewRequireApprovalCommand(SourceMCPTool, "Run the prod migration", "+ALTER TABLE users", []string{"docs/plan.md"}, []string{"perles-abc1.2"})
+
+	require.Equal(t, CmdRequireApproval, cmd.Type())
+	require.Equal(t, SourceMCPTool, cmd.Source())
+	require.True(t, strings.HasPrefix(cmd.ApprovalID, "approval-"))
+	require.Equal(t, "Run the prod migration", cmd.Summary)
+	require.Equal(t, "+ALTER TABLE users", cmd.Diff)
+	require.Equal(t, []string{"docs/plan.md"}, cmd.Artifacts)
+	require.Equal(t, []string{"perles-abc1.2"}, cmd.TaskIDs)
+	require.NoError(t, cmd.Validate())
+
+	other := NewRequireApprovalCommand(SourceMCPTool, "Another change", "", nil, nil)
+	require.NotEqual(t, cmd.ApprovalID, other.ApprovalID)
+}
+
+func TestRequireApprovalCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		summary   string
+		taskIDs   []string
+		errSubstr string
+	}{
+		{name: "valid without tasks", summary: "Approve the release"},
+		{name: "valid with tasks", summary: "Approve the release", taskIDs: []string{"perles-abc1.2", "perles-abc1.3"}},
+		{name: "empty summary", summary: "", errSubstr: "summary is required"},
+		{name: "invalid task ID", summary: "Approve the release", taskIDs: []string{"not a task"}, errSubstr: "invalid task_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRequireApprovalCommand(SourceMCPTool, tt.summary, "", nil, tt.taskIDs).Validate()
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errSubstr)
+		})
+	}
+}
+
+func TestResolveApprovalCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		approvalID string
+		approved   bool
+		feedback   string
+		errSubstr  string
+	}{
+		{name: "approve without feedback", approvalID: "approval-1a2b3c4d", approved: true},
+		{name: "reject with feedback", approvalID: "approval-1a2b3c4d", feedback: "Split the migration"},
+		{name: "reject without feedback", approvalID: "approval-1a2b3c4d", feedback: "  ", errSubstr: "feedback is required"},
+		{name: "missing approval ID", approved: true, errSubstr: "approval_id is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewResolveApprovalCommand(SourceUser, tt.approvalID, tt.approved, tt.feedback)
+			require.Equal(t, CmdResolveApproval, cmd.Type())
+			err := cmd.Validate()
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errSubstr)
+		})
+	}
+}
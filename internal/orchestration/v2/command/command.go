@@ -60,6 +60,8 @@ const (
 	CmdMarkTaskComplete CommandType = "mark_task_complete"
 	// CmdMarkTaskFailed marks a BD task as failed with a reason.
 	CmdMarkTaskFailed CommandType = "mark_task_failed"
+	// CmdRetryTask starts another attempt at a failed task on a fresh worker.
+	CmdRetryTask CommandType = "retry_task"
 
 	// Unified Process Commands (for both coordinator and workers)
 
@@ -99,6 +101,14 @@ const (
 
 	// CmdNotifyUser requests user attention (e.g., for human review checkpoints).
 	CmdNotifyUser CommandType = "notify_user"
+	// CmdRequireApproval pauses tasks until a human approves or rejects them.
+	CmdRequireApproval CommandType = "require_approval"
+	// CmdResolveApproval records a human's decision on a pending approval.
+	CmdResolveApproval CommandType = "resolve_approval"
+	// CmdReportPolicyViolation records a worker action blocked by its tool policy.
+	CmdReportPolicyViolation CommandType = "report_policy_violation"
+	// CmdSubmitHandoff records a process's handoff summary before a context handoff.
+	CmdSubmitHandoff CommandType = "submit_handoff"
 )
 
 // String returns the string representation of the CommandType.
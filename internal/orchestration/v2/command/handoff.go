@@ -0,0 +1,41 @@
+package command
+
+import "fmt"
+
+// ===========================================================================
+// Handoff Commands
+// ===========================================================================
+
+// SubmitHandoffCommand records the summary a process writes when it nears the
+// end of its context window. The summary seeds the replacement process.
+type SubmitHandoffCommand struct {
+	*BaseCommand
+	ProcessID string // Required: process handing off
+	Summary   string // Required: what the replacement needs to continue the work
+}
+
+// NewSubmitHandoffCommand creates a new SubmitHandoffCommand.
+func NewSubmitHandoffCommand(source CommandSource, processID, summary string) *SubmitHandoffCommand {
+	base := NewBaseCommand(CmdSubmitHandoff, source)
+	return &SubmitHandoffCommand{
+		BaseCommand: &base,
+		ProcessID:   processID,
+		Summary:     summary,
+	}
+}
+
+// Validate checks that the process and summary are provided.
+func (c *SubmitHandoffCommand) Validate() error {
+	if c.ProcessID == "" {
+		return fmt.Errorf("process_id is required")
+	}
+	if c.Summary == "" {
+		return fmt.Errorf("summary is required")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *SubmitHandoffCommand) String() string {
+	return fmt.Sprintf("SubmitHandoff{process=%s, summary=%q}", c.ProcessID, truncate(c.Summary, 50))
+}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitHandoffCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		processID string
+		summary   string
+		errSubstr string
+	}{
+		{name: "valid", processID: "worker-1", summary: "Finished the parser, tests pending"},
+		{name: "missing process", summary: "Finished the parser", errSubstr: "process_id is required"},
+		{name: "missing summary", processID: "worker-1", errSubstr: "summary is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewSubmitHandoffCommand(SourceMCPTool, tt.processID, tt.summary).Validate()
+			if tt.errSubstr != "" {
+				require.ErrorContains(t, err, tt.errSubstr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewSubmitHandoffCommand(t *testing.T) {
+	cmd := NewSubmitHandoffCommand(SourceMCPTool, "coordinator", "Three tasks remain")
+
+	require.Equal(t, CmdSubmitHandoff, cmd.Type())
+	require.Equal(t, SourceMCPTool, cmd.Source())
+	require.Equal(t, "coordinator", cmd.ProcessID)
+	require.Contains(t, cmd.String(), "process=coordinator")
+}
@@ -14,17 +14,38 @@ type NotifyUserCommand struct {
 	Message string // Required: message to display to the user
 	Phase   string // Optional: phase name (e.g., "clarification-review")
 	TaskID  string // Optional: task ID associated with this notification
+
+	// SoundFile and SoundUseCase select the notification sound.
+	// Optional: defaults to the user_notification sound.
+	SoundFile    string
+	SoundUseCase string
+}
+
+// NotifyUserOption configures optional NotifyUserCommand fields.
+type NotifyUserOption func(*NotifyUserCommand)
+
+// WithNotifySound plays the given sound instead of the user_notification
+// sound, e.g. for budget alerts.
+func WithNotifySound(soundFile, useCase string) NotifyUserOption {
+	return func(cmd *NotifyUserCommand) {
+		cmd.SoundFile = soundFile
+		cmd.SoundUseCase = useCase
+	}
 }
 
 // NewNotifyUserCommand creates a new NotifyUserCommand.
-func NewNotifyUserCommand(source CommandSource, message, phase, taskID string) *NotifyUserCommand {
+func NewNotifyUserCommand(source CommandSource, message, phase, taskID string, opts ...NotifyUserOption) *NotifyUserCommand {
 	base := NewBaseCommand(CmdNotifyUser, source)
-	return &NotifyUserCommand{
+	cmd := &NotifyUserCommand{
 		BaseCommand: &base,
 		Message:     message,
 		Phase:       phase,
 		TaskID:      taskID,
 	}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
 }
 
 // Validate checks that Message is provided.
@@ -0,0 +1,49 @@
+package command
+
+import "fmt"
+
+// ===========================================================================
+// Policy Commands
+// ===========================================================================
+
+// ReportPolicyViolationCommand records that a worker needed an action its
+// tool policy forbids, so the coordinator and the user can react.
+type ReportPolicyViolationCommand struct {
+	*BaseCommand
+	ProcessID string // Required: worker that hit the policy
+	Action    string // Required: what the worker tried or needed to do
+	Rule      string // Required: the policy rule that forbids it
+	Details   string // Optional: context, e.g. why the action was needed
+}
+
+// NewReportPolicyViolationCommand creates a new ReportPolicyViolationCommand.
+func NewReportPolicyViolationCommand(source CommandSource, processID, action, rule, details string) *ReportPolicyViolationCommand {
+	base := NewBaseCommand(CmdReportPolicyViolation, source)
+	return &ReportPolicyViolationCommand{
+		BaseCommand: &base,
+		ProcessID:   processID,
+		Action:      action,
+		Rule:        rule,
+		Details:     details,
+	}
+}
+
+// Validate checks that the process, action and rule are provided.
+func (c *ReportPolicyViolationCommand) Validate() error {
+	if c.ProcessID == "" {
+		return fmt.Errorf("process_id is required")
+	}
+	if c.Action == "" {
+		return fmt.Errorf("action is required")
+	}
+	if c.Rule == "" {
+		return fmt.Errorf("rule is required")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *ReportPolicyViolationCommand) String() string {
+	return fmt.Sprintf("ReportPolicyViolation{process=%s, action=%q, rule=%q}",
+		c.ProcessID, truncate(c.Action, 50), truncate(c.Rule, 50))
+}
@@ -0,0 +1,44 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportPolicyViolationCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		processID string
+		action    string
+		rule      string
+		errSubstr string
+	}{
+		{name: "valid", processID: "worker-1", action: "edit main.go", rule: "Never create, edit or delete files"},
+		{name: "missing process", action: "edit main.go", rule: "Never create, edit or delete files", errSubstr: "process_id is required"},
+		{name: "missing action", processID: "worker-1", rule: "Never create, edit or delete files", errSubstr: "action is required"},
+		{name: "missing rule", processID: "worker-1", action: "edit main.go", errSubstr: "rule is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewReportPolicyViolationCommand(SourceMCPTool, tt.processID, tt.action, tt.rule, "")
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.ErrorContains(t, err, tt.errSubstr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewReportPolicyViolationCommand(t *testing.T) {
+	cmd := NewReportPolicyViolationCommand(SourceMCPTool, "worker-1", "curl the API docs", "Never access the network", "needed the schema")
+
+	require.Equal(t, CmdReportPolicyViolation, cmd.Type())
+	require.Equal(t, SourceMCPTool, cmd.Source())
+	require.Equal(t, "worker-1", cmd.ProcessID)
+	require.Equal(t, "needed the schema", cmd.Details)
+	require.Contains(t, cmd.String(), "process=worker-1")
+}
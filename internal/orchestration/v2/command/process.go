@@ -23,6 +23,7 @@ type SpawnProcessCommand struct {
 	ProcessID      string                 // Optional: specific ID (auto-generated for workers if empty)
 	AgentType      roles.AgentType        // Optional: agent specialization (default: generic)
 	WorkflowConfig *roles.WorkflowConfig  // Optional: workflow-specific prompt customizations
+	RetryProvider  bool                   // Optional: spawn a worker on the task retry provider
 }
 
 // SpawnProcessOption configures a SpawnProcessCommand.
@@ -44,6 +45,14 @@ func WithWorkflowConfig(config *roles.WorkflowConfig) SpawnProcessOption {
 	}
 }
 
+// WithRetryProvider spawns the worker on the provider configured for task
+// retries instead of the regular worker provider.
+func WithRetryProvider() SpawnProcessOption {
+	return func(cmd *SpawnProcessCommand) {
+		cmd.RetryProvider = true
+	}
+}
+
 // NewSpawnProcessCommand creates a new SpawnProcessCommand.
 // Options can be provided to configure optional fields like AgentType.
 func NewSpawnProcessCommand(source CommandSource, role repository.ProcessRole, opts ...SpawnProcessOption) *SpawnProcessCommand {
@@ -95,18 +104,36 @@ func (c *RetireProcessCommand) Validate() error {
 // ReplaceProcessCommand retires a process and spawns a replacement with fresh context.
 type ReplaceProcessCommand struct {
 	*BaseCommand
-	ProcessID string // Required: ID of the process to replace
-	Reason    string // Optional: reason for replacement
+	ProcessID      string // Required: ID of the process to replace
+	Reason         string // Optional: reason for replacement
+	Handoff        bool   // Optional: seed the replacement with the old process's work (context handoff)
+	HandoffSummary string // Optional: summary written by the old process for its replacement
+}
+
+// ReplaceProcessOption configures a ReplaceProcessCommand.
+type ReplaceProcessOption func(*ReplaceProcessCommand)
+
+// WithHandoff marks the replacement as a context handoff. The replacement is
+// seeded with the summary and takes over the old process's task and queue.
+func WithHandoff(summary string) ReplaceProcessOption {
+	return func(cmd *ReplaceProcessCommand) {
+		cmd.Handoff = true
+		cmd.HandoffSummary = summary
+	}
 }
 
 // NewReplaceProcessCommand creates a new ReplaceProcessCommand.
-func NewReplaceProcessCommand(source CommandSource, processID, reason string) *ReplaceProcessCommand {
+func NewReplaceProcessCommand(source CommandSource, processID, reason string, opts ...ReplaceProcessOption) *ReplaceProcessCommand {
 	base := NewBaseCommand(CmdReplaceProcess, source)
-	return &ReplaceProcessCommand{
+	cmd := &ReplaceProcessCommand{
 		BaseCommand: &base,
 		ProcessID:   processID,
 		Reason:      reason,
 	}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
 }
 
 // Validate checks that ProcessID is provided.
@@ -125,18 +152,44 @@ func (c *ReplaceProcessCommand) Validate() error {
 // Works for both coordinator ("coordinator") and workers ("worker-1", etc.).
 type SendToProcessCommand struct {
 	*BaseCommand
-	ProcessID string // Required: ID of the process (e.g., "coordinator", "worker-1")
-	Content   string // Required: message content
+	ProcessID       string                     // Required: ID of the process (e.g., "coordinator", "worker-1")
+	Content         string                     // Required: message content
+	MessagePriority repository.MessagePriority // Optional: queue priority (default: derived from the sender)
+	Interrupt       bool                       // Optional: cancel the current turn and deliver immediately
+}
+
+// SendToProcessOption configures a SendToProcessCommand.
+type SendToProcessOption func(*SendToProcessCommand)
+
+// WithMessagePriority sets the queue priority of the message.
+func WithMessagePriority(priority repository.MessagePriority) SendToProcessOption {
+	return func(cmd *SendToProcessCommand) {
+		cmd.MessagePriority = priority
+	}
+}
+
+// WithInterrupt delivers the message in interrupt mode: a working process has
+// its current turn cancelled and receives the message as soon as it stops.
+func WithInterrupt() SendToProcessOption {
+	return func(cmd *SendToProcessCommand) {
+		cmd.Interrupt = true
+		cmd.MessagePriority = repository.PriorityInterrupt
+	}
 }
 
 // NewSendToProcessCommand creates a new SendToProcessCommand.
-func NewSendToProcessCommand(source CommandSource, processID, content string) *SendToProcessCommand {
+// Options can be provided to set the priority or interrupt mode.
+func NewSendToProcessCommand(source CommandSource, processID, content string, opts ...SendToProcessOption) *SendToProcessCommand {
 	base := NewBaseCommand(CmdSendToProcess, source)
-	return &SendToProcessCommand{
+	cmd := &SendToProcessCommand{
 		BaseCommand: &base,
 		ProcessID:   processID,
 		Content:     content,
 	}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	return cmd
 }
 
 // Validate checks that ProcessID and Content are provided.
@@ -188,6 +241,9 @@ type ProcessTurnCompleteCommand struct {
 	Succeeded bool                  // true if turn completed normally, false if error/cancelled
 	Metrics   *metrics.TokenMetrics // Optional: token usage from this turn
 	Error     error                 // Optional: error if turn failed
+
+	// Interrupted is true if the turn was cancelled to deliver an interrupt message.
+	Interrupted bool
 }
 
 // NewProcessTurnCompleteCommand creates a new ProcessTurnCompleteCommand.
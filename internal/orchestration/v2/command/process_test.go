@@ -75,6 +75,13 @@ func TestSpawnProcessCommand_DefaultAgentType(t *testing.T) {
 	require.Equal(t, roles.AgentTypeGeneric, cmd.AgentType)
 }
 
+func TestSpawnProcessCommand_WithRetryProvider(t *testing.T) {
+	require.False(t, NewSpawnProcessCommand(SourceInternal, repository.RoleWorker).RetryProvider)
+
+	cmd := NewSpawnProcessCommand(SourceInternal, repository.RoleWorker, WithRetryProvider())
+	require.True(t, cmd.RetryProvider)
+}
+
 func TestSpawnProcessCommand_WithAgentType_AllTypes(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -166,6 +173,15 @@ func TestReplaceProcessCommand_StoresReason(t *testing.T) {
 	require.Equal(t, reason, cmd.Reason)
 }
 
+func TestReplaceProcessCommand_WithHandoff(t *testing.T) {
+	cmd := NewReplaceProcessCommand(SourceInternal, "worker-1", "context_handoff")
+	require.False(t, cmd.Handoff)
+
+	cmd = NewReplaceProcessCommand(SourceInternal, "worker-1", "context_handoff", WithHandoff("Parser done, tests left"))
+	require.True(t, cmd.Handoff)
+	require.Equal(t, "Parser done, tests left", cmd.HandoffSummary)
+}
+
 func TestReplaceProcessCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &ReplaceProcessCommand{}
 }
@@ -210,6 +226,19 @@ func TestSendToProcessCommand_WorksWithWorker(t *testing.T) {
 	require.Equal(t, "worker-1", cmd.ProcessID)
 }
 
+func TestSendToProcessCommand_Options(t *testing.T) {
+	cmd := NewSendToProcessCommand(SourceUser, "worker-1", "Hello")
+	require.Zero(t, cmd.MessagePriority, "the handler derives the priority from the sender")
+	require.False(t, cmd.Interrupt)
+
+	cmd = NewSendToProcessCommand(SourceInternal, "worker-1", "Hello", WithMessagePriority(repository.PriorityCoordinator))
+	require.Equal(t, repository.PriorityCoordinator, cmd.MessagePriority)
+
+	cmd = NewSendToProcessCommand(SourceUser, "worker-1", "STOP, wrong file", WithInterrupt())
+	require.True(t, cmd.Interrupt)
+	require.Equal(t, repository.PriorityInterrupt, cmd.MessagePriority)
+}
+
 func TestSendToProcessCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &SendToProcessCommand{}
 }
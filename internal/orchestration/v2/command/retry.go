@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/validation"
+)
+
+// ===========================================================================
+// Retry Commands
+// ===========================================================================
+
+// RetryTaskCommand starts another attempt at a failed task. The worker that
+// failed is retired and a fresh one is spawned for the coordinator to assign
+// the task to. It is submitted by the retry policy once the backoff elapses.
+type RetryTaskCommand struct {
+	*BaseCommand
+	TaskID        string // Required: BD task ID to retry
+	Attempt       int    // Required: number of the attempt about to start (2 for the first retry)
+	WorkerID      string // Optional: worker that failed the previous attempt
+	RetryProvider bool   // Optional: spawn the fresh worker on the retry provider
+}
+
+// NewRetryTaskCommand creates a new RetryTaskCommand.
+func NewRetryTaskCommand(source CommandSource, taskID string, attempt int, workerID string, retryProvider bool) *RetryTaskCommand {
+	base := NewBaseCommand(CmdRetryTask, source)
+	return &RetryTaskCommand{
+		BaseCommand:   &base,
+		TaskID:        taskID,
+		Attempt:       attempt,
+		WorkerID:      workerID,
+		RetryProvider: retryProvider,
+	}
+}
+
+// Validate checks that TaskID has a valid format and Attempt is a retry.
+func (c *RetryTaskCommand) Validate() error {
+	if c.TaskID == "" {
+		return fmt.Errorf("task_id is required")
+	}
+	if !validation.IsValidTaskID(c.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.TaskID)
+	}
+	if c.Attempt < 2 {
+		return fmt.Errorf("attempt must be at least 2, got %d", c.Attempt)
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *RetryTaskCommand) String() string {
+	return fmt.Sprintf("RetryTask{task=%s, attempt=%d, worker=%s}", c.TaskID, c.Attempt, c.WorkerID)
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTaskCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		taskID    string
+		attempt   int
+		errSubstr string
+	}{
+		{name: "valid", taskID: "perles-abc1.2", attempt: 2},
+		{name: "missing task", attempt: 2, errSubstr: "task_id is required"},
+		{name: "invalid task", taskID: "not a task", attempt: 2, errSubstr: "invalid task_id format"},
+		{name: "first attempt", taskID: "perles-abc1.2", attempt: 1, errSubstr: "attempt must be at least 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRetryTaskCommand(SourceInternal, tt.taskID, tt.attempt, "worker-1", false).Validate()
+			if tt.errSubstr != "" {
+				require.ErrorContains(t, err, tt.errSubstr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewRetryTaskCommand(t *testing.T) {
+	cmd := NewRetryTaskCommand(SourceInternal, "perles-abc1.2", 3, "worker-4", true)
+
+	require.Equal(t, CmdRetryTask, cmd.Type())
+	require.Equal(t, SourceInternal, cmd.Source())
+	require.Equal(t, 3, cmd.Attempt)
+	require.Equal(t, "worker-4", cmd.WorkerID)
+	require.True(t, cmd.RetryProvider)
+	require.Contains(t, cmd.String(), "task=perles-abc1.2")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -342,20 +343,41 @@ func (c *MarkTaskCompleteCommand) Validate() error {
 }
 
 // MarkTaskFailedCommand marks a BD task as failed with a reason.
+// With a retry policy, the failure ends the current attempt and the task may
+// be retried on a fresh worker.
 type MarkTaskFailedCommand struct {
 	*BaseCommand
-	TaskID string // Required: BD task ID to mark as failed
-	Reason string // Required: reason for failure
+	TaskID   string                 // Required: BD task ID to mark as failed
+	Reason   string                 // Required: reason for failure
+	Kind     repository.FailureKind // Optional: failure classification (default: gave_up)
+	WorkerID string                 // Optional: worker that failed (default: the task's implementer)
+}
+
+// MarkTaskFailedOption configures a MarkTaskFailedCommand.
+type MarkTaskFailedOption func(*MarkTaskFailedCommand)
+
+// WithFailure records the classification of the failure and the worker it
+// happened on.
+func WithFailure(kind repository.FailureKind, workerID string) MarkTaskFailedOption {
+	return func(cmd *MarkTaskFailedCommand) {
+		cmd.Kind = kind
+		cmd.WorkerID = workerID
+	}
 }
 
 // NewMarkTaskFailedCommand creates a new MarkTaskFailedCommand.
-func NewMarkTaskFailedCommand(source CommandSource, taskID, reason string) *MarkTaskFailedCommand {
+func NewMarkTaskFailedCommand(source CommandSource, taskID, reason string, opts ...MarkTaskFailedOption) *MarkTaskFailedCommand {
 	base := NewBaseCommand(CmdMarkTaskFailed, source)
-	return &MarkTaskFailedCommand{
+	cmd := &MarkTaskFailedCommand{
 		BaseCommand: &base,
 		TaskID:      taskID,
 		Reason:      reason,
+		Kind:        repository.FailureGaveUp,
+	}
+	for _, opt := range opts {
+		opt(cmd)
 	}
+	return cmd
 }
 
 // Validate checks that TaskID and Reason are provided and TaskID has a valid format.
@@ -369,6 +391,9 @@ func (c *MarkTaskFailedCommand) Validate() error {
 	if c.Reason == "" {
 		return fmt.Errorf("reason is required")
 	}
+	if !c.Kind.IsValid() {
+		return fmt.Errorf("invalid failure kind: %s", c.Kind)
+	}
 	return nil
 }
 
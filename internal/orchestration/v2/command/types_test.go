@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -841,6 +842,21 @@ func TestMarkTaskFailedCommand_Type(t *testing.T) {
 	require.Equal(t, CmdMarkTaskFailed, cmd.Type())
 }
 
+func TestMarkTaskFailedCommand_WithFailure(t *testing.T) {
+	cmd := NewMarkTaskFailedCommand(SourceMCPTool, "perles-abc1", "gave up")
+	require.Equal(t, repository.FailureGaveUp, cmd.Kind)
+	require.Empty(t, cmd.WorkerID)
+
+	cmd = NewMarkTaskFailedCommand(SourceInternal, "perles-abc1", "429 Too Many Requests",
+		WithFailure(repository.FailureRateLimit, "worker-2"))
+	require.Equal(t, repository.FailureRateLimit, cmd.Kind)
+	require.Equal(t, "worker-2", cmd.WorkerID)
+	require.NoError(t, cmd.Validate())
+
+	cmd = NewMarkTaskFailedCommand(SourceInternal, "perles-abc1", "boom", WithFailure("crashed", "worker-2"))
+	require.ErrorContains(t, cmd.Validate(), "invalid failure kind")
+}
+
 func TestMarkTaskFailedCommand_ImplementsCommand(t *testing.T) {
 	var _ Command = &MarkTaskFailedCommand{}
 }
@@ -0,0 +1,274 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handlers for human approval commands.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/sound"
+)
+
+// ===========================================================================
+// RequireApprovalHandler
+// ===========================================================================
+
+// RequireApprovalHandler handles CmdRequireApproval commands.
+// It pauses the workers of the held tasks, records the pending approval, plays
+// the approval_required sound and emits a ProcessApprovalRequested event.
+type RequireApprovalHandler struct {
+	processRepo  repository.ProcessRepository
+	taskRepo     repository.TaskRepository
+	approvalRepo repository.ApprovalRepository
+	soundService sound.SoundService
+}
+
+// RequireApprovalHandlerOption configures RequireApprovalHandler.
+type RequireApprovalHandlerOption func(*RequireApprovalHandler)
+
+// WithRequireApprovalSoundService sets the sound service for audio feedback on approval requests.
+// If svc is nil, the handler keeps its default NoopSoundService.
+func WithRequireApprovalSoundService(svc sound.SoundService) RequireApprovalHandlerOption {
+	return func(h *RequireApprovalHandler) {
+		if svc != nil {
+			h.soundService = svc
+		}
+	}
+}
+
+// NewRequireApprovalHandler creates a new RequireApprovalHandler.
+func NewRequireApprovalHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+	approvalRepo repository.ApprovalRepository,
+	opts ...RequireApprovalHandlerOption,
+) *RequireApprovalHandler {
+	h := &RequireApprovalHandler{
+		processRepo:  processRepo,
+		taskRepo:     taskRepo,
+		approvalRepo: approvalRepo,
+		soundService: sound.NoopSoundService{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes a RequireApprovalCommand.
+// 1. Validates the command and that no task is already held by another approval
+// 2. Finds the implementers and reviewers of the held tasks that are still running
+// 3. Saves the pending approval
+// 4. Plays the approval_required sound and emits ProcessApprovalRequested
+// 5. Returns PauseProcess follow-ups for the workers
+func (h *RequireApprovalHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	approvalCmd := cmd.(*command.RequireApprovalCommand)
+
+	// 1. Validate the command
+	if err := approvalCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	for _, taskID := range approvalCmd.TaskIDs {
+		if held, ok := h.approvalRepo.ForTask(taskID); ok {
+			return nil, fmt.Errorf("%w: %s is held by %s", types.ErrAwaitingApproval, taskID, held.ID)
+		}
+	}
+
+	approval := &repository.Approval{
+		ID:          approvalCmd.ApprovalID,
+		Summary:     approvalCmd.Summary,
+		Diff:        approvalCmd.Diff,
+		Artifacts:   approvalCmd.Artifacts,
+		TaskIDs:     approvalCmd.TaskIDs,
+		RequestedAt: time.Now(),
+	}
+
+	// 2. Find the workers to pause. Tasks nobody works on, such as approval
+	// gates, have no assignment and hold no workers.
+	for _, taskID := range approvalCmd.TaskIDs {
+		task, err := h.taskRepo.Get(taskID)
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task %s: %w", taskID, err)
+		}
+
+		for _, workerID := range []string{task.Implementer, task.Reviewer} {
+			if workerID == "" || slices.Contains(approval.PausedProcessIDs, workerID) {
+				continue
+			}
+			proc, err := h.processRepo.Get(workerID)
+			if errors.Is(err, repository.ErrProcessNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get process %s: %w", workerID, err)
+			}
+			if proc.Status != repository.StatusReady && proc.Status != repository.StatusWorking {
+				continue
+			}
+			approval.PausedProcessIDs = append(approval.PausedProcessIDs, workerID)
+			if proc.Status == repository.StatusWorking {
+				approval.InterruptedProcessIDs = append(approval.InterruptedProcessIDs, workerID)
+			}
+		}
+	}
+
+	// 3. Save the pending approval
+	if err := h.approvalRepo.Save(approval); err != nil {
+		return nil, fmt.Errorf("failed to save approval: %w", err)
+	}
+
+	// 4. Alert the user
+	h.soundService.Play("greeting", "approval_required")
+
+	requested := *approval
+	event := events.ProcessEvent{
+		Type:      events.ProcessApprovalRequested,
+		ProcessID: repository.CoordinatorID,
+		Role:      events.RoleCoordinator,
+		Output:    approval.Summary,
+		Approval:  &requested,
+	}
+
+	// 5. Pause the workers until the approval is resolved
+	followUps := make([]command.Command, 0, len(approval.PausedProcessIDs))
+	for _, workerID := range approval.PausedProcessIDs {
+		followUps = append(followUps,
+			command.NewPauseProcessCommand(command.SourceInternal, workerID, "awaiting approval "+approval.ID))
+	}
+
+	result := &RequireApprovalResult{
+		ApprovalID:       approval.ID,
+		PausedProcessIDs: approval.PausedProcessIDs,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{event}, followUps), nil
+}
+
+// RequireApprovalResult contains the result of requesting an approval.
+type RequireApprovalResult struct {
+	ApprovalID       string
+	PausedProcessIDs []string
+}
+
+// ===========================================================================
+// ResolveApprovalHandler
+// ===========================================================================
+
+// ResolveApprovalHandler handles CmdResolveApproval commands.
+// It records the decision on the pending approval, resumes the workers paused
+// for it and tells the coordinator the decision.
+type ResolveApprovalHandler struct {
+	approvalRepo repository.ApprovalRepository
+}
+
+// NewResolveApprovalHandler creates a new ResolveApprovalHandler.
+func NewResolveApprovalHandler(approvalRepo repository.ApprovalRepository) *ResolveApprovalHandler {
+	return &ResolveApprovalHandler{
+		approvalRepo: approvalRepo,
+	}
+}
+
+// Handle processes a ResolveApprovalCommand.
+// 1. Validates the command and records the decision
+// 2. Emits ProcessApprovalResolved
+// 3. Returns follow-ups that resume the paused workers, tell interrupted
+// workers to continue and send the decision to the coordinator
+func (h *ResolveApprovalHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	resolveCmd := cmd.(*command.ResolveApprovalCommand)
+
+	// 1. Validate and record the decision. The resolved approval is kept so
+	// granted approval gates can be marked complete.
+	if err := resolveCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	pending, err := h.approvalRepo.Get(resolveCmd.ApprovalID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, resolveCmd.ApprovalID)
+	}
+
+	resolved := *pending
+	resolved.Approved = resolveCmd.Approved
+	resolved.Feedback = resolveCmd.Feedback
+	resolved.ResolvedAt = time.Now()
+	if err := h.approvalRepo.Save(&resolved); err != nil {
+		return nil, fmt.Errorf("failed to save approval: %w", err)
+	}
+
+	// 2. Build ProcessApprovalResolved event
+	event := events.ProcessEvent{
+		Type:      events.ProcessApprovalResolved,
+		ProcessID: repository.CoordinatorID,
+		Role:      events.RoleCoordinator,
+		Output:    resolved.Summary,
+		Approval:  &resolved,
+	}
+
+	// 3. Resume workers before messaging them, so delivery is not skipped
+	var followUps []command.Command
+	for _, workerID := range resolved.PausedProcessIDs {
+		followUps = append(followUps, command.NewResumeProcessCommand(command.SourceInternal, workerID))
+		if slices.Contains(resolved.InterruptedProcessIDs, workerID) {
+			followUps = append(followUps,
+				command.NewSendToProcessCommand(command.SourceInternal, workerID, workerApprovalMessage(&resolved)))
+		}
+	}
+	followUps = append(followUps,
+		command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, coordinatorApprovalMessage(&resolved)))
+
+	result := &ResolveApprovalResult{
+		ApprovalID:        resolved.ID,
+		Approved:          resolved.Approved,
+		ResumedProcessIDs: resolved.PausedProcessIDs,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{event}, followUps), nil
+}
+
+// ResolveApprovalResult contains the result of resolving an approval.
+type ResolveApprovalResult struct {
+	ApprovalID        string
+	Approved          bool
+	ResumedProcessIDs []string
+}
+
+// coordinatorApprovalMessage tells the coordinator how the user decided.
+func coordinatorApprovalMessage(approval *repository.Approval) string {
+	var b strings.Builder
+	if approval.Approved {
+		fmt.Fprintf(&b, "[APPROVAL GRANTED] The user approved %s: %s\n", approval.ID, approval.Summary)
+	} else {
+		fmt.Fprintf(&b, "[APPROVAL REJECTED] The user rejected %s: %s\n", approval.ID, approval.Summary)
+	}
+	if len(approval.TaskIDs) > 0 {
+		fmt.Fprintf(&b, "Tasks: %s\n", strings.Join(approval.TaskIDs, ", "))
+	}
+	if approval.Feedback != "" {
+		fmt.Fprintf(&b, "Feedback: %s\n", approval.Feedback)
+	}
+	if approval.Approved {
+		b.WriteString("\nContinue the workflow. Mark approved gate tasks complete with mark_task_complete.")
+	} else {
+		b.WriteString("\nDo not proceed with the rejected work. Address the feedback, then request approval again.")
+	}
+	return b.String()
+}
+
+// workerApprovalMessage tells a worker whose turn was cut short by an approval
+// request how to carry on.
+func workerApprovalMessage(approval *repository.Approval) string {
+	if approval.Approved {
+		return "[APPROVAL GRANTED] Your task was paused for human approval and has been approved. Continue where you left off."
+	}
+	return "[APPROVAL REJECTED] Your task was paused for human approval and was rejected. Wait for the coordinator's instructions."
+}
@@ -0,0 +1,243 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// approvalFixture holds the repositories for approval handler tests. worker-1
+// is implementing perles-abc1.2 and worker-2 is idle after reviewing it.
+type approvalFixture struct {
+	processRepo  *repository.MemoryProcessRepository
+	taskRepo     *repository.MemoryTaskRepository
+	approvalRepo *repository.MemoryApprovalRepository
+}
+
+func newApprovalFixture(t *testing.T) approvalFixture {
+	t.Helper()
+	f := approvalFixture{
+		processRepo:  repository.NewMemoryProcessRepository(),
+		taskRepo:     repository.NewMemoryTaskRepository(),
+		approvalRepo: repository.NewMemoryApprovalRepository(),
+	}
+
+	implementing := events.ProcessPhaseImplementing
+	idle := events.ProcessPhaseIdle
+	f.processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  &implementing,
+		TaskID: "perles-abc1.2",
+	})
+	f.processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  &idle,
+	})
+	require.NoError(t, f.taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskImplementing,
+		StartedAt:   time.Now(),
+	}))
+	return f
+}
+
+// ===========================================================================
+// RequireApprovalHandler Tests
+// ===========================================================================
+
+func TestRequireApprovalHandler_PausesWorkersAndRecordsApproval(t *testing.T) {
+	f := newApprovalFixture(t)
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo)
+
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool,
+		"Apply the billing migration to prod", "+ALTER TABLE invoices", []string{"docs/migration.md"}, []string{"perles-abc1.2"})
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	data := result.Data.(*handler.RequireApprovalResult)
+	require.Equal(t, cmd.ApprovalID, data.ApprovalID)
+	require.Equal(t, []string{"worker-1", "worker-2"}, data.PausedProcessIDs)
+
+	// Both workers are paused
+	require.Len(t, result.FollowUp, 2)
+	for i, workerID := range []string{"worker-1", "worker-2"} {
+		pause, ok := result.FollowUp[i].(*command.PauseProcessCommand)
+		require.True(t, ok, "expected PauseProcessCommand, got: %T", result.FollowUp[i])
+		require.Equal(t, workerID, pause.ProcessID)
+	}
+
+	// Only the working implementer was interrupted
+	pending, err := f.approvalRepo.Get(cmd.ApprovalID)
+	require.NoError(t, err)
+	require.Equal(t, "Apply the billing migration to prod", pending.Summary)
+	require.Equal(t, []string{"worker-1"}, pending.InterruptedProcessIDs)
+
+	require.Len(t, result.Events, 1)
+	event := result.Events[0].(events.ProcessEvent)
+	require.Equal(t, events.ProcessApprovalRequested, event.Type)
+	require.Equal(t, repository.CoordinatorID, event.ProcessID)
+	require.NotNil(t, event.Approval)
+	require.Equal(t, "+ALTER TABLE invoices", event.Approval.Diff)
+	require.Equal(t, []string{"docs/migration.md"}, event.Approval.Artifacts)
+}
+
+func TestRequireApprovalHandler_GateTaskPausesNobody(t *testing.T) {
+	f := newApprovalFixture(t)
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo)
+
+	// Gate tasks are never assigned, so there is no task assignment to pause
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool, "Ship to prod?", "", nil, []string{"perles-abc1.9"})
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+
+	held, ok := f.approvalRepo.ForTask("perles-abc1.9")
+	require.True(t, ok)
+	require.Equal(t, cmd.ApprovalID, held.ID)
+}
+
+func TestRequireApprovalHandler_FailsIfTaskAlreadyHeld(t *testing.T) {
+	f := newApprovalFixture(t)
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo)
+
+	first := command.NewRequireApprovalCommand(command.SourceMCPTool, "First", "", nil, []string{"perles-abc1.2"})
+	_, err := h.Handle(context.Background(), first)
+	require.NoError(t, err)
+
+	second := command.NewRequireApprovalCommand(command.SourceMCPTool, "Second", "", nil, []string{"perles-abc1.2"})
+	_, err = h.Handle(context.Background(), second)
+
+	require.ErrorIs(t, err, types.ErrAwaitingApproval)
+	require.Len(t, f.approvalRepo.Pending(), 1)
+}
+
+func TestRequireApprovalHandler_FailsValidation(t *testing.T) {
+	f := newApprovalFixture(t)
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo)
+
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool, "", "", nil, nil)
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "validation failed")
+	require.Empty(t, f.approvalRepo.Pending())
+}
+
+func TestRequireApprovalHandler_PlaysApprovalSound(t *testing.T) {
+	f := newApprovalFixture(t)
+	soundService := mocks.NewMockSoundService(t)
+	soundService.EXPECT().Play("greeting", "approval_required").Once()
+
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo,
+		handler.WithRequireApprovalSoundService(soundService))
+
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool, "Deploy?", "", nil, nil)
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+}
+
+// ===========================================================================
+// ResolveApprovalHandler Tests
+// ===========================================================================
+
+// requestApproval holds perles-abc1.2 and returns the approval ID.
+func requestApproval(t *testing.T, f approvalFixture) string {
+	t.Helper()
+	h := handler.NewRequireApprovalHandler(f.processRepo, f.taskRepo, f.approvalRepo)
+	cmd := command.NewRequireApprovalCommand(command.SourceMCPTool, "Deploy the API", "", nil, []string{"perles-abc1.2"})
+	_, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	return cmd.ApprovalID
+}
+
+func TestResolveApprovalHandler_ApproveResumesWorkers(t *testing.T) {
+	f := newApprovalFixture(t)
+	approvalID := requestApproval(t, f)
+	h := handler.NewResolveApprovalHandler(f.approvalRepo)
+
+	cmd := command.NewResolveApprovalCommand(command.SourceUser, approvalID, true, "")
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	_, ok := f.approvalRepo.ForTask("perles-abc1.2")
+	require.False(t, ok, "resolved approval no longer holds the task")
+	require.True(t, f.approvalRepo.Granted("perles-abc1.2"), "the decision is kept")
+	_, err = f.approvalRepo.Get(approvalID)
+	require.ErrorIs(t, err, repository.ErrApprovalNotFound, "the approval is no longer pending")
+
+	// Resume worker-1, tell it to continue, resume worker-2, tell the coordinator
+	require.Len(t, result.FollowUp, 4)
+	resume, ok := result.FollowUp[0].(*command.ResumeProcessCommand)
+	require.True(t, ok, "expected ResumeProcessCommand, got: %T", result.FollowUp[0])
+	require.Equal(t, "worker-1", resume.ProcessID)
+
+	send, ok := result.FollowUp[1].(*command.SendToProcessCommand)
+	require.True(t, ok, "expected SendToProcessCommand, got: %T", result.FollowUp[1])
+	require.Equal(t, "worker-1", send.ProcessID)
+	require.Contains(t, send.Content, "[APPROVAL GRANTED]")
+
+	resume, ok = result.FollowUp[2].(*command.ResumeProcessCommand)
+	require.True(t, ok, "expected ResumeProcessCommand, got: %T", result.FollowUp[2])
+	require.Equal(t, "worker-2", resume.ProcessID)
+
+	send, ok = result.FollowUp[3].(*command.SendToProcessCommand)
+	require.True(t, ok, "expected SendToProcessCommand, got: %T", result.FollowUp[3])
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Contains(t, send.Content, "[APPROVAL GRANTED]")
+	require.Contains(t, send.Content, "perles-abc1.2")
+
+	require.Len(t, result.Events, 1)
+	event := result.Events[0].(events.ProcessEvent)
+	require.Equal(t, events.ProcessApprovalResolved, event.Type)
+	require.True(t, event.Approval.Approved)
+}
+
+func TestResolveApprovalHandler_RejectSendsFeedback(t *testing.T) {
+	f := newApprovalFixture(t)
+	approvalID := requestApproval(t, f)
+	h := handler.NewResolveApprovalHandler(f.approvalRepo)
+
+	cmd := command.NewResolveApprovalCommand(command.SourceUser, approvalID, false, "Run it against staging first")
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+
+	send := result.FollowUp[len(result.FollowUp)-1].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Contains(t, send.Content, "[APPROVAL REJECTED]")
+	require.Contains(t, send.Content, "Feedback: Run it against staging first")
+
+	data := result.Data.(*handler.ResolveApprovalResult)
+	require.False(t, data.Approved)
+	require.Equal(t, []string{"worker-1", "worker-2"}, data.ResumedProcessIDs)
+}
+
+func TestResolveApprovalHandler_FailsForUnknownApproval(t *testing.T) {
+	h := handler.NewResolveApprovalHandler(repository.NewMemoryApprovalRepository())
+
+	cmd := command.NewResolveApprovalCommand(command.SourceUser, "approval-missing", true, "")
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, repository.ErrApprovalNotFound)
+}
@@ -6,11 +6,18 @@ package handler
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // ===========================================================================
@@ -23,26 +30,54 @@ import (
 type MarkTaskCompleteHandler struct {
 	bdExecutor appbeads.IssueExecutor
 	taskRepo   repository.TaskRepository
+	approvals  repository.ApprovalRepository
+}
+
+// MarkTaskCompleteHandlerOption configures MarkTaskCompleteHandler.
+type MarkTaskCompleteHandlerOption func(*MarkTaskCompleteHandler)
+
+// WithMarkTaskCompleteApprovals refuses to complete tasks held by a pending
+// approval, and approval gates that no granted approval covers.
+func WithMarkTaskCompleteApprovals(approvals repository.ApprovalRepository) MarkTaskCompleteHandlerOption {
+	return func(h *MarkTaskCompleteHandler) {
+		h.approvals = approvals
+	}
 }
 
 // NewMarkTaskCompleteHandler creates a new MarkTaskCompleteHandler.
 // Panics if bdExecutor is nil.
 // taskRepo can be nil for backward compatibility (graceful degradation).
-func NewMarkTaskCompleteHandler(bdExecutor appbeads.IssueExecutor, taskRepo repository.TaskRepository) *MarkTaskCompleteHandler {
+func NewMarkTaskCompleteHandler(
+	bdExecutor appbeads.IssueExecutor,
+	taskRepo repository.TaskRepository,
+	opts ...MarkTaskCompleteHandlerOption,
+) *MarkTaskCompleteHandler {
 	if bdExecutor == nil {
 		panic("bdExecutor is required for MarkTaskCompleteHandler")
 	}
-	return &MarkTaskCompleteHandler{
+	h := &MarkTaskCompleteHandler{
 		bdExecutor: bdExecutor,
 		taskRepo:   taskRepo,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes a MarkTaskCompleteCommand.
 // It updates the BD task status to "closed" and adds a completion comment.
+// Tasks awaiting a human decision are refused.
 func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	markCmd := cmd.(*command.MarkTaskCompleteCommand)
 
+	// 0. Closing a gate unblocks its dependents, so only a human may open it
+	if h.approvals != nil {
+		if err := h.checkApproval(markCmd.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
 	// 1. Update task status to closed
 	if err := h.bdExecutor.UpdateStatus(markCmd.TaskID, beads.StatusClosed); err != nil {
 		return nil, fmt.Errorf("failed to update BD task status: %w", err)
@@ -68,6 +103,23 @@ func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Comman
 	return SuccessResult(result), nil
 }
 
+// checkApproval refuses tasks held by a pending approval, and approval gates
+// that no granted approval covers.
+func (h *MarkTaskCompleteHandler) checkApproval(taskID string) error {
+	if held, ok := h.approvals.ForTask(taskID); ok {
+		return fmt.Errorf("%w: %s is held by %s", types.ErrAwaitingApproval, taskID, held.ID)
+	}
+
+	issue, err := h.bdExecutor.ShowIssue(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get bd issue: %w", err)
+	}
+	if issue != nil && issue.IsApprovalGate() && !h.approvals.Granted(taskID) {
+		return fmt.Errorf("%w: %s has not been approved, use require_approval and wait for the user", types.ErrApprovalGate, taskID)
+	}
+	return nil
+}
+
 // MarkTaskCompleteResult contains the result of marking a task as complete.
 type MarkTaskCompleteResult struct {
 	TaskID string
@@ -79,43 +131,201 @@ type MarkTaskCompleteResult struct {
 
 // MarkTaskFailedHandler handles CmdMarkTaskFailed commands.
 // It adds a failure comment to the BD task with the provided reason.
+// With a retry policy, the failure ends the current attempt: the comment
+// records the attempt history and the next attempt is scheduled.
 type MarkTaskFailedHandler struct {
-	bdExecutor appbeads.IssueExecutor
+	bdExecutor  appbeads.IssueExecutor
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+	retries     *TaskRetries
+}
+
+// MarkTaskFailedHandlerOption configures MarkTaskFailedHandler.
+type MarkTaskFailedHandlerOption func(*MarkTaskFailedHandler)
+
+// WithMarkTaskFailedRetries applies the retry policy of retries to failed tasks.
+// processRepo and taskRepo identify the worker and provider of the failed attempt.
+func WithMarkTaskFailedRetries(
+	retries *TaskRetries,
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+) MarkTaskFailedHandlerOption {
+	return func(h *MarkTaskFailedHandler) {
+		h.retries = retries
+		h.processRepo = processRepo
+		h.taskRepo = taskRepo
+	}
 }
 
 // NewMarkTaskFailedHandler creates a new MarkTaskFailedHandler.
 // Panics if bdExecutor is nil.
-func NewMarkTaskFailedHandler(bdExecutor appbeads.IssueExecutor) *MarkTaskFailedHandler {
+func NewMarkTaskFailedHandler(bdExecutor appbeads.IssueExecutor, opts ...MarkTaskFailedHandlerOption) *MarkTaskFailedHandler {
 	if bdExecutor == nil {
 		panic("bdExecutor is required for MarkTaskFailedHandler")
 	}
-	return &MarkTaskFailedHandler{
+	h := &MarkTaskFailedHandler{
 		bdExecutor: bdExecutor,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes a MarkTaskFailedCommand.
-// It adds a failure comment to the BD task with the provided reason.
+// It adds a failure comment to the BD task with the provided reason, and
+// schedules a retry when the retry policy allows one.
 func (h *MarkTaskFailedHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	markCmd := cmd.(*command.MarkTaskFailedCommand)
 
-	// 1. Add failure comment with reason
-	comment := fmt.Sprintf("Task failed: %s", markCmd.Reason)
+	if !h.retries.Enabled() {
+		// 1. Add failure comment with reason
+		comment := fmt.Sprintf("Task failed: %s", markCmd.Reason)
+		if err := h.bdExecutor.AddComment(markCmd.TaskID, "coordinator", comment); err != nil {
+			return nil, fmt.Errorf("failed to add BD comment: %w", err)
+		}
+
+		// 2. Return success result
+		result := &MarkTaskFailedResult{
+			TaskID: markCmd.TaskID,
+			Reason: markCmd.Reason,
+		}
+
+		return SuccessResult(result), nil
+	}
+
+	// 1. Decide whether the task gets another attempt. Attempts that failed
+	// before a restart are counted from the task's history
+	workerID := h.failedWorker(markCmd)
+	h.retries.RestoreAttempts(markCmd.TaskID, func() int { return h.recordedAttempts(markCmd.TaskID) })
+	decision := h.retries.RecordFailure(markCmd.TaskID, markCmd.Kind)
+
+	// 2. Record the attempt in the task's history
+	comment := h.attemptComment(markCmd, workerID, decision)
 	if err := h.bdExecutor.AddComment(markCmd.TaskID, "coordinator", comment); err != nil {
 		return nil, fmt.Errorf("failed to add BD comment: %w", err)
 	}
 
-	// 2. Return success result
+	// 3. Schedule the next attempt
+	if decision.Retry {
+		retryCmd := command.NewRetryTaskCommand(command.SourceInternal, markCmd.TaskID,
+			decision.Attempt+1, workerID, decision.RetryProvider)
+		h.retries.Schedule(retryCmd, decision.Delay)
+	}
+
+	log.Info(log.CatOrch, "Task attempt failed",
+		"taskID", markCmd.TaskID, "workerID", workerID, "kind", markCmd.Kind,
+		"attempt", decision.Attempt, "retry", decision.Retry, "delay", decision.Delay)
+
 	result := &MarkTaskFailedResult{
-		TaskID: markCmd.TaskID,
-		Reason: markCmd.Reason,
+		TaskID:      markCmd.TaskID,
+		Reason:      markCmd.Reason,
+		Kind:        markCmd.Kind,
+		Attempt:     decision.Attempt,
+		MaxAttempts: decision.MaxAttempts,
+		Retrying:    decision.Retry,
+		RetryIn:     decision.Delay,
 	}
 
-	return SuccessResult(result), nil
+	// 4. The coordinator did not see failures detected by the orchestrator
+	if markCmd.Source() != command.SourceInternal {
+		return SuccessResult(result), nil
+	}
+	var msg string
+	if decision.Retry {
+		msg = prompt.TaskAttemptFailedMessage(markCmd.TaskID, workerID, string(markCmd.Kind),
+			markCmd.Reason, decision.Attempt, decision.MaxAttempts, decision.Delay)
+	} else {
+		msg = prompt.TaskGaveUpMessage(markCmd.TaskID, workerID, string(markCmd.Kind),
+			markCmd.Reason, decision.Attempt)
+	}
+	notifyCmd := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, msg)
+	return SuccessWithEventsAndFollowUp(result, nil, []command.Command{notifyCmd}), nil
+}
+
+// failedWorker returns the worker the attempt failed on, defaulting to the
+// implementer of the task.
+func (h *MarkTaskFailedHandler) failedWorker(markCmd *command.MarkTaskFailedCommand) string {
+	if markCmd.WorkerID != "" || h.taskRepo == nil {
+		return markCmd.WorkerID
+	}
+	if task, err := h.taskRepo.Get(markCmd.TaskID); err == nil {
+		return task.Implementer
+	}
+	return ""
+}
+
+// attemptCommentPattern matches the attempt comments written by attemptComment.
+var attemptCommentPattern = regexp.MustCompile(`^Attempt (\d+)/\d+ failed`)
+
+// recordedAttempts returns the number of failed attempts recorded in the
+// task's comments, or 0 if they can't be read.
+func (h *MarkTaskFailedHandler) recordedAttempts(taskID string) int {
+	issue, err := h.bdExecutor.ShowIssue(taskID)
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to read task attempt history", "taskID", taskID, "error", err)
+		return 0
+	}
+	attempts := 0
+	for _, comment := range issue.Comments {
+		if comment.Author != "coordinator" {
+			continue
+		}
+		if match := attemptCommentPattern.FindStringSubmatch(comment.Text); match != nil {
+			if n, err := strconv.Atoi(match[1]); err == nil {
+				attempts = max(attempts, n)
+			}
+		}
+	}
+	return attempts
+}
+
+// attemptComment describes a failed attempt for the task's history, e.g.
+// "Attempt 1/3 failed (rate_limit) on worker-2 (claude): 429. Retrying in 30s on a fresh worker (amp)."
+func (h *MarkTaskFailedHandler) attemptComment(markCmd *command.MarkTaskFailedCommand, workerID string, decision RetryDecision) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Attempt %d/%d failed (%s)", decision.Attempt, decision.MaxAttempts, markCmd.Kind)
+	if workerID != "" {
+		fmt.Fprintf(&b, " on %s", workerID)
+		if h.processRepo != nil {
+			if proc, err := h.processRepo.Get(workerID); err == nil {
+				fmt.Fprintf(&b, " (%s)", h.retries.ProviderOf(proc))
+			}
+		}
+	}
+	fmt.Fprintf(&b, ": %s.", markCmd.Reason)
+
+	switch {
+	case decision.Retry:
+		fmt.Fprintf(&b, " Retrying in %s on a fresh worker (%s).",
+			decision.Delay, h.retries.workerProvider(decision.RetryProvider))
+	case decision.Attempt >= decision.MaxAttempts:
+		fmt.Fprintf(&b, " Giving up after %d attempts.", decision.Attempt)
+	default:
+		fmt.Fprintf(&b, " Not retrying %s failures.", markCmd.Kind)
+	}
+	return b.String()
 }
 
 // MarkTaskFailedResult contains the result of marking a task as failed.
 type MarkTaskFailedResult struct {
 	TaskID string
 	Reason string
+
+	// Retry policy outcome, zero when retries are disabled
+	Kind        repository.FailureKind
+	Attempt     int
+	MaxAttempts int
+	Retrying    bool
+	RetryIn     time.Duration
+}
+
+// RetryNotice tells the coordinator about the scheduled retry, or returns ""
+// if the task is not retried.
+func (r *MarkTaskFailedResult) RetryNotice() string {
+	if !r.Retrying {
+		return ""
+	}
+	return fmt.Sprintf("Attempt %d/%d failed. The task will be retried on a fresh worker in %s. "+
+		"DO NOT reassign it yourself, you will be told when the retry starts.", r.Attempt, r.MaxAttempts, r.RetryIn)
 }
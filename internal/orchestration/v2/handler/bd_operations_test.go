@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 )
 
 // ===========================================================================
@@ -153,6 +155,53 @@ func TestMarkTaskCompleteHandler_WorksWithNilTaskRepo(t *testing.T) {
 	require.Equal(t, "perles-abc1.2", completeResult.TaskID)
 }
 
+func TestMarkTaskCompleteHandler_FailsWhileAwaitingApproval(t *testing.T) {
+	approvals := repository.NewMemoryApprovalRepository()
+	require.NoError(t, approvals.Save(&repository.Approval{ID: "approval-1", TaskIDs: []string{"perles-abc1.2"}}))
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, nil, WithMarkTaskCompleteApprovals(approvals))
+
+	_, err := handler.Handle(context.Background(), command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2"))
+
+	require.ErrorIs(t, err, types.ErrAwaitingApproval)
+	require.ErrorContains(t, err, "held by approval-1")
+}
+
+func TestMarkTaskCompleteHandler_ApprovalGate(t *testing.T) {
+	approvals := repository.NewMemoryApprovalRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.3").Return(&beads.Issue{
+		ID:     "perles-abc1.3",
+		Labels: []string{beads.LabelApprovalGate},
+	}, nil)
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, nil, WithMarkTaskCompleteApprovals(approvals))
+	cmd := command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.3")
+
+	// Without an approval the gate stays open
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrApprovalGate)
+
+	// A rejected approval does not open it either
+	require.NoError(t, approvals.Save(&repository.Approval{
+		ID: "approval-1", TaskIDs: []string{"perles-abc1.3"}, ResolvedAt: time.Now(),
+	}))
+	_, err = handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrApprovalGate)
+
+	// Once the user grants an approval covering the gate, it can be closed
+	require.NoError(t, approvals.Save(&repository.Approval{
+		ID: "approval-2", TaskIDs: []string{"perles-abc1.3"}, Approved: true, ResolvedAt: time.Now(),
+	}))
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.3", beads.StatusClosed).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.3", "coordinator", "Task completed").Return(nil)
+
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
 // ===========================================================================
 // MarkTaskFailedHandler Tests
 // ===========================================================================
@@ -0,0 +1,133 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the context handoff tracker and the handler for handoff summaries.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ContextHandoffReason is the ReplaceProcessCommand reason for context handoffs.
+const ContextHandoffReason = "context_handoff"
+
+// ===========================================================================
+// ContextHandoffs
+// ===========================================================================
+
+// ContextHandoffs tracks processes that crossed the context handoff threshold.
+// A handoff runs over two turns: the turn that crosses the threshold asks the
+// process for a summary, and the turn that follows replaces it. Safe for
+// concurrent use.
+type ContextHandoffs struct {
+	threshold float64
+
+	mu        sync.Mutex
+	requested map[string]bool   // processID -> summary requested
+	summaries map[string]string // processID -> submitted summary
+}
+
+// NewContextHandoffs creates a tracker that hands off processes whose context
+// window usage reaches threshold percent. A threshold of 0 disables handoffs.
+func NewContextHandoffs(threshold float64) *ContextHandoffs {
+	return &ContextHandoffs{
+		threshold: threshold,
+		requested: make(map[string]bool),
+		summaries: make(map[string]string),
+	}
+}
+
+// ShouldRequest reports whether proc crossed the threshold and has not been
+// asked for a handoff yet. Observers are never handed off.
+func (c *ContextHandoffs) ShouldRequest(proc *repository.Process) bool {
+	if c == nil || c.threshold <= 0 || proc.Metrics == nil || proc.Role == repository.RoleObserver {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.requested[proc.ID] && proc.Metrics.ContextUsage() >= c.threshold
+}
+
+// MarkRequested records that processID was asked for a handoff summary.
+func (c *ContextHandoffs) MarkRequested(processID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requested[processID] = true
+}
+
+// SubmitSummary stores the handoff summary of processID. Returns false if no
+// handoff was requested from the process.
+func (c *ContextHandoffs) SubmitSummary(processID, summary string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.requested[processID] {
+		return false
+	}
+	c.summaries[processID] = summary
+	return true
+}
+
+// Take returns the summary of a requested handoff and forgets the handoff.
+// ok is false if no handoff was requested from processID. The summary is
+// empty if the process did not submit one.
+func (c *ContextHandoffs) Take(processID string) (summary string, ok bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.requested[processID] {
+		return "", false
+	}
+	summary = c.summaries[processID]
+	delete(c.requested, processID)
+	delete(c.summaries, processID)
+	return summary, true
+}
+
+// ===========================================================================
+// SubmitHandoffHandler
+// ===========================================================================
+
+// SubmitHandoffHandler handles CmdSubmitHandoff commands.
+// It stores the summary until the process's turn ends and it is replaced.
+type SubmitHandoffHandler struct {
+	processRepo repository.ProcessRepository
+	handoffs    *ContextHandoffs
+}
+
+// NewSubmitHandoffHandler creates a new SubmitHandoffHandler.
+func NewSubmitHandoffHandler(processRepo repository.ProcessRepository, handoffs *ContextHandoffs) *SubmitHandoffHandler {
+	return &SubmitHandoffHandler{processRepo: processRepo, handoffs: handoffs}
+}
+
+// Handle processes a SubmitHandoffCommand.
+// Fails if the process was not asked for a handoff.
+func (h *SubmitHandoffHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	handoffCmd := cmd.(*command.SubmitHandoffCommand)
+
+	if err := handoffCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if _, err := h.processRepo.Get(handoffCmd.ProcessID); err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	if h.handoffs == nil || !h.handoffs.SubmitSummary(handoffCmd.ProcessID, handoffCmd.Summary) {
+		return nil, fmt.Errorf("no context handoff was requested from %s", handoffCmd.ProcessID)
+	}
+
+	return SuccessResult(&SubmitHandoffResult{ProcessID: handoffCmd.ProcessID}), nil
+}
+
+// SubmitHandoffResult contains the result of submitting a handoff summary.
+type SubmitHandoffResult struct {
+	ProcessID string
+}
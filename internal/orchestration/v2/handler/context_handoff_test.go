@@ -0,0 +1,319 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// contextMetrics returns token metrics with the given context window usage in percent.
+func contextMetrics(percent int) *metrics.TokenMetrics {
+	return &metrics.TokenMetrics{TokensUsed: percent * 2000, TotalTokens: 200000}
+}
+
+// ===========================================================================
+// ContextHandoffs Tests
+// ===========================================================================
+
+func TestContextHandoffs_ShouldRequest(t *testing.T) {
+	handoffs := handler.NewContextHandoffs(85)
+	proc := &repository.Process{ID: "worker-1", Role: repository.RoleWorker, Metrics: contextMetrics(90)}
+
+	assert.True(t, handoffs.ShouldRequest(proc))
+
+	// Once requested, the process is not asked again
+	handoffs.MarkRequested("worker-1")
+	assert.False(t, handoffs.ShouldRequest(proc))
+
+	// Below the threshold, without metrics, for observers or when disabled
+	assert.False(t, handoffs.ShouldRequest(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Metrics: contextMetrics(50)}))
+	assert.False(t, handoffs.ShouldRequest(&repository.Process{ID: "worker-3", Role: repository.RoleWorker}))
+	assert.False(t, handoffs.ShouldRequest(&repository.Process{ID: "observer", Role: repository.RoleObserver, Metrics: contextMetrics(95)}))
+	assert.False(t, handler.NewContextHandoffs(0).ShouldRequest(proc))
+}
+
+func TestContextHandoffs_SubmitAndTake(t *testing.T) {
+	handoffs := handler.NewContextHandoffs(85)
+
+	assert.False(t, handoffs.SubmitSummary("worker-1", "unrequested"))
+	_, ok := handoffs.Take("worker-1")
+	assert.False(t, ok)
+
+	handoffs.MarkRequested("worker-1")
+	assert.True(t, handoffs.SubmitSummary("worker-1", "Parser done, tests left"))
+
+	summary, ok := handoffs.Take("worker-1")
+	assert.True(t, ok)
+	assert.Equal(t, "Parser done, tests left", summary)
+
+	// Take forgets the handoff
+	_, ok = handoffs.Take("worker-1")
+	assert.False(t, ok)
+}
+
+// ===========================================================================
+// SubmitHandoffHandler Tests
+// ===========================================================================
+
+func TestSubmitHandoffHandler_StoresSummary(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking})
+
+	handoffs := handler.NewContextHandoffs(85)
+	handoffs.MarkRequested("worker-1")
+
+	h := handler.NewSubmitHandoffHandler(processRepo, handoffs)
+	result, err := h.Handle(context.Background(),
+		command.NewSubmitHandoffCommand(command.SourceMCPTool, "worker-1", "Parser done, tests left"))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	summary, ok := handoffs.Take("worker-1")
+	require.True(t, ok)
+	assert.Equal(t, "Parser done, tests left", summary)
+}
+
+func TestSubmitHandoffHandler_NotRequested_ReturnsError(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking})
+
+	h := handler.NewSubmitHandoffHandler(processRepo, handler.NewContextHandoffs(85))
+	_, err := h.Handle(context.Background(),
+		command.NewSubmitHandoffCommand(command.SourceMCPTool, "worker-1", "Parser done"))
+	require.ErrorContains(t, err, "no context handoff was requested from worker-1")
+}
+
+func TestSubmitHandoffHandler_UnknownProcess_ReturnsError(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+
+	h := handler.NewSubmitHandoffHandler(processRepo, handler.NewContextHandoffs(85))
+	_, err := h.Handle(context.Background(),
+		command.NewSubmitHandoffCommand(command.SourceMCPTool, "worker-9", "Parser done"))
+	require.ErrorIs(t, err, handler.ErrProcessNotFound)
+}
+
+// ===========================================================================
+// ProcessTurnCompleteHandler Context Handoff Tests
+// ===========================================================================
+
+func TestProcessTurnCompleteHandler_ThresholdCrossed_RequestsHandoff(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:               repository.CoordinatorID,
+		Role:             repository.RoleCoordinator,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+	require.NoError(t, queueRepo.GetOrCreate(repository.CoordinatorID).Enqueue("worker-1 finished", repository.SenderSystem))
+
+	handoffs := handler.NewContextHandoffs(85)
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithTurnCompleteContextHandoffs(handoffs))
+
+	cmd := command.NewProcessTurnCompleteCommand(repository.CoordinatorID, true, contextMetrics(88), nil)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	assert.True(t, turnResult.HandoffRequested)
+	assert.True(t, turnResult.QueuedDelivery)
+
+	// The request jumps ahead of the queued message
+	next, ok := queueRepo.GetOrCreate(repository.CoordinatorID).Peek()
+	require.True(t, ok)
+	assert.Contains(t, next.Content, "[CONTEXT HANDOFF REQUIRED]")
+	assert.Equal(t, repository.PriorityInterrupt, next.Priority)
+
+	require.Len(t, result.Events, 2)
+	handoffEvent := result.Events[1].(events.ProcessEvent)
+	assert.Equal(t, events.ProcessContextHandoff, handoffEvent.Type)
+	assert.Contains(t, handoffEvent.Output, "88% full")
+
+	// The next turn over the threshold does not ask again
+	proc, _ := processRepo.Get(repository.CoordinatorID)
+	assert.False(t, handoffs.ShouldRequest(proc))
+}
+
+func TestProcessTurnCompleteHandler_BelowThreshold_NoHandoff(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithTurnCompleteContextHandoffs(handler.NewContextHandoffs(85)))
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", true, contextMetrics(60), nil)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	assert.False(t, turnResult.HandoffRequested)
+	assert.True(t, queueRepo.GetOrCreate("worker-1").IsEmpty())
+}
+
+func TestProcessTurnCompleteHandler_AfterHandoffRequest_ReplacesProcess(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+		TaskID:           "perles-abc.1",
+	})
+
+	handoffs := handler.NewContextHandoffs(85)
+	handoffs.MarkRequested("worker-1")
+	handoffs.SubmitSummary("worker-1", "Parser done, tests left")
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithTurnCompleteContextHandoffs(handoffs))
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", true, contextMetrics(91), nil)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	assert.True(t, turnResult.HandoffStarted)
+
+	require.Len(t, result.FollowUp, 1)
+	replaceCmd, ok := result.FollowUp[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok, "expected ReplaceProcessCommand, got: %T", result.FollowUp[0])
+	assert.Equal(t, "worker-1", replaceCmd.ProcessID)
+	assert.Equal(t, handler.ContextHandoffReason, replaceCmd.Reason)
+	assert.True(t, replaceCmd.Handoff)
+	assert.Equal(t, "Parser done, tests left", replaceCmd.HandoffSummary)
+
+	_, pending := handoffs.Take("worker-1")
+	assert.False(t, pending, "the handoff is consumed")
+}
+
+// ===========================================================================
+// ReplaceProcessHandler Context Handoff Tests
+// ===========================================================================
+
+func TestReplaceProcessHandler_CoordinatorHandoff_SeedsSummary(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	spawner := &mockProcessSpawner{}
+	processRepo.AddProcess(&repository.Process{
+		ID:     repository.CoordinatorID,
+		Role:   repository.RoleCoordinator,
+		Status: repository.StatusReady,
+	})
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil, handler.WithReplaceSpawner(spawner))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceInternal, repository.CoordinatorID,
+		handler.ContextHandoffReason, command.WithHandoff("Epic half done, worker-2 on .3"))
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	require.Len(t, spawner.spawnCalls, 1)
+	assert.Contains(t, spawner.spawnCalls[0].InitialPromptOverride, "[CONTEXT HANDOFF - NEW SESSION]")
+	assert.Contains(t, spawner.spawnCalls[0].InitialPromptOverride, "Epic half done, worker-2 on .3")
+
+	var handoffEvents int
+	for _, e := range result.Events {
+		if pe, ok := e.(events.ProcessEvent); ok && pe.Type == events.ProcessContextHandoff {
+			handoffEvents++
+		}
+	}
+	assert.Equal(t, 1, handoffEvents)
+}
+
+func TestReplaceProcessHandler_WorkerHandoff_TransfersTaskAndQueue(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	taskRepo := repository.NewMemoryTaskRepository()
+	phase := events.ProcessPhaseImplementing
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		TaskID: "perles-abc.1",
+		Phase:  &phase,
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc.1",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+		ThreadID:    "msg-42",
+	}))
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("Also update the docs", repository.SenderCoordinator))
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskRepository(taskRepo),
+		handler.WithReplaceQueueRepository(queueRepo))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceInternal, "worker-1",
+		handler.ContextHandoffReason, command.WithHandoff("Parser done, tests left"))
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	newID := result.Data.(*handler.ReplaceProcessResult).NewProcessID
+	assert.Equal(t, "worker-2", newID)
+
+	// The replacement owns the task
+	newWorker, err := processRepo.Get(newID)
+	require.NoError(t, err)
+	assert.Equal(t, "perles-abc.1", newWorker.TaskID)
+	require.NotNil(t, newWorker.Phase)
+	assert.Equal(t, events.ProcessPhaseImplementing, *newWorker.Phase)
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	assert.Equal(t, newID, task.Implementer)
+
+	// The seed comes first, followed by the old worker's queued messages
+	entries := queueRepo.GetOrCreate(newID).Entries()
+	require.Len(t, entries, 2)
+	assert.Contains(t, entries[0].Content, "[CONTEXT HANDOFF]")
+	assert.Contains(t, entries[0].Content, "Parser done, tests left")
+	assert.Contains(t, entries[0].Content, "**Fabric Thread ID:** msg-42")
+	assert.Equal(t, "Also update the docs", entries[1].Content)
+	assert.True(t, queueRepo.GetOrCreate("worker-1").IsEmpty())
+
+	// The coordinator learns about the swap
+	require.Len(t, result.FollowUp, 1)
+	notify, ok := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.True(t, ok, "expected SendToProcessCommand, got: %T", result.FollowUp[0])
+	assert.Equal(t, repository.CoordinatorID, notify.ProcessID)
+	assert.Contains(t, notify.Content, "replaced by worker-2, which continues task perles-abc.1")
+}
+
+func TestReplaceProcessHandler_WorkerWithoutHandoff_KeepsTask(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		TaskID: "perles-abc.1",
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-1"}))
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceTaskRepository(taskRepo),
+		handler.WithReplaceQueueRepository(queueRepo))
+
+	result, err := h.Handle(context.Background(),
+		command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "stuck"))
+	require.NoError(t, err)
+	assert.Empty(t, result.FollowUp)
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	assert.Equal(t, "worker-1", task.Implementer, "plain replacements leave reassignment to the coordinator")
+}
@@ -47,7 +47,7 @@ func NewNotifyUserHandler(opts ...NotifyUserHandlerOption) *NotifyUserHandler {
 
 // Handle processes a NotifyUserCommand.
 // 1. Validates the command
-// 2. Plays the user_notification sound
+// 2. Plays the command's sound, or the user_notification sound
 // 3. Emits ProcessUserNotification event for the TUI to display
 func (h *NotifyUserHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
 	notifyCmd := cmd.(*command.NotifyUserCommand)
@@ -58,7 +58,11 @@ func (h *NotifyUserHandler) Handle(_ context.Context, cmd command.Command) (*com
 	}
 
 	// 2. Play notification sound
-	h.soundService.Play("notification", "user_notification")
+	if notifyCmd.SoundUseCase != "" {
+		h.soundService.Play(notifyCmd.SoundFile, notifyCmd.SoundUseCase)
+	} else {
+		h.soundService.Play("notification", "user_notification")
+	}
 
 	// 3. Build ProcessUserNotification event
 	event := events.ProcessEvent{
@@ -94,6 +94,23 @@ func TestNotifyUserHandler_EmptyMessage(t *testing.T) {
 	assert.Contains(t, err.Error(), "message is required")
 }
 
+func TestNotifyUserHandler_PlaysCommandSound(t *testing.T) {
+	soundService := mocks.NewMockSoundService(t)
+
+	// Only the command's sound is played, never the default one as well
+	soundService.EXPECT().Play("deny", "budget_warning").Once()
+
+	h := handler.NewNotifyUserHandler(
+		handler.WithNotifyUserSoundService(soundService),
+	)
+
+	cmd := command.NewNotifyUserCommand(command.SourceInternal, "Budget 80% used", "budget", "",
+		command.WithNotifySound("deny", "budget_warning"))
+
+	_, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+}
+
 func TestNotifyUserHandler_PlaysNotificationSound(t *testing.T) {
 	soundService := mocks.NewMockSoundService(t)
 
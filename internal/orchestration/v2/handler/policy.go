@@ -0,0 +1,92 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handler for tool policy violation reports.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// ReportPolicyViolationHandler
+// ===========================================================================
+
+// ReportPolicyViolationHandler handles CmdReportPolicyViolation commands.
+// It emits a ProcessPolicyViolation event for the user and tells the
+// coordinator, which decides how the blocked work continues.
+type ReportPolicyViolationHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewReportPolicyViolationHandler creates a new ReportPolicyViolationHandler.
+func NewReportPolicyViolationHandler(processRepo repository.ProcessRepository) *ReportPolicyViolationHandler {
+	return &ReportPolicyViolationHandler{processRepo: processRepo}
+}
+
+// Handle processes a ReportPolicyViolationCommand.
+// 1. Validates the command and looks up the reporting process
+// 2. Emits ProcessPolicyViolation
+// 3. Returns a follow-up that sends the violation to the coordinator
+func (h *ReportPolicyViolationHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	violationCmd := cmd.(*command.ReportPolicyViolationCommand)
+
+	// 1. Validate and look up the process
+	if err := violationCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	proc, err := h.processRepo.Get(violationCmd.ProcessID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+
+	// 2. Build ProcessPolicyViolation event
+	event := events.ProcessEvent{
+		Type:      events.ProcessPolicyViolation,
+		ProcessID: proc.ID,
+		Role:      proc.Role,
+		Output:    fmt.Sprintf("%s (rule: %s)", violationCmd.Action, violationCmd.Rule),
+		TaskID:    proc.TaskID,
+	}
+
+	// 3. Let the coordinator decide how to continue
+	followUp := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+		coordinatorPolicyViolationMessage(violationCmd, proc.TaskID))
+
+	result := &ReportPolicyViolationResult{
+		ProcessID: proc.ID,
+		TaskID:    proc.TaskID,
+	}
+
+	return SuccessWithEventsAndFollowUp(result, []any{event}, []command.Command{followUp}), nil
+}
+
+// coordinatorPolicyViolationMessage tells the coordinator a worker is blocked by its policy.
+func coordinatorPolicyViolationMessage(cmd *command.ReportPolicyViolationCommand, taskID string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[POLICY VIOLATION] %s needs an action its tool policy forbids", cmd.ProcessID)
+	if taskID != "" {
+		fmt.Fprintf(&b, " while working on %s", taskID)
+	}
+	fmt.Fprintf(&b, ".\n\nAction: %s\nRule: %s\n", cmd.Action, cmd.Rule)
+	if cmd.Details != "" {
+		fmt.Fprintf(&b, "Details: %s\n", cmd.Details)
+	}
+	b.WriteString("\nThe worker has stopped. Hand the action to a worker whose policy allows it, " +
+		"change the approach, or ask the user.")
+	return b.String()
+}
+
+// ReportPolicyViolationResult contains the result of reporting a policy violation.
+type ReportPolicyViolationResult struct {
+	ProcessID string
+	TaskID    string
+}
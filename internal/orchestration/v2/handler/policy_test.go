@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// ReportPolicyViolationHandler Tests
+// ===========================================================================
+
+func TestReportPolicyViolationHandler_EmitsEventAndNotifiesCoordinator(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		TaskID: "perles-abc.1",
+	})
+
+	h := handler.NewReportPolicyViolationHandler(processRepo)
+	cmd := command.NewReportPolicyViolationCommand(command.SourceMCPTool, "worker-1",
+		"fix the typo in main.go", "Never create, edit or delete files", "found while reviewing")
+
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	violation := result.Data.(*handler.ReportPolicyViolationResult)
+	assert.Equal(t, "perles-abc.1", violation.TaskID)
+
+	require.Len(t, result.Events, 1)
+	event := result.Events[0].(events.ProcessEvent)
+	assert.Equal(t, events.ProcessPolicyViolation, event.Type)
+	assert.Equal(t, "worker-1", event.ProcessID)
+	assert.Equal(t, events.RoleWorker, event.Role)
+	assert.Equal(t, "perles-abc.1", event.TaskID)
+	assert.Equal(t, "fix the typo in main.go (rule: Never create, edit or delete files)", event.Output)
+
+	require.Len(t, result.FollowUp, 1)
+	send, ok := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.True(t, ok, "expected SendToProcessCommand, got: %T", result.FollowUp[0])
+	assert.Equal(t, repository.CoordinatorID, send.ProcessID)
+	assert.Contains(t, send.Content, "[POLICY VIOLATION] worker-1")
+	assert.Contains(t, send.Content, "while working on perles-abc.1")
+	assert.Contains(t, send.Content, "Rule: Never create, edit or delete files")
+	assert.Contains(t, send.Content, "Details: found while reviewing")
+}
+
+func TestReportPolicyViolationHandler_UnknownProcess_ReturnsError(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+
+	h := handler.NewReportPolicyViolationHandler(processRepo)
+	cmd := command.NewReportPolicyViolationCommand(command.SourceMCPTool, "worker-9", "edit", "read only", "")
+
+	_, err := h.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, handler.ErrProcessNotFound)
+}
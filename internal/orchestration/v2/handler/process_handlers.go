@@ -72,9 +72,13 @@ type UnifiedProcessSpawner interface {
 // It implements queue-or-deliver logic identically for both coordinator and workers:
 // - If process is Working: queue message, emit ProcessQueueChanged
 // - If process is Ready: queue message, return DeliverProcessQueuedCommand follow-up
+//
+// Interrupt messages to a Working process also cancel its current turn; the
+// turn completion then delivers them ahead of everything else in the queue.
 type SendToProcessHandler struct {
 	processRepo repository.ProcessRepository
 	queueRepo   repository.QueueRepository
+	registry    *process.ProcessRegistry
 	tracer      trace.Tracer
 }
 
@@ -91,6 +95,14 @@ func WithSendToProcessTracer(tracer trace.Tracer) SendToProcessHandlerOption {
 	}
 }
 
+// WithSendToProcessRegistry sets the process registry used to cancel the
+// current turn of a process for interrupt messages.
+func WithSendToProcessRegistry(registry *process.ProcessRegistry) SendToProcessHandlerOption {
+	return func(h *SendToProcessHandler) {
+		h.registry = registry
+	}
+}
+
 // NewSendToProcessHandler creates a new SendToProcessHandler.
 func NewSendToProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -165,7 +177,7 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 
 	// Always enqueue the message first - queue is the single path for all messages
 	queue := h.queueRepo.GetOrCreate(sendCmd.ProcessID)
-	if err := queue.Enqueue(sendCmd.Content, sender); err != nil {
+	if err := queue.EnqueueWithPriority(sendCmd.Content, sender, sendCmd.MessagePriority); err != nil {
 		return nil, fmt.Errorf("failed to enqueue message: %w", err)
 	}
 
@@ -188,6 +200,9 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 	// If process is Working, just emit queue changed event - delivery happens when turn completes
 	if proc.Status == repository.StatusWorking {
 		result.Queued = true
+		if sendCmd.Interrupt {
+			result.Interrupted = h.interruptTurn(proc)
+		}
 		event := events.ProcessEvent{
 			Type:       events.ProcessQueueChanged,
 			ProcessID:  proc.ID,
@@ -208,11 +223,31 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 	return SuccessWithFollowUp(result, deliverCmd), nil
 }
 
+// interruptTurn cancels the current turn of a working process so that the
+// queued interrupt message is delivered as soon as the turn ends. A process
+// that has not completed its first turn has no session to continue and is
+// left to finish; the interrupt message is still delivered first.
+func (h *SendToProcessHandler) interruptTurn(proc *repository.Process) bool {
+	if h.registry == nil || !proc.HasCompletedTurn {
+		return false
+	}
+	liveProcess := h.registry.Get(proc.ID)
+	if liveProcess == nil {
+		return false
+	}
+	if err := liveProcess.Interrupt(); err != nil {
+		log.Warn(log.CatOrch, "Failed to interrupt process turn", "processID", proc.ID, "error", err)
+		return false
+	}
+	return true
+}
+
 // SendToProcessResult contains the result of sending a message to a process.
 type SendToProcessResult struct {
-	ProcessID string
-	Queued    bool // True if message was queued, false if will be delivered via follow-up
-	QueueSize int
+	ProcessID   string
+	Queued      bool // True if message was queued, false if will be delivered via follow-up
+	Interrupted bool // True if the current turn was cancelled to deliver an interrupt message
+	QueueSize   int
 }
 
 // ===========================================================================
@@ -299,7 +334,7 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	// Process must be Ready to receive delivery
 	// If Working, re-enqueue and return (shouldn't happen in normal operation)
 	if proc.Status == repository.StatusWorking {
-		_ = queue.Enqueue(entry.Content, entry.Sender)
+		_ = queue.EnqueueWithPriority(entry.Content, entry.Sender, entry.Priority)
 		result := &DeliverProcessQueuedResult{
 			ProcessID:  proc.ID,
 			Delivered:  false,
@@ -312,18 +347,18 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	// Update process status to Working
 	proc.Status = repository.StatusWorking
 	if err := h.processRepo.Save(proc); err != nil {
-		// Re-enqueue on failure (preserve sender)
-		_ = queue.Enqueue(entry.Content, entry.Sender)
+		// Re-enqueue on failure (preserve sender and priority)
+		_ = queue.EnqueueWithPriority(entry.Content, entry.Sender, entry.Priority)
 		return nil, fmt.Errorf("failed to update process status: %w", err)
 	}
 
 	// Attempt actual delivery if deliverer is configured
 	if h.deliverer != nil {
 		if err := h.deliverer.Deliver(ctx, proc.ID, entry.Content); err != nil {
-			// Revert process status on delivery failure (preserve sender)
+			// Revert process status on delivery failure (preserve sender and priority)
 			proc.Status = repository.StatusReady
 			_ = h.processRepo.Save(proc)
-			_ = queue.Enqueue(entry.Content, entry.Sender)
+			_ = queue.EnqueueWithPriority(entry.Content, entry.Sender, entry.Priority)
 			return nil, fmt.Errorf("failed to deliver message: %w", err)
 		}
 	}
@@ -399,6 +434,8 @@ type DeliverProcessQueuedResult struct {
 // Same logic for both coordinator and workers.
 // For workers, it also enforces turn completion by checking if required tools were called.
 // For coordinators, it detects context exhaustion and triggers automatic replacement.
+// For both roles, it hands off processes nearing the end of their context window.
+// With a retry policy, a worker whose turn errors fails the attempt at its task.
 type ProcessTurnCompleteHandler struct {
 	processRepo     repository.ProcessRepository
 	queueRepo       repository.QueueRepository
@@ -406,6 +443,8 @@ type ProcessTurnCompleteHandler struct {
 	registry        *process.ProcessRegistry
 	sessionNotifier SessionRefNotifier
 	soundService    sound.SoundService
+	handoffs        *ContextHandoffs
+	retries         *TaskRetries
 }
 
 // ProcessTurnCompleteHandlerOption configures ProcessTurnCompleteHandler.
@@ -448,6 +487,23 @@ func WithProcessTurnSoundService(svc sound.SoundService) ProcessTurnCompleteHand
 	}
 }
 
+// WithTurnCompleteContextHandoffs enables context handoffs. A process whose
+// context usage crosses the threshold is asked for a handoff summary, and
+// replaced once the turn that answers the request completes.
+func WithTurnCompleteContextHandoffs(handoffs *ContextHandoffs) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		h.handoffs = handoffs
+	}
+}
+
+// WithTurnCompleteTaskRetries enables task retries. A rate limit cools the
+// provider down, and a worker whose turn errors on a task fails the attempt.
+func WithTurnCompleteTaskRetries(retries *TaskRetries) ProcessTurnCompleteHandlerOption {
+	return func(h *ProcessTurnCompleteHandler) {
+		h.retries = retries
+	}
+}
+
 // NewProcessTurnCompleteHandler creates a new ProcessTurnCompleteHandler.
 func NewProcessTurnCompleteHandler(
 	processRepo repository.ProcessRepository,
@@ -490,6 +546,53 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		}), nil
 	}
 
+	// ===========================================================================
+	// Failed attempt handling
+	// ===========================================================================
+	// A rate limit cools the provider down for every workflow. A worker whose
+	// turn errored on a task fails the attempt, and the retry policy decides
+	// whether the task gets another one.
+	if turnCmd.Error != nil && h.retries.Enabled() && !interruptedTurn(proc, turnCmd) {
+		kind, detail := ClassifyFailure(turnCmd.Error)
+		if kind == repository.FailureRateLimit {
+			h.retries.CoolDown(h.retries.ProviderOf(proc))
+		}
+
+		if proc.Role == repository.RoleWorker && proc.TaskID != "" {
+			proc.Status = repository.StatusFailed
+			proc.LastActivityAt = time.Now()
+			if turnCmd.Metrics != nil {
+				proc.Metrics = turnCmd.Metrics
+			}
+			if err := h.processRepo.Save(proc); err != nil {
+				return nil, fmt.Errorf("failed to save process: %w", err)
+			}
+
+			errorEvent := events.ProcessEvent{
+				Type:      events.ProcessError,
+				ProcessID: proc.ID,
+				Role:      proc.Role,
+				Status:    events.ProcessStatusFailed,
+				TaskID:    proc.TaskID,
+				Error:     turnCmd.Error,
+			}
+
+			failCmd := command.NewMarkTaskFailedCommand(command.SourceInternal, proc.TaskID,
+				detail, command.WithFailure(kind, proc.ID))
+			if turnCmd.TraceID() != "" {
+				failCmd.SetTraceID(turnCmd.TraceID())
+			}
+
+			result := &ProcessTurnCompleteResult{
+				ProcessID:     proc.ID,
+				NewStatus:     repository.StatusFailed,
+				AttemptFailed: true,
+			}
+
+			return SuccessWithEventsAndFollowUp(result, []any{errorEvent}, []command.Command{failCmd}), nil
+		}
+	}
+
 	// ===========================================================================
 	// Context Exceeded Error Handling
 	// ===========================================================================
@@ -587,6 +690,41 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		}
 	}
 
+	// ===========================================================================
+	// Context handoff replacement
+	// ===========================================================================
+	// The turn after a handoff request was spent writing the handoff summary.
+	// Replace the process with a fresh one seeded with that summary.
+	if turnCmd.Succeeded {
+		if summary, ok := h.handoffs.Take(proc.ID); ok {
+			proc.Status = repository.StatusReady
+			proc.LastActivityAt = time.Now()
+			if turnCmd.Metrics != nil {
+				proc.Metrics = turnCmd.Metrics
+			}
+			if err := h.processRepo.Save(proc); err != nil {
+				return nil, fmt.Errorf("failed to save process: %w", err)
+			}
+
+			log.Info(log.CatOrch, "Replacing process for context handoff",
+				"processID", proc.ID, "taskID", proc.TaskID, "hasSummary", summary != "")
+
+			replaceCmd := command.NewReplaceProcessCommand(command.SourceInternal, proc.ID,
+				ContextHandoffReason, command.WithHandoff(summary))
+			if turnCmd.TraceID() != "" {
+				replaceCmd.SetTraceID(turnCmd.TraceID())
+			}
+
+			result := &ProcessTurnCompleteResult{
+				ProcessID:      proc.ID,
+				NewStatus:      repository.StatusReady,
+				HandoffStarted: true,
+			}
+
+			return SuccessWithEventsAndFollowUp(result, nil, []command.Command{replaceCmd}), nil
+		}
+	}
+
 	// ===========================================================================
 	// Turn completion enforcement for workers
 	// ===========================================================================
@@ -652,6 +790,13 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// End of turn completion enforcement
 	// ===========================================================================
 
+	// ===========================================================================
+	// Interrupted turn handling
+	// ===========================================================================
+	// A turn cancelled for an interrupt message ends without succeeding. The
+	// process did not fail: it becomes Ready and receives the interrupt below.
+	interrupted := interruptedTurn(proc, turnCmd)
+
 	// ===========================================================================
 	// Startup failure handling (coordinator and workers)
 	// ===========================================================================
@@ -735,7 +880,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// When a turn fails after the process has already completed at least one turn,
 	// we mark it as failed and emit an error. This catches resume failures, context
 	// exceeded errors, and other runtime errors that occur after the initial spawn.
-	if !turnCmd.Succeeded && proc.HasCompletedTurn {
+	if !turnCmd.Succeeded && proc.HasCompletedTurn && !interrupted {
 		proc.Status = repository.StatusFailed
 		proc.LastActivityAt = time.Now()
 
@@ -792,10 +937,39 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		Status:    events.ProcessStatusReady,
 		TaskID:    proc.TaskID,
 	}
+	resultEvents := []any{readyEvent}
+
+	// ===========================================================================
+	// Context handoff request
+	// ===========================================================================
+	// Ask a process nearing the end of its context window for a handoff
+	// summary. The request jumps the queue so it is the next turn.
+	queue := h.queueRepo.GetOrCreate(proc.ID)
+	handoffRequested := false
+	if turnCmd.Succeeded && h.handoffs.ShouldRequest(proc) {
+		usage := proc.Metrics.ContextUsage()
+		if err := queue.EnqueueWithPriority(prompt.ContextHandoffRequestPrompt(usage),
+			repository.SenderSystem, repository.PriorityInterrupt); err != nil {
+			return nil, fmt.Errorf("failed to enqueue context handoff request: %w", err)
+		}
+		h.handoffs.MarkRequested(proc.ID)
+		handoffRequested = true
+
+		log.Info(log.CatOrch, "Context handoff threshold crossed, requesting handoff summary",
+			"processID", proc.ID, "contextUsage", usage)
+
+		resultEvents = append(resultEvents, events.ProcessEvent{
+			Type:      events.ProcessContextHandoff,
+			ProcessID: proc.ID,
+			Role:      proc.Role,
+			Status:    events.ProcessStatusReady,
+			TaskID:    proc.TaskID,
+			Output:    fmt.Sprintf("Context window %.0f%% full, requesting handoff summary", usage),
+		})
+	}
 
 	// Check for queued messages - same logic for both roles
 	var followUps []command.Command
-	queue := h.queueRepo.GetOrCreate(proc.ID)
 	if !queue.IsEmpty() {
 		deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, proc.ID)
 		if turnCmd.TraceID() != "" {
@@ -805,13 +979,22 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	}
 
 	result := &ProcessTurnCompleteResult{
-		ProcessID:      proc.ID,
-		NewStatus:      repository.StatusReady,
-		QueuedDelivery: len(followUps) > 0,
-		WasNoOp:        false,
+		ProcessID:        proc.ID,
+		NewStatus:        repository.StatusReady,
+		QueuedDelivery:   len(followUps) > 0,
+		WasNoOp:          false,
+		Interrupted:      interrupted,
+		HandoffRequested: handoffRequested,
 	}
 
-	return SuccessWithEventsAndFollowUp(result, []any{readyEvent}, followUps), nil
+	return SuccessWithEventsAndFollowUp(result, resultEvents, followUps), nil
+}
+
+// interruptedTurn reports whether a turn ended because it was cancelled for
+// an interrupt message. The live process records the interrupt when it
+// cancels the turn; a turn that succeeded anyway was not cut short.
+func interruptedTurn(proc *repository.Process, turnCmd *command.ProcessTurnCompleteCommand) bool {
+	return turnCmd.Interrupted && !turnCmd.Succeeded && proc.HasCompletedTurn
 }
 
 // ProcessTurnCompleteResult contains the result of handling turn completion.
@@ -821,6 +1004,10 @@ type ProcessTurnCompleteResult struct {
 	QueuedDelivery       bool // true if DeliverProcessQueuedCommand was added to follow-ups
 	WasNoOp              bool // true if process was already Retired (idempotent)
 	EnforcementTriggered bool // true if a turn completion enforcement reminder was sent
+	Interrupted          bool // true if the turn was cancelled for an interrupt message
+	HandoffRequested     bool // true if the process was asked for a context handoff summary
+	HandoffStarted       bool // true if the process is being replaced for a context handoff
+	AttemptFailed        bool // true if the worker failed the attempt at its task
 }
 
 // ===========================================================================
@@ -833,6 +1020,7 @@ type RetireProcessHandler struct {
 	processRepo repository.ProcessRepository
 	registry    *process.ProcessRegistry
 	enforcer    TurnCompletionEnforcer
+	worktrees   WorkerWorktrees
 }
 
 // RetireProcessHandlerOption configures RetireProcessHandler.
@@ -846,6 +1034,13 @@ func WithRetireTurnEnforcer(enforcer TurnCompletionEnforcer) RetireProcessHandle
 	}
 }
 
+// WithRetireWorkerWorktrees sets the worker worktrees removed when a worker retires.
+func WithRetireWorkerWorktrees(worktrees WorkerWorktrees) RetireProcessHandlerOption {
+	return func(h *RetireProcessHandler) {
+		h.worktrees = worktrees
+	}
+}
+
 // NewRetireProcessHandler creates a new RetireProcessHandler.
 func NewRetireProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -907,6 +1102,14 @@ func (h *RetireProcessHandler) Handle(ctx context.Context, cmd command.Command)
 		h.enforcer.CleanupProcess(retireCmd.ProcessID)
 	}
 
+	// Remove the worker's worktree (best-effort, unintegrated branches are kept)
+	if h.worktrees != nil && proc.Role == repository.RoleWorker {
+		if err := h.worktrees.Remove(proc.ID); err != nil {
+			log.Warn(log.CatOrch, "Failed to remove worker worktree",
+				"processID", proc.ID, "error", err)
+		}
+	}
+
 	// Emit ProcessStatusChange event
 	event := events.ProcessEvent{
 		Type:      events.ProcessStatusChange,
@@ -1010,6 +1213,9 @@ type SpawnProcessHandler struct {
 	registry    *process.ProcessRegistry
 	spawner     UnifiedProcessSpawner
 	enforcer    TurnCompletionEnforcer
+	admitter    WorkerAdmitter
+	worktrees   WorkerWorktrees
+	retries     *TaskRetries
 	tracer      trace.Tracer
 }
 
@@ -1031,6 +1237,30 @@ func WithTurnEnforcer(enforcer TurnCompletionEnforcer) SpawnProcessHandlerOption
 	}
 }
 
+// WithWorkerAdmitter sets the admitter consulted before spawning a worker.
+// Coordinator and observer spawns are never gated.
+func WithWorkerAdmitter(admitter WorkerAdmitter) SpawnProcessHandlerOption {
+	return func(h *SpawnProcessHandler) {
+		h.admitter = admitter
+	}
+}
+
+// WithSpawnWorkerWorktrees gives each spawned worker its own git worktree.
+// Coordinator and observer keep the workflow's working directory.
+func WithSpawnWorkerWorktrees(worktrees WorkerWorktrees) SpawnProcessHandlerOption {
+	return func(h *SpawnProcessHandler) {
+		h.worktrees = worktrees
+	}
+}
+
+// WithSpawnTaskRetries holds back worker spawns on a provider cooling down
+// after a rate limit, and records workers spawned on the retry provider.
+func WithSpawnTaskRetries(retries *TaskRetries) SpawnProcessHandlerOption {
+	return func(h *SpawnProcessHandler) {
+		h.retries = retries
+	}
+}
+
 // WithSpawnProcessTracer sets the tracer for span instrumentation.
 // If tracer is nil, the handler keeps its default noop tracer.
 func WithSpawnProcessTracer(tracer trace.Tracer) SpawnProcessHandlerOption {
@@ -1109,6 +1339,20 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		}
 	default:
 		// Worker-specific logic
+		if h.retries.Enabled() {
+			if provider, remaining := h.retries.WorkerCooldown(spawnCmd.RetryProvider); remaining > 0 {
+				return nil, fmt.Errorf("worker not admitted: provider %s is cooling down after a rate limit for another %s",
+					provider, remaining.Round(time.Second))
+			}
+		}
+		if h.admitter != nil {
+			release, err := h.admitter.AdmitWorker()
+			if err != nil {
+				return nil, fmt.Errorf("worker not admitted: %w", err)
+			}
+			// Hold the reservation until the process is saved below
+			defer release()
+		}
 		processID = h.generateWorkerID()
 	}
 
@@ -1130,6 +1374,7 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		CreatedAt:      time.Now(),
 		LastActivityAt: time.Now(),
 		AgentType:      spawnCmd.AgentType,
+		RetryProvider:  spawnCmd.RetryProvider && spawnCmd.Role == repository.RoleWorker,
 	}
 
 	// Save to repository
@@ -1144,6 +1389,16 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		opts := SpawnOptions{
 			AgentType:      spawnCmd.AgentType,
 			WorkflowConfig: spawnCmd.WorkflowConfig,
+			RetryProvider:  proc.RetryProvider,
+		}
+
+		// Workers get their own worktree when enabled
+		if h.worktrees != nil && spawnCmd.Role == repository.RoleWorker {
+			workDir, err := h.worktrees.Create(ctx, processID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create worker worktree: %w", err)
+			}
+			opts.WorkDir = workDir
 		}
 
 		var err error
@@ -1233,11 +1488,17 @@ type WorkflowStateProvider interface {
 // This is one of the two handlers with role-specific branching:
 // - Coordinator: context window refresh with handoff prompt
 // - Worker: simple retire and spawn replacement
+//
+// Context handoffs seed the replacement of either role with the handoff summary
+// of the old process; a replacement worker also takes over its task and queue.
 type ReplaceProcessHandler struct {
 	processRepo           repository.ProcessRepository
 	registry              *process.ProcessRegistry
 	spawner               UnifiedProcessSpawner
 	workflowStateProvider WorkflowStateProvider
+	worktrees             WorkerWorktrees
+	taskRepo              repository.TaskRepository
+	queueRepo             repository.QueueRepository
 }
 
 // ReplaceProcessHandlerOption configures ReplaceProcessHandler.
@@ -1260,6 +1521,29 @@ func WithWorkflowStateProvider(provider WorkflowStateProvider) ReplaceProcessHan
 	}
 }
 
+// WithReplaceWorkerWorktrees hands a replaced worker's git worktree over to its replacement.
+func WithReplaceWorkerWorktrees(worktrees WorkerWorktrees) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		h.worktrees = worktrees
+	}
+}
+
+// WithReplaceTaskRepository sets the task repository used to move the task of a
+// handed-off worker to its replacement.
+func WithReplaceTaskRepository(taskRepo repository.TaskRepository) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		h.taskRepo = taskRepo
+	}
+}
+
+// WithReplaceQueueRepository sets the queue repository used to seed the
+// replacement of a handed-off worker and move its queued messages.
+func WithReplaceQueueRepository(queueRepo repository.QueueRepository) ReplaceProcessHandlerOption {
+	return func(h *ReplaceProcessHandler) {
+		h.queueRepo = queueRepo
+	}
+}
+
 // NewReplaceProcessHandler creates a new ReplaceProcessHandler.
 func NewReplaceProcessHandler(
 	processRepo repository.ProcessRepository,
@@ -1291,9 +1575,9 @@ func (h *ReplaceProcessHandler) Handle(ctx context.Context, cmd command.Command)
 	}
 
 	if proc.IsCoordinator() {
-		return h.replaceCoordinator(ctx, proc)
+		return h.replaceCoordinator(ctx, proc, replaceCmd)
 	}
-	return h.replaceWorker(ctx, proc, replaceCmd.Reason)
+	return h.replaceWorker(ctx, proc, replaceCmd)
 }
 
 // replaceCoordinator handles coordinator replacement with context handoff.
@@ -1304,6 +1588,7 @@ func (h *ReplaceProcessHandler) Handle(ctx context.Context, cmd command.Command)
 // For auto-refresh scenarios (reason="context_exceeded_auto_refresh") with an active workflow,
 // the handler uses BuildWorkflowContinuationPrompt instead, which includes the workflow content
 // and instructs the coordinator to resume autonomously without waiting for user input.
+// Context handoffs use BuildCoordinatorHandoffPrompt with the old coordinator's summary.
 //
 // This method uses spawn-before-retire ordering to prevent unrecoverable session state:
 // 1. Mark old coordinator StatusRetiring (still active, rejecting new messages)
@@ -1314,7 +1599,7 @@ func (h *ReplaceProcessHandler) Handle(ctx context.Context, cmd command.Command)
 // 6. Mark old coordinator StatusRetired
 // 7. Update registry (unregister old, register new)
 // 8. Save new coordinator as StatusReady
-func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *repository.Process) (*command.CommandResult, error) {
+func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *repository.Process, replaceCmd *command.ReplaceProcessCommand) (*command.CommandResult, error) {
 	// Step 1: Mark old coordinator as Retiring (not Retired yet)
 	// This intermediate state signals that the coordinator should reject new messages
 	// but is still active until replacement succeeds.
@@ -1325,6 +1610,9 @@ func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *re
 
 	// Step 2: Build replacement prompt
 	replacePrompt := h.buildReplacementPrompt()
+	if replaceCmd.Handoff {
+		replacePrompt = prompt.BuildCoordinatorHandoffPrompt(replaceCmd.HandoffSummary)
+	}
 
 	// Step 3: Spawn new coordinator FIRST (before stopping old)
 	var newLiveProcess *process.Process
@@ -1400,6 +1688,18 @@ func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *re
 	}
 	resultEvents = append(resultEvents, spawnedEvent)
 
+	if replaceCmd.Handoff {
+		log.Info(log.CatOrch, "Coordinator handed off to a new session",
+			"hasSummary", replaceCmd.HandoffSummary != "")
+		resultEvents = append(resultEvents, events.ProcessEvent{
+			Type:      events.ProcessContextHandoff,
+			ProcessID: repository.CoordinatorID,
+			Role:      events.RoleCoordinator,
+			Status:    newProc.Status,
+			Output:    "Handed off to a new coordinator session",
+		})
+	}
+
 	result := &ReplaceProcessResult{
 		OldProcessID: proc.ID,
 		NewProcessID: repository.CoordinatorID,
@@ -1414,7 +1714,10 @@ func (h *ReplaceProcessHandler) replaceCoordinator(ctx context.Context, proc *re
 // task executors. Each worker receives fresh task assignments from the coordinator, so there's
 // no context to hand off - the coordinator maintains all orchestration state and will simply
 // assign new work to the replacement worker.
-func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *repository.Process, _ string) (*command.CommandResult, error) {
+//
+// Context handoffs are the exception: the replacement takes over the task and queued
+// messages of the old worker and is seeded with its handoff summary (see handOffWorker).
+func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *repository.Process, replaceCmd *command.ReplaceProcessCommand) (*command.CommandResult, error) {
 	// Generate new worker ID
 	workers := h.processRepo.Workers()
 	maxNum := 0
@@ -1451,6 +1754,7 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		Status:         repository.StatusPending,
 		CreatedAt:      time.Now(),
 		LastActivityAt: time.Now(),
+		AgentType:      proc.AgentType,
 	}
 
 	if err := h.processRepo.Save(newProc); err != nil {
@@ -1459,8 +1763,23 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 
 	// Spawn new worker process
 	if h.spawner != nil {
-		// Replacement workers use generic agent type (agent type is not preserved across replacements)
-		newLiveProcess, err := h.spawner.SpawnProcess(ctx, newWorkerID, repository.RoleWorker, SpawnOptions{})
+		// Replacement workers keep the agent type, and with it their tool policy.
+		// Workflow prompt customizations are not preserved across replacements.
+		opts := SpawnOptions{AgentType: proc.AgentType}
+		// The replacement takes over the old worker's worktree, and with it
+		// any uncommitted work; workers without one get a fresh worktree.
+		if h.worktrees != nil {
+			workDir, ok := h.worktrees.Transfer(proc.ID, newWorkerID)
+			if !ok {
+				var err error
+				workDir, err = h.worktrees.Create(ctx, newWorkerID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create worker worktree: %w", err)
+				}
+			}
+			opts.WorkDir = workDir
+		}
+		newLiveProcess, err := h.spawner.SpawnProcess(ctx, newWorkerID, repository.RoleWorker, opts)
 		if err != nil {
 			return nil, fmt.Errorf("failed to spawn new worker: %w", err)
 		}
@@ -1471,6 +1790,16 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		}
 	}
 
+	// Move the task and queue of a handed-off worker to its replacement
+	var followUps []command.Command
+	if replaceCmd.Handoff {
+		notify, err := h.handOffWorker(proc, newProc, replaceCmd.HandoffSummary)
+		if err != nil {
+			return nil, err
+		}
+		followUps = append(followUps, notify)
+	}
+
 	// Update status to Ready (spawner success or no spawner = ready for tests)
 	newProc.Status = repository.StatusReady
 	_ = h.processRepo.Save(newProc)
@@ -1497,13 +1826,85 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 	}
 	resultEvents = append(resultEvents, spawnedEvent)
 
+	if replaceCmd.Handoff {
+		resultEvents = append(resultEvents, events.ProcessEvent{
+			Type:      events.ProcessContextHandoff,
+			ProcessID: newWorkerID,
+			Role:      events.RoleWorker,
+			Status:    newProc.Status,
+			TaskID:    newProc.TaskID,
+			Output:    fmt.Sprintf("Took over from %s after a context handoff", proc.ID),
+		})
+	}
+
 	result := &ReplaceProcessResult{
 		OldProcessID: proc.ID,
 		NewProcessID: newWorkerID,
 		Role:         repository.RoleWorker,
 	}
 
-	return SuccessWithEvents(result, resultEvents...), nil
+	return SuccessWithEventsAndFollowUp(result, resultEvents, followUps), nil
+}
+
+// handOffWorker moves the task and queued messages of a worker replaced for a
+// context handoff to its replacement, and seeds the replacement's queue with the
+// handoff summary. The seed is delivered once the replacement finishes its
+// startup turn. Returns a follow-up that tells the coordinator about the swap.
+func (h *ReplaceProcessHandler) handOffWorker(oldProc, newProc *repository.Process, summary string) (command.Command, error) {
+	var taskStatus, threadID string
+	if oldProc.TaskID != "" && h.taskRepo != nil {
+		task, err := h.taskRepo.Get(oldProc.TaskID)
+		switch {
+		case errors.Is(err, repository.ErrTaskNotFound):
+			log.Warn(log.CatOrch, "Handed-off worker's task not found",
+				"processID", oldProc.ID, "taskID", oldProc.TaskID)
+		case err != nil:
+			return nil, fmt.Errorf("failed to get task: %w", err)
+		default:
+			if task.Implementer == oldProc.ID {
+				task.Implementer = newProc.ID
+			}
+			if task.Reviewer == oldProc.ID {
+				task.Reviewer = newProc.ID
+			}
+			if err := h.taskRepo.Save(task); err != nil {
+				return nil, fmt.Errorf("failed to save task: %w", err)
+			}
+			newProc.TaskID = task.TaskID
+			newProc.Phase = oldProc.Phase
+			taskStatus = string(task.Status)
+			threadID = task.ThreadID
+		}
+	}
+
+	if h.queueRepo != nil {
+		queue := h.queueRepo.GetOrCreate(newProc.ID)
+		seed := prompt.WorkerHandoffPrompt(oldProc.ID, summary, newProc.TaskID, taskStatus, threadID)
+		if err := queue.EnqueueWithPriority(seed, repository.SenderSystem, repository.PriorityInterrupt); err != nil {
+			return nil, fmt.Errorf("failed to enqueue handoff summary: %w", err)
+		}
+		for _, entry := range h.queueRepo.GetOrCreate(oldProc.ID).Drain() {
+			if err := queue.EnqueueWithPriority(entry.Content, entry.Sender, entry.Priority); err != nil {
+				log.Warn(log.CatOrch, "Dropped queued message during context handoff",
+					"from", oldProc.ID, "to", newProc.ID, "error", err)
+			}
+		}
+	}
+
+	log.Info(log.CatOrch, "Worker handed off to replacement",
+		"oldProcessID", oldProc.ID, "newProcessID", newProc.ID, "taskID", newProc.TaskID)
+
+	return command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+		coordinatorWorkerHandoffMessage(oldProc.ID, newProc.ID, newProc.TaskID)), nil
+}
+
+// coordinatorWorkerHandoffMessage tells the coordinator a worker was replaced for a context handoff.
+func coordinatorWorkerHandoffMessage(oldID, newID, taskID string) string {
+	msg := fmt.Sprintf("[CONTEXT HANDOFF] %s ran low on context and was replaced by %s", oldID, newID)
+	if taskID != "" {
+		msg += fmt.Sprintf(", which continues task %s", taskID)
+	}
+	return msg + fmt.Sprintf(". Send further messages to %s; %s is retired.", newID, oldID)
 }
 
 // buildReplacementPrompt determines which prompt to use for coordinator replacement.
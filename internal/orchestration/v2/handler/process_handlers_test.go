@@ -40,10 +40,12 @@ type spawnCall struct {
 	Role                  repository.ProcessRole
 	AgentType             roles.AgentType
 	InitialPromptOverride string
+	WorkDir               string
+	RetryProvider         bool
 }
 
 func (m *mockProcessSpawner) SpawnProcess(ctx context.Context, id string, role repository.ProcessRole, opts handler.SpawnOptions) (*process.Process, error) {
-	m.spawnCalls = append(m.spawnCalls, spawnCall{ID: id, Role: role, AgentType: opts.AgentType, InitialPromptOverride: opts.InitialPromptOverride})
+	m.spawnCalls = append(m.spawnCalls, spawnCall{ID: id, Role: role, AgentType: opts.AgentType, InitialPromptOverride: opts.InitialPromptOverride, WorkDir: opts.WorkDir, RetryProvider: opts.RetryProvider})
 	if m.spawnErr != nil {
 		return nil, m.spawnErr
 	}
@@ -204,6 +206,118 @@ func TestSendToProcessHandler_SendToRetiredProcess_ReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, handler.ErrProcessRetired)
 }
 
+func TestSendToProcessHandler_QueuesByPriority(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+
+	h := handler.NewSendToProcessHandler(processRepo, queueRepo)
+
+	for _, cmd := range []*command.SendToProcessCommand{
+		command.NewSendToProcessCommand(command.SourceInternal, "worker-1", "fabric notification"),
+		command.NewSendToProcessCommand(command.SourceMCPTool, "worker-1", "coordinator instructions"),
+		command.NewSendToProcessCommand(command.SourceUser, "worker-1", "user question"),
+		command.NewSendToProcessCommand(command.SourceInternal, "worker-1", "urgent notice",
+			command.WithMessagePriority(repository.PriorityCoordinator)),
+	} {
+		_, err := h.Handle(context.Background(), cmd)
+		require.NoError(t, err)
+	}
+
+	var contents []string
+	for _, entry := range queueRepo.GetOrCreate("worker-1").Entries() {
+		contents = append(contents, entry.Content)
+	}
+	require.Equal(t, []string{"user question", "coordinator instructions", "urgent notice", "fabric notification"}, contents)
+}
+
+func TestSendToProcessHandler_InterruptWhileWorking_CancelsTurn(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	registry := process.NewProcessRegistry()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("coordinator instructions", repository.SenderCoordinator))
+
+	mockProc := newMockHeadlessProcess(1234)
+	liveProcess := process.New("worker-1", repository.RoleWorker, mockProc, nil, nil)
+	liveProcess.Start()
+	t.Cleanup(liveProcess.Stop)
+	registry.Register(liveProcess)
+
+	h := handler.NewSendToProcessHandler(processRepo, queueRepo, handler.WithSendToProcessRegistry(registry))
+
+	cmd := command.NewSendToProcessCommand(command.SourceUser, "worker-1", "STOP, wrong file", command.WithInterrupt())
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	sendResult := result.Data.(*handler.SendToProcessResult)
+	require.True(t, sendResult.Queued)
+	require.True(t, sendResult.Interrupted)
+	require.True(t, mockProc.cancelled, "the current turn is cancelled")
+
+	next, ok := queueRepo.GetOrCreate("worker-1").Peek()
+	require.True(t, ok)
+	require.Equal(t, "STOP, wrong file", next.Content, "the interrupt jumps the queue")
+	require.Equal(t, repository.PriorityInterrupt, next.Priority)
+}
+
+func TestSendToProcessHandler_InterruptDuringFirstTurn_OnlyQueues(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	registry := process.NewProcessRegistry()
+
+	// Without a completed turn there is no session to deliver the interrupt to
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+	})
+
+	mockProc := newMockHeadlessProcess(1234)
+	liveProcess := process.New("worker-1", repository.RoleWorker, mockProc, nil, nil)
+	liveProcess.Start()
+	t.Cleanup(liveProcess.Stop)
+	registry.Register(liveProcess)
+
+	h := handler.NewSendToProcessHandler(processRepo, queueRepo, handler.WithSendToProcessRegistry(registry))
+
+	cmd := command.NewSendToProcessCommand(command.SourceUser, "worker-1", "STOP", command.WithInterrupt())
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	sendResult := result.Data.(*handler.SendToProcessResult)
+	require.True(t, sendResult.Queued)
+	require.False(t, sendResult.Interrupted)
+	require.False(t, mockProc.cancelled)
+}
+
+func TestSendToProcessHandler_InterruptWhileReady_DeliversImmediately(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+	})
+
+	h := handler.NewSendToProcessHandler(processRepo, queueRepo)
+
+	cmd := command.NewSendToProcessCommand(command.SourceUser, "worker-1", "STOP", command.WithInterrupt())
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	sendResult := result.Data.(*handler.SendToProcessResult)
+	require.False(t, sendResult.Interrupted, "a ready process has no turn to cancel")
+	require.Len(t, result.FollowUp, 1)
+	require.IsType(t, &command.DeliverProcessQueuedCommand{}, result.FollowUp[0])
+}
+
 // ===========================================================================
 // DeliverProcessQueuedHandler Tests
 // ===========================================================================
@@ -717,6 +831,88 @@ func TestProcessTurnCompleteHandler_SucceededFalseTransitionsToFailed(t *testing
 	assert.True(t, foundError, "ProcessError event should be emitted for mid-session failure")
 }
 
+func TestProcessTurnCompleteHandler_InterruptedTurn_DeliversInterrupt(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").EnqueueWithPriority(
+		"STOP, wrong file", repository.SenderUser, repository.PriorityInterrupt))
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo)
+
+	// The cancelled turn completes without succeeding
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", false, nil, nil)
+	cmd.Interrupted = true
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	require.True(t, turnResult.Interrupted)
+	require.True(t, turnResult.QueuedDelivery)
+	require.Equal(t, repository.StatusReady, turnResult.NewStatus)
+
+	updated, _ := processRepo.Get("worker-1")
+	require.Equal(t, repository.StatusReady, updated.Status, "an interrupted process has not failed")
+
+	for _, ev := range result.Events {
+		require.NotEqual(t, events.ProcessError, ev.(events.ProcessEvent).Type)
+	}
+	require.Len(t, result.FollowUp, 1)
+	deliverCmd := result.FollowUp[0].(*command.DeliverProcessQueuedCommand)
+	require.Equal(t, "worker-1", deliverCmd.ProcessID)
+}
+
+func TestProcessTurnCompleteHandler_FailedTurnWithoutInterrupt_StillFails(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("user question", repository.SenderUser))
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo)
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", false, nil, errors.New("api error"))
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	require.False(t, turnResult.Interrupted)
+	require.Equal(t, repository.StatusFailed, turnResult.NewStatus)
+}
+
+func TestProcessTurnCompleteHandler_FailedTurnBeforeQueuedInterrupt_StillFails(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:               "worker-1",
+		Role:             repository.RoleWorker,
+		Status:           repository.StatusWorking,
+		HasCompletedTurn: true,
+	})
+	// An interrupt-priority message is waiting, but nothing cancelled the turn
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").EnqueueWithPriority(
+		"handoff request", repository.SenderSystem, repository.PriorityInterrupt))
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo)
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", false, nil, errors.New("api error"))
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	require.False(t, turnResult.Interrupted)
+	require.Equal(t, repository.StatusFailed, turnResult.NewStatus)
+}
+
 func TestProcessTurnCompleteHandler_StartupFailure_TransitionsToFailed(t *testing.T) {
 	// Tests that first turn failures (before any success) transition to Failed
 	// and emit ProcessError instead of ProcessReady.
@@ -2367,6 +2563,50 @@ func TestSpawnProcessHandler_SavesProcessToRepository(t *testing.T) {
 	assert.Len(t, workers, 1)
 }
 
+// stubWorkerAdmitter admits workers until the limit is reached.
+type stubWorkerAdmitter struct {
+	limit    int
+	admitted int
+	released int
+}
+
+func (a *stubWorkerAdmitter) AdmitWorker() (func(), error) {
+	if a.admitted >= a.limit {
+		return nil, errors.New("worker limit reached")
+	}
+	a.admitted++
+	return func() { a.released++ }, nil
+}
+
+func TestSpawnProcessHandler_WorkerAdmitterRefusesOverLimit(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	registry := process.NewProcessRegistry()
+	admitter := &stubWorkerAdmitter{limit: 1}
+
+	h := handler.NewSpawnProcessHandler(processRepo, registry, handler.WithWorkerAdmitter(admitter))
+
+	_, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker))
+	require.NoError(t, err)
+	require.Equal(t, 1, admitter.released, "reservation is released once the worker is saved")
+
+	result, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker))
+	require.Nil(t, result)
+	require.ErrorContains(t, err, "worker limit reached")
+	require.Len(t, processRepo.Workers(), 1)
+}
+
+func TestSpawnProcessHandler_WorkerAdmitterSkipsCoordinator(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	registry := process.NewProcessRegistry()
+	admitter := &stubWorkerAdmitter{limit: 0}
+
+	h := handler.NewSpawnProcessHandler(processRepo, registry, handler.WithWorkerAdmitter(admitter))
+
+	_, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleCoordinator))
+	require.NoError(t, err)
+	require.Zero(t, admitter.admitted)
+}
+
 func TestSpawnProcessHandler_EmitsProcessSpawnedEvent(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	registry := process.NewProcessRegistry()
@@ -2591,6 +2831,33 @@ func TestReplaceProcessHandler_ReplaceWorker_RetiresAndSpawnsNew(t *testing.T) {
 	assert.Equal(t, repository.StatusRetired, oldWorker.Status)
 }
 
+func TestReplaceProcessHandler_ReplaceWorker_KeepsAgentType(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	spawner := &mockProcessSpawner{}
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		AgentType: roles.AgentTypeReviewer,
+	})
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil, handler.WithReplaceSpawner(spawner))
+
+	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, "worker-1", "stuck")
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	// The replacement runs under the reviewer's tool policy
+	require.Len(t, spawner.spawnCalls, 1)
+	assert.Equal(t, roles.AgentTypeReviewer, spawner.spawnCalls[0].AgentType)
+
+	replaceResult := result.Data.(*handler.ReplaceProcessResult)
+	newWorker, err := processRepo.Get(replaceResult.NewProcessID)
+	require.NoError(t, err)
+	assert.Equal(t, roles.AgentTypeReviewer, newWorker.AgentType)
+}
+
 func TestReplaceProcessHandler_UnknownProcess_ReturnsError(t *testing.T) {
 	processRepo, _ := setupProcessRepos()
 	registry := process.NewProcessRegistry()
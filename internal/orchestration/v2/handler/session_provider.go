@@ -18,6 +18,32 @@ type ProcessRegistrySessionProvider struct {
 	workerClient      client.HeadlessClient
 	workDir           string
 	port              int
+	retryWorkerClient client.HeadlessClient
+	onRetryProvider   func(processID string) bool
+	worktrees         WorkerWorktrees
+}
+
+// ProcessRegistrySessionProviderOption configures ProcessRegistrySessionProvider.
+type ProcessRegistrySessionProviderOption func(*ProcessRegistrySessionProvider)
+
+// WithSessionRetryWorkerClient sets the client of workers retrying a failed
+// task. onRetryProvider reports whether a worker runs on it.
+func WithSessionRetryWorkerClient(
+	retryWorkerClient client.HeadlessClient,
+	onRetryProvider func(processID string) bool,
+) ProcessRegistrySessionProviderOption {
+	return func(p *ProcessRegistrySessionProvider) {
+		p.retryWorkerClient = retryWorkerClient
+		p.onRetryProvider = onRetryProvider
+	}
+}
+
+// WithSessionWorkerWorktrees resumes workers in their own worktree when the
+// registry does not know it, e.g. after a restart.
+func WithSessionWorkerWorktrees(worktrees WorkerWorktrees) ProcessRegistrySessionProviderOption {
+	return func(p *ProcessRegistrySessionProvider) {
+		p.worktrees = worktrees
+	}
 }
 
 // NewProcessRegistrySessionProvider creates a new ProcessRegistrySessionProvider.
@@ -28,20 +54,26 @@ type ProcessRegistrySessionProvider struct {
 //   - workerClient: HeadlessClient for worker MCP config format
 //   - workDir: Working directory for processes
 //   - port: MCP server port for process connections
+//   - opts: optional configuration
 func NewProcessRegistrySessionProvider(
 	registry *process.ProcessRegistry,
 	coordinatorClient client.HeadlessClient,
 	workerClient client.HeadlessClient,
 	workDir string,
 	port int,
+	opts ...ProcessRegistrySessionProviderOption,
 ) *ProcessRegistrySessionProvider {
-	return &ProcessRegistrySessionProvider{
+	p := &ProcessRegistrySessionProvider{
 		registry:          registry,
 		coordinatorClient: coordinatorClient,
 		workerClient:      workerClient,
 		workDir:           workDir,
 		port:              port,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // GetProcessSessionID returns the session ID for a process (coordinator or worker) from the registry.
@@ -90,24 +122,29 @@ func (p *ProcessRegistrySessionProvider) generateCoordinatorMCPConfig() (string,
 
 // generateWorkerMCPConfig generates the worker-specific MCP config.
 func (p *ProcessRegistrySessionProvider) generateWorkerMCPConfig(workerID string) (string, error) {
-	if p.workerClient == nil {
-		return mcp.GenerateWorkerConfigHTTP(p.port, workerID)
-	}
-	switch p.workerClient.Type() {
-	case client.ClientAmp:
-		return mcp.GenerateWorkerConfigAmp(p.port, workerID)
-	case client.ClientCodex:
-		return mcp.GenerateWorkerConfigCodex(p.port, workerID), nil
-	case client.ClientGemini:
-		return mcp.GenerateWorkerConfigGemini(p.port, workerID)
-	case client.ClientOpenCode:
-		return mcp.GenerateWorkerConfigOpenCode(p.port, workerID)
-	default:
-		return mcp.GenerateWorkerConfigHTTP(p.port, workerID)
+	if p.retryWorkerClient != nil && p.onRetryProvider != nil && p.onRetryProvider(workerID) {
+		return workerMCPConfig(p.retryWorkerClient, p.port, workerID)
 	}
+	return workerMCPConfig(p.workerClient, p.port, workerID)
 }
 
 // GetWorkDir returns the working directory for processes.
 func (p *ProcessRegistrySessionProvider) GetWorkDir() string {
 	return p.workDir
 }
+
+// GetProcessWorkDir returns the working directory a process was spawned in,
+// which differs from GetWorkDir for workers running in their own worktree.
+func (p *ProcessRegistrySessionProvider) GetProcessWorkDir(processID string) string {
+	if proc := p.registry.Get(processID); proc != nil {
+		if workDir := proc.WorkDir(); workDir != "" {
+			return workDir
+		}
+	}
+	if p.worktrees != nil {
+		if wt, ok := p.worktrees.Get(processID); ok {
+			return wt.Path
+		}
+	}
+	return p.workDir
+}
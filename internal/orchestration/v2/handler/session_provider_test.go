@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -132,6 +133,44 @@ func TestProcessRegistrySessionProvider_GetWorkDir_Empty(t *testing.T) {
 	require.Equal(t, "", provider.GetWorkDir())
 }
 
+func TestProcessRegistrySessionProvider_GetProcessWorkDir(t *testing.T) {
+	registry := process.NewProcessRegistry()
+	provider := NewProcessRegistrySessionProvider(registry, nil, nil, "/my/work/dir", 8765)
+
+	mockProc := newMockHeadlessProcess("session-ref")
+	mockProc.workDir = "/my/work/dir-worker-1"
+	registry.Register(process.New("worker-1", repository.RoleWorker, mockProc, nil, nil))
+
+	require.Equal(t, "/my/work/dir-worker-1", provider.GetProcessWorkDir("worker-1"),
+		"workers are resumed in the directory they were spawned in")
+	require.Equal(t, "/my/work/dir", provider.GetProcessWorkDir("worker-2"))
+}
+
+// staticWorkerWorktrees is a WorkerWorktrees that only knows fixed worktrees.
+type staticWorkerWorktrees map[string]WorkerWorktree
+
+func (s staticWorkerWorktrees) Create(context.Context, string) (string, error) { return "", nil }
+func (s staticWorkerWorktrees) Get(workerID string) (WorkerWorktree, bool) {
+	wt, ok := s[workerID]
+	return wt, ok
+}
+func (s staticWorkerWorktrees) Transfer(string, string) (string, bool) { return "", false }
+func (s staticWorkerWorktrees) Sync(string) error                      { return nil }
+func (s staticWorkerWorktrees) Integrate(string, string) error         { return nil }
+func (s staticWorkerWorktrees) Remove(string) error                    { return nil }
+
+func TestProcessRegistrySessionProvider_GetProcessWorkDir_RestoredWorker(t *testing.T) {
+	registry := process.NewProcessRegistry()
+	provider := NewProcessRegistrySessionProvider(registry, nil, nil, "/my/work/dir", 8765,
+		WithSessionWorkerWorktrees(staticWorkerWorktrees{
+			"worker-1": {Path: "/my/work/dir-worker-1", Branch: "perles-auth-worker-1", BaseBranch: "perles-auth"},
+		}))
+
+	require.Equal(t, "/my/work/dir-worker-1", provider.GetProcessWorkDir("worker-1"),
+		"workers restored without a live process resume in their own worktree")
+	require.Equal(t, "/my/work/dir", provider.GetProcessWorkDir("worker-2"))
+}
+
 // Verify ProcessRegistrySessionProvider implements SessionProvider interface
 // by checking it has all the required methods via a local interface definition.
 func TestProcessRegistrySessionProvider_ImplementsSessionProvider(t *testing.T) {
@@ -142,7 +181,7 @@ func TestProcessRegistrySessionProvider_ImplementsSessionProvider(t *testing.T)
 	type SessionProvider interface {
 		GetProcessSessionID(processID string) (string, error)
 		GenerateProcessMCPConfig(processID string) (string, error)
-		GetWorkDir() string
+		GetProcessWorkDir(processID string) string
 	}
 
 	var _ SessionProvider = provider
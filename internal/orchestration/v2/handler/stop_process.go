@@ -35,6 +35,7 @@ type StopWorkerHandler struct {
 	queueRepo          repository.QueueRepository
 	registry           *process.ProcessRegistry
 	fabricUnsubscriber FabricUnsubscriber
+	worktrees          WorkerWorktrees
 }
 
 // StopWorkerHandlerOption configures StopWorkerHandler.
@@ -48,6 +49,13 @@ func WithFabricUnsubscriber(unsubscriber FabricUnsubscriber) StopWorkerHandlerOp
 	}
 }
 
+// WithStopWorkerWorktrees sets the worker worktrees removed when a worker is stopped.
+func WithStopWorkerWorktrees(worktrees WorkerWorktrees) StopWorkerHandlerOption {
+	return func(h *StopWorkerHandler) {
+		h.worktrees = worktrees
+	}
+}
+
 // NewStopWorkerHandler creates a new StopWorkerHandler.
 func NewStopWorkerHandler(
 	processRepo repository.ProcessRepository,
@@ -210,6 +218,14 @@ func (h *StopWorkerHandler) updateRepositoryAndCleanupWithResult(proc *repositor
 		}
 	}
 
+	// Remove the worker's worktree (best-effort, unintegrated branches are kept)
+	if h.worktrees != nil && proc.Role == repository.RoleWorker {
+		if err := h.worktrees.Remove(proc.ID); err != nil {
+			log.Warn(log.CatOrch, "Failed to remove worker worktree",
+				"processID", proc.ID, "error", err)
+		}
+	}
+
 	// Drain any queued messages for this worker
 	var drainedCount int
 	if h.queueRepo != nil {
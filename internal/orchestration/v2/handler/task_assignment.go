@@ -36,6 +36,8 @@ type AssignTaskHandler struct {
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
 	bdExecutor  appbeads.IssueExecutor
+	worktrees   WorkerWorktrees
+	approvals   repository.ApprovalRepository
 	tracer      trace.Tracer
 }
 
@@ -57,6 +59,21 @@ func WithQueueRepository(queueRepo repository.QueueRepository) AssignTaskHandler
 	}
 }
 
+// WithAssignTaskWorkerWorktrees syncs the worker's own worktree with the
+// workflow branch before a task is assigned.
+func WithAssignTaskWorkerWorktrees(worktrees WorkerWorktrees) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.worktrees = worktrees
+	}
+}
+
+// WithAssignTaskApprovals refuses to assign tasks held by a pending approval.
+func WithAssignTaskApprovals(approvals repository.ApprovalRepository) AssignTaskHandlerOption {
+	return func(h *AssignTaskHandler) {
+		h.approvals = approvals
+	}
+}
+
 // WithAssignTaskTracer sets the tracer for span instrumentation.
 // If tracer is nil, the handler keeps its default noop tracer.
 func WithAssignTaskTracer(tracer trace.Tracer) AssignTaskHandlerOption {
@@ -162,6 +179,14 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 	if issue == nil {
 		return nil, fmt.Errorf("bd issue not found: %s. did you mean to use send_to_worker", proc.TaskID)
 	}
+	if issue.IsApprovalGate() {
+		return nil, fmt.Errorf("%w: %s is approved by a human, use require_approval instead", types.ErrApprovalGate, assignCmd.TaskID)
+	}
+	if h.approvals != nil {
+		if held, ok := h.approvals.ForTask(assignCmd.TaskID); ok {
+			return nil, fmt.Errorf("%w: %s is held by %s", types.ErrAwaitingApproval, assignCmd.TaskID, held.ID)
+		}
+	}
 
 	// Also check task repo for any task where this process is implementer
 	existingTasks, err := h.taskRepo.GetByImplementer(assignCmd.WorkerID)
@@ -179,6 +204,15 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 		)
 	}
 
+	// Start the task from all work integrated into the workflow branch so far
+	if h.worktrees != nil {
+		if _, ok := h.worktrees.Get(assignCmd.WorkerID); ok {
+			if err := h.worktrees.Sync(assignCmd.WorkerID); err != nil {
+				return nil, fmt.Errorf("failed to sync worker worktree: %w", err)
+			}
+		}
+	}
+
 	// 5. Create TaskAssignment with Implementer = workerID
 	task := &repository.TaskAssignment{
 		TaskID:      assignCmd.TaskID,
@@ -271,6 +305,18 @@ type AssignReviewHandler struct {
 	processRepo repository.ProcessRepository
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
+	worktrees   WorkerWorktrees
+}
+
+// AssignReviewHandlerOption configures AssignReviewHandler.
+type AssignReviewHandlerOption func(*AssignReviewHandler)
+
+// WithAssignReviewWorkerWorktrees limits reviews to the changes in the
+// implementer's own worktree.
+func WithAssignReviewWorkerWorktrees(worktrees WorkerWorktrees) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		h.worktrees = worktrees
+	}
 }
 
 // NewAssignReviewHandler creates a new AssignReviewHandler.
@@ -279,15 +325,20 @@ func NewAssignReviewHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...AssignReviewHandlerOption,
 ) *AssignReviewHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for AssignReviewHandler")
 	}
-	return &AssignReviewHandler{
+	h := &AssignReviewHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes an AssignReviewCommand.
@@ -366,6 +417,11 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 	} else {
 		reviewPrompt = prompt.ReviewAssignmentPrompt(reviewCmd.TaskID, reviewCmd.ImplementerID)
 	}
+	if h.worktrees != nil {
+		if wt, ok := h.worktrees.Get(reviewCmd.ImplementerID); ok {
+			reviewPrompt += prompt.WorkerWorktreeReviewScope(reviewCmd.ImplementerID, wt.Path, wt.Branch, wt.BaseBranch)
+		}
+	}
 	queue := h.queueRepo.GetOrCreate(reviewCmd.ReviewerID)
 	if err := queue.Enqueue(reviewPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue review prompt: %w", err)
@@ -408,10 +464,32 @@ type AssignReviewResult struct {
 // ApproveCommitHandler handles CmdApproveCommit commands.
 // It transitions the implementer to the committing phase after approval.
 // After updating state, it queues a CommitApprovalPrompt message to the implementer.
+// An implementer working in its own worktree has its changes committed and
+// merged into the workflow branch instead, and is sent an IntegratedCommitPrompt.
 type ApproveCommitHandler struct {
 	processRepo repository.ProcessRepository
 	taskRepo    repository.TaskRepository
 	queueRepo   repository.QueueRepository
+	worktrees   WorkerWorktrees
+	approvals   repository.ApprovalRepository
+}
+
+// ApproveCommitHandlerOption configures ApproveCommitHandler.
+type ApproveCommitHandlerOption func(*ApproveCommitHandler)
+
+// WithApproveCommitWorkerWorktrees integrates the implementer's worktree
+// branch into the workflow branch on approval.
+func WithApproveCommitWorkerWorktrees(worktrees WorkerWorktrees) ApproveCommitHandlerOption {
+	return func(h *ApproveCommitHandler) {
+		h.worktrees = worktrees
+	}
+}
+
+// WithApproveCommitApprovals refuses to commit tasks held by a pending approval.
+func WithApproveCommitApprovals(approvals repository.ApprovalRepository) ApproveCommitHandlerOption {
+	return func(h *ApproveCommitHandler) {
+		h.approvals = approvals
+	}
 }
 
 // NewApproveCommitHandler creates a new ApproveCommitHandler.
@@ -420,15 +498,20 @@ func NewApproveCommitHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...ApproveCommitHandlerOption,
 ) *ApproveCommitHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for ApproveCommitHandler")
 	}
-	return &ApproveCommitHandler{
+	h := &ApproveCommitHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes an ApproveCommitCommand.
@@ -450,6 +533,13 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 		return nil, types.ErrTaskNotApproved
 	}
 
+	// A human has not yet approved the change
+	if h.approvals != nil {
+		if held, ok := h.approvals.ForTask(approveCmd.TaskID); ok {
+			return nil, fmt.Errorf("%w: %s is held by %s", types.ErrAwaitingApproval, approveCmd.TaskID, held.ID)
+		}
+	}
+
 	// Validate task's implementer matches
 	if task.Implementer != approveCmd.ImplementerID {
 		return nil, types.ErrProcessNotImplementer
@@ -468,6 +558,20 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 		return nil, types.ErrProcessNotAwaitingReview
 	}
 
+	// Integrate the implementer's worktree branch before any state changes,
+	// so a conflicting merge leaves the task approved for a retry.
+	// The FIFO processor runs approvals one at a time, in order.
+	commitPrompt := prompt.CommitApprovalPrompt(approveCmd.TaskID, "")
+	if h.worktrees != nil {
+		if wt, ok := h.worktrees.Get(approveCmd.ImplementerID); ok {
+			message := fmt.Sprintf("%s: approved changes from %s", approveCmd.TaskID, approveCmd.ImplementerID)
+			if err := h.worktrees.Integrate(approveCmd.ImplementerID, message); err != nil {
+				return nil, fmt.Errorf("failed to integrate %s into %s: %w", wt.Branch, wt.BaseBranch, err)
+			}
+			commitPrompt = prompt.IntegratedCommitPrompt(approveCmd.TaskID, wt.Branch, wt.BaseBranch)
+		}
+	}
+
 	// 3. Update implementer: Phase = PhaseCommitting
 	committing := events.ProcessPhaseCommitting
 	implementer.Phase = &committing
@@ -487,8 +591,7 @@ func (h *ApproveCommitHandler) Handle(ctx context.Context, cmd command.Command)
 		return nil, fmt.Errorf("failed to save implementer: %w", err)
 	}
 
-	// 6. Queue CommitApprovalPrompt to the implementer (from coordinator)
-	commitPrompt := prompt.CommitApprovalPrompt(approveCmd.TaskID, "")
+	// 6. Queue the commit prompt to the implementer (from coordinator)
 	queue := h.queueRepo.GetOrCreate(approveCmd.ImplementerID)
 	if err := queue.Enqueue(commitPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue commit prompt: %w", err)
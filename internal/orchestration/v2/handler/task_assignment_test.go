@@ -81,6 +81,66 @@ func TestAssignTaskHandler_AssignsToReadyWorker(t *testing.T) {
 	require.Equal(t, repository.TaskImplementing, task.Status)
 }
 
+func TestAssignTaskHandler_FailsForApprovalGate(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.3").Return(&beads.Issue{
+		ID:     "perles-abc1.3",
+		Status: beads.StatusOpen,
+		Labels: []string{beads.LabelApprovalGate},
+	}, nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.3", "", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrApprovalGate)
+	_, err = taskRepo.Get("perles-abc1.3")
+	require.ErrorIs(t, err, repository.ErrTaskNotFound, "gate task must not be assigned")
+}
+
+func TestAssignTaskHandler_FailsWhileAwaitingApproval(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	approvalRepo := repository.NewMemoryApprovalRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, approvalRepo.Save(&repository.Approval{
+		ID:      "approval-1",
+		Summary: "Run the prod migration",
+		TaskIDs: []string{"perles-abc1.2"},
+	}))
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignTaskHandler(processRepo, taskRepo,
+		WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo), WithAssignTaskApprovals(approvalRepo))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrAwaitingApproval)
+	require.Contains(t, err.Error(), "approval-1")
+}
+
 func TestAssignTaskHandler_FailsIfWorkerNotReady(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -867,6 +927,43 @@ func TestApproveCommitHandler_FailsIfNotApproved(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrTaskNotApproved)
 }
 
+func TestApproveCommitHandler_FailsWhileAwaitingApproval(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	approvalRepo := repository.NewMemoryApprovalRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskApproved,
+		StartedAt:   time.Now(),
+	})
+	require.NoError(t, approvalRepo.Save(&repository.Approval{
+		ID:      "approval-1",
+		Summary: "Deploy the schema change",
+		TaskIDs: []string{"perles-abc1.2"},
+	}))
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewApproveCommitHandler(processRepo, taskRepo, queueRepo, WithApproveCommitApprovals(approvalRepo))
+
+	cmd := command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrAwaitingApproval)
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskApproved, updatedTask.Status)
+}
+
 func TestApproveCommitHandler_FailsIfNotAwaitingReview(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
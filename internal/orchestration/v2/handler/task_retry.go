@@ -0,0 +1,542 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the task retry policy, the provider cooldowns shared across
+// workflows, and the handler that starts the next attempt at a failed task.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"sync"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Retry defaults applied to zero RetryPolicy fields.
+const (
+	DefaultRetryBackoff           = 30 * time.Second
+	DefaultRetryRateLimitCooldown = 5 * time.Minute
+)
+
+// TaskRetryReason is the RetireProcessCommand reason for workers that failed
+// an attempt at a task.
+const TaskRetryReason = "task_retry"
+
+// ClassifyFailure classifies the error a worker's turn failed with, and
+// returns a detail for the attempt history.
+func ClassifyFailure(err error) (repository.FailureKind, string) {
+	var contextErr *process.ContextExceededError
+	if errors.As(err, &contextErr) {
+		return repository.FailureContextExceeded, contextErr.Error()
+	}
+
+	var agentErr *process.AgentError
+	if errors.As(err, &agentErr) {
+		switch agentErr.Reason {
+		case client.ErrReasonRateLimited:
+			return repository.FailureRateLimit, agentErr.Message
+		case client.ErrReasonContextExceeded:
+			return repository.FailureContextExceeded, agentErr.Message
+		}
+		return repository.FailureToolError, agentErr.Message
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return repository.FailureToolError, fmt.Sprintf("exit code %d: %s", exitErr.ExitCode(), err)
+	}
+	return repository.FailureToolError, err.Error()
+}
+
+// ===========================================================================
+// RetryPolicy
+// ===========================================================================
+
+// RetryPolicy configures how failed tasks are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts at a task, including the first.
+	// 0 or 1 disables retries.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for every retry
+	// after it. Defaults to DefaultRetryBackoff.
+	Backoff time.Duration
+	// RetryOn lists the failure kinds that are retried. Empty retries all kinds.
+	RetryOn []repository.FailureKind
+	// RateLimitCooldown is how long a provider gets no new workers after a
+	// rate limit. Defaults to DefaultRetryRateLimitCooldown.
+	RateLimitCooldown time.Duration
+}
+
+// Enabled reports whether failed tasks are retried.
+func (p RetryPolicy) Enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// withDefaults returns the policy with zero fields set to their defaults.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.Backoff == 0 {
+		p.Backoff = DefaultRetryBackoff
+	}
+	if p.RateLimitCooldown == 0 {
+		p.RateLimitCooldown = DefaultRetryRateLimitCooldown
+	}
+	return p
+}
+
+// retries reports whether failures of kind are retried.
+func (p RetryPolicy) retries(kind repository.FailureKind) bool {
+	return len(p.RetryOn) == 0 || slices.Contains(p.RetryOn, kind)
+}
+
+// backoff returns the delay before the retry that follows attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	return p.Backoff << (attempt - 1)
+}
+
+// ===========================================================================
+// ProviderCooldowns
+// ===========================================================================
+
+// ProviderCooldowns records the providers that hit a rate limit, so no new
+// workers are started on them until the cooldown ends. A single instance is
+// shared by all workflows of a control plane. Safe for concurrent use.
+type ProviderCooldowns struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	until map[client.ClientType]time.Time
+}
+
+// NewProviderCooldowns creates an empty set of provider cooldowns.
+func NewProviderCooldowns() *ProviderCooldowns {
+	return &ProviderCooldowns{
+		now:   time.Now,
+		until: make(map[client.ClientType]time.Time),
+	}
+}
+
+// CoolDown starts a cooldown of d for provider. An ongoing cooldown that ends
+// later is kept.
+func (c *ProviderCooldowns) CoolDown(provider client.ClientType, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if until := c.now().Add(d); until.After(c.until[provider]) {
+		c.until[provider] = until
+	}
+}
+
+// Remaining returns how long provider is still cooling down, or 0.
+func (c *ProviderCooldowns) Remaining(provider client.ClientType) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return max(c.until[provider].Sub(c.now()), 0)
+}
+
+// ===========================================================================
+// TaskRetries
+// ===========================================================================
+
+// RetryDecision is the outcome of a failed attempt at a task.
+type RetryDecision struct {
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+	// MaxAttempts is the number of attempts the policy allows.
+	MaxAttempts int
+	// Retry is true if another attempt is scheduled.
+	Retry bool
+	// Delay is how long until the next attempt starts.
+	Delay time.Duration
+	// RetryProvider is true if the next attempt runs on the retry provider.
+	RetryProvider bool
+}
+
+// TaskRetries applies the retry policy to failed tasks of a workflow. It
+// counts the attempts at each task and schedules the next attempt once the
+// backoff elapses. Which workers run on the retry provider is read from the
+// process repository, where it survives a restart.
+// Methods on a nil or disabled TaskRetries never retry. Safe for concurrent use.
+type TaskRetries struct {
+	policy      RetryPolicy
+	cooldowns   *ProviderCooldowns
+	providers   client.AgentProviders
+	processRepo repository.ProcessRepository
+	submitter   process.CommandSubmitter
+
+	mu       sync.Mutex
+	attempts map[string]int // taskID -> failed attempts
+	timers   map[string]*time.Timer
+	stopped  bool
+}
+
+// NewTaskRetries creates a retry tracker. cooldowns may be shared with other
+// workflows, providers selects the provider of each process, processRepo
+// records the workers on the retry provider, and scheduled retries are
+// submitted through submitter.
+func NewTaskRetries(
+	policy RetryPolicy,
+	cooldowns *ProviderCooldowns,
+	providers client.AgentProviders,
+	processRepo repository.ProcessRepository,
+	submitter process.CommandSubmitter,
+) *TaskRetries {
+	if cooldowns == nil {
+		cooldowns = NewProviderCooldowns()
+	}
+	return &TaskRetries{
+		policy:      policy.withDefaults(),
+		cooldowns:   cooldowns,
+		providers:   providers,
+		processRepo: processRepo,
+		submitter:   submitter,
+		attempts:    make(map[string]int),
+		timers:      make(map[string]*time.Timer),
+	}
+}
+
+// Enabled reports whether failed tasks are retried.
+func (r *TaskRetries) Enabled() bool {
+	return r != nil && r.policy.Enabled()
+}
+
+// MaxAttempts returns the number of attempts the policy allows.
+func (r *TaskRetries) MaxAttempts() int {
+	return r.policy.MaxAttempts
+}
+
+// RestoreAttempts seeds the failed attempts at taskID from recorded, e.g. the
+// attempt history of the task, when they were not counted since the workflow
+// started. recorded is only called for tasks without a count.
+func (r *TaskRetries) RestoreAttempts(taskID string, recorded func() int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.attempts[taskID]; ok {
+		return
+	}
+	r.attempts[taskID] = recorded()
+}
+
+// RecordFailure counts a failed attempt at taskID and decides whether the
+// task is retried. The next attempt runs on the retry provider when one is
+// configured, and waits for the cooldown of its provider.
+func (r *TaskRetries) RecordFailure(taskID string, kind repository.FailureKind) RetryDecision {
+	r.mu.Lock()
+	r.attempts[taskID]++
+	attempt := r.attempts[taskID]
+	r.mu.Unlock()
+
+	decision := RetryDecision{Attempt: attempt, MaxAttempts: r.policy.MaxAttempts}
+	if attempt >= r.policy.MaxAttempts || !r.policy.retries(kind) {
+		return decision
+	}
+
+	decision.Retry = true
+	decision.RetryProvider = r.providers.RetryWorker() != nil
+	decision.Delay = r.retryDelay(attempt, decision.RetryProvider)
+	return decision
+}
+
+// retryDelay returns how long to wait before retrying after attempt: its
+// backoff, or longer while the provider of the next worker is cooling down.
+func (r *TaskRetries) retryDelay(attempt int, retryProvider bool) time.Duration {
+	return max(r.policy.backoff(attempt), r.cooldowns.Remaining(r.workerProvider(retryProvider)))
+}
+
+// Schedule submits cmd once delay elapses, unless Stop is called first.
+func (r *TaskRetries) Schedule(cmd *command.RetryTaskCommand, delay time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return
+	}
+	if timer, ok := r.timers[cmd.TaskID]; ok {
+		timer.Stop()
+	}
+	r.timers[cmd.TaskID] = time.AfterFunc(delay, func() {
+		r.mu.Lock()
+		delete(r.timers, cmd.TaskID)
+		stopped := r.stopped
+		r.mu.Unlock()
+		if !stopped {
+			r.submitter.Submit(cmd)
+		}
+	})
+}
+
+// Stop cancels the retries that have not started yet.
+func (r *TaskRetries) Stop() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = true
+	for taskID, timer := range r.timers {
+		timer.Stop()
+		delete(r.timers, taskID)
+	}
+}
+
+// OnRetryProvider reports whether processID runs on the retry provider.
+func (r *TaskRetries) OnRetryProvider(processID string) bool {
+	if r == nil || r.processRepo == nil {
+		return false
+	}
+	proc, err := r.processRepo.Get(processID)
+	return err == nil && proc.RetryProvider
+}
+
+// ProviderOf returns the provider proc runs on.
+func (r *TaskRetries) ProviderOf(proc *repository.Process) client.ClientType {
+	switch proc.Role {
+	case repository.RoleCoordinator:
+		return r.providers.Coordinator().Type()
+	case repository.RoleObserver:
+		if observer, ok := r.providers[client.RoleObserver]; ok {
+			return observer.Type()
+		}
+	}
+	return r.workerProvider(proc.RetryProvider)
+}
+
+// CoolDown starts the rate limit cooldown of provider for all workflows.
+func (r *TaskRetries) CoolDown(provider client.ClientType) {
+	r.cooldowns.CoolDown(provider, r.policy.RateLimitCooldown)
+	log.Warn(log.CatOrch, "Provider rate limited, cooling down",
+		"provider", provider, "cooldown", r.policy.RateLimitCooldown)
+}
+
+// WorkerCooldown returns the provider a new worker would be spawned on and
+// how long that provider is still cooling down.
+func (r *TaskRetries) WorkerCooldown(retryProvider bool) (client.ClientType, time.Duration) {
+	provider := r.workerProvider(retryProvider)
+	return provider, r.cooldowns.Remaining(provider)
+}
+
+// workerProvider returns the provider of new workers.
+func (r *TaskRetries) workerProvider(retryProvider bool) client.ClientType {
+	if retryProvider {
+		if retry := r.providers.RetryWorker(); retry != nil {
+			return retry.Type()
+		}
+	}
+	return r.providers.Worker().Type()
+}
+
+// ===========================================================================
+// RetryTaskHandler
+// ===========================================================================
+
+// RetryTaskHandler handles CmdRetryTask commands.
+// It spawns a fresh worker, retires the worker that failed the previous
+// attempt and tells the coordinator to hand the work to the new worker. A
+// failed implementation reopens the task; a failed review keeps the
+// implementation and only the review is assigned again.
+type RetryTaskHandler struct {
+	processRepo repository.ProcessRepository
+	taskRepo    repository.TaskRepository
+	bdExecutor  appbeads.IssueExecutor
+	retries     *TaskRetries
+	spawner     CommandHandler
+}
+
+// NewRetryTaskHandler creates a new RetryTaskHandler. spawner handles the
+// SpawnProcessCommand of the fresh worker, so the retry only goes ahead once
+// the worker exists.
+// Panics if bdExecutor or spawner is nil.
+func NewRetryTaskHandler(
+	processRepo repository.ProcessRepository,
+	taskRepo repository.TaskRepository,
+	bdExecutor appbeads.IssueExecutor,
+	retries *TaskRetries,
+	spawner CommandHandler,
+) *RetryTaskHandler {
+	if bdExecutor == nil {
+		panic("bdExecutor is required for RetryTaskHandler")
+	}
+	if spawner == nil {
+		panic("spawner is required for RetryTaskHandler")
+	}
+	return &RetryTaskHandler{
+		processRepo: processRepo,
+		taskRepo:    taskRepo,
+		bdExecutor:  bdExecutor,
+		retries:     retries,
+		spawner:     spawner,
+	}
+}
+
+// Handle processes a RetryTaskCommand.
+// 1. Spawns a fresh worker, rescheduling the retry if it can't be spawned yet
+// 2. Releases the failed review, or the task and reopens it
+// 3. Retires the failed worker and tells the coordinator about the retry
+func (h *RetryTaskHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+	retryCmd := cmd.(*command.RetryTaskCommand)
+
+	if err := retryCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var failed *repository.Process
+	if retryCmd.WorkerID != "" {
+		if proc, err := h.processRepo.Get(retryCmd.WorkerID); err == nil {
+			failed = proc
+		}
+	}
+
+	// 1. Spawn the fresh worker with the agent type of the failed one. Nothing
+	// changes until it exists: a spawn held back by a cooldown or the worker
+	// limit is retried after another backoff
+	spawnResult, err := h.spawnWorker(ctx, retryCmd, failed)
+	if err != nil {
+		delay := h.retries.retryDelay(retryCmd.Attempt-1, retryCmd.RetryProvider)
+		log.Warn(log.CatOrch, "Failed to spawn worker for task retry, rescheduling",
+			"taskID", retryCmd.TaskID, "attempt", retryCmd.Attempt, "delay", delay, "error", err)
+		h.retries.Schedule(command.NewRetryTaskCommand(command.SourceInternal, retryCmd.TaskID,
+			retryCmd.Attempt, retryCmd.WorkerID, retryCmd.RetryProvider), delay)
+		return SuccessResult(&RetryTaskResult{
+			TaskID:  retryCmd.TaskID,
+			Attempt: retryCmd.Attempt,
+			RetryIn: delay,
+		}), nil
+	}
+	newWorkerID := spawnResult.Data.(*SpawnProcessResult).ProcessID
+
+	// 2. Release the failed work
+	var notify string
+	implementer := h.reviewedImplementer(failed, retryCmd.TaskID)
+	if implementer != "" {
+		if err := h.releaseReview(failed, retryCmd.TaskID); err != nil {
+			return nil, err
+		}
+		notify = prompt.ReviewRetryMessage(retryCmd.TaskID, retryCmd.Attempt, h.retries.MaxAttempts(),
+			retryCmd.WorkerID, newWorkerID, implementer)
+	} else {
+		if err := h.releaseTask(retryCmd.TaskID); err != nil {
+			return nil, err
+		}
+		notify = prompt.TaskRetryMessage(retryCmd.TaskID, retryCmd.Attempt, h.retries.MaxAttempts(),
+			retryCmd.WorkerID, newWorkerID)
+	}
+
+	// 3. Retire the failed worker and tell the coordinator about the retry
+	var followUps []command.Command
+	if failed != nil && failed.Status != repository.StatusRetired {
+		followUps = append(followUps,
+			command.NewRetireProcessCommand(command.SourceInternal, failed.ID, TaskRetryReason))
+	}
+	followUps = append(followUps, spawnResult.FollowUp...)
+	followUps = append(followUps,
+		command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, notify))
+
+	log.Info(log.CatOrch, "Retrying task",
+		"taskID", retryCmd.TaskID, "attempt", retryCmd.Attempt, "failedWorker", retryCmd.WorkerID,
+		"newWorker", newWorkerID, "review", implementer != "", "retryProvider", retryCmd.RetryProvider)
+
+	result := &RetryTaskResult{
+		TaskID:      retryCmd.TaskID,
+		Attempt:     retryCmd.Attempt,
+		NewWorkerID: newWorkerID,
+		Review:      implementer != "",
+	}
+	return SuccessWithEventsAndFollowUp(result, spawnResult.Events, followUps), nil
+}
+
+// spawnWorker spawns the worker for the next attempt through the spawn handler.
+func (h *RetryTaskHandler) spawnWorker(ctx context.Context, retryCmd *command.RetryTaskCommand, failed *repository.Process) (*command.CommandResult, error) {
+	var opts []command.SpawnProcessOption
+	if failed != nil && failed.AgentType != "" {
+		opts = append(opts, command.WithAgentType(failed.AgentType))
+	}
+	if retryCmd.RetryProvider {
+		opts = append(opts, command.WithRetryProvider())
+	}
+	result, err := h.spawner.Handle(ctx, command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker, opts...))
+	if err == nil && !result.Success {
+		err = result.Error
+	}
+	return result, err
+}
+
+// reviewedImplementer returns the implementer whose work the failed worker
+// was reviewing, or "" if the failed attempt was not a review.
+func (h *RetryTaskHandler) reviewedImplementer(failed *repository.Process, taskID string) string {
+	if failed == nil || failed.TaskID != taskID || failed.Phase == nil ||
+		*failed.Phase != events.ProcessPhaseReviewing || h.taskRepo == nil {
+		return ""
+	}
+	task, err := h.taskRepo.Get(taskID)
+	if err != nil || task.Implementer == "" || task.Implementer == failed.ID {
+		return ""
+	}
+	return task.Implementer
+}
+
+// releaseReview takes the review of taskID from the failed reviewer. The
+// implementer keeps the task and waits for the review to be assigned again.
+func (h *RetryTaskHandler) releaseReview(reviewer *repository.Process, taskID string) error {
+	task, err := h.taskRepo.Get(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	task.Reviewer = ""
+	if err := h.taskRepo.Save(task); err != nil {
+		return fmt.Errorf("failed to save task: %w", err)
+	}
+
+	idle := events.ProcessPhaseIdle
+	reviewer.TaskID = ""
+	reviewer.Phase = &idle
+	if err := h.processRepo.Save(reviewer); err != nil {
+		return fmt.Errorf("failed to save process: %w", err)
+	}
+	return nil
+}
+
+// releaseTask takes taskID from every worker holding it and reopens it. The
+// assignment is removed best-effort since the task may not be tracked in
+// memory after a restart.
+func (h *RetryTaskHandler) releaseTask(taskID string) error {
+	for _, proc := range h.processRepo.Workers() {
+		if proc.TaskID != taskID {
+			continue
+		}
+		idle := events.ProcessPhaseIdle
+		proc.TaskID = ""
+		proc.Phase = &idle
+		if err := h.processRepo.Save(proc); err != nil {
+			return fmt.Errorf("failed to save process: %w", err)
+		}
+	}
+
+	if h.taskRepo != nil {
+		_ = h.taskRepo.Delete(taskID)
+	}
+	if err := h.bdExecutor.UpdateStatus(taskID, beads.StatusOpen); err != nil {
+		return fmt.Errorf("failed to update BD task status: %w", err)
+	}
+	return nil
+}
+
+// RetryTaskResult contains the result of starting another attempt at a task.
+type RetryTaskResult struct {
+	TaskID  string
+	Attempt int
+
+	// NewWorkerID is the worker spawned for the attempt, empty if it was rescheduled.
+	NewWorkerID string
+	// Review is true if only the review of the task is retried.
+	Review bool
+	// RetryIn is the delay until the rescheduled retry, zero if it started.
+	RetryIn time.Duration
+}
@@ -0,0 +1,580 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// recordingSubmitter records the commands submitted by scheduled retries.
+type recordingSubmitter struct {
+	mu       sync.Mutex
+	commands []command.Command
+}
+
+func (s *recordingSubmitter) Submit(cmd command.Command) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commands = append(s.commands, cmd)
+}
+
+func (s *recordingSubmitter) submitted() []command.Command {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]command.Command(nil), s.commands...)
+}
+
+// claudeProviders runs every role on claude, with amp as the retry provider
+// when withRetry is set.
+func claudeProviders(withRetry bool) client.AgentProviders {
+	providers := client.AgentProviders{
+		client.RoleCoordinator: client.NewAgentProvider(client.ClientClaude, nil),
+	}
+	if withRetry {
+		providers[client.RoleRetryWorker] = client.NewAgentProvider(client.ClientAmp, nil)
+	}
+	return providers
+}
+
+func newTaskRetries(policy handler.RetryPolicy, withRetry bool) (*handler.TaskRetries, *recordingSubmitter) {
+	submitter := &recordingSubmitter{}
+	return handler.NewTaskRetries(policy, handler.NewProviderCooldowns(), claudeProviders(withRetry), nil, submitter), submitter
+}
+
+// ===========================================================================
+// ClassifyFailure Tests
+// ===========================================================================
+
+func TestClassifyFailure(t *testing.T) {
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+	require.Error(t, exitErr)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantKind   repository.FailureKind
+		wantDetail string
+	}{
+		{
+			name:       "rate limit reason",
+			err:        &process.AgentError{Message: "429 Too Many Requests", Reason: client.ErrReasonRateLimited},
+			wantKind:   repository.FailureRateLimit,
+			wantDetail: "429 Too Many Requests",
+		},
+		{
+			name:       "context exceeded reason",
+			err:        &process.AgentError{Message: "prompt is too long", Reason: client.ErrReasonContextExceeded},
+			wantKind:   repository.FailureContextExceeded,
+			wantDetail: "prompt is too long",
+		},
+		{
+			name:       "context exceeded error",
+			err:        &process.ContextExceededError{},
+			wantKind:   repository.FailureContextExceeded,
+			wantDetail: "context exceeded limit",
+		},
+		{
+			name:       "other agent error",
+			err:        &process.AgentError{Message: "invalid tool call", Reason: client.ErrReasonInvalidRequest},
+			wantKind:   repository.FailureToolError,
+			wantDetail: "invalid tool call",
+		},
+		{
+			name:       "exit code",
+			err:        fmt.Errorf("claude process exited: %w", exitErr),
+			wantKind:   repository.FailureToolError,
+			wantDetail: "exit code 3: claude process exited: exit status 3",
+		},
+		{
+			name:       "timeout",
+			err:        client.ErrTimeout,
+			wantKind:   repository.FailureToolError,
+			wantDetail: client.ErrTimeout.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, detail := handler.ClassifyFailure(tt.err)
+			require.Equal(t, tt.wantKind, kind)
+			require.Equal(t, tt.wantDetail, detail)
+		})
+	}
+}
+
+// ===========================================================================
+// ProviderCooldowns Tests
+// ===========================================================================
+
+func TestProviderCooldowns(t *testing.T) {
+	cooldowns := handler.NewProviderCooldowns()
+	require.Zero(t, cooldowns.Remaining(client.ClientClaude))
+
+	cooldowns.CoolDown(client.ClientClaude, time.Hour)
+	require.Greater(t, cooldowns.Remaining(client.ClientClaude), 59*time.Minute)
+	require.Zero(t, cooldowns.Remaining(client.ClientAmp))
+
+	// A shorter cooldown does not cut an ongoing one short
+	cooldowns.CoolDown(client.ClientClaude, time.Minute)
+	require.Greater(t, cooldowns.Remaining(client.ClientClaude), 59*time.Minute)
+}
+
+// ===========================================================================
+// TaskRetries Tests
+// ===========================================================================
+
+func TestTaskRetries_Disabled(t *testing.T) {
+	var nilRetries *handler.TaskRetries
+	require.False(t, nilRetries.Enabled())
+	require.False(t, nilRetries.OnRetryProvider("worker-1"))
+	nilRetries.Stop()
+
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 1}, false)
+	require.False(t, retries.Enabled())
+}
+
+func TestTaskRetries_RecordFailure_BacksOffUntilMaxAttempts(t *testing.T) {
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Second}, false)
+
+	first := retries.RecordFailure("perles-abc.1", repository.FailureToolError)
+	require.Equal(t, handler.RetryDecision{Attempt: 1, MaxAttempts: 3, Retry: true, Delay: 10 * time.Second}, first)
+
+	second := retries.RecordFailure("perles-abc.1", repository.FailureToolError)
+	require.True(t, second.Retry)
+	require.Equal(t, 20*time.Second, second.Delay)
+
+	third := retries.RecordFailure("perles-abc.1", repository.FailureToolError)
+	require.Equal(t, 3, third.Attempt)
+	require.False(t, third.Retry)
+
+	// Attempts are counted per task
+	require.Equal(t, 1, retries.RecordFailure("perles-abc.2", repository.FailureToolError).Attempt)
+}
+
+func TestTaskRetries_RecordFailure_RetryOn(t *testing.T) {
+	retries, _ := newTaskRetries(handler.RetryPolicy{
+		MaxAttempts: 3,
+		RetryOn:     []repository.FailureKind{repository.FailureRateLimit},
+	}, false)
+
+	require.False(t, retries.RecordFailure("perles-abc.1", repository.FailureGaveUp).Retry)
+	require.True(t, retries.RecordFailure("perles-abc.2", repository.FailureRateLimit).Retry)
+}
+
+func TestTaskRetries_RecordFailure_WaitsForProviderCooldown(t *testing.T) {
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 2, Backoff: time.Second}, false)
+	retries.CoolDown(client.ClientClaude)
+
+	decision := retries.RecordFailure("perles-abc.1", repository.FailureRateLimit)
+
+	require.True(t, decision.Retry)
+	require.False(t, decision.RetryProvider)
+	require.Greater(t, decision.Delay, 4*time.Minute, "delay covers the default rate limit cooldown")
+}
+
+func TestTaskRetries_RecordFailure_RetryProvider(t *testing.T) {
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 2, Backoff: time.Second}, true)
+	retries.CoolDown(client.ClientClaude)
+
+	decision := retries.RecordFailure("perles-abc.1", repository.FailureRateLimit)
+
+	// The retry runs on amp, which is not cooling down
+	require.True(t, decision.RetryProvider)
+	require.Equal(t, time.Second, decision.Delay)
+}
+
+func TestTaskRetries_ProviderOf(t *testing.T) {
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 2}, true)
+
+	require.Equal(t, client.ClientClaude, retries.ProviderOf(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator}))
+	require.Equal(t, client.ClientClaude, retries.ProviderOf(&repository.Process{ID: "worker-1", Role: repository.RoleWorker}))
+	require.Equal(t, client.ClientAmp, retries.ProviderOf(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, RetryProvider: true}))
+}
+
+func TestTaskRetries_Schedule(t *testing.T) {
+	retries, submitter := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, false)
+
+	cmd := command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "worker-1", false)
+	retries.Schedule(cmd, time.Millisecond)
+
+	require.Eventually(t, func() bool { return len(submitter.submitted()) == 1 }, time.Second, 5*time.Millisecond)
+	require.Same(t, cmd, submitter.submitted()[0])
+}
+
+func TestTaskRetries_StopCancelsScheduledRetries(t *testing.T) {
+	retries, submitter := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, false)
+
+	retries.Schedule(command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "", false), 20*time.Millisecond)
+	retries.Stop()
+	retries.Schedule(command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.2", 2, "", false), time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, submitter.submitted())
+}
+
+// ===========================================================================
+// MarkTaskFailedHandler Retry Tests
+// ===========================================================================
+
+func TestMarkTaskFailedHandler_SchedulesRetry(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusFailed, TaskID: "perles-abc.1"})
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-2"}))
+	retries, submitter := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}, false)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc.1").Return(&beads.Issue{ID: "perles-abc.1"}, nil).Once()
+	bdExecutor.EXPECT().AddComment("perles-abc.1", "coordinator",
+		"Attempt 1/3 failed (gave_up) on worker-2 (claude): Tests keep failing. Retrying in 1ms on a fresh worker (claude).").Return(nil)
+
+	h := handler.NewMarkTaskFailedHandler(bdExecutor, handler.WithMarkTaskFailedRetries(retries, processRepo, taskRepo))
+
+	cmd := command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc.1", "Tests keep failing")
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp, "the coordinator reported the failure itself")
+	failed := result.Data.(*handler.MarkTaskFailedResult)
+	require.True(t, failed.Retrying)
+	require.Contains(t, failed.RetryNotice(), "Attempt 1/3 failed")
+
+	require.Eventually(t, func() bool { return len(submitter.submitted()) == 1 }, time.Second, 5*time.Millisecond)
+	retryCmd := submitter.submitted()[0].(*command.RetryTaskCommand)
+	require.Equal(t, "perles-abc.1", retryCmd.TaskID)
+	require.Equal(t, 2, retryCmd.Attempt)
+	require.Equal(t, "worker-2", retryCmd.WorkerID)
+}
+
+func TestMarkTaskFailedHandler_CountsAttemptsFromTaskHistory(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3, Backoff: time.Hour}, false)
+	t.Cleanup(retries.Stop)
+
+	// The first attempt failed before a restart, so only its comment remains
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc.1").Return(&beads.Issue{ID: "perles-abc.1", Comments: []beads.Comment{
+		{Author: "coordinator", Text: "Attempt 1/3 failed (tool_error) on worker-2: exit code 1. Retrying in 30s on a fresh worker (claude)."},
+		{Author: "worker-5", Text: "Attempt 7/9 failed, says the worker"},
+	}}, nil).Once()
+	bdExecutor.EXPECT().AddComment("perles-abc.1", "coordinator", mock.MatchedBy(func(text string) bool {
+		return strings.HasPrefix(text, "Attempt 2/3 failed")
+	})).Return(nil).Once()
+	bdExecutor.EXPECT().AddComment("perles-abc.1", "coordinator", mock.MatchedBy(func(text string) bool {
+		return strings.HasPrefix(text, "Attempt 3/3 failed")
+	})).Return(nil).Once()
+
+	h := handler.NewMarkTaskFailedHandler(bdExecutor, handler.WithMarkTaskFailedRetries(retries, processRepo, nil))
+
+	result, err := h.Handle(context.Background(), command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc.1", "exit code 1"))
+	require.NoError(t, err)
+	failed := result.Data.(*handler.MarkTaskFailedResult)
+	require.Equal(t, 2, failed.Attempt)
+	require.True(t, failed.Retrying)
+
+	// The history is read once; later failures are counted in memory
+	result, err = h.Handle(context.Background(), command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc.1", "exit code 1"))
+	require.NoError(t, err)
+	failed = result.Data.(*handler.MarkTaskFailedResult)
+	require.Equal(t, 3, failed.Attempt)
+	require.False(t, failed.Retrying)
+}
+
+func TestMarkTaskFailedHandler_GivesUpAfterMaxAttempts(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	retries, submitter := newTaskRetries(handler.RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond}, false)
+	retries.RecordFailure("perles-abc.1", repository.FailureToolError)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc.1", "coordinator",
+		"Attempt 2/2 failed (tool_error) on worker-4: exit code 1. Giving up after 2 attempts.").Return(nil)
+
+	h := handler.NewMarkTaskFailedHandler(bdExecutor, handler.WithMarkTaskFailedRetries(retries, processRepo, nil))
+
+	// Failures detected by the orchestrator are reported to the coordinator
+	cmd := command.NewMarkTaskFailedCommand(command.SourceInternal, "perles-abc.1", "exit code 1",
+		command.WithFailure(repository.FailureToolError, "worker-4"))
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.False(t, result.Data.(*handler.MarkTaskFailedResult).Retrying)
+	require.Len(t, result.FollowUp, 1)
+	notify := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, notify.ProcessID)
+	require.Contains(t, notify.Content, "[TASK FAILED] Task perles-abc.1 failed on worker-4 (tool_error)")
+
+	time.Sleep(20 * time.Millisecond)
+	require.Empty(t, submitter.submitted())
+}
+
+// ===========================================================================
+// ProcessTurnCompleteHandler Retry Tests
+// ===========================================================================
+
+func TestProcessTurnCompleteHandler_WorkerErrorFailsAttempt(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	phase := events.ProcessPhaseImplementing
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking,
+		TaskID: "perles-abc.1", Phase: &phase, HasCompletedTurn: true,
+	})
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, false)
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo, handler.WithTurnCompleteTaskRetries(retries))
+
+	turnErr := &process.AgentError{Message: "429 Too Many Requests", Reason: client.ErrReasonRateLimited}
+	result, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-2", false, nil, turnErr))
+
+	require.NoError(t, err)
+	require.True(t, result.Data.(*handler.ProcessTurnCompleteResult).AttemptFailed)
+
+	proc, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, repository.StatusFailed, proc.Status)
+
+	require.Len(t, result.FollowUp, 1)
+	failCmd := result.FollowUp[0].(*command.MarkTaskFailedCommand)
+	require.Equal(t, "perles-abc.1", failCmd.TaskID)
+	require.Equal(t, "429 Too Many Requests", failCmd.Reason)
+	require.Equal(t, repository.FailureRateLimit, failCmd.Kind)
+	require.Equal(t, "worker-2", failCmd.WorkerID)
+	require.Equal(t, command.SourceInternal, failCmd.Source())
+
+	// The rate limit cools the worker's provider down
+	_, remaining := retries.WorkerCooldown(false)
+	require.Positive(t, remaining)
+}
+
+func TestProcessTurnCompleteHandler_CoordinatorRateLimitCoolsDownProvider(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking, HasCompletedTurn: true,
+	})
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, true)
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo, handler.WithTurnCompleteTaskRetries(retries))
+
+	turnErr := &process.AgentError{Message: "rate limited", Reason: client.ErrReasonRateLimited}
+	result, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand(repository.CoordinatorID, false, nil, turnErr))
+
+	require.NoError(t, err)
+	require.False(t, result.Data.(*handler.ProcessTurnCompleteResult).AttemptFailed)
+	for _, followUp := range result.FollowUp {
+		require.NotEqual(t, command.CmdMarkTaskFailed, followUp.Type())
+	}
+
+	provider, remaining := retries.WorkerCooldown(false)
+	require.Equal(t, client.ClientClaude, provider)
+	require.Positive(t, remaining)
+	_, remaining = retries.WorkerCooldown(true)
+	require.Zero(t, remaining, "the retry provider is not cooling down")
+}
+
+// ===========================================================================
+// SpawnProcessHandler Retry Tests
+// ===========================================================================
+
+func TestSpawnProcessHandler_HoldsBackWorkersDuringCooldown(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	spawner := &mockProcessSpawner{}
+	retries := handler.NewTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, handler.NewProviderCooldowns(),
+		claudeProviders(true), processRepo, &recordingSubmitter{})
+	retries.CoolDown(client.ClientClaude)
+
+	h := handler.NewSpawnProcessHandler(processRepo, nil,
+		handler.WithUnifiedSpawner(spawner),
+		handler.WithSpawnTaskRetries(retries))
+
+	_, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker))
+	require.ErrorContains(t, err, "provider claude is cooling down after a rate limit")
+	require.Empty(t, spawner.spawnCalls)
+
+	// Workers on the retry provider are still spawned, and remembered as such
+	result, err := h.Handle(context.Background(),
+		command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker, command.WithRetryProvider()))
+	require.NoError(t, err)
+	require.Len(t, spawner.spawnCalls, 1)
+	require.True(t, spawner.spawnCalls[0].RetryProvider)
+	processID := result.Data.(*handler.SpawnProcessResult).ProcessID
+	require.True(t, retries.OnRetryProvider(processID))
+	proc, err := processRepo.Get(processID)
+	require.NoError(t, err)
+	require.True(t, proc.RetryProvider, "the retry provider is persisted with the process")
+}
+
+// ===========================================================================
+// RetryTaskHandler Tests
+// ===========================================================================
+
+// newRetrySpawnHandler returns a spawn handler for RetryTaskHandler tests
+// that spawns workers without live processes.
+func newRetrySpawnHandler(processRepo repository.ProcessRepository, retries *handler.TaskRetries) *handler.SpawnProcessHandler {
+	return handler.NewSpawnProcessHandler(processRepo, nil, handler.WithSpawnTaskRetries(retries))
+}
+
+func TestRetryTaskHandler_StartsNextAttempt(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	phase := events.ProcessPhaseReviewing
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusFailed,
+		TaskID: "perles-abc.1", AgentType: roles.AgentTypeImplementer,
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusReady,
+		TaskID: "perles-abc.1", Phase: &phase,
+	})
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-2", Reviewer: "worker-3"}))
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, true)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc.1", beads.StatusOpen).Return(nil)
+
+	h := handler.NewRetryTaskHandler(processRepo, taskRepo, bdExecutor, retries, newRetrySpawnHandler(processRepo, retries))
+
+	cmd := command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "worker-2", true)
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, &handler.RetryTaskResult{TaskID: "perles-abc.1", Attempt: 2, NewWorkerID: "worker-4"}, result.Data)
+
+	// The fresh worker exists before the coordinator hears about it
+	spawned, err := processRepo.Get("worker-4")
+	require.NoError(t, err)
+	require.Equal(t, roles.AgentTypeImplementer, spawned.AgentType)
+	require.True(t, spawned.RetryProvider)
+	require.Len(t, result.Events, 1)
+	require.Equal(t, events.ProcessSpawned, result.Events[0].(events.ProcessEvent).Type)
+
+	// The task is released by both workers, and the assignment is gone
+	for _, id := range []string{"worker-2", "worker-3"} {
+		proc, err := processRepo.Get(id)
+		require.NoError(t, err)
+		require.Empty(t, proc.TaskID)
+		require.Equal(t, events.ProcessPhaseIdle, *proc.Phase)
+	}
+	_, err = taskRepo.Get("perles-abc.1")
+	require.Error(t, err)
+
+	require.Len(t, result.FollowUp, 2)
+	retire := result.FollowUp[0].(*command.RetireProcessCommand)
+	require.Equal(t, "worker-2", retire.ProcessID)
+	require.Equal(t, handler.TaskRetryReason, retire.Reason)
+
+	notify := result.FollowUp[1].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, notify.ProcessID)
+	require.Contains(t, notify.Content, "[TASK RETRY] Starting attempt 2/3 at task perles-abc.1. worker-2 has been retired.")
+	require.Contains(t, notify.Content, "worker-4 has been spawned for it")
+}
+
+func TestRetryTaskHandler_FailedReviewRetriesOnlyTheReview(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	awaitingReview := events.ProcessPhaseAwaitingReview
+	reviewing := events.ProcessPhaseReviewing
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady,
+		TaskID: "perles-abc.1", Phase: &awaitingReview,
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusFailed,
+		TaskID: "perles-abc.1", Phase: &reviewing, AgentType: roles.AgentTypeReviewer,
+	})
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc.1", Implementer: "worker-2", Reviewer: "worker-3", Status: repository.TaskInReview,
+	}))
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, false)
+
+	// The task is not reopened
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+
+	h := handler.NewRetryTaskHandler(processRepo, taskRepo, bdExecutor, retries, newRetrySpawnHandler(processRepo, retries))
+
+	result, err := h.Handle(context.Background(), command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "worker-3", false))
+	require.NoError(t, err)
+	require.Equal(t, &handler.RetryTaskResult{TaskID: "perles-abc.1", Attempt: 2, NewWorkerID: "worker-4", Review: true}, result.Data)
+
+	// The implementer keeps the task and waits for a new reviewer
+	implementer, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, "perles-abc.1", implementer.TaskID)
+	require.Equal(t, events.ProcessPhaseAwaitingReview, *implementer.Phase)
+
+	reviewer, err := processRepo.Get("worker-3")
+	require.NoError(t, err)
+	require.Empty(t, reviewer.TaskID)
+
+	task, err := taskRepo.Get("perles-abc.1")
+	require.NoError(t, err)
+	require.Equal(t, "worker-2", task.Implementer)
+	require.Empty(t, task.Reviewer)
+
+	spawned, err := processRepo.Get("worker-4")
+	require.NoError(t, err)
+	require.Equal(t, roles.AgentTypeReviewer, spawned.AgentType)
+
+	require.Len(t, result.FollowUp, 2)
+	require.Equal(t, "worker-3", result.FollowUp[0].(*command.RetireProcessCommand).ProcessID)
+	notify := result.FollowUp[1].(*command.SendToProcessCommand)
+	require.Contains(t, notify.Content, "[TASK RETRY] Starting attempt 2/3 at the review of task perles-abc.1. worker-3 has been retired.")
+	require.Contains(t, notify.Content, "use assign_review to assign it the review of task perles-abc.1 (implementer: worker-2)")
+}
+
+func TestRetryTaskHandler_ReschedulesWhenSpawnFails(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{
+		ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusFailed, TaskID: "perles-abc.1",
+	})
+	retries, submitter := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}, false)
+	retries.CoolDown(client.ClientClaude)
+
+	// Nothing changes while the worker can't be spawned
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+
+	h := handler.NewRetryTaskHandler(processRepo, nil, bdExecutor, retries, newRetrySpawnHandler(processRepo, retries))
+
+	result, err := h.Handle(context.Background(), command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "worker-2", false))
+	require.NoError(t, err)
+	retry := result.Data.(*handler.RetryTaskResult)
+	require.Empty(t, retry.NewWorkerID)
+	require.Greater(t, retry.RetryIn, time.Minute, "the retry waits for the provider cooldown")
+	require.Empty(t, result.FollowUp, "the coordinator is not told about a retry that has not started")
+
+	proc, err := processRepo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, "perles-abc.1", proc.TaskID)
+	require.Equal(t, repository.StatusFailed, proc.Status)
+
+	retries.Stop()
+	require.Empty(t, submitter.submitted())
+}
+
+func TestRetryTaskHandler_FailsOnStatusUpdateError(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	retries, _ := newTaskRetries(handler.RetryPolicy{MaxAttempts: 3}, false)
+
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc.1", beads.StatusOpen).Return(errors.New("database is locked"))
+
+	h := handler.NewRetryTaskHandler(processRepo, nil, bdExecutor, retries, newRetrySpawnHandler(processRepo, retries))
+
+	_, err := h.Handle(context.Background(), command.NewRetryTaskCommand(command.SourceInternal, "perles-abc.1", 2, "", false))
+	require.ErrorContains(t, err, "failed to update BD task status: database is locked")
+}
@@ -23,6 +23,8 @@ var RequiredTools = []string{
 	"fabric_ack",
 	"report_implementation_complete",
 	"report_review_verdict",
+	"report_policy_violation",
+	"submit_handoff",
 	"signal_ready",
 }
 
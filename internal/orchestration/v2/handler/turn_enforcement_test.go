@@ -493,8 +493,10 @@ func TestRequiredTools_ContainsExpectedTools(t *testing.T) {
 	assert.Contains(t, handler.RequiredTools, "fabric_ack")
 	assert.Contains(t, handler.RequiredTools, "report_implementation_complete")
 	assert.Contains(t, handler.RequiredTools, "report_review_verdict")
+	assert.Contains(t, handler.RequiredTools, "report_policy_violation")
+	assert.Contains(t, handler.RequiredTools, "submit_handoff")
 	assert.Contains(t, handler.RequiredTools, "signal_ready")
-	assert.Len(t, handler.RequiredTools, 6)
+	assert.Len(t, handler.RequiredTools, 8)
 }
 
 // ===========================================================================
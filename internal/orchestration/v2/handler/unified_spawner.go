@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
@@ -38,6 +39,14 @@ type SpawnOptions struct {
 	// SystemPromptOverride overrides the system prompt for the process.
 	// Empty string means use the default prompt.
 	SystemPromptOverride string
+
+	// WorkDir overrides the working directory of a worker, e.g. its own worktree.
+	// Empty string means use the spawner's working directory.
+	WorkDir string
+
+	// RetryProvider spawns a worker on the retry provider, used for workers
+	// retrying a failed task. Ignored if no retry provider is configured.
+	RetryProvider bool
 }
 
 // UnifiedProcessSpawnerImpl implements UnifiedProcessSpawner for spawning real AI processes.
@@ -47,15 +56,18 @@ type UnifiedProcessSpawnerImpl struct {
 	coordinatorClient     client.HeadlessClient
 	workerClient          client.HeadlessClient
 	observerClient        client.HeadlessClient
+	retryWorkerClient     client.HeadlessClient
 	coordinatorExtensions map[string]any
 	workerExtensions      map[string]any
 	observerExtensions    map[string]any
+	retryWorkerExtensions map[string]any
 	workDir               string
 	port                  int
 	submitter             process.CommandSubmitter
 	eventBus              *pubsub.Broker[any]
 	beadsDir              string
 	sessionDir            string
+	policies              client.ToolPolicies
 }
 
 // UnifiedSpawnerConfig holds configuration for creating a UnifiedProcessSpawnerImpl.
@@ -68,22 +80,30 @@ type UnifiedSpawnerConfig struct {
 	// ObserverClient is the AI client for spawning the observer.
 	// If nil, uses WorkerClient (or CoordinatorClient) as fallback.
 	ObserverClient client.HeadlessClient
+	// RetryWorkerClient is the AI client for workers retrying a failed task.
+	// If nil, retries use WorkerClient.
+	RetryWorkerClient client.HeadlessClient
 	// CoordinatorExtensions holds provider-specific config for coordinator.
 	CoordinatorExtensions map[string]any
 	// WorkerExtensions holds provider-specific config for workers.
 	WorkerExtensions map[string]any
 	// ObserverExtensions holds provider-specific config for observer.
 	ObserverExtensions map[string]any
-	WorkDir            string
-	Port               int
-	Submitter          process.CommandSubmitter
-	EventBus           *pubsub.Broker[any]
+	// RetryWorkerExtensions holds provider-specific config for retry workers.
+	RetryWorkerExtensions map[string]any
+	WorkDir               string
+	Port                  int
+	Submitter             process.CommandSubmitter
+	EventBus              *pubsub.Broker[any]
 	// BeadsDir is the path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
 	// SessionDir is the path to the session directory.
 	// Used for template replacement in Observer prompts ({{SESSION_DIR}}).
 	SessionDir string
+	// Policies restricts the tools of each role. Workers are looked up by
+	// agent type first, then as workers. Nil means no restrictions.
+	Policies client.ToolPolicies
 }
 
 // NewUnifiedProcessSpawner creates a new UnifiedProcessSpawnerImpl.
@@ -108,19 +128,30 @@ func NewUnifiedProcessSpawner(cfg UnifiedSpawnerConfig) *UnifiedProcessSpawnerIm
 		observerExtensions = workerExtensions
 	}
 
+	// Retry workers run on the worker client unless a retry client is set
+	retryWorkerClient := cfg.RetryWorkerClient
+	retryWorkerExtensions := cfg.RetryWorkerExtensions
+	if retryWorkerClient == nil {
+		retryWorkerClient = workerClient
+		retryWorkerExtensions = workerExtensions
+	}
+
 	return &UnifiedProcessSpawnerImpl{
 		coordinatorClient:     cfg.CoordinatorClient,
 		workerClient:          workerClient,
 		observerClient:        observerClient,
+		retryWorkerClient:     retryWorkerClient,
 		coordinatorExtensions: cfg.CoordinatorExtensions,
 		workerExtensions:      workerExtensions,
 		observerExtensions:    observerExtensions,
+		retryWorkerExtensions: retryWorkerExtensions,
 		workDir:               cfg.WorkDir,
 		port:                  cfg.Port,
 		submitter:             cfg.Submitter,
 		eventBus:              cfg.EventBus,
 		beadsDir:              cfg.BeadsDir,
 		sessionDir:            cfg.SessionDir,
+		policies:              cfg.Policies,
 	}
 }
 
@@ -143,6 +174,10 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 	default:
 		aiClient = s.workerClient
 		extensions = s.workerExtensions
+		if opts.RetryProvider {
+			aiClient = s.retryWorkerClient
+			extensions = s.retryWorkerExtensions
+		}
 	}
 
 	if aiClient == nil {
@@ -225,7 +260,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	default:
 		// Worker uses role-specific prompts based on AgentType
-		mcpConfig, err := s.generateWorkerMCPConfig(id)
+		mcpConfig, err := workerMCPConfig(aiClient, s.port, id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate MCP config: %w", err)
 		}
@@ -237,8 +272,13 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		systemPrompt := roles.ComposeSystemPrompt(id, opts.AgentType, opts.WorkflowConfig)
 		initialPrompt := roles.ComposeInitialPrompt(id, opts.AgentType, opts.WorkflowConfig)
 
+		workDir := s.workDir
+		if opts.WorkDir != "" {
+			workDir = opts.WorkDir
+		}
+
 		cfg = client.Config{
-			WorkDir:         s.workDir,
+			WorkDir:         workDir,
 			BeadsDir:        s.beadsDir,
 			Prompt:          initialPrompt,
 			SystemPrompt:    systemPrompt,
@@ -249,6 +289,13 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	}
 
+	// Apply the role's tool policy: providers translate it into native flags,
+	// and the rules are stated in the system prompt for those they can't enforce
+	if policy := s.PolicyFor(role, opts.AgentType); policy != nil {
+		cfg.Policy = policy
+		cfg.SystemPrompt += policyPrompt(policy, role)
+	}
+
 	// Spawn the underlying AI process
 	headlessProc, err := aiClient.Spawn(ctx, cfg)
 	if err != nil {
@@ -264,6 +311,45 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 	return proc, nil
 }
 
+// PolicyFor returns the tool policy of a process, or nil if it is unrestricted.
+func (s *UnifiedProcessSpawnerImpl) PolicyFor(role repository.ProcessRole, agentType roles.AgentType) *client.ToolPolicy {
+	switch role {
+	case repository.RoleCoordinator:
+		return s.policies.Lookup(client.PolicyCoordinator)
+	case repository.RoleObserver:
+		return s.policies.Lookup(client.PolicyObserver)
+	default:
+		return s.policies.Lookup(string(agentType), client.PolicyWorker)
+	}
+}
+
+// PolicyForProcess returns the tool policy of a process's next turn, or nil
+// if it is unrestricted. A worker reviewing a task runs under the reviewer
+// policy whatever agent type it was spawned with, so a generic worker assigned
+// a review can't write while it reviews.
+func (s *UnifiedProcessSpawnerImpl) PolicyForProcess(proc *repository.Process) *client.ToolPolicy {
+	if proc.Role == repository.RoleWorker && proc.Phase != nil && *proc.Phase == events.ProcessPhaseReviewing {
+		return s.policies.Lookup(string(roles.AgentTypeReviewer), string(proc.AgentType), client.PolicyWorker)
+	}
+	return s.PolicyFor(proc.Role, proc.AgentType)
+}
+
+// policyPrompt states the rules of a tool policy for the system prompt.
+func policyPrompt(policy *client.ToolPolicy, role repository.ProcessRole) string {
+	var b strings.Builder
+	b.WriteString("\n\n## Tool Policy\n\nYour tools are restricted by these rules:\n")
+	for _, rule := range policy.Rules() {
+		fmt.Fprintf(&b, "- %s\n", rule)
+	}
+	if role == repository.RoleWorker {
+		b.WriteString("\nIf your task needs an action these rules forbid, do not work around them. " +
+			"Call report_policy_violation with the action and the rule it breaks, then end your turn and wait for the coordinator.\n")
+	} else {
+		b.WriteString("\nNever work around these rules.\n")
+	}
+	return b.String()
+}
+
 // generateCoordinatorMCPConfig returns the appropriate MCP config for the coordinator.
 func (s *UnifiedProcessSpawnerImpl) generateCoordinatorMCPConfig() (string, error) {
 	if s.coordinatorClient == nil {
@@ -285,20 +371,26 @@ func (s *UnifiedProcessSpawnerImpl) generateCoordinatorMCPConfig() (string, erro
 
 // generateWorkerMCPConfig returns the appropriate MCP config format for workers based on client type.
 func (s *UnifiedProcessSpawnerImpl) generateWorkerMCPConfig(processID string) (string, error) {
-	if s.workerClient == nil {
-		return mcp.GenerateWorkerConfigHTTP(s.port, processID)
+	return workerMCPConfig(s.workerClient, s.port, processID)
+}
+
+// workerMCPConfig returns the appropriate MCP config format for a worker on
+// workerClient.
+func workerMCPConfig(workerClient client.HeadlessClient, port int, processID string) (string, error) {
+	if workerClient == nil {
+		return mcp.GenerateWorkerConfigHTTP(port, processID)
 	}
-	switch s.workerClient.Type() {
+	switch workerClient.Type() {
 	case client.ClientAmp:
-		return mcp.GenerateWorkerConfigAmp(s.port, processID)
+		return mcp.GenerateWorkerConfigAmp(port, processID)
 	case client.ClientCodex:
-		return mcp.GenerateWorkerConfigCodex(s.port, processID), nil
+		return mcp.GenerateWorkerConfigCodex(port, processID), nil
 	case client.ClientGemini:
-		return mcp.GenerateWorkerConfigGemini(s.port, processID)
+		return mcp.GenerateWorkerConfigGemini(port, processID)
 	case client.ClientOpenCode:
-		return mcp.GenerateWorkerConfigOpenCode(s.port, processID)
+		return mcp.GenerateWorkerConfigOpenCode(port, processID)
 	default:
-		return mcp.GenerateWorkerConfigHTTP(s.port, processID)
+		return mcp.GenerateWorkerConfigHTTP(port, processID)
 	}
 }
 
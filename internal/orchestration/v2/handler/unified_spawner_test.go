@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/mock"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
@@ -384,6 +385,112 @@ func TestUnifiedProcessSpawner_SpawnWorker_PassesBeadsDir(t *testing.T) {
 	proc.Stop()
 }
 
+func TestUnifiedProcessSpawner_SpawnWorker_UsesWorkDirOverride(t *testing.T) {
+	var capturedConfig client.Config
+	mockClient := mock.NewClient()
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		capturedConfig = cfg
+		return mock.NewProcess(), nil
+	}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkDir:           "/test/workdir",
+		Port:              8080,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker,
+		SpawnOptions{WorkDir: "/test/workdir-worker-1"})
+	require.NoError(t, err)
+	require.NotNil(t, proc)
+
+	// Verify the worker runs in its own worktree
+	assert.Equal(t, "/test/workdir-worker-1", capturedConfig.WorkDir)
+
+	// Cleanup
+	proc.Stop()
+}
+
+func TestUnifiedProcessSpawner_SpawnWorker_AppliesAgentTypePolicy(t *testing.T) {
+	var capturedConfig client.Config
+	mockClient := mock.NewClient()
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		capturedConfig = cfg
+		return mock.NewProcess(), nil
+	}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkerClient:      mockClient,
+		WorkDir:           "/test/workdir",
+		Port:              8080,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+		Policies: client.ToolPolicies{
+			client.PolicyWorker: {DenyNetwork: true},
+			"reviewer":          {ReadOnly: true},
+		},
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker,
+		SpawnOptions{AgentType: roles.AgentTypeReviewer})
+	require.NoError(t, err)
+	require.NotNil(t, proc)
+	proc.Stop()
+
+	// The reviewer policy replaces the generic worker policy
+	require.NotNil(t, capturedConfig.Policy)
+	assert.True(t, capturedConfig.Policy.ReadOnly)
+	assert.False(t, capturedConfig.Policy.DenyNetwork)
+	assert.Contains(t, capturedConfig.SystemPrompt, "## Tool Policy")
+	assert.Contains(t, capturedConfig.SystemPrompt, "Never create, edit or delete files")
+	assert.Contains(t, capturedConfig.SystemPrompt, "report_policy_violation")
+
+	// Generic workers fall back to the worker policy
+	proc, err = spawner.SpawnProcess(context.Background(), "worker-2", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	proc.Stop()
+
+	require.NotNil(t, capturedConfig.Policy)
+	assert.True(t, capturedConfig.Policy.DenyNetwork)
+
+	// Coordinators are unrestricted without a coordinator policy
+	proc, err = spawner.SpawnProcess(context.Background(), repository.CoordinatorID, repository.RoleCoordinator, SpawnOptions{})
+	require.NoError(t, err)
+	proc.Stop()
+
+	assert.Nil(t, capturedConfig.Policy)
+	assert.NotContains(t, capturedConfig.SystemPrompt, "## Tool Policy")
+}
+
+func TestUnifiedProcessSpawner_PolicyForProcess_ReviewingWorkerIsReadOnly(t *testing.T) {
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		Policies: client.ToolPolicies{
+			client.PolicyWorker: {DenyNetwork: true},
+			"reviewer":          {ReadOnly: true},
+		},
+	})
+
+	implementing := events.ProcessPhaseImplementing
+	reviewing := events.ProcessPhaseReviewing
+
+	// A generic worker implements under the worker policy
+	policy := spawner.PolicyForProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Phase: &implementing})
+	require.NotNil(t, policy)
+	assert.False(t, policy.ReadOnly)
+	assert.True(t, policy.DenyNetwork)
+
+	// The same worker reviews under the reviewer policy
+	policy = spawner.PolicyForProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Phase: &reviewing})
+	require.NotNil(t, policy)
+	assert.True(t, policy.ReadOnly)
+
+	// Coordinators are never treated as reviewers
+	assert.Nil(t, spawner.PolicyForProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Phase: &reviewing}))
+}
+
 func TestUnifiedProcessSpawner_SpawnCoordinator_UsesWorkflowConfigSystemPromptOverride(t *testing.T) {
 	var capturedConfig client.Config
 	mockClient := mock.NewClient()
@@ -0,0 +1,12 @@
+package handler
+
+// WorkerAdmitter gates worker spawns against resource limits shared with other
+// workflows (e.g., a global worker cap or a spend budget).
+// Implementations must be thread-safe.
+type WorkerAdmitter interface {
+	// AdmitWorker reserves room for one new worker, or returns an error
+	// explaining why the spawn is refused. The returned release func must be
+	// called once the worker has been saved to the process repository (or the
+	// spawn failed), after which the worker is counted as live.
+	AdmitWorker() (release func(), err error)
+}
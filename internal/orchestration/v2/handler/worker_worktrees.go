@@ -0,0 +1,35 @@
+package handler
+
+import "context"
+
+// WorkerWorktree describes the git worktree a worker runs in.
+type WorkerWorktree struct {
+	// Path is the directory of the worker's worktree.
+	Path string
+	// Branch is the worker's branch, checked out in Path.
+	Branch string
+	// BaseBranch is the workflow branch the worker's branch is integrated into.
+	BaseBranch string
+}
+
+// WorkerWorktrees gives each worker its own git worktree on a branch of the
+// workflow branch, so implementers never edit the same checkout.
+// Implementations must be thread-safe.
+type WorkerWorktrees interface {
+	// Create creates the worktree of a worker and returns its path.
+	Create(ctx context.Context, workerID string) (string, error)
+	// Get returns the worktree of a worker, or false if it has none.
+	Get(workerID string) (WorkerWorktree, bool)
+	// Transfer hands the worktree of a worker over to its replacement and
+	// returns its path, or false if the worker has none.
+	Transfer(fromWorkerID, toWorkerID string) (string, bool)
+	// Sync points an idle worker's branch at the tip of the workflow branch,
+	// so a new task starts from all work integrated so far.
+	Sync(workerID string) error
+	// Integrate commits a worker's outstanding changes with message and merges
+	// its branch into the workflow branch. Calls are serialized, so branches
+	// are integrated in the order they are approved.
+	Integrate(workerID, message string) error
+	// Remove removes a worker's worktree, and its branch once integrated.
+	Remove(workerID string) error
+}
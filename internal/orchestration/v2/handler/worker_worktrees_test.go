@@ -0,0 +1,262 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// stubWorkerWorktrees is a test implementation of handler.WorkerWorktrees
+// that records the calls made to it.
+type stubWorkerWorktrees struct {
+	mu           sync.Mutex
+	worktrees    map[string]handler.WorkerWorktree
+	synced       []string
+	integrated   []string
+	messages     []string
+	removed      []string
+	integrateErr error
+}
+
+func newStubWorkerWorktrees() *stubWorkerWorktrees {
+	return &stubWorkerWorktrees{worktrees: make(map[string]handler.WorkerWorktree)}
+}
+
+func (s *stubWorkerWorktrees) Create(_ context.Context, workerID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wt := handler.WorkerWorktree{
+		Path:       "/repo-wt-" + workerID,
+		Branch:     "perles-auth-" + workerID,
+		BaseBranch: "perles-auth",
+	}
+	s.worktrees[workerID] = wt
+	return wt.Path, nil
+}
+
+func (s *stubWorkerWorktrees) Get(workerID string) (handler.WorkerWorktree, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wt, ok := s.worktrees[workerID]
+	return wt, ok
+}
+
+func (s *stubWorkerWorktrees) Transfer(fromWorkerID, toWorkerID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wt, ok := s.worktrees[fromWorkerID]
+	if !ok {
+		return "", false
+	}
+	delete(s.worktrees, fromWorkerID)
+	s.worktrees[toWorkerID] = wt
+	return wt.Path, true
+}
+
+func (s *stubWorkerWorktrees) Sync(workerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.synced = append(s.synced, workerID)
+	return nil
+}
+
+func (s *stubWorkerWorktrees) Integrate(workerID, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.integrateErr != nil {
+		return s.integrateErr
+	}
+	s.integrated = append(s.integrated, workerID)
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func (s *stubWorkerWorktrees) Remove(workerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.worktrees, workerID)
+	s.removed = append(s.removed, workerID)
+	return nil
+}
+
+func TestSpawnProcessHandler_WorkerWorktrees(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	spawner := &mockProcessSpawner{}
+	worktrees := newStubWorkerWorktrees()
+
+	h := handler.NewSpawnProcessHandler(processRepo, nil,
+		handler.WithUnifiedSpawner(spawner),
+		handler.WithSpawnWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleCoordinator))
+	require.NoError(t, err)
+	_, err = h.Handle(context.Background(), command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker))
+	require.NoError(t, err)
+
+	require.Len(t, spawner.spawnCalls, 2)
+	require.Empty(t, spawner.spawnCalls[0].WorkDir, "the coordinator keeps the workflow's working directory")
+	require.Equal(t, "/repo-wt-worker-1", spawner.spawnCalls[1].WorkDir)
+}
+
+func TestRetireProcessHandler_RemovesWorkerWorktree(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady})
+	worktrees := newStubWorkerWorktrees()
+
+	h := handler.NewRetireProcessHandler(processRepo, process.NewProcessRegistry(),
+		handler.WithRetireWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewRetireProcessCommand(command.SourceMCPTool, "worker-1", "done"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1"}, worktrees.removed)
+}
+
+func TestStopWorkerHandler_RemovesWorkerWorktree(t *testing.T) {
+	processRepo, taskRepo, queueRepo := setupStopWorkerRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady})
+	worktrees := newStubWorkerWorktrees()
+
+	h := handler.NewStopWorkerHandler(processRepo, taskRepo, queueRepo, process.NewProcessRegistry(),
+		handler.WithStopWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewStopProcessCommand(command.SourceUser, "worker-1", false, "user requested stop"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1"}, worktrees.removed)
+}
+
+func TestReplaceProcessHandler_HandsWorkerWorktreeOver(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking})
+	spawner := &mockProcessSpawner{}
+	worktrees := newStubWorkerWorktrees()
+	_, err := worktrees.Create(context.Background(), "worker-1")
+	require.NoError(t, err)
+
+	h := handler.NewReplaceProcessHandler(processRepo, nil,
+		handler.WithReplaceSpawner(spawner),
+		handler.WithReplaceWorkerWorktrees(worktrees))
+
+	result, err := h.Handle(context.Background(), command.NewReplaceProcessCommand(command.SourceInternal, "worker-1", "stuck"))
+	require.NoError(t, err)
+	newWorkerID := result.Data.(*handler.ReplaceProcessResult).NewProcessID
+
+	require.Len(t, spawner.spawnCalls, 1)
+	require.Equal(t, "/repo-wt-worker-1", spawner.spawnCalls[0].WorkDir, "the replacement continues in the old worktree")
+	_, ok := worktrees.Get("worker-1")
+	require.False(t, ok)
+	wt, ok := worktrees.Get(newWorkerID)
+	require.True(t, ok)
+	require.Equal(t, "perles-auth-worker-1", wt.Branch)
+}
+
+func TestAssignTaskHandler_SyncsWorkerWorktree(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	idle := events.ProcessPhaseIdle
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle})
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusInProgress).Return(nil)
+	worktrees := newStubWorkerWorktrees()
+	_, _ = worktrees.Create(context.Background(), "worker-1")
+
+	h := handler.NewAssignTaskHandler(processRepo, repository.NewMemoryTaskRepository(),
+		handler.WithBDExecutor(bdExecutor),
+		handler.WithQueueRepository(repository.NewMemoryQueueRepository(0)),
+		handler.WithAssignTaskWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Implement X", ""))
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1"}, worktrees.synced, "the task starts from the tip of the workflow branch")
+}
+
+func TestAssignReviewHandler_ScopesReviewToImplementerWorktree(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	idle := events.ProcessPhaseIdle
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	worktrees := newStubWorkerWorktrees()
+	_, _ = worktrees.Create(context.Background(), "worker-1")
+
+	h := handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo,
+		handler.WithAssignReviewWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeSimple))
+	require.NoError(t, err)
+
+	msg, _ := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.Contains(t, msg.Content, "git -C /repo-wt-worker-1 diff $(git -C /repo-wt-worker-1 merge-base perles-auth HEAD)")
+}
+
+// setupApprovedTask adds worker-1 awaiting review of an approved task.
+func setupApprovedTask(t *testing.T) (*repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	awaitingReview := events.ProcessPhaseAwaitingReview
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     &awaitingReview,
+		TaskID:    "perles-abc1.2",
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskApproved,
+	}))
+	return processRepo, taskRepo
+}
+
+func TestApproveCommitHandler_IntegratesWorkerBranch(t *testing.T) {
+	processRepo, taskRepo := setupApprovedTask(t)
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	worktrees := newStubWorkerWorktrees()
+	_, _ = worktrees.Create(context.Background(), "worker-1")
+
+	h := handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo,
+		handler.WithApproveCommitWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2"))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"worker-1"}, worktrees.integrated)
+	require.Equal(t, []string{"perles-abc1.2: approved changes from worker-1"}, worktrees.messages)
+	msg, _ := queueRepo.GetOrCreate("worker-1").Dequeue()
+	require.Contains(t, msg.Content, "merged into the workflow branch perles-auth")
+	require.NotContains(t, msg.Content, "Please create a git commit")
+}
+
+func TestApproveCommitHandler_IntegrationFailureKeepsTaskApproved(t *testing.T) {
+	processRepo, taskRepo := setupApprovedTask(t)
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	worktrees := newStubWorkerWorktrees()
+	_, _ = worktrees.Create(context.Background(), "worker-1")
+	worktrees.integrateErr = errors.New("merge conflict: auth.go")
+
+	h := handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo,
+		handler.WithApproveCommitWorkerWorktrees(worktrees))
+
+	_, err := h.Handle(context.Background(), command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2"))
+	require.ErrorContains(t, err, "failed to integrate perles-auth-worker-1 into perles-auth: merge conflict: auth.go")
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, repository.TaskApproved, task.Status)
+	require.Equal(t, 0, queueRepo.GetOrCreate("worker-1").Size())
+}
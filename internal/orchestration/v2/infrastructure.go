@@ -12,6 +12,7 @@ import (
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
@@ -45,6 +46,7 @@ type InfrastructureConfig struct {
 	Port int
 	// AgentProviders maps roles to their AI client providers.
 	// Must contain at least RoleCoordinator. RoleWorker falls back to coordinator if not set.
+	// RoleRetryWorker, if set, runs the workers that retry failed tasks.
 	AgentProviders client.AgentProviders
 	// WorkDir is the working directory for the orchestration session.
 	WorkDir string
@@ -75,6 +77,43 @@ type InfrastructureConfig struct {
 	// CommandPersistenceProvider returns the current CommandWriter for persisting commands.
 	// Optional - if nil, commands are not persisted to commands.jsonl.
 	CommandPersistenceProvider func() processor.CommandWriter
+	// WorkerAdmitter gates worker spawns against limits shared across workflows.
+	// Optional - if nil, workers are spawned without limits.
+	WorkerAdmitter handler.WorkerAdmitter
+	// WorkerWorktrees gives each worker its own git worktree branched from the
+	// workflow branch. Optional - if nil, all processes share WorkDir.
+	WorkerWorktrees handler.WorkerWorktrees
+	// ToolPolicies restricts the tools of processes by role and agent type.
+	// Optional - if nil, processes run with the provider's default permissions.
+	ToolPolicies client.ToolPolicies
+	// ContextHandoffThreshold is the context window usage, in percent, at which a
+	// process is asked for a handoff summary and replaced by a fresh process.
+	// Optional - if 0, processes are not handed off.
+	ContextHandoffThreshold float64
+	// Autoscale spawns and retires workers to match the ready tasks of EpicID.
+	// Optional - if nil, or EpicID or TaskQuerier is unset, the coordinator
+	// scales its workers itself.
+	Autoscale *AutoscaleConfig
+	// EpicID is the beads epic the workflow works on, used by Autoscale.
+	EpicID string
+	// TaskQuerier runs the BQL queries that find the epic's ready tasks.
+	TaskQuerier bql.BQLExecutor
+	// RetryPolicy retries failed tasks on fresh workers.
+	// Optional - if MaxAttempts is 0 or 1, failed tasks are not retried.
+	RetryPolicy handler.RetryPolicy
+	// ProviderCooldowns records rate-limited providers, shared by the workflows
+	// of a control plane. Optional - if nil, the workflow keeps its own.
+	ProviderCooldowns *handler.ProviderCooldowns
+	// Repositories holds the repositories for process, task and queue state,
+	// e.g. durable ones that survive a crash. Optional - if nil, in-memory
+	// repositories are used.
+	Repositories *RepositoryComponents
+	// BeadsExecutor syncs task state to the issue tracker.
+	// Optional - if nil, a bd executor for WorkDir and BeadsDir is used.
+	BeadsExecutor appbeads.IssueExecutor
+	// SkipFollowUps drops the follow-up commands returned by handlers. Used when
+	// replaying a command log, where follow-ups were recorded as commands of their own.
+	SkipFollowUps bool
 }
 
 // Validate checks that all required configuration is provided.
@@ -132,6 +171,9 @@ type RepositoryComponents struct {
 	TaskRepo repository.TaskRepository
 	// QueueRepo tracks per-worker message queues.
 	QueueRepo repository.QueueRepository
+	// ApprovalRepo tracks approval requests awaiting a human decision.
+	// Defaults to an in-memory repository when nil.
+	ApprovalRepo repository.ApprovalRepository
 }
 
 // InternalComponents holds internal infrastructure not exposed externally.
@@ -140,6 +182,10 @@ type InternalComponents struct {
 	ProcessRegistry *process.ProcessRegistry
 	// TurnEnforcer tracks MCP tool calls during worker turns for enforcement.
 	TurnEnforcer handler.TurnCompletionEnforcer
+	// Autoscaler sizes the worker pool to the epic's ready tasks (nil when disabled).
+	Autoscaler *Autoscaler
+	// TaskRetries schedules the retries of failed tasks.
+	TaskRetries *handler.TaskRetries
 }
 
 // NewInfrastructure creates all v2 orchestration infrastructure components.
@@ -168,10 +214,30 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	}
 	workerExtensions := cfg.AgentProviders.Worker().Extensions()
 
+	// Get retry worker client and extensions (nil retries on the worker client)
+	var retryWorkerClient client.HeadlessClient
+	var retryWorkerExtensions map[string]any
+	if retryProvider := cfg.AgentProviders.RetryWorker(); retryProvider != nil {
+		retryWorkerClient, err = retryProvider.Client()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get retry worker client: %w", err)
+		}
+		retryWorkerExtensions = retryProvider.Extensions()
+	}
+
 	// Create repositories
-	taskRepo := repository.NewMemoryTaskRepository()
-	queueRepo := repository.NewMemoryQueueRepository(repository.DefaultQueueMaxSize)
-	processRepo := repository.NewMemoryProcessRepository()
+	var taskRepo repository.TaskRepository = repository.NewMemoryTaskRepository()
+	var queueRepo repository.QueueRepository = repository.NewMemoryQueueRepository(repository.DefaultQueueMaxSize)
+	var processRepo repository.ProcessRepository = repository.NewMemoryProcessRepository()
+	var approvalRepo repository.ApprovalRepository = repository.NewMemoryApprovalRepository()
+	if cfg.Repositories != nil {
+		taskRepo = cfg.Repositories.TaskRepo
+		queueRepo = cfg.Repositories.QueueRepo
+		processRepo = cfg.Repositories.ProcessRepo
+		if cfg.Repositories.ApprovalRepo != nil {
+			approvalRepo = cfg.Repositories.ApprovalRepo
+		}
+	}
 
 	// Create Fabric messaging layer repositories and service
 	// Fabric provides graph-based messaging ("Slack for Agents") with channels, threads, and artifacts.
@@ -200,13 +266,17 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	})
 
 	// Create command processor with event bus for TUI event propagation
-	cmdProcessor := processor.NewCommandProcessor(
+	processorOpts := []processor.Option{
 		processor.WithQueueCapacity(1000),
 		processor.WithTaskRepository(taskRepo),
 		processor.WithQueueRepository(queueRepo),
 		processor.WithEventBus(eventBus),
 		processor.WithMiddleware(tracingMiddleware, loggingMiddleware, commandLogMiddleware, commandPersistenceMiddleware, timeoutMiddleware),
-	)
+	}
+	if cfg.SkipFollowUps {
+		processorOpts = append(processorOpts, processor.WithoutFollowUps())
+	}
+	cmdProcessor := processor.NewCommandProcessor(processorOpts...)
 
 	// Create unified ProcessRegistry for coordinator and workers
 	processRegistry := process.NewProcessRegistry()
@@ -215,7 +285,16 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	turnEnforcer := handler.NewTurnCompletionTracker()
 
 	// Create BDTaskExecutor for syncing v2 state changes to BD tracker
-	beadsExec := infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
+	beadsExec := cfg.BeadsExecutor
+	if beadsExec == nil {
+		beadsExec = infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
+	}
+
+	// Create command submitter adapter
+	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
+
+	// Create task retry tracker, scheduling retries through the processor
+	taskRetries := handler.NewTaskRetries(cfg.RetryPolicy, cfg.ProviderCooldowns, cfg.AgentProviders, processRepo, cmdSubmitter)
 
 	// Register all command handlers
 	registerHandlers(
@@ -223,12 +302,15 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		processRepo,
 		taskRepo,
 		queueRepo,
+		approvalRepo,
 		processRegistry,
 		turnEnforcer,
 		coordinatorClient,
 		workerClient,
+		retryWorkerClient,
 		coordinatorExtensions,
 		workerExtensions,
+		retryWorkerExtensions,
 		beadsExec,
 		cfg.Port,
 		eventBus,
@@ -240,12 +322,14 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		cfg.SoundService,
 		cfg.SessionMetadataProvider,
 		cfg.WorkflowStateProvider,
+		cfg.WorkerAdmitter,
+		cfg.WorkerWorktrees,
+		cfg.ToolPolicies,
+		cfg.ContextHandoffThreshold,
+		taskRetries,
 		fabricService,
 	)
 
-	// Create command submitter adapter
-	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
-
 	// Create V2Adapter with repositories for read-only operations
 	v2Adapter := adapter.NewV2Adapter(cmdProcessor,
 		adapter.WithProcessRepository(processRepo),
@@ -256,6 +340,12 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 
 	// NOTE: CoordinatorNudger removed - FabricBroker handles @mention notifications
 
+	// Create autoscaler when enabled for an epic
+	var autoscaler *Autoscaler
+	if cfg.Autoscale != nil && cfg.EpicID != "" && cfg.TaskQuerier != nil {
+		autoscaler = NewAutoscaler(*cfg.Autoscale, cfg.EpicID, cfg.TaskQuerier, processRepo, cmdProcessor)
+	}
+
 	return &Infrastructure{
 		Core: CoreComponents{
 			Processor:     cmdProcessor,
@@ -265,13 +355,16 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 			FabricService: fabricService,
 		},
 		Repositories: RepositoryComponents{
-			ProcessRepo: processRepo,
-			TaskRepo:    taskRepo,
-			QueueRepo:   queueRepo,
+			ProcessRepo:  processRepo,
+			TaskRepo:     taskRepo,
+			QueueRepo:    queueRepo,
+			ApprovalRepo: approvalRepo,
 		},
 		Internal: InternalComponents{
 			ProcessRegistry: processRegistry,
 			TurnEnforcer:    turnEnforcer,
+			Autoscaler:      autoscaler,
+			TaskRetries:     taskRetries,
 		},
 		config: cfg,
 	}, nil
@@ -299,6 +392,10 @@ func (i *Infrastructure) Start(ctx context.Context) error {
 
 	// NOTE: CoordinatorNudger.Start() removed - FabricBroker.Start() is called by Supervisor
 
+	if i.Internal.Autoscaler != nil {
+		i.Internal.Autoscaler.Start(ctx)
+	}
+
 	return nil
 }
 
@@ -314,6 +411,12 @@ func (i *Infrastructure) Drain() {
 // This is the recommended way to cleanly shut down the infrastructure.
 // NOTE: FabricBroker.Stop() is called by Supervisor before this.
 func (i *Infrastructure) Shutdown() {
+	// Stop the autoscaler first so it doesn't spawn workers while they stop
+	if i.Internal.Autoscaler != nil {
+		i.Internal.Autoscaler.Stop()
+	}
+	// Cancel retries that have not started yet
+	i.Internal.TaskRetries.Stop()
 	// Stop all processes (coordinator and workers)
 	if i.Internal.ProcessRegistry != nil {
 		i.Internal.ProcessRegistry.StopAll()
@@ -329,19 +432,25 @@ func (i *Infrastructure) Shutdown() {
 //   - Task Assignment (4): AssignTask, AssignReview, ApproveCommit, AssignReviewFeedback
 //   - State Transition (4): ReportComplete, ReportVerdict, TransitionPhase, ProcessTurnComplete
 //   - BD Task Status (2): MarkTaskComplete, MarkTaskFailed
-//   - Process Management (7): SpawnProcess, SendToProcess, DeliverProcessQueued,
-//     RetireProcess, StopProcess, ReplaceProcess
+//   - Process Management (9): SpawnProcess, RetryTask, SendToProcess, DeliverProcessQueued,
+//     RetireProcess, StopProcess, ReplaceProcess, PauseProcess, ResumeProcess
+//   - User Interaction (3): NotifyUser, RequireApproval, ResolveApproval
+//   - Policy (1): ReportPolicyViolation
+//   - Context Handoff (1): SubmitHandoff
 func registerHandlers(
 	cmdProcessor *processor.CommandProcessor,
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	approvalRepo repository.ApprovalRepository,
 	processRegistry *process.ProcessRegistry,
 	turnEnforcer handler.TurnCompletionEnforcer,
 	coordinatorClient client.HeadlessClient,
 	workerClient client.HeadlessClient,
+	retryWorkerClient client.HeadlessClient,
 	coordinatorExtensions map[string]any,
 	workerExtensions map[string]any,
+	retryWorkerExtensions map[string]any,
 	beadsExec appbeads.IssueExecutor,
 	port int,
 	eventBus *pubsub.Broker[any],
@@ -353,10 +462,16 @@ func registerHandlers(
 	soundService sound.SoundService,
 	sessionMetadataProvider handler.SessionMetadataProvider,
 	workflowStateProvider handler.WorkflowStateProvider,
+	workerAdmitter handler.WorkerAdmitter,
+	workerWorktrees handler.WorkerWorktrees,
+	toolPolicies client.ToolPolicies,
+	contextHandoffThreshold float64,
+	taskRetries *handler.TaskRetries,
 	fabricService *fabric.Service,
 ) {
 	// Create shared infrastructure components
 	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
+	contextHandoffs := handler.NewContextHandoffs(contextHandoffThreshold)
 
 	// Use NoopSoundService if none provided
 	if soundService == nil {
@@ -370,11 +485,16 @@ func registerHandlers(
 		handler.NewAssignTaskHandler(processRepo, taskRepo,
 			handler.WithBDExecutor(beadsExec),
 			handler.WithQueueRepository(queueRepo),
+			handler.WithAssignTaskWorkerWorktrees(workerWorktrees),
+			handler.WithAssignTaskApprovals(approvalRepo),
 			handler.WithAssignTaskTracer(tracer)))
 	cmdProcessor.RegisterHandler(command.CmdAssignReview,
-		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo))
+		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo,
+			handler.WithAssignReviewWorkerWorktrees(workerWorktrees)))
 	cmdProcessor.RegisterHandler(command.CmdApproveCommit,
-		handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo))
+		handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo,
+			handler.WithApproveCommitWorkerWorktrees(workerWorktrees),
+			handler.WithApproveCommitApprovals(approvalRepo)))
 	cmdProcessor.RegisterHandler(command.CmdAssignReviewFeedback,
 		handler.NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo))
 
@@ -397,37 +517,47 @@ func registerHandlers(
 			handler.WithProcessTurnEnforcer(turnEnforcer),
 			handler.WithTurnCompleteProcessRegistry(processRegistry),
 			handler.WithSessionRefNotifier(sessionRefNotifier),
-			handler.WithProcessTurnSoundService(soundService)))
+			handler.WithProcessTurnSoundService(soundService),
+			handler.WithTurnCompleteContextHandoffs(contextHandoffs),
+			handler.WithTurnCompleteTaskRetries(taskRetries)))
 
 	// ============================================================
 	// BD Task Status handlers (2)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskComplete,
-		handler.NewMarkTaskCompleteHandler(beadsExec, taskRepo))
+		handler.NewMarkTaskCompleteHandler(beadsExec, taskRepo,
+			handler.WithMarkTaskCompleteApprovals(approvalRepo)))
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskFailed,
-		handler.NewMarkTaskFailedHandler(beadsExec))
+		handler.NewMarkTaskFailedHandler(beadsExec,
+			handler.WithMarkTaskFailedRetries(taskRetries, processRepo, taskRepo)))
 
 	// ============================================================
-	// Process Management handlers (7)
+	// Process Management handlers (9)
 	// ============================================================
 
 	// Create process spawner with separate coordinator/worker clients
 	processSpawner := handler.NewUnifiedProcessSpawner(handler.UnifiedSpawnerConfig{
 		CoordinatorClient:     coordinatorClient,
 		WorkerClient:          workerClient,
+		RetryWorkerClient:     retryWorkerClient,
 		CoordinatorExtensions: coordinatorExtensions,
 		WorkerExtensions:      workerExtensions,
+		RetryWorkerExtensions: retryWorkerExtensions,
 		WorkDir:               workDir,
 		Port:                  port,
 		Submitter:             cmdSubmitter,
 		EventBus:              eventBus,
 		BeadsDir:              beadsDir,
 		SessionDir:            sessionDir,
+		Policies:              toolPolicies,
 	})
 
 	// MessageDeliverer for delivering messages to processes via session resume
 	// Uses role-based client selection (coordinator vs worker)
-	sessionProvider := handler.NewProcessRegistrySessionProvider(processRegistry, coordinatorClient, workerClient, workDir, port)
+	// Workers retrying a failed task are resumed on the retry provider
+	sessionProvider := handler.NewProcessRegistrySessionProvider(processRegistry, coordinatorClient, workerClient, workDir, port,
+		handler.WithSessionRetryWorkerClient(retryWorkerClient, taskRetries.OnRetryProvider),
+		handler.WithSessionWorkerWorktrees(workerWorktrees))
 
 	messageDeliverer := integration.NewProcessSessionDeliverer(
 		sessionProvider,
@@ -437,30 +567,50 @@ func registerHandlers(
 		coordinatorExtensions,
 		workerExtensions,
 		integration.WithBeadsDir(beadsDir),
+		integration.WithToolPolicies(func(processID string) *client.ToolPolicy {
+			proc, err := processRepo.Get(processID)
+			if err != nil {
+				return nil
+			}
+			return processSpawner.PolicyForProcess(proc)
+		}),
+		integration.WithRetryWorkerClient(retryWorkerClient, retryWorkerExtensions, taskRetries.OnRetryProvider),
 	)
 
-	cmdProcessor.RegisterHandler(command.CmdSpawnProcess,
-		handler.NewSpawnProcessHandler(processRepo, processRegistry,
-			handler.WithUnifiedSpawner(processSpawner),
-			handler.WithTurnEnforcer(turnEnforcer),
-			handler.WithSpawnProcessTracer(tracer)))
+	spawnHandler := handler.NewSpawnProcessHandler(processRepo, processRegistry,
+		handler.WithUnifiedSpawner(processSpawner),
+		handler.WithTurnEnforcer(turnEnforcer),
+		handler.WithWorkerAdmitter(workerAdmitter),
+		handler.WithSpawnWorkerWorktrees(workerWorktrees),
+		handler.WithSpawnTaskRetries(taskRetries),
+		handler.WithSpawnProcessTracer(tracer))
+	cmdProcessor.RegisterHandler(command.CmdSpawnProcess, spawnHandler)
+	// Retries spawn the fresh worker synchronously, before releasing the task
+	cmdProcessor.RegisterHandler(command.CmdRetryTask,
+		handler.NewRetryTaskHandler(processRepo, taskRepo, beadsExec, taskRetries, spawnHandler))
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo,
-			handler.WithSendToProcessTracer(tracer)))
+			handler.WithSendToProcessTracer(tracer),
+			handler.WithSendToProcessRegistry(processRegistry)))
 	cmdProcessor.RegisterHandler(command.CmdDeliverProcessQueued,
 		handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, processRegistry,
 			handler.WithProcessDeliverer(messageDeliverer),
 			handler.WithDeliverTurnEnforcer(turnEnforcer)))
 	cmdProcessor.RegisterHandler(command.CmdRetireProcess,
 		handler.NewRetireProcessHandler(processRepo, processRegistry,
-			handler.WithRetireTurnEnforcer(turnEnforcer)))
+			handler.WithRetireTurnEnforcer(turnEnforcer),
+			handler.WithRetireWorkerWorktrees(workerWorktrees)))
 	cmdProcessor.RegisterHandler(command.CmdStopProcess,
 		handler.NewStopWorkerHandler(processRepo, taskRepo, queueRepo, processRegistry,
-			handler.WithFabricUnsubscriber(fabricService)))
+			handler.WithFabricUnsubscriber(fabricService),
+			handler.WithStopWorkerWorktrees(workerWorktrees)))
 	cmdProcessor.RegisterHandler(command.CmdReplaceProcess,
 		handler.NewReplaceProcessHandler(processRepo, processRegistry,
 			handler.WithReplaceSpawner(processSpawner),
-			handler.WithWorkflowStateProvider(workflowStateProvider)))
+			handler.WithWorkflowStateProvider(workflowStateProvider),
+			handler.WithReplaceWorkerWorktrees(workerWorktrees),
+			handler.WithReplaceTaskRepository(taskRepo),
+			handler.WithReplaceQueueRepository(queueRepo)))
 	cmdProcessor.RegisterHandler(command.CmdPauseProcess,
 		handler.NewPauseProcessHandler(processRepo,
 			handler.WithPauseRegistry(processRegistry)))
@@ -482,9 +632,26 @@ func registerHandlers(
 			handler.WithWorkflowSoundService(soundService)))
 
 	// ============================================================
-	// User Interaction handlers (1)
+	// User Interaction handlers (3)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdNotifyUser,
 		handler.NewNotifyUserHandler(
 			handler.WithNotifyUserSoundService(soundService)))
+	cmdProcessor.RegisterHandler(command.CmdRequireApproval,
+		handler.NewRequireApprovalHandler(processRepo, taskRepo, approvalRepo,
+			handler.WithRequireApprovalSoundService(soundService)))
+	cmdProcessor.RegisterHandler(command.CmdResolveApproval,
+		handler.NewResolveApprovalHandler(approvalRepo))
+
+	// ============================================================
+	// Policy handlers (1)
+	// ============================================================
+	cmdProcessor.RegisterHandler(command.CmdReportPolicyViolation,
+		handler.NewReportPolicyViolationHandler(processRepo))
+
+	// ============================================================
+	// Context Handoff handlers (1)
+	// ============================================================
+	cmdProcessor.RegisterHandler(command.CmdSubmitHandoff,
+		handler.NewSubmitHandoffHandler(processRepo, contextHandoffs))
 }
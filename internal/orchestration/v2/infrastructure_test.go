@@ -112,6 +112,25 @@ func TestNewInfrastructure(t *testing.T) {
 
 		// Verify Internal components are created
 		assert.NotNil(t, infra.Internal.ProcessRegistry)
+		assert.Nil(t, infra.Internal.Autoscaler)
+	})
+
+	t.Run("creates autoscaler for an epic", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:     "/tmp/test",
+			Autoscale:   &AutoscaleConfig{MaxWorkers: 2},
+			EpicID:      "perles-abc",
+			TaskQuerier: mocks.NewMockBQLExecutor(t),
+		}
+
+		infra, err := NewInfrastructure(cfg)
+		require.NoError(t, err)
+		require.NotNil(t, infra.Internal.Autoscaler)
+		assert.Equal(t, 2, infra.Internal.Autoscaler.cfg.MaxWorkers)
 	})
 
 	t.Run("returns error for invalid config", func(t *testing.T) {
@@ -33,8 +33,8 @@ type SessionProvider interface {
 	// For workers, generates worker MCP config with their ID.
 	GenerateProcessMCPConfig(processID string) (string, error)
 
-	// GetWorkDir returns the working directory for processes.
-	GetWorkDir() string
+	// GetProcessWorkDir returns the working directory of a process.
+	GetProcessWorkDir(processID string) string
 }
 
 // ProcessResumer abstracts process resume functionality for message delivery.
@@ -55,6 +55,10 @@ type ProcessSessionDeliverer struct {
 	coordinatorExtensions map[string]any
 	workerExtensions      map[string]any
 	beadsDir              string
+	policyFor             func(processID string) *client.ToolPolicy
+	retryWorkerClient     client.HeadlessClient
+	retryWorkerExtensions map[string]any
+	onRetryProvider       func(processID string) bool
 }
 
 // ProcessSessionDelivererOption configures ProcessSessionDeliverer.
@@ -74,6 +78,30 @@ func WithBeadsDir(beadsDir string) ProcessSessionDelivererOption {
 	}
 }
 
+// WithToolPolicies sets the lookup for the tool policy of a process, so resumed
+// sessions run under the same policy as the first turn. policyFor returns nil
+// for unrestricted processes.
+func WithToolPolicies(policyFor func(processID string) *client.ToolPolicy) ProcessSessionDelivererOption {
+	return func(d *ProcessSessionDeliverer) {
+		d.policyFor = policyFor
+	}
+}
+
+// WithRetryWorkerClient sets the client and extensions of workers retrying a
+// failed task, so their sessions are resumed on the provider they started on.
+// onRetryProvider reports whether a worker runs on the retry provider.
+func WithRetryWorkerClient(
+	retryWorkerClient client.HeadlessClient,
+	extensions map[string]any,
+	onRetryProvider func(processID string) bool,
+) ProcessSessionDelivererOption {
+	return func(d *ProcessSessionDeliverer) {
+		d.retryWorkerClient = retryWorkerClient
+		d.retryWorkerExtensions = maps.Clone(extensions)
+		d.onRetryProvider = onRetryProvider
+	}
+}
+
 // NewProcessSessionDeliverer creates a new ProcessSessionDeliverer.
 //
 // Parameters:
@@ -179,14 +207,18 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 		log.Error(log.CatOrch, "is coordinator process", "processId", processID, "isCoord", false)
 		aiClient = d.workerClient
 		extensions = d.workerExtensions
+		if d.retryWorkerClient != nil && d.onRetryProvider != nil && d.onRetryProvider(processID) {
+			aiClient = d.retryWorkerClient
+			extensions = d.retryWorkerExtensions
+		}
 	}
 
 	// 4. Spawn/resume the session with the message as prompt
 	// IMPORTANT: Use context.Background() here because the claude process lifetime
 	// is managed by the Process struct, not by this function's context.
 	// If we used the parent context, the process would be killed when Deliver() returns.
-	proc, err := aiClient.Spawn(context.Background(), client.Config{
-		WorkDir:         d.sessionProvider.GetWorkDir(),
+	cfg := client.Config{
+		WorkDir:         d.sessionProvider.GetProcessWorkDir(processID),
 		BeadsDir:        d.beadsDir,
 		SessionID:       sessionID,
 		Prompt:          content,
@@ -194,7 +226,11 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 		SkipPermissions: true,
 		DisallowedTools: []string{"AskUserQuestion"},
 		Extensions:      extensions,
-	})
+	}
+	if d.policyFor != nil {
+		cfg.Policy = d.policyFor(processID)
+	}
+	proc, err := aiClient.Spawn(context.Background(), cfg)
 	if err != nil {
 		return fmt.Errorf("failed to resume session for process %s: %w", processID, err)
 	}
@@ -30,7 +30,7 @@ func (m *mockSessionProvider) GenerateProcessMCPConfig(processID string) (string
 	return m.mcpConfig, m.mcpConfigErr
 }
 
-func (m *mockSessionProvider) GetWorkDir() string {
+func (m *mockSessionProvider) GetProcessWorkDir(processID string) string {
 	return m.workDir
 }
 
@@ -51,7 +51,7 @@ func (m *slowMockSessionProvider) GenerateProcessMCPConfig(processID string) (st
 	return m.mcpConfig, nil
 }
 
-func (m *slowMockSessionProvider) GetWorkDir() string {
+func (m *slowMockSessionProvider) GetProcessWorkDir(processID string) string {
 	return m.workDir
 }
 
@@ -174,6 +174,58 @@ func TestProcessSessionDeliverer_Deliver_Success(t *testing.T) {
 	mockResumer.AssertExpectations(t)
 }
 
+func TestProcessSessionDeliverer_Deliver_AppliesToolPolicy(t *testing.T) {
+	sessionProvider := &mockSessionProvider{sessionID: "session-123", workDir: "/test/workdir"}
+	policy := &client.ToolPolicy{ReadOnly: true}
+
+	mockClient := &mockHeadlessClient{}
+	mockProc := &mockHeadlessProcess{}
+	mockResumer := &mockProcessResumer{}
+
+	// Resumed turns run under the same policy as the first turn
+	mockClient.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.Policy == policy
+	})).Return(mockProc, nil)
+	mockResumer.On("ResumeProcess", "worker-1", mockProc).Return(nil)
+
+	deliverer := NewProcessSessionDeliverer(sessionProvider, mockClient, mockClient, mockResumer, nil, nil,
+		WithToolPolicies(func(processID string) *client.ToolPolicy {
+			if processID == "worker-1" {
+				return policy
+			}
+			return nil
+		}))
+
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-1", "Review the diff"))
+	mockClient.AssertExpectations(t)
+}
+
+func TestProcessSessionDeliverer_Deliver_RetryWorkerClient(t *testing.T) {
+	sessionProvider := &mockSessionProvider{sessionID: "session-123", workDir: "/test/workdir"}
+
+	workerClient := &mockHeadlessClient{}
+	retryClient := &mockHeadlessClient{}
+	mockProc := &mockHeadlessProcess{}
+	mockResumer := &mockProcessResumer{}
+
+	// Workers spawned on the retry provider are resumed on it, others on the worker client
+	retryClient.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.Extensions["amp.mode"] == "smart"
+	})).Return(mockProc, nil).Once()
+	workerClient.On("Spawn", mock.Anything, mock.Anything).Return(mockProc, nil).Once()
+	mockResumer.On("ResumeProcess", mock.Anything, mockProc).Return(nil)
+
+	deliverer := NewProcessSessionDeliverer(sessionProvider, workerClient, workerClient, mockResumer, nil, nil,
+		WithRetryWorkerClient(retryClient, map[string]any{"amp.mode": "smart"}, func(processID string) bool {
+			return processID == "worker-2"
+		}))
+
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-2", "Retry the task"))
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-1", "Keep going"))
+	retryClient.AssertExpectations(t)
+	workerClient.AssertExpectations(t)
+}
+
 func TestProcessSessionDeliverer_Deliver_SessionNotFound(t *testing.T) {
 	// Setup
 	sessionProvider := &mockSessionProvider{
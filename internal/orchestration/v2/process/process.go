@@ -3,7 +3,6 @@ package process
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sync"
 	"time"
 
@@ -53,6 +52,7 @@ type Process struct {
 	cumulativeCostUSD    float64 // Running total cost across all turns
 	taskID               string  // Worker-specific: current task ID
 	isRetired            bool    // Whether this process has been retired
+	interruptRequested   bool    // Whether the current turn was cancelled for an interrupt message
 	lastError            error   // Last error received during this turn (for passing to command)
 }
 
@@ -154,6 +154,7 @@ func (p *Process) Resume(proc client.HeadlessProcess) {
 
 	p.mu.Lock()
 	p.proc = proc
+	p.interruptRequested = false
 	// Create fresh context and done channel for the new event loop
 	p.ctx, p.cancel = context.WithCancel(context.Background())
 	p.eventDone = make(chan struct{})
@@ -274,7 +275,7 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 			return
 		}
 
-		p.handleInFlightError(fmt.Errorf("process error: %s", errMsg))
+		p.handleInFlightError(newAgentError(errMsg, event.Error))
 		return
 	}
 
@@ -293,7 +294,7 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 			}
 
 			// Publish immediately for real-time TUI visibility
-			p.handleInFlightError(fmt.Errorf("process error: %s", errMsg))
+			p.handleInFlightError(newAgentError(errMsg, event.Error))
 			return
 		}
 	}
@@ -417,12 +418,19 @@ func (p *Process) handleProcessComplete() {
 		p.mu.Unlock()
 	}
 
+	// A turn cancelled by Interrupt ends without succeeding; the handler
+	// must not treat it as a failure
+	p.mu.Lock()
+	interrupted := p.interruptRequested
+	p.interruptRequested = false
+	p.mu.Unlock()
+
 	// Submit unified command - handler routes based on process ID
 	// Pass lastError so handler can include it in ProcessError event for startup failures
 	if p.cmdSubmitter != nil {
-		p.cmdSubmitter.Submit(command.NewProcessTurnCompleteCommand(
-			p.ID, succeeded, m, lastErr,
-		))
+		turnCmd := command.NewProcessTurnCompleteCommand(p.ID, succeeded, m, lastErr)
+		turnCmd.Interrupted = interrupted
+		p.cmdSubmitter.Submit(turnCmd)
 	}
 }
 
@@ -605,6 +613,27 @@ func (p *Process) Cancel() error {
 	return nil
 }
 
+// Interrupt cancels the current turn to deliver an interrupt message and
+// records that it did, so the turn's completion is reported as interrupted
+// rather than failed.
+func (p *Process) Interrupt() error {
+	p.mu.Lock()
+	proc := p.proc
+	p.interruptRequested = proc != nil
+	p.mu.Unlock()
+
+	if proc == nil {
+		return nil
+	}
+	if err := proc.Cancel(); err != nil {
+		p.mu.Lock()
+		p.interruptRequested = false
+		p.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
 // Wait blocks until the underlying process completes.
 func (p *Process) Wait() error {
 	p.mu.RLock()
@@ -692,3 +721,22 @@ type ContextExceededError struct{}
 func (e *ContextExceededError) Error() string {
 	return "context exceeded limit"
 }
+
+// AgentError is an error the agent reported during a turn, e.g. a rate limit
+// or a failed request. Reason carries the provider's classification.
+type AgentError struct {
+	Message string
+	Reason  client.ErrorReason
+}
+
+func newAgentError(message string, info *client.ErrorInfo) *AgentError {
+	err := &AgentError{Message: message}
+	if info != nil {
+		err.Reason = info.Reason
+	}
+	return err
+}
+
+func (e *AgentError) Error() string {
+	return "process error: " + e.Message
+}
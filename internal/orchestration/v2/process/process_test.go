@@ -401,6 +401,37 @@ func TestHandleProcessComplete_SubmitsProcessTurnCompleteCommand(t *testing.T) {
 	assert.Equal(t, "worker-1", cmd.ProcessID)
 }
 
+func TestHandleProcessComplete_ReportsInterruptedTurn(t *testing.T) {
+	proc := newMockHeadlessProcess()
+	submitter := &mockCommandSubmitter{}
+
+	p := New("worker-1", repository.RoleWorker, proc, submitter, nil)
+	p.Start()
+
+	require.NoError(t, p.Interrupt())
+	assert.True(t, proc.cancelled)
+
+	proc.Complete()
+	<-p.eventDone
+
+	submitted := submitter.getSubmitted()
+	require.Len(t, submitted, 1)
+
+	cmd := submitted[0].(*command.ProcessTurnCompleteCommand)
+	assert.False(t, cmd.Succeeded)
+	assert.True(t, cmd.Interrupted)
+
+	// The flag covers only the cancelled turn
+	next := newMockHeadlessProcess()
+	p.Resume(next)
+	next.Complete()
+	<-p.eventDone
+
+	submitted = submitter.getSubmitted()
+	require.Len(t, submitted, 2)
+	assert.False(t, submitted[1].(*command.ProcessTurnCompleteCommand).Interrupted)
+}
+
 func TestHandleProcessComplete_SetsSucceededTrueForStatusCompleted(t *testing.T) {
 	proc := newMockHeadlessProcess()
 	proc.status = client.StatusCompleted
@@ -1836,6 +1867,44 @@ func TestHandleInFlightError_DoesNotOverwriteContextExceededError(t *testing.T)
 		"ContextExceededError should be preserved even after subsequent errors")
 }
 
+func TestHandleEvent_ErrorEventKeepsReason(t *testing.T) {
+	proc := newMockHeadlessProcess()
+	submitter := &mockCommandSubmitter{}
+	eventBus := pubsub.NewBroker[any]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+
+	p := New("worker-1", repository.RoleWorker, proc, submitter, eventBus)
+	p.Start()
+
+	proc.events <- client.OutputEvent{
+		Type: client.EventError,
+		Error: &client.ErrorInfo{
+			Message: "Rate limit reached",
+			Reason:  client.ErrReasonRateLimited,
+		},
+	}
+
+	select {
+	case <-sub:
+	case <-time.After(500 * time.Millisecond):
+		require.FailNow(t, "did not receive error event")
+	}
+
+	proc.Complete()
+	<-p.eventDone
+
+	submitted := submitter.getSubmitted()
+	require.Len(t, submitted, 1)
+	turnCmd := submitted[0].(*command.ProcessTurnCompleteCommand)
+
+	var agentErr *AgentError
+	require.True(t, errors.As(turnCmd.Error, &agentErr))
+	require.Equal(t, client.ErrReasonRateLimited, agentErr.Reason)
+	require.Equal(t, "process error: Rate limit reached", agentErr.Error())
+}
+
 // ===========================================================================
 // Cost Extraction Tests
 // ===========================================================================
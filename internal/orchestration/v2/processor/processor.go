@@ -64,6 +64,15 @@ func WithMiddleware(middlewares ...Middleware) Option {
 	}
 }
 
+// WithoutFollowUps drops the follow-up commands returned by handlers.
+// Used when replaying a command log, where follow-ups were recorded as
+// commands of their own.
+func WithoutFollowUps() Option {
+	return func(p *CommandProcessor) {
+		p.skipFollowUps = true
+	}
+}
+
 // CommandProcessor processes commands sequentially in FIFO order.
 // This is the heart of the v2 architecture - single-threaded processing
 // eliminates most lock operations while maintaining deterministic execution.
@@ -82,6 +91,9 @@ type CommandProcessor struct {
 	// Middleware chain applied to all handlers
 	middlewares []Middleware
 
+	// skipFollowUps drops follow-up commands instead of enqueuing them
+	skipFollowUps bool
+
 	// Event publishing
 	eventBus *pubsub.Broker[any]
 
@@ -361,7 +373,7 @@ func (p *CommandProcessor) processCommand(cmd command.Command) *command.CommandR
 	}
 
 	// Step 5: Enqueue follow-up commands
-	if result != nil && len(result.FollowUp) > 0 {
+	if result != nil && len(result.FollowUp) > 0 && !p.skipFollowUps {
 		for _, followUp := range result.FollowUp {
 			// Submit follow-ups - they go to the end of the queue (FIFO)
 			// Use non-blocking submit to avoid deadlock
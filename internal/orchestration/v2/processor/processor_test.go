@@ -435,6 +435,30 @@ func TestProcessor_FollowUpCommands(t *testing.T) {
 	assert.Contains(t, processed, 3)
 }
 
+func TestProcessor_WithoutFollowUps_DropsFollowUpCommands(t *testing.T) {
+	p, handler, cleanup := startProcessor(t, WithoutFollowUps())
+	defer cleanup()
+
+	p.handlers["test_command"] = HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		tc := cmd.(*testCommand)
+		handler.mu.Lock()
+		handler.processed = append(handler.processed, tc.value)
+		handler.mu.Unlock()
+		return &command.CommandResult{
+			Success:  true,
+			FollowUp: []command.Command{newTestCommand(tc.value + 1)},
+		}, nil
+	})
+
+	_, err := p.SubmitAndWait(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	_, err = p.SubmitAndWait(context.Background(), newTestCommand(10))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 10}, handler.getProcessed())
+	assert.Equal(t, int64(2), p.ProcessedCount())
+}
+
 // ===========================================================================
 // Events Tests
 // ===========================================================================
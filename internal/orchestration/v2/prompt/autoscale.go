@@ -0,0 +1,24 @@
+package prompt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AutoscaleSpawnMessage generates the system message telling the coordinator
+// that the autoscaler spawned workers for the ready tasks of an epic.
+func AutoscaleSpawnMessage(workerIDs []string, readyTasks int, epicID string) string {
+	return fmt.Sprintf(`[AUTOSCALER] Spawned %s: %d ready task(s) in epic %s had no worker to take them.
+
+The new workers will notify you when they are ready. DO NOT assign work until they have sent you a ready signal.`,
+		strings.Join(workerIDs, ", "), readyTasks, epicID)
+}
+
+// AutoscaleRetireMessage generates the system message telling the coordinator
+// that the autoscaler retired workers that stayed idle past the cooldown.
+func AutoscaleRetireMessage(workerIDs []string, readyTasks int, epicID string) string {
+	return fmt.Sprintf(`[AUTOSCALER] Retired idle %s: only %d ready task(s) left in epic %s.
+
+Do not assign work to the retired workers. Use spawn_worker if you need more workers again.`,
+		strings.Join(workerIDs, ", "), readyTasks, epicID)
+}
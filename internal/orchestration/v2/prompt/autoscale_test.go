@@ -0,0 +1,23 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoscaleSpawnMessage(t *testing.T) {
+	msg := AutoscaleSpawnMessage([]string{"worker-3", "worker-4"}, 5, "perles-abc")
+
+	require.Contains(t, msg, "[AUTOSCALER] Spawned worker-3, worker-4")
+	require.Contains(t, msg, "5 ready task(s) in epic perles-abc")
+	require.Contains(t, msg, "ready signal")
+}
+
+func TestAutoscaleRetireMessage(t *testing.T) {
+	msg := AutoscaleRetireMessage([]string{"worker-2"}, 0, "perles-abc")
+
+	require.Contains(t, msg, "[AUTOSCALER] Retired idle worker-2")
+	require.Contains(t, msg, "only 0 ready task(s) left in epic perles-abc")
+	require.Contains(t, msg, "spawn_worker")
+}
@@ -0,0 +1,93 @@
+package prompt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ContextHandoffRequestPrompt generates the prompt sent to a process whose context
+// window usage crossed the handoff threshold. The process writes a summary for its
+// replacement via submit_handoff and ends its turn.
+func ContextHandoffRequestPrompt(usage float64) string {
+	return fmt.Sprintf(`[CONTEXT HANDOFF REQUIRED]
+
+Your context window is %.0f%% full. You will be replaced by a fresh session that continues your work.
+
+Stop what you are doing and call submit_handoff with a summary your replacement can continue from:
+- What you were working on and what is already done
+- What remains, in order, and any decisions or constraints you settled on
+- Files, commands or messages your replacement must look at first
+- Anything you were waiting on (workers, reviews, user answers)
+
+Do not start new work. End your turn after calling submit_handoff.`, usage)
+}
+
+// BuildCoordinatorHandoffPrompt creates the initial prompt for a coordinator that
+// replaced a predecessor after a context handoff. Unlike BuildReplacePrompt, the
+// replacement continues the work autonomously from the predecessor's summary.
+func BuildCoordinatorHandoffPrompt(summary string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[CONTEXT HANDOFF - NEW SESSION]\n\n")
+	prompt.WriteString("The previous coordinator's context window was nearly full, so you've replaced it with a fresh session.\n")
+	prompt.WriteString("Your workers are still running and all external state is preserved.\n\n")
+
+	writeHandoffSummary(&prompt, "previous coordinator", summary)
+
+	prompt.WriteString("WHAT TO DO NOW:\n")
+	prompt.WriteString("1. Run `query_worker_state` to confirm which workers and tasks are active\n")
+	prompt.WriteString("2. Run `fabric_inbox` to catch up on messages the previous coordinator had not handled\n")
+	prompt.WriteString("3. Continue orchestrating from where the handoff summary leaves off\n")
+
+	return prompt.String()
+}
+
+// WorkerHandoffPrompt generates the message that seeds a worker replacing a
+// predecessor after a context handoff. taskID, status and threadID describe the
+// task the worker takes over and are empty when the predecessor had no task.
+func WorkerHandoffPrompt(previousWorkerID, summary, taskID, status, threadID string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[CONTEXT HANDOFF]\n\n")
+	prompt.WriteString(fmt.Sprintf("You are taking over from `%s`, whose context window was nearly full.\n\n", previousWorkerID))
+
+	if taskID != "" {
+		prompt.WriteString(fmt.Sprintf("**Task ID:** %s\n", taskID))
+		prompt.WriteString(fmt.Sprintf("**Task Status:** %s\n", status))
+		if threadID != "" {
+			prompt.WriteString(fmt.Sprintf("**Fabric Thread ID:** %s\n", threadID))
+		}
+		prompt.WriteString("\n")
+	}
+
+	writeHandoffSummary(&prompt, previousWorkerID, summary)
+
+	prompt.WriteString("WHAT TO DO NOW:\n")
+	if taskID != "" {
+		prompt.WriteString(fmt.Sprintf("1. Read the task with `bd show %s`\n", taskID))
+		if threadID != "" {
+			prompt.WriteString(fmt.Sprintf("2. Read the task thread with fabric_read_thread(message_id=\"%s\")\n", threadID))
+		} else {
+			prompt.WriteString("2. Run fabric_inbox to catch up on messages about the task\n")
+		}
+		prompt.WriteString("3. Check the existing changes in your working directory before writing code\n")
+		prompt.WriteString("4. Continue the task from where the handoff summary leaves off and report as usual\n")
+	} else {
+		prompt.WriteString("1. Run fabric_inbox to catch up on messages\n")
+		prompt.WriteString("2. Continue from where the handoff summary leaves off\n")
+	}
+
+	return prompt.String()
+}
+
+// writeHandoffSummary writes the predecessor's handoff summary, or a note that
+// none was submitted.
+func writeHandoffSummary(prompt *strings.Builder, from, summary string) {
+	if summary == "" {
+		prompt.WriteString(fmt.Sprintf("The %s did not submit a handoff summary. Rebuild your picture of the work from the state below before acting.\n\n", from))
+		return
+	}
+	prompt.WriteString(fmt.Sprintf("HANDOFF SUMMARY FROM %s:\n", strings.ToUpper(from)))
+	prompt.WriteString(summary)
+	prompt.WriteString("\n\n")
+}
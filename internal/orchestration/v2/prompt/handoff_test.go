@@ -0,0 +1,50 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextHandoffRequestPrompt(t *testing.T) {
+	prompt := ContextHandoffRequestPrompt(87.4)
+
+	require.Contains(t, prompt, "[CONTEXT HANDOFF REQUIRED]")
+	require.Contains(t, prompt, "87% full")
+	require.Contains(t, prompt, "submit_handoff")
+}
+
+func TestBuildCoordinatorHandoffPrompt(t *testing.T) {
+	prompt := BuildCoordinatorHandoffPrompt("Epic perles-abc: tasks .1 and .2 done, .3 with worker-2")
+
+	require.Contains(t, prompt, "[CONTEXT HANDOFF - NEW SESSION]")
+	require.Contains(t, prompt, "HANDOFF SUMMARY FROM PREVIOUS COORDINATOR:")
+	require.Contains(t, prompt, ".3 with worker-2")
+	require.Contains(t, prompt, "query_worker_state")
+}
+
+func TestBuildCoordinatorHandoffPrompt_NoSummary(t *testing.T) {
+	prompt := BuildCoordinatorHandoffPrompt("")
+
+	require.Contains(t, prompt, "did not submit a handoff summary")
+	require.NotContains(t, prompt, "HANDOFF SUMMARY FROM")
+}
+
+func TestWorkerHandoffPrompt_WithTask(t *testing.T) {
+	prompt := WorkerHandoffPrompt("worker-1", "Parser done, tests left", "perles-abc.1", "implementing", "msg-42")
+
+	require.Contains(t, prompt, "taking over from `worker-1`")
+	require.Contains(t, prompt, "**Task ID:** perles-abc.1")
+	require.Contains(t, prompt, "**Task Status:** implementing")
+	require.Contains(t, prompt, "**Fabric Thread ID:** msg-42")
+	require.Contains(t, prompt, `fabric_read_thread(message_id="msg-42")`)
+	require.Contains(t, prompt, "Parser done, tests left")
+}
+
+func TestWorkerHandoffPrompt_WithoutTask(t *testing.T) {
+	prompt := WorkerHandoffPrompt("worker-1", "Was researching the cache layer", "", "", "")
+
+	require.NotContains(t, prompt, "**Task ID:**")
+	require.Contains(t, prompt, "Was researching the cache layer")
+	require.Contains(t, prompt, "fabric_inbox")
+}
@@ -0,0 +1,51 @@
+package prompt
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskAttemptFailedMessage generates the system message telling the coordinator
+// that a worker failed an attempt at a task and the task will be retried.
+func TaskAttemptFailedMessage(taskID, workerID, kind, detail string, attempt, maxAttempts int, retryIn time.Duration) string {
+	return fmt.Sprintf(`[TASK FAILED] Attempt %d/%d at task %s failed on %s (%s): %s
+
+The task will be retried on a fresh worker in %s. DO NOT reassign it yourself, you will be told when the retry starts.`,
+		attempt, maxAttempts, taskID, workerID, kind, detail, retryIn)
+}
+
+// TaskGaveUpMessage generates the system message telling the coordinator that
+// a worker failed the last attempt at a task and it will not be retried.
+func TaskGaveUpMessage(taskID, workerID, kind, detail string, attempts int) string {
+	return fmt.Sprintf(`[TASK FAILED] Task %s failed on %s (%s): %s
+
+It will not be retried after %d attempt(s). Decide whether to split the task, adjust the plan or ask the user for help.`,
+		taskID, workerID, kind, detail, attempts)
+}
+
+// TaskRetryMessage generates the system message telling the coordinator that
+// the next attempt at a failed task has started on newWorkerID.
+func TaskRetryMessage(taskID string, attempt, maxAttempts int, failedWorkerID, newWorkerID string) string {
+	return fmt.Sprintf(`[TASK RETRY] Starting attempt %d/%d at task %s.%s The task is open again and %s has been spawned for it.
+
+Once %s sends you a ready signal, use assign_task to assign it task %s. Tell it this is a retry so it checks for work left behind by the previous attempt.`,
+		attempt, maxAttempts, taskID, retiredNote(failedWorkerID), newWorkerID, newWorkerID, taskID)
+}
+
+// ReviewRetryMessage generates the system message telling the coordinator that
+// the review of a task failed and its next attempt has started on newWorkerID.
+// The implementation is kept; only the review runs again.
+func ReviewRetryMessage(taskID string, attempt, maxAttempts int, failedWorkerID, newWorkerID, implementerID string) string {
+	return fmt.Sprintf(`[TASK RETRY] Starting attempt %d/%d at the review of task %s.%s The implementation by %s is kept and %s has been spawned to review it.
+
+Once %s sends you a ready signal, use assign_review to assign it the review of task %s (implementer: %s).`,
+		attempt, maxAttempts, taskID, retiredNote(failedWorkerID), implementerID, newWorkerID, newWorkerID, taskID, implementerID)
+}
+
+// retiredNote names the worker retired after a failed attempt, if any.
+func retiredNote(failedWorkerID string) string {
+	if failedWorkerID == "" {
+		return ""
+	}
+	return fmt.Sprintf(" %s has been retired.", failedWorkerID)
+}
@@ -0,0 +1,42 @@
+package prompt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAttemptFailedMessage(t *testing.T) {
+	msg := TaskAttemptFailedMessage("perles-abc.1", "worker-2", "rate_limit", "429 Too Many Requests", 1, 3, 30*time.Second)
+
+	require.Contains(t, msg, "[TASK FAILED] Attempt 1/3 at task perles-abc.1 failed on worker-2 (rate_limit): 429 Too Many Requests")
+	require.Contains(t, msg, "retried on a fresh worker in 30s")
+}
+
+func TestTaskGaveUpMessage(t *testing.T) {
+	msg := TaskGaveUpMessage("perles-abc.1", "worker-4", "tool_error", "exit code 1", 3)
+
+	require.Contains(t, msg, "[TASK FAILED] Task perles-abc.1 failed on worker-4 (tool_error): exit code 1")
+	require.Contains(t, msg, "not be retried after 3 attempt(s)")
+}
+
+func TestTaskRetryMessage(t *testing.T) {
+	msg := TaskRetryMessage("perles-abc.1", 2, 3, "worker-2", "worker-5")
+
+	require.Contains(t, msg, "[TASK RETRY] Starting attempt 2/3 at task perles-abc.1. worker-2 has been retired.")
+	require.Contains(t, msg, "worker-5 has been spawned for it")
+	require.Contains(t, msg, "assign_task")
+
+	msg = TaskRetryMessage("perles-abc.1", 2, 3, "", "worker-5")
+	require.NotContains(t, msg, "retired")
+}
+
+func TestReviewRetryMessage(t *testing.T) {
+	msg := ReviewRetryMessage("perles-abc.1", 2, 3, "worker-3", "worker-5", "worker-2")
+
+	require.Contains(t, msg, "[TASK RETRY] Starting attempt 2/3 at the review of task perles-abc.1. worker-3 has been retired.")
+	require.Contains(t, msg, "The implementation by worker-2 is kept")
+	require.Contains(t, msg, "use assign_review to assign it the review of task perles-abc.1 (implementer: worker-2)")
+	require.NotContains(t, msg, "assign_task")
+}
@@ -14,6 +14,8 @@ Available tools:
 - fabric_reply: Reply to an EXISTING message thread (use the message_id from the message you're responding to)
 - report_implementation_complete: Report bd task completion with summary
 - report_review_verdict: Report code review verdict (APPROVED/DENIED)
+- report_policy_violation: Report an action your tool policy forbids, then end your turn
+- submit_handoff: Submit a handoff summary when asked to hand off your context window
 - post_accountability_summary: Save accountability summary for session tracking
 
 **IMPORTANT: fabric_send vs fabric_reply:**
@@ -510,11 +512,37 @@ Suggested commit message:
 %s`, commitMessage)
 	}
 
-	prompt += fmt.Sprintf(`
+	prompt += `
 
 ## After Committing
 
-Please document your work using post_accountability_summary. This helps capture valuable learnings and provides accountability for future sessions.
+` + accountabilitySummaryInstructions(taskID) + `
+
+Then report via fabric_reply(content="Committed: [hash]").`
+
+	return prompt
+}
+
+// IntegratedCommitPrompt generates the prompt sent to an implementer working in
+// its own worktree once its approved changes were committed and merged into
+// the workflow branch.
+func IntegratedCommitPrompt(taskID, branch, baseBranch string) string {
+	return fmt.Sprintf(`[COMMIT APPROVED]
+
+Your implementation of task **%s** has been **APPROVED** by the reviewer.
+
+Your changes have been committed on branch %s and merged into the workflow branch %s. Do not create another commit.
+
+## After Integration
+
+`, taskID, branch, baseBranch) + accountabilitySummaryInstructions(taskID) + `
+
+Then report via fabric_reply(content="Integrated: [hash]").`
+}
+
+// accountabilitySummaryInstructions explains how to document a committed task.
+func accountabilitySummaryInstructions(taskID string) string {
+	return fmt.Sprintf(`Please document your work using post_accountability_summary. This helps capture valuable learnings and provides accountability for future sessions.
 
 **What to include:**
 - **task_id**: The task you completed (required)
@@ -537,11 +565,28 @@ post_accountability_summary(
         friction="Initially forgot to handle empty string case, caught by reviewer",
         patterns="This codebase prefers returning errors over panicking for invalid input"
     }
-)
+)`, taskID)
+}
 
-Then report via fabric_reply(content="Committed: [hash]").`, taskID)
+// WorkerWorktreeReviewScope generates the section added to a review assignment
+// when the implementer works in its own worktree, limiting the review to the
+// implementer's changes.
+func WorkerWorktreeReviewScope(implementerID, path, branch, baseBranch string) string {
+	return fmt.Sprintf(`
 
-	return prompt
+---
+
+## Review Scope
+
+%s works in its own worktree at %s on branch %s. Review only its changes against the workflow branch %s:
+
+`+"```bash"+`
+git -C %s diff $(git -C %s merge-base %s HEAD)
+git -C %s status --short
+`+"```"+`
+
+Run the tests inside that worktree, not in your own working directory.`,
+		implementerID, path, branch, baseBranch, path, path, baseBranch, path)
 }
 
 // AggregationWorkerPrompt generates the prompt for a worker assigned to aggregate
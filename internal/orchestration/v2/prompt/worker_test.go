@@ -130,6 +130,25 @@ func TestCommitApprovalPrompt_IncludesRetroStructure(t *testing.T) {
 	require.Contains(t, prompt, "patterns", "Prompt should show patterns in retro")
 }
 
+// TestIntegratedCommitPrompt verifies the implementer is told not to commit again.
+func TestIntegratedCommitPrompt(t *testing.T) {
+	prompt := IntegratedCommitPrompt("perles-abc.1", "perles-auth-worker-1", "perles-auth")
+
+	require.Contains(t, prompt, "committed on branch perles-auth-worker-1 and merged into the workflow branch perles-auth")
+	require.Contains(t, prompt, "Do not create another commit")
+	require.Contains(t, prompt, `task_id="perles-abc.1"`)
+	require.NotContains(t, prompt, "Please create a git commit")
+}
+
+// TestWorkerWorktreeReviewScope verifies the review is limited to the implementer's worktree.
+func TestWorkerWorktreeReviewScope(t *testing.T) {
+	scope := WorkerWorktreeReviewScope("worker-1", "/repo-wt-worker-1", "perles-auth-worker-1", "perles-auth")
+
+	require.Contains(t, scope, "worker-1 works in its own worktree at /repo-wt-worker-1 on branch perles-auth-worker-1")
+	require.Contains(t, scope, "git -C /repo-wt-worker-1 diff $(git -C /repo-wt-worker-1 merge-base perles-auth HEAD)")
+	require.Contains(t, scope, "git -C /repo-wt-worker-1 status --short")
+}
+
 // TestWorkerMCPInstructions_ContainsToolDescriptions verifies MCP instructions list available tools.
 func TestWorkerMCPInstructions_ContainsToolDescriptions(t *testing.T) {
 	instructions := WorkerMCPInstructions("worker-1")
@@ -0,0 +1,146 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+// ErrUnknownCommand is returned when a recorded command type has no decoder.
+var ErrUnknownCommand = errors.New("unknown command type")
+
+// errUnrecordedTurnError stands in for a turn error the command log could not
+// keep: error values marshal to an empty JSON object.
+var errUnrecordedTurnError = errors.New("turn failed (error not recorded in command log)")
+
+// decoders creates an empty command for each recorded command type. The
+// payload is unmarshalled into it afterwards.
+var decoders = map[command.CommandType]func(base *command.BaseCommand) command.Command{
+	command.CmdAssignTask: func(base *command.BaseCommand) command.Command {
+		return &command.AssignTaskCommand{BaseCommand: base}
+	},
+	command.CmdAssignReview: func(base *command.BaseCommand) command.Command {
+		return &command.AssignReviewCommand{BaseCommand: base}
+	},
+	command.CmdApproveCommit: func(base *command.BaseCommand) command.Command {
+		return &command.ApproveCommitCommand{BaseCommand: base}
+	},
+	command.CmdAssignReviewFeedback: func(base *command.BaseCommand) command.Command {
+		return &command.AssignReviewFeedbackCommand{BaseCommand: base}
+	},
+	command.CmdBroadcast: func(base *command.BaseCommand) command.Command {
+		return &command.BroadcastCommand{BaseCommand: base}
+	},
+	command.CmdReportComplete: func(base *command.BaseCommand) command.Command {
+		return &command.ReportCompleteCommand{BaseCommand: base}
+	},
+	command.CmdReportVerdict: func(base *command.BaseCommand) command.Command {
+		return &command.ReportVerdictCommand{BaseCommand: base}
+	},
+	command.CmdTransitionPhase: func(base *command.BaseCommand) command.Command {
+		return &command.TransitionPhaseCommand{BaseCommand: base}
+	},
+	command.CmdMarkTaskComplete: func(base *command.BaseCommand) command.Command {
+		return &command.MarkTaskCompleteCommand{BaseCommand: base}
+	},
+	command.CmdMarkTaskFailed: func(base *command.BaseCommand) command.Command {
+		return &command.MarkTaskFailedCommand{BaseCommand: base}
+	},
+	command.CmdRetryTask: func(base *command.BaseCommand) command.Command {
+		return &command.RetryTaskCommand{BaseCommand: base}
+	},
+	command.CmdSpawnProcess: func(base *command.BaseCommand) command.Command {
+		return &command.SpawnProcessCommand{BaseCommand: base}
+	},
+	command.CmdRetireProcess: func(base *command.BaseCommand) command.Command {
+		return &command.RetireProcessCommand{BaseCommand: base}
+	},
+	command.CmdReplaceProcess: func(base *command.BaseCommand) command.Command {
+		return &command.ReplaceProcessCommand{BaseCommand: base}
+	},
+	command.CmdSendToProcess: func(base *command.BaseCommand) command.Command {
+		return &command.SendToProcessCommand{BaseCommand: base}
+	},
+	command.CmdDeliverProcessQueued: func(base *command.BaseCommand) command.Command {
+		return &command.DeliverProcessQueuedCommand{BaseCommand: base}
+	},
+	command.CmdProcessTurnComplete: func(base *command.BaseCommand) command.Command {
+		return &command.ProcessTurnCompleteCommand{BaseCommand: base}
+	},
+	command.CmdPauseProcess: func(base *command.BaseCommand) command.Command {
+		return &command.PauseProcessCommand{BaseCommand: base}
+	},
+	command.CmdResumeProcess: func(base *command.BaseCommand) command.Command {
+		return &command.ResumeProcessCommand{BaseCommand: base}
+	},
+	command.CmdGenerateAccountabilitySummary: func(base *command.BaseCommand) command.Command {
+		return &command.GenerateAccountabilitySummaryCommand{BaseCommand: base}
+	},
+	command.CmdStopProcess: func(base *command.BaseCommand) command.Command {
+		return &command.StopProcessCommand{BaseCommand: base}
+	},
+	command.CmdSignalWorkflowComplete: func(base *command.BaseCommand) command.Command {
+		return &command.SignalWorkflowCompleteCommand{BaseCommand: base}
+	},
+	command.CmdNotifyUser: func(base *command.BaseCommand) command.Command {
+		return &command.NotifyUserCommand{BaseCommand: base}
+	},
+	command.CmdRequireApproval: func(base *command.BaseCommand) command.Command {
+		return &command.RequireApprovalCommand{BaseCommand: base}
+	},
+	command.CmdResolveApproval: func(base *command.BaseCommand) command.Command {
+		return &command.ResolveApprovalCommand{BaseCommand: base}
+	},
+	command.CmdReportPolicyViolation: func(base *command.BaseCommand) command.Command {
+		return &command.ReportPolicyViolationCommand{BaseCommand: base}
+	},
+	command.CmdSubmitHandoff: func(base *command.BaseCommand) command.Command {
+		return &command.SubmitHandoffCommand{BaseCommand: base}
+	},
+}
+
+// DecodeCommand rebuilds the command recorded in a command log entry.
+//
+// The decoded command gets a fresh ID and timestamp; only its type, source and
+// exported fields come from the log. Fields the log cannot represent, such as
+// error values, are left unset or replaced with a placeholder.
+func DecodeCommand(event processor.CommandEvent) (command.Command, error) {
+	cmdType := command.CommandType(event.CommandType)
+	newCommand, ok := decoders[cmdType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCommand, event.CommandType)
+	}
+
+	base := command.NewBaseCommand(cmdType, command.CommandSource(event.Source))
+	base.SetTraceID(event.TraceID)
+	cmd := newCommand(&base)
+
+	if len(event.Payload) > 0 {
+		// json keeps filling the remaining fields after a type mismatch, so an
+		// unrepresentable field only loses itself.
+		var typeErr *json.UnmarshalTypeError
+		if err := json.Unmarshal(event.Payload, cmd); err != nil && !errors.As(err, &typeErr) {
+			return nil, fmt.Errorf("decoding %s payload: %w", event.CommandType, err)
+		}
+	}
+
+	if turnCmd, ok := cmd.(*command.ProcessTurnCompleteCommand); ok && turnCmd.Error == nil && hasRecordedError(event.Payload) {
+		turnCmd.Error = errUnrecordedTurnError
+	}
+
+	return cmd, nil
+}
+
+// hasRecordedError reports whether the payload carries a non-null Error field.
+func hasRecordedError(payload json.RawMessage) bool {
+	var fields struct {
+		Error json.RawMessage
+	}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return false
+	}
+	return len(fields.Error) > 0 && string(fields.Error) != "null"
+}
@@ -0,0 +1,128 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/mock"
+)
+
+// replayProvider hands out a mock client whose processes report a session and
+// then stay silent. Turns only end when the command log says so, through its
+// recorded process_turn_complete commands.
+type replayProvider struct {
+	client   *mock.Client
+	sessions atomic.Int64
+}
+
+func newReplayProvider() *replayProvider {
+	p := &replayProvider{client: mock.NewClient()}
+	p.client.SpawnFunc = func(_ context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		sessionID := cfg.SessionID
+		if sessionID == "" {
+			sessionID = fmt.Sprintf("replay-session-%d", p.sessions.Add(1))
+		}
+		return newReplayProcess(cfg, sessionID), nil
+	}
+	return p
+}
+
+// Type returns the mock client type.
+func (p *replayProvider) Type() client.ClientType {
+	return client.ClientMock
+}
+
+// Client returns the mock client.
+func (p *replayProvider) Client() (client.HeadlessClient, error) {
+	return p.client, nil
+}
+
+// Extensions returns no provider-specific configuration.
+func (p *replayProvider) Extensions() map[string]any {
+	return map[string]any{}
+}
+
+// replayProcess is a mock process whose Cancel does not close its output
+// channels. A closed channel would end the process event loop's turn and
+// submit a process_turn_complete command that was never recorded.
+type replayProcess struct {
+	*mock.Process
+	done       chan struct{}
+	cancelOnce sync.Once
+}
+
+// newReplayProcess creates a process whose only output is the init event that
+// gives it a session, which message delivery needs to resume it.
+func newReplayProcess(cfg client.Config, sessionID string) *replayProcess {
+	p := &replayProcess{
+		Process: mock.NewProcessWithConfig(cfg),
+		done:    make(chan struct{}),
+	}
+	p.SendEvent(client.OutputEvent{Type: client.EventSystem, SubType: "init", SessionID: sessionID})
+	return p
+}
+
+// Cancel marks the process cancelled without closing its channels.
+func (p *replayProcess) Cancel() error {
+	p.cancelOnce.Do(func() { close(p.done) })
+	return nil
+}
+
+// Wait blocks until the process is cancelled.
+func (p *replayProcess) Wait() error {
+	<-p.done
+	return nil
+}
+
+// Status returns running until the process is cancelled.
+func (p *replayProcess) Status() client.ProcessStatus {
+	select {
+	case <-p.done:
+		return client.StatusCancelled
+	default:
+		return client.StatusRunning
+	}
+}
+
+// IsRunning returns true until the process is cancelled.
+func (p *replayProcess) IsRunning() bool {
+	return p.Status() == client.StatusRunning
+}
+
+// Compile-time check that replayIssueExecutor implements IssueExecutor.
+var _ appbeads.IssueExecutor = replayIssueExecutor{}
+
+// replayIssueExecutor stands in for the bd tracker. Every issue exists and
+// every write succeeds, so replay never touches the real issue database.
+type replayIssueExecutor struct{}
+
+func (replayIssueExecutor) ShowIssue(issueID string) (*beads.Issue, error) {
+	return &beads.Issue{ID: issueID, Status: beads.StatusOpen}, nil
+}
+
+func (replayIssueExecutor) UpdateStatus(string, beads.Status) error     { return nil }
+func (replayIssueExecutor) UpdatePriority(string, beads.Priority) error { return nil }
+func (replayIssueExecutor) UpdateType(string, beads.IssueType) error    { return nil }
+func (replayIssueExecutor) CloseIssue(string, string) error             { return nil }
+func (replayIssueExecutor) ReopenIssue(string) error                    { return nil }
+func (replayIssueExecutor) SetLabels(string, []string) error            { return nil }
+func (replayIssueExecutor) AddComment(string, string, string) error     { return nil }
+func (replayIssueExecutor) DeleteIssues([]string) error                 { return nil }
+func (replayIssueExecutor) AddDependency(string, string) error          { return nil }
+
+func (replayIssueExecutor) CreateEpic(string, string, []string) (beads.CreateResult, error) {
+	return beads.CreateResult{}, nil
+}
+
+func (replayIssueExecutor) CreateTask(string, string, string, string, []string) (beads.CreateResult, error) {
+	return beads.CreateResult{}, nil
+}
+
+func (replayIssueExecutor) CreateIssue(beads.CreateIssueOptions) (beads.CreateResult, error) {
+	return beads.CreateResult{}, nil
+}
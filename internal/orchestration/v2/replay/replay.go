@@ -0,0 +1,186 @@
+// Package replay rebuilds orchestration state from a session's command log.
+//
+// CommandPersistenceMiddleware records every processed command to commands.jsonl.
+// Run feeds those commands, in order, through a fresh CommandProcessor whose
+// handlers drive mock processes instead of AI agents, and snapshots the process,
+// task and queue repositories after each one. Comparing each replayed outcome
+// with the recorded one shows exactly which command sent a workflow off course.
+package replay
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// CommandTimeout bounds how long a single replayed command may take.
+const CommandTimeout = 30 * time.Second
+
+// sessionTimeout bounds how long Run waits for spawned processes to report
+// their session before replaying the next command.
+const sessionTimeout = time.Second
+
+// replayPort is the MCP port written into spawned process configs. Replayed
+// processes never connect to it.
+const replayPort = 1
+
+// Step is the orchestration state right after one recorded command was replayed.
+type Step struct {
+	// Index is the command's 0-based position in the command log.
+	Index int
+	// Event is the recorded command log entry.
+	Event processor.CommandEvent
+	// Replayed is false when the command could not be decoded and was skipped.
+	Replayed bool
+	// Success reports whether the replayed command succeeded.
+	Success bool
+	// Error is the replayed command's error, or why it could not be replayed.
+	Error string
+
+	// Processes holds every process, coordinator first, then workers by ID.
+	Processes []repository.Process
+	// Tasks holds every task assignment, sorted by task ID.
+	Tasks []repository.TaskAssignment
+	// Queues holds the pending messages of each process with a non-empty queue.
+	Queues map[string][]repository.QueueEntry
+}
+
+// Diverged reports whether replaying the command turned out differently from
+// the recording: it was skipped, or it succeeded where the original failed or
+// vice versa.
+func (s Step) Diverged() bool {
+	return !s.Replayed || s.Success != s.Event.Success
+}
+
+// Run replays the commands in order and returns the state after each one.
+//
+// Follow-up commands returned by handlers are dropped because the log recorded
+// them as commands of their own. Process turns only end where the log has a
+// process_turn_complete command, and issue tracker writes are discarded.
+func Run(ctx context.Context, commands []processor.CommandEvent) ([]Step, error) {
+	provider := newReplayProvider()
+	infra, err := v2.NewInfrastructure(v2.InfrastructureConfig{
+		Port: replayPort,
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: provider,
+			client.RoleWorker:      provider,
+			client.RoleObserver:    provider,
+		},
+		// Nothing is written here: processes are mocks and the tracker is stubbed.
+		WorkDir:       os.TempDir(),
+		BeadsExecutor: replayIssueExecutor{},
+		SkipFollowUps: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating replay infrastructure: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if err := infra.Start(runCtx); err != nil {
+		return nil, fmt.Errorf("starting replay infrastructure: %w", err)
+	}
+	defer infra.Shutdown()
+
+	steps := make([]Step, 0, len(commands))
+	for i, event := range commands {
+		step := Step{Index: i, Event: event}
+
+		cmd, err := DecodeCommand(event)
+		if err != nil {
+			step.Error = err.Error()
+		} else {
+			step.Replayed = true
+			cmdCtx, cmdCancel := context.WithTimeout(runCtx, CommandTimeout)
+			result, err := infra.Core.Processor.SubmitAndWait(cmdCtx, cmd)
+			cmdCancel()
+			awaitSessions(runCtx, infra.Internal.ProcessRegistry)
+			switch {
+			case err != nil:
+				return steps, fmt.Errorf("replaying command %d (%s): %w", i, event.CommandType, err)
+			case result.Success:
+				step.Success = true
+			case result.Error != nil:
+				step.Error = result.Error.Error()
+			}
+		}
+
+		snapshot(&step, infra.Repositories)
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// awaitSessions waits until every running process has picked up the session
+// from its init event. The event loop records it asynchronously, and a later
+// command delivering a message to the process would fail without it.
+func awaitSessions(ctx context.Context, registry *process.ProcessRegistry) {
+	deadline := time.Now().Add(sessionTimeout)
+	for _, proc := range registry.All() {
+		for proc.IsRunning() && proc.SessionID() == "" && time.Now().Before(deadline) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}
+}
+
+// snapshot copies the repositories' current state into the step.
+func snapshot(step *Step, repos v2.RepositoryComponents) {
+	for _, proc := range repos.ProcessRepo.List() {
+		copied := *proc
+		if proc.Phase != nil {
+			phase := *proc.Phase
+			copied.Phase = &phase
+		}
+		if proc.Metrics != nil {
+			m := *proc.Metrics
+			copied.Metrics = &m
+		}
+		step.Processes = append(step.Processes, copied)
+
+		if repos.QueueRepo.Size(proc.ID) > 0 {
+			if step.Queues == nil {
+				step.Queues = make(map[string][]repository.QueueEntry)
+			}
+			step.Queues[proc.ID] = repos.QueueRepo.GetOrCreate(proc.ID).Entries()
+		}
+	}
+	slices.SortFunc(step.Processes, compareProcesses)
+
+	for _, task := range repos.TaskRepo.All() {
+		step.Tasks = append(step.Tasks, *task)
+	}
+	slices.SortFunc(step.Tasks, func(a, b repository.TaskAssignment) int {
+		return cmp.Compare(a.TaskID, b.TaskID)
+	})
+}
+
+// compareProcesses orders the coordinator first, then by ID with shorter IDs
+// first so worker-2 sorts before worker-10.
+func compareProcesses(a, b repository.Process) int {
+	return cmp.Or(
+		cmp.Compare(roleRank(a.Role), roleRank(b.Role)),
+		cmp.Compare(len(a.ID), len(b.ID)),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
+
+func roleRank(role repository.ProcessRole) int {
+	if role == repository.RoleCoordinator {
+		return 0
+	}
+	return 1
+}
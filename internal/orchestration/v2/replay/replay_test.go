@@ -0,0 +1,168 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// recorded builds the command log entry CommandPersistenceMiddleware writes for cmd.
+func recorded(t *testing.T, cmd command.Command, success bool) processor.CommandEvent {
+	t.Helper()
+	payload, err := json.Marshal(cmd)
+	require.NoError(t, err)
+	source := cmd.(interface{ Source() command.CommandSource }).Source()
+	return processor.CommandEvent{
+		CommandID:   cmd.ID(),
+		CommandType: cmd.Type().String(),
+		Source:      string(source),
+		Success:     success,
+		Payload:     payload,
+	}
+}
+
+func spawn(role repository.ProcessRole, processID string) *command.SpawnProcessCommand {
+	cmd := command.NewSpawnProcessCommand(command.SourceInternal, role)
+	cmd.ProcessID = processID
+	return cmd
+}
+
+// workflowLog records a coordinator and a worker starting up, the worker taking
+// a task, and a message for the busy worker being queued. The task assignment is
+// delivered by its follow-up command, which the log records separately.
+func workflowLog(t *testing.T) []processor.CommandEvent {
+	t.Helper()
+	return []processor.CommandEvent{
+		recorded(t, spawn(repository.RoleCoordinator, repository.CoordinatorID), true),
+		recorded(t, spawn(repository.RoleWorker, "worker-1"), true),
+		recorded(t, command.NewProcessTurnCompleteCommand("worker-1", true, &metrics.TokenMetrics{TokensUsed: 1200}, nil), true),
+		recorded(t, command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Fix the parser", ""), true),
+		recorded(t, command.NewDeliverProcessQueuedCommand(command.SourceInternal, "worker-1"), true),
+		recorded(t, command.NewSendToProcessCommand(command.SourceMCPTool, "worker-1", "also update the docs"), true),
+	}
+}
+
+func TestRun_RebuildsStateStepByStep(t *testing.T) {
+	steps, err := Run(context.Background(), workflowLog(t))
+	require.NoError(t, err)
+	require.Len(t, steps, 6)
+
+	for i, step := range steps {
+		require.Equal(t, i, step.Index)
+		require.True(t, step.Replayed, "step %d", i)
+		require.True(t, step.Success, "step %d: %s", i, step.Error)
+		require.False(t, step.Diverged(), "step %d", i)
+	}
+
+	require.Len(t, steps[0].Processes, 1)
+	require.Equal(t, repository.CoordinatorID, steps[0].Processes[0].ID)
+
+	require.Len(t, steps[1].Processes, 2)
+	require.Equal(t, "worker-1", steps[1].Processes[1].ID)
+	require.Equal(t, repository.StatusWorking, steps[1].Processes[1].Status)
+
+	worker := steps[2].Processes[1]
+	require.Equal(t, repository.StatusReady, worker.Status)
+	require.True(t, worker.HasCompletedTurn)
+	require.Empty(t, steps[2].Tasks)
+
+	require.Len(t, steps[3].Tasks, 1)
+	require.Equal(t, "perles-abc1.2", steps[3].Tasks[0].TaskID)
+	require.Equal(t, "worker-1", steps[3].Tasks[0].Implementer)
+	require.Equal(t, "perles-abc1.2", steps[3].Processes[1].TaskID)
+	require.Len(t, steps[3].Queues["worker-1"], 1, "the assignment waits for its follow-up delivery")
+
+	require.Empty(t, steps[4].Queues)
+	require.Equal(t, repository.StatusWorking, steps[4].Processes[1].Status)
+
+	require.Len(t, steps[5].Queues["worker-1"], 1)
+	require.Equal(t, "also update the docs", steps[5].Queues["worker-1"][0].Content)
+}
+
+func TestRun_SnapshotsAreIndependent(t *testing.T) {
+	steps, err := Run(context.Background(), workflowLog(t))
+	require.NoError(t, err)
+
+	// Later commands must not change what an earlier step shows.
+	require.Empty(t, steps[2].Processes[1].TaskID)
+	require.Equal(t, repository.StatusReady, steps[2].Processes[1].Status)
+	require.Nil(t, steps[2].Processes[1].Phase)
+	require.NotNil(t, steps[5].Processes[1].Phase)
+	require.Empty(t, steps[2].Queues)
+}
+
+func TestRun_FlagsDivergentCommands(t *testing.T) {
+	log := workflowLog(t)[:5]
+	// The recording claims a second assignment to the busy worker succeeded.
+	log = append(log, recorded(t, command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.3", "Another task", ""), true))
+	log = append(log, processor.CommandEvent{CommandType: "teleport_worker", Success: true})
+
+	steps, err := Run(context.Background(), log)
+	require.NoError(t, err)
+	require.Len(t, steps, 7)
+
+	require.True(t, steps[5].Replayed)
+	require.False(t, steps[5].Success)
+	require.NotEmpty(t, steps[5].Error)
+	require.True(t, steps[5].Diverged())
+	require.Len(t, steps[5].Tasks, 1, "the failed assignment leaves state untouched")
+
+	require.False(t, steps[6].Replayed)
+	require.Contains(t, steps[6].Error, "teleport_worker")
+	require.True(t, steps[6].Diverged())
+	require.Equal(t, steps[5].Processes, steps[6].Processes)
+}
+
+func TestDecodeCommand_RestoresFields(t *testing.T) {
+	original := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "Fix the parser", "thread-7")
+
+	cmd, err := DecodeCommand(recorded(t, original, true))
+	require.NoError(t, err)
+
+	decoded, ok := cmd.(*command.AssignTaskCommand)
+	require.True(t, ok)
+	require.Equal(t, command.CmdAssignTask, decoded.Type())
+	require.Equal(t, command.SourceMCPTool, decoded.Source())
+	require.Equal(t, "worker-2", decoded.WorkerID)
+	require.Equal(t, "perles-abc1.2", decoded.TaskID)
+	require.Equal(t, "Fix the parser", decoded.Summary)
+	require.Equal(t, "thread-7", decoded.ThreadID)
+	require.NoError(t, decoded.Validate())
+}
+
+func TestDecodeCommand_ReplacesUnrecordedTurnError(t *testing.T) {
+	failed := command.NewProcessTurnCompleteCommand("worker-1", false, nil, errors.New("usage limit reached"))
+
+	cmd, err := DecodeCommand(recorded(t, failed, true))
+	require.NoError(t, err)
+
+	decoded := cmd.(*command.ProcessTurnCompleteCommand)
+	require.Equal(t, "worker-1", decoded.ProcessID)
+	require.False(t, decoded.Succeeded)
+	require.ErrorIs(t, decoded.Error, errUnrecordedTurnError)
+
+	succeeded, err := DecodeCommand(recorded(t, command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil), true))
+	require.NoError(t, err)
+	require.NoError(t, succeeded.(*command.ProcessTurnCompleteCommand).Error)
+}
+
+func TestDecodeCommand_UnknownType(t *testing.T) {
+	_, err := DecodeCommand(processor.CommandEvent{CommandType: "teleport_worker"})
+	require.ErrorIs(t, err, ErrUnknownCommand)
+}
+
+func TestDecodeCommand_MalformedPayload(t *testing.T) {
+	_, err := DecodeCommand(processor.CommandEvent{
+		CommandType: string(command.CmdAssignTask),
+		Payload:     json.RawMessage(`{"WorkerID": "worker-1",`),
+	})
+	require.Error(t, err)
+}
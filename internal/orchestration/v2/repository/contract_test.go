@@ -0,0 +1,26 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository/repositorytest"
+)
+
+func TestMemoryProcessRepository_Contract(t *testing.T) {
+	repositorytest.TestProcessRepository(t, func(*testing.T) repository.ProcessRepository {
+		return repository.NewMemoryProcessRepository()
+	})
+}
+
+func TestMemoryTaskRepository_Contract(t *testing.T) {
+	repositorytest.TestTaskRepository(t, func(*testing.T) repository.TaskRepository {
+		return repository.NewMemoryTaskRepository()
+	})
+}
+
+func TestMemoryQueueRepository_Contract(t *testing.T) {
+	repositorytest.TestQueueRepository(t, func(_ *testing.T, maxSize int) repository.QueueRepository {
+		return repository.NewMemoryQueueRepository(maxSize)
+	})
+}
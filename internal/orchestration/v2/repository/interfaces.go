@@ -5,6 +5,7 @@ package repository
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
@@ -25,6 +26,9 @@ var ErrQueueFull = errors.New("message queue is full")
 // ErrProcessNotFound is returned when a process ID does not exist in the repository.
 var ErrProcessNotFound = errors.New("process not found")
 
+// ErrApprovalNotFound is returned when an approval ID is not pending.
+var ErrApprovalNotFound = errors.New("approval not found")
+
 // ===========================================================================
 // Process Constants and Types (Unified Coordinator/Worker Model)
 // ===========================================================================
@@ -65,6 +69,11 @@ const (
 	StatusFailed   = events.ProcessStatusFailed
 )
 
+// Approval is a pending request for a human to approve work.
+// This is a type alias to events.Approval so approval events carry the
+// repository entity without conversion.
+type Approval = events.Approval
+
 // ===========================================================================
 // Domain Entities
 // ===========================================================================
@@ -103,6 +112,9 @@ type Process struct {
 	// AgentType is the worker's specialization (generic, implementer, reviewer, researcher).
 	// Empty string represents generic (default). Only relevant for workers.
 	AgentType roles.AgentType
+	// RetryProvider is true if the worker was spawned on the retry provider
+	// to retry a failed task. Its sessions are resumed on that provider.
+	RetryProvider bool
 }
 
 // IsCoordinator returns true if this is the coordinator process.
@@ -139,6 +151,29 @@ const (
 	TaskCompleted TaskStatus = "completed"
 )
 
+// FailureKind classifies why an attempt at a task failed.
+type FailureKind string
+
+const (
+	// FailureRateLimit means the worker's provider rejected it for exceeding its rate limit.
+	FailureRateLimit FailureKind = "rate_limit"
+	// FailureContextExceeded means the worker ran out of context window.
+	FailureContextExceeded FailureKind = "context_exceeded"
+	// FailureToolError means the agent process errored or exited abnormally.
+	FailureToolError FailureKind = "tool_error"
+	// FailureGaveUp means the task was declared failed, e.g. via mark_task_failed.
+	FailureGaveUp FailureKind = "gave_up"
+)
+
+// IsValid returns true if k is a known failure kind.
+func (k FailureKind) IsValid() bool {
+	switch k {
+	case FailureRateLimit, FailureContextExceeded, FailureToolError, FailureGaveUp:
+		return true
+	}
+	return false
+}
+
 // TaskAssignment represents a task assigned to workers for implementation and review.
 // This is the aggregate root for the Task bounded context.
 type TaskAssignment struct {
@@ -171,12 +206,41 @@ const (
 	SenderSystem SenderType = "system"
 )
 
+// MessagePriority orders the messages of a queue. Higher priorities are
+// delivered first; messages of equal priority are delivered in FIFO order.
+type MessagePriority int
+
+const (
+	// PriorityNotification is for system messages such as fabric notifications.
+	PriorityNotification MessagePriority = iota + 1
+	// PriorityCoordinator is for messages from the coordinator process.
+	PriorityCoordinator
+	// PriorityUser is for messages from the user.
+	PriorityUser
+	// PriorityInterrupt is for urgent user messages that cut the current turn short.
+	PriorityInterrupt
+)
+
+// DefaultPriority returns the priority of messages from the sender.
+func (s SenderType) DefaultPriority() MessagePriority {
+	switch s {
+	case SenderUser:
+		return PriorityUser
+	case SenderCoordinator:
+		return PriorityCoordinator
+	default:
+		return PriorityNotification
+	}
+}
+
 // QueueEntry represents a single message in a worker's message queue.
 type QueueEntry struct {
 	// Content is the message content.
 	Content string
 	// Sender identifies who sent this message (user or coordinator).
 	Sender SenderType
+	// Priority determines the delivery order of this message.
+	Priority MessagePriority
 	// Timestamp is when this entry was enqueued.
 	Timestamp time.Time
 }
@@ -187,14 +251,28 @@ type QueueEntry struct {
 
 // MessageQueue is a domain entity representing a worker's message queue.
 // The QueueRepository provides access to these entities.
-// MessageQueue maintains FIFO ordering and bounded capacity.
+// MessageQueue maintains priority ordering, FIFO within a priority, and bounded capacity.
 type MessageQueue struct {
 	// WorkerID identifies which worker this queue belongs to.
 	WorkerID string
-	// entries holds the queued messages in FIFO order.
+	// entries holds the queued messages in delivery order.
 	entries []QueueEntry
 	// maxSize is the maximum number of entries allowed (0 means unlimited).
 	maxSize int
+	// store persists changes to entries (nil for in-memory queues).
+	store QueueStore
+}
+
+// QueueStore persists the entries of message queues so queued messages
+// survive a crash. Implementations must be thread-safe.
+type QueueStore interface {
+	// Append persists an entry added to a worker's queue.
+	Append(workerID string, entry QueueEntry) error
+	// RemoveFirst removes the persisted entry that is delivered next, the
+	// oldest entry of the highest priority.
+	RemoveFirst(workerID string)
+	// RemoveAll removes every persisted entry of a worker's queue.
+	RemoveAll(workerID string)
 }
 
 // NewMessageQueue creates a new MessageQueue for a worker with the specified max size.
@@ -207,20 +285,65 @@ func NewMessageQueue(workerID string, maxSize int) *MessageQueue {
 	}
 }
 
-// Enqueue adds a message to the end of the queue with the specified sender.
+// NewStoredMessageQueue creates a MessageQueue holding the given entries, in
+// delivery order, that persists every change through store. Entries without a
+// priority get the default priority of their sender.
+func NewStoredMessageQueue(workerID string, maxSize int, entries []QueueEntry, store QueueStore) *MessageQueue {
+	q := NewMessageQueue(workerID, maxSize)
+	q.entries = append(q.entries, entries...)
+	for i := range q.entries {
+		if q.entries[i].Priority == 0 {
+			q.entries[i].Priority = q.entries[i].Sender.DefaultPriority()
+		}
+	}
+	q.store = store
+	return q
+}
+
+// Enqueue adds a message with the default priority of its sender.
 // Returns ErrQueueFull if the queue has reached maxSize (and maxSize > 0).
 func (q *MessageQueue) Enqueue(content string, sender SenderType) error {
+	return q.EnqueueWithPriority(content, sender, sender.DefaultPriority())
+}
+
+// EnqueueWithPriority adds a message behind the queued messages of the same or
+// higher priority. A zero priority uses the default priority of the sender.
+// Returns ErrQueueFull if the queue has reached maxSize (and maxSize > 0).
+func (q *MessageQueue) EnqueueWithPriority(content string, sender SenderType, priority MessagePriority) error {
 	if q.maxSize > 0 && len(q.entries) >= q.maxSize {
 		return ErrQueueFull
 	}
-	q.entries = append(q.entries, QueueEntry{
+	if priority == 0 {
+		priority = sender.DefaultPriority()
+	}
+	entry := QueueEntry{
 		Content:   content,
 		Sender:    sender,
+		Priority:  priority,
 		Timestamp: time.Now(),
-	})
+	}
+	if q.store != nil {
+		if err := q.store.Append(q.WorkerID, entry); err != nil {
+			return err
+		}
+	}
+	i := len(q.entries)
+	for i > 0 && q.entries[i-1].Priority < priority {
+		i--
+	}
+	q.entries = slices.Insert(q.entries, i, entry)
 	return nil
 }
 
+// Peek returns the message that Dequeue would return without removing it.
+func (q *MessageQueue) Peek() (*QueueEntry, bool) {
+	if len(q.entries) == 0 {
+		return nil, false
+	}
+	entry := q.entries[0]
+	return &entry, true
+}
+
 // Dequeue removes and returns the first message from the queue.
 // Returns the entry and true if the queue had a message, or an empty entry and false if empty.
 func (q *MessageQueue) Dequeue() (*QueueEntry, bool) {
@@ -229,6 +352,9 @@ func (q *MessageQueue) Dequeue() (*QueueEntry, bool) {
 	}
 	entry := q.entries[0]
 	q.entries = q.entries[1:]
+	if q.store != nil {
+		q.store.RemoveFirst(q.WorkerID)
+	}
 	return &entry, true
 }
 
@@ -237,6 +363,17 @@ func (q *MessageQueue) Dequeue() (*QueueEntry, bool) {
 func (q *MessageQueue) Drain() []QueueEntry {
 	entries := q.entries
 	q.entries = make([]QueueEntry, 0)
+	if q.store != nil && len(entries) > 0 {
+		q.store.RemoveAll(q.WorkerID)
+	}
+	return entries
+}
+
+// Entries returns a copy of the queued messages in delivery order without
+// removing them.
+func (q *MessageQueue) Entries() []QueueEntry {
+	entries := make([]QueueEntry, len(q.entries))
+	copy(entries, q.entries)
 	return entries
 }
 
@@ -335,3 +472,27 @@ type ProcessRepository interface {
 	// FailedWorkers returns workers that failed (session expired, crashed, etc.).
 	FailedWorkers() []*Process
 }
+
+// ApprovalRepository tracks approvals awaiting a human decision.
+// Resolved approvals are kept, so granted approval gates can be closed.
+// Implementations must be thread-safe.
+type ApprovalRepository interface {
+	// Get retrieves a pending approval by ID.
+	// Returns ErrApprovalNotFound if no such approval is pending.
+	Get(approvalID string) (*Approval, error)
+
+	// Save persists an approval. Creates new or updates existing.
+	Save(approval *Approval) error
+
+	// Delete removes an approval from the repository.
+	Delete(approvalID string) error
+
+	// Pending returns all pending approvals, oldest first.
+	Pending() []*Approval
+
+	// ForTask returns the pending approval holding a task, or false if none does.
+	ForTask(taskID string) (*Approval, bool)
+
+	// Granted reports whether a resolved approval covering a task was approved.
+	Granted(taskID string) bool
+}
@@ -143,6 +143,51 @@ func TestMessageQueue_Dequeue_ReturnsFIFOOrder(t *testing.T) {
 	assert.Nil(t, entry)
 }
 
+func TestMessageQueue_EnqueueWithPriority_DeliversHigherPrioritiesFirst(t *testing.T) {
+	q := NewMessageQueue("worker-1", 10)
+
+	require.NoError(t, q.Enqueue("fabric", SenderSystem))
+	require.NoError(t, q.Enqueue("assign", SenderCoordinator))
+	require.NoError(t, q.Enqueue("question", SenderUser))
+	require.NoError(t, q.EnqueueWithPriority("STOP", SenderUser, PriorityInterrupt))
+	require.NoError(t, q.EnqueueWithPriority("nudge", SenderSystem, 0))
+
+	var contents []string
+	var priorities []MessagePriority
+	for {
+		entry, ok := q.Dequeue()
+		if !ok {
+			break
+		}
+		contents = append(contents, entry.Content)
+		priorities = append(priorities, entry.Priority)
+	}
+	require.Equal(t, []string{"STOP", "question", "assign", "fabric", "nudge"}, contents)
+	require.Equal(t, []MessagePriority{
+		PriorityInterrupt, PriorityUser, PriorityCoordinator, PriorityNotification, PriorityNotification,
+	}, priorities)
+}
+
+func TestMessageQueue_Peek(t *testing.T) {
+	q := NewMessageQueue("worker-1", 10)
+	_, ok := q.Peek()
+	require.False(t, ok)
+
+	require.NoError(t, q.Enqueue("first", SenderCoordinator))
+	require.NoError(t, q.Enqueue("urgent", SenderUser))
+
+	entry, ok := q.Peek()
+	require.True(t, ok)
+	require.Equal(t, "urgent", entry.Content)
+	require.Equal(t, 2, q.Size())
+}
+
+func TestSenderType_DefaultPriority(t *testing.T) {
+	require.Equal(t, PriorityUser, SenderUser.DefaultPriority())
+	require.Equal(t, PriorityCoordinator, SenderCoordinator.DefaultPriority())
+	require.Equal(t, PriorityNotification, SenderSystem.DefaultPriority())
+}
+
 func TestMessageQueue_Dequeue_EmptyQueue(t *testing.T) {
 	q := NewMessageQueue("worker-1", 10)
 
@@ -151,6 +196,52 @@ func TestMessageQueue_Dequeue_EmptyQueue(t *testing.T) {
 	assert.Nil(t, entry)
 }
 
+// recordingQueueStore is a QueueStore that records the changes persisted through it.
+type recordingQueueStore struct {
+	appended  []string
+	removed   int
+	cleared   int
+	appendErr error
+}
+
+func (s *recordingQueueStore) Append(_ string, entry QueueEntry) error {
+	if s.appendErr != nil {
+		return s.appendErr
+	}
+	s.appended = append(s.appended, entry.Content)
+	return nil
+}
+
+func (s *recordingQueueStore) RemoveFirst(string) { s.removed++ }
+
+func (s *recordingQueueStore) RemoveAll(string) { s.cleared++ }
+
+func TestMessageQueue_StoredQueuePersistsChanges(t *testing.T) {
+	store := &recordingQueueStore{}
+	q := NewStoredMessageQueue("worker-1", 10, []QueueEntry{{Content: "restored", Sender: SenderSystem}}, store)
+	require.Equal(t, 1, q.Size())
+
+	require.NoError(t, q.Enqueue("second", SenderSystem))
+	entry, ok := q.Dequeue()
+	require.True(t, ok)
+	require.Equal(t, "restored", entry.Content)
+	require.Equal(t, PriorityNotification, entry.Priority, "restored entries get their sender's priority")
+	require.Len(t, q.Drain(), 1)
+	q.Drain()
+
+	require.Equal(t, []string{"second"}, store.appended)
+	require.Equal(t, 1, store.removed)
+	require.Equal(t, 1, store.cleared, "draining an empty queue persists nothing")
+}
+
+func TestMessageQueue_StoredQueueRejectsUnpersistedEntry(t *testing.T) {
+	store := &recordingQueueStore{appendErr: errors.New("disk full")}
+	q := NewStoredMessageQueue("worker-1", 10, nil, store)
+
+	require.EqualError(t, q.Enqueue("lost", SenderUser), "disk full")
+	require.True(t, q.IsEmpty())
+}
+
 func TestMessageQueue_Drain_ReturnsAllAndEmpties(t *testing.T) {
 	q := NewMessageQueue("worker-1", 10)
 
@@ -184,6 +275,23 @@ func TestMessageQueue_Drain_EmptyQueue(t *testing.T) {
 	assert.NotNil(t, entries) // Should return empty slice, not nil
 }
 
+func TestMessageQueue_Entries_ReturnsCopyWithoutDequeuing(t *testing.T) {
+	q := NewMessageQueue("worker-1", 10)
+	require.NoError(t, q.Enqueue("first", SenderCoordinator))
+	require.NoError(t, q.Enqueue("second", SenderCoordinator))
+
+	entries := q.Entries()
+	require.Len(t, entries, 2)
+	require.Equal(t, "first", entries[0].Content)
+	require.Equal(t, "second", entries[1].Content)
+	require.Equal(t, 2, q.Size())
+
+	entries[0].Content = "changed"
+	first, ok := q.Dequeue()
+	require.True(t, ok)
+	require.Equal(t, "first", first.Content)
+}
+
 func TestMessageQueue_RespectsMaxSize(t *testing.T) {
 	q := NewMessageQueue("worker-1", 3)
 
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
@@ -358,3 +359,95 @@ func (r *MemoryProcessRepository) AddProcess(process *Process) {
 
 	r.processes[process.ID] = process
 }
+
+// ===========================================================================
+// MemoryApprovalRepository
+// ===========================================================================
+
+// MemoryApprovalRepository is an in-memory implementation of ApprovalRepository.
+// It is thread-safe using sync.RWMutex for concurrent access.
+type MemoryApprovalRepository struct {
+	mu        sync.RWMutex
+	approvals map[string]*Approval
+}
+
+// NewMemoryApprovalRepository creates a new in-memory approval repository.
+func NewMemoryApprovalRepository() *MemoryApprovalRepository {
+	return &MemoryApprovalRepository{
+		approvals: make(map[string]*Approval),
+	}
+}
+
+// Get retrieves a pending approval by ID.
+// Returns ErrApprovalNotFound if no such approval is pending.
+func (r *MemoryApprovalRepository) Get(approvalID string) (*Approval, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	approval, ok := r.approvals[approvalID]
+	if !ok || !approval.ResolvedAt.IsZero() {
+		return nil, ErrApprovalNotFound
+	}
+	return approval, nil
+}
+
+// Save persists an approval. Creates new or updates existing.
+func (r *MemoryApprovalRepository) Save(approval *Approval) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.approvals[approval.ID] = approval
+	return nil
+}
+
+// Delete removes an approval from the repository.
+func (r *MemoryApprovalRepository) Delete(approvalID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.approvals, approvalID)
+	return nil
+}
+
+// Pending returns all pending approvals, oldest first.
+func (r *MemoryApprovalRepository) Pending() []*Approval {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*Approval, 0, len(r.approvals))
+	for _, approval := range r.approvals {
+		if approval.ResolvedAt.IsZero() {
+			result = append(result, approval)
+		}
+	}
+	slices.SortFunc(result, func(a, b *Approval) int {
+		return a.RequestedAt.Compare(b.RequestedAt)
+	})
+	return result
+}
+
+// ForTask returns the pending approval holding a task, or false if none does.
+func (r *MemoryApprovalRepository) ForTask(taskID string) (*Approval, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, approval := range r.approvals {
+		if approval.ResolvedAt.IsZero() && slices.Contains(approval.TaskIDs, taskID) {
+			return approval, true
+		}
+	}
+	return nil, false
+}
+
+// Granted reports whether a resolved approval covering a task was approved.
+func (r *MemoryApprovalRepository) Granted(taskID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, approval := range r.approvals {
+		if !approval.ResolvedAt.IsZero() && approval.Approved && slices.Contains(approval.TaskIDs, taskID) {
+			return true
+		}
+	}
+	return false
+}
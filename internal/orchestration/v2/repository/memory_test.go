@@ -607,6 +607,74 @@ func TestMemoryQueueRepository_ConcurrentGetOrCreate(t *testing.T) {
 	}
 }
 
+// ===========================================================================
+// MemoryApprovalRepository Tests
+// ===========================================================================
+
+func TestMemoryApprovalRepository_SaveGetDelete(t *testing.T) {
+	repo := NewMemoryApprovalRepository()
+
+	_, err := repo.Get("approval-1")
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+
+	approval := &Approval{ID: "approval-1", Summary: "Deploy the migration", TaskIDs: []string{taskID(1)}}
+	require.NoError(t, repo.Save(approval))
+
+	got, err := repo.Get("approval-1")
+	require.NoError(t, err)
+	require.Equal(t, approval, got)
+
+	require.NoError(t, repo.Delete("approval-1"))
+	_, err = repo.Get("approval-1")
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+}
+
+func TestMemoryApprovalRepository_Pending_OldestFirst(t *testing.T) {
+	repo := NewMemoryApprovalRepository()
+	now := time.Now()
+
+	require.NoError(t, repo.Save(&Approval{ID: "approval-2", RequestedAt: now.Add(time.Minute)}))
+	require.NoError(t, repo.Save(&Approval{ID: "approval-1", RequestedAt: now}))
+	require.NoError(t, repo.Save(&Approval{ID: "approval-3", RequestedAt: now.Add(2 * time.Minute)}))
+
+	pending := repo.Pending()
+	require.Len(t, pending, 3)
+	require.Equal(t, "approval-1", pending[0].ID)
+	require.Equal(t, "approval-2", pending[1].ID)
+	require.Equal(t, "approval-3", pending[2].ID)
+}
+
+func TestMemoryApprovalRepository_ForTask(t *testing.T) {
+	repo := NewMemoryApprovalRepository()
+	require.NoError(t, repo.Save(&Approval{ID: "approval-1", TaskIDs: []string{taskID(1), taskID(2)}}))
+
+	approval, ok := repo.ForTask(taskID(2))
+	require.True(t, ok)
+	require.Equal(t, "approval-1", approval.ID)
+
+	_, ok = repo.ForTask(taskID(3))
+	require.False(t, ok)
+}
+
+func TestMemoryApprovalRepository_ResolvedApprovals(t *testing.T) {
+	repo := NewMemoryApprovalRepository()
+	require.NoError(t, repo.Save(&Approval{ID: "approval-1", TaskIDs: []string{taskID(1)}}))
+	require.False(t, repo.Granted(taskID(1)), "pending approvals are not granted")
+
+	require.NoError(t, repo.Save(&Approval{ID: "approval-1", TaskIDs: []string{taskID(1)}, Approved: true, ResolvedAt: time.Now()}))
+	require.NoError(t, repo.Save(&Approval{ID: "approval-2", TaskIDs: []string{taskID(2)}, ResolvedAt: time.Now()}))
+
+	// Resolved approvals no longer hold their tasks
+	require.Empty(t, repo.Pending())
+	_, ok := repo.ForTask(taskID(1))
+	require.False(t, ok)
+	_, err := repo.Get("approval-1")
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+
+	require.True(t, repo.Granted(taskID(1)))
+	require.False(t, repo.Granted(taskID(2)), "rejected approvals are not granted")
+}
+
 // Helper functions for generating test IDs
 func workerID(n int) string {
 	return fmt.Sprintf("worker-%d", n)
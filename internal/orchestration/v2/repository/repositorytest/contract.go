@@ -0,0 +1,280 @@
+// Package repositorytest provides the contract test suites every implementation
+// of the v2 repository interfaces must pass, so in-memory and durable backends
+// stay interchangeable.
+package repositorytest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// processIDs returns the IDs of processes.
+func processIDs(processes []*repository.Process) []string {
+	ids := make([]string, 0, len(processes))
+	for _, p := range processes {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+// taskIDs returns the IDs of task assignments.
+func taskIDs(tasks []*repository.TaskAssignment) []string {
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.TaskID)
+	}
+	return ids
+}
+
+// TestProcessRepository runs the ProcessRepository contract against
+// repositories created by newRepo, which must return an empty repository.
+func TestProcessRepository(t *testing.T, newRepo func(t *testing.T) repository.ProcessRepository) {
+	t.Run("GetMissing", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.Get("worker-1")
+		require.ErrorIs(t, err, repository.ErrProcessNotFound)
+		_, err = repo.GetCoordinator()
+		require.ErrorIs(t, err, repository.ErrProcessNotFound)
+	})
+
+	t.Run("SaveAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		phase := events.ProcessPhaseImplementing
+		want := &repository.Process{
+			ID:               "worker-1",
+			Role:             repository.RoleWorker,
+			Status:           repository.StatusWorking,
+			SessionID:        "session-abc",
+			Metrics:          &metrics.TokenMetrics{TokensUsed: 27000, TotalTokens: 200000, CumulativeCostUSD: 0.42},
+			CreatedAt:        time.Unix(1767225600, 123456789),
+			LastActivityAt:   time.Unix(1767225660, 0),
+			HasCompletedTurn: true,
+			Phase:            &phase,
+			TaskID:           "perles-abc1.2",
+			AgentType:        roles.AgentTypeImplementer,
+		}
+		require.NoError(t, repo.Save(want))
+
+		got, err := repo.Get("worker-1")
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+
+	t.Run("SaveUpdatesExisting", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady}))
+		retiredAt := time.Unix(1767225600, 0)
+		require.NoError(t, repo.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusRetired, RetiredAt: retiredAt}))
+
+		got, err := repo.Get("worker-1")
+		require.NoError(t, err)
+		require.Equal(t, repository.StatusRetired, got.Status)
+		require.True(t, retiredAt.Equal(got.RetiredAt))
+		require.Len(t, repo.List(), 1)
+	})
+
+	t.Run("RoleAndStatusQueries", func(t *testing.T) {
+		repo := newRepo(t)
+		idle := events.ProcessPhaseIdle
+		reviewing := events.ProcessPhaseReviewing
+		for _, p := range []*repository.Process{
+			{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking},
+			{ID: repository.ObserverID, Role: repository.RoleObserver, Status: repository.StatusReady},
+			{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady},
+			{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle},
+			{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing},
+			{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusRetired},
+			{ID: "worker-5", Role: repository.RoleWorker, Status: repository.StatusFailed},
+		} {
+			require.NoError(t, repo.Save(p))
+		}
+
+		coordinator, err := repo.GetCoordinator()
+		require.NoError(t, err)
+		require.Equal(t, repository.CoordinatorID, coordinator.ID)
+
+		require.Len(t, repo.List(), 7)
+		require.ElementsMatch(t, []string{"worker-1", "worker-2", "worker-3", "worker-4", "worker-5"}, processIDs(repo.Workers()))
+		require.ElementsMatch(t, []string{"worker-1", "worker-2", "worker-3"}, processIDs(repo.ActiveWorkers()))
+		require.ElementsMatch(t, []string{"worker-1", "worker-2"}, processIDs(repo.ReadyWorkers()))
+		require.ElementsMatch(t, []string{"worker-4"}, processIDs(repo.RetiredWorkers()))
+		require.ElementsMatch(t, []string{"worker-5"}, processIDs(repo.FailedWorkers()))
+	})
+}
+
+// TestTaskRepository runs the TaskRepository contract against repositories
+// created by newRepo, which must return an empty repository.
+func TestTaskRepository(t *testing.T, newRepo func(t *testing.T) repository.TaskRepository) {
+	t.Run("GetMissing", func(t *testing.T) {
+		repo := newRepo(t)
+		_, err := repo.Get("perles-abc1.2")
+		require.ErrorIs(t, err, repository.ErrTaskNotFound)
+		_, err = repo.GetByWorker("worker-1")
+		require.ErrorIs(t, err, repository.ErrTaskNotFound)
+		require.Empty(t, repo.All())
+	})
+
+	t.Run("SaveAndGet", func(t *testing.T) {
+		repo := newRepo(t)
+		want := &repository.TaskAssignment{
+			TaskID:          "perles-abc1.2",
+			Implementer:     "worker-1",
+			Reviewer:        "worker-2",
+			Status:          repository.TaskInReview,
+			StartedAt:       time.Unix(1767225600, 123456789),
+			ReviewStartedAt: time.Unix(1767229200, 0),
+			ThreadID:        "thread-7",
+		}
+		require.NoError(t, repo.Save(want))
+
+		got, err := repo.Get("perles-abc1.2")
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+
+	t.Run("SaveUpdatesExisting", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing}))
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskApproved}))
+
+		got, err := repo.Get("perles-abc1.2")
+		require.NoError(t, err)
+		require.Equal(t, repository.TaskApproved, got.Status)
+		require.Equal(t, "worker-2", got.Reviewer)
+		require.Len(t, repo.All(), 1)
+	})
+
+	t.Run("WorkerQueries", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.1", Implementer: "worker-1", Status: repository.TaskCompleted}))
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-2", Reviewer: "worker-3", Status: repository.TaskInReview}))
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.3", Implementer: "worker-1", Status: repository.TaskImplementing}))
+
+		task, err := repo.GetByWorker("worker-3")
+		require.NoError(t, err)
+		require.Equal(t, "perles-abc1.2", task.TaskID, "reviewers are found by worker")
+		task, err = repo.GetByWorker("worker-2")
+		require.NoError(t, err)
+		require.Equal(t, "perles-abc1.2", task.TaskID, "implementers are found by worker")
+
+		tasks, err := repo.GetByImplementer("worker-1")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"perles-abc1.1", "perles-abc1.3"}, taskIDs(tasks))
+		tasks, err = repo.GetByImplementer("worker-3")
+		require.NoError(t, err)
+		require.Empty(t, tasks)
+
+		require.ElementsMatch(t, []string{"perles-abc1.1", "perles-abc1.2", "perles-abc1.3"}, taskIDs(repo.All()))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t)
+		require.NoError(t, repo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing}))
+		require.NoError(t, repo.Delete("perles-abc1.2"))
+		_, err := repo.Get("perles-abc1.2")
+		require.ErrorIs(t, err, repository.ErrTaskNotFound)
+		require.NoError(t, repo.Delete("perles-abc1.2"), "deleting a missing task is a no-op")
+	})
+}
+
+// TestQueueRepository runs the QueueRepository contract against repositories
+// created by newRepo, which must return an empty repository whose queues hold
+// at most maxSize entries.
+func TestQueueRepository(t *testing.T, newRepo func(t *testing.T, maxSize int) repository.QueueRepository) {
+	t.Run("GetOrCreate", func(t *testing.T) {
+		repo := newRepo(t, 10)
+		queue := repo.GetOrCreate("worker-1")
+		require.NotNil(t, queue)
+		require.Equal(t, "worker-1", queue.WorkerID)
+		require.True(t, queue.IsEmpty())
+		require.Equal(t, 10, queue.MaxSize())
+		require.Equal(t, 0, repo.Size("worker-2"), "a missing queue is empty")
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		repo := newRepo(t, 0)
+		require.NoError(t, repo.GetOrCreate("worker-1").Enqueue("first", repository.SenderCoordinator))
+		require.NoError(t, repo.GetOrCreate("worker-1").Enqueue("second", repository.SenderCoordinator))
+		require.NoError(t, repo.GetOrCreate("worker-1").Enqueue("third", repository.SenderCoordinator))
+		require.Equal(t, 3, repo.Size("worker-1"))
+
+		entry, ok := repo.GetOrCreate("worker-1").Dequeue()
+		require.True(t, ok)
+		require.Equal(t, "first", entry.Content)
+		require.Equal(t, repository.SenderCoordinator, entry.Sender)
+		require.Equal(t, repository.PriorityCoordinator, entry.Priority)
+		require.Equal(t, 2, repo.Size("worker-1"))
+
+		entries := repo.GetOrCreate("worker-1").Drain()
+		require.Len(t, entries, 2)
+		require.Equal(t, "second", entries[0].Content)
+		require.Equal(t, "third", entries[1].Content)
+		require.Equal(t, 0, repo.Size("worker-1"))
+
+		_, ok = repo.GetOrCreate("worker-1").Dequeue()
+		require.False(t, ok)
+	})
+
+	t.Run("Priority", func(t *testing.T) {
+		repo := newRepo(t, 0)
+		queue := repo.GetOrCreate("worker-1")
+		require.NoError(t, queue.Enqueue("notification", repository.SenderSystem))
+		require.NoError(t, queue.Enqueue("task", repository.SenderCoordinator))
+		require.NoError(t, queue.Enqueue("question", repository.SenderUser))
+		require.NoError(t, queue.Enqueue("review", repository.SenderCoordinator))
+		require.NoError(t, queue.EnqueueWithPriority("STOP", repository.SenderUser, repository.PriorityInterrupt))
+
+		next, ok := queue.Peek()
+		require.True(t, ok)
+		require.Equal(t, "STOP", next.Content)
+		require.Equal(t, 5, queue.Size(), "peek does not remove the message")
+
+		entry, ok := queue.Dequeue()
+		require.True(t, ok)
+		require.Equal(t, "STOP", entry.Content)
+		require.Equal(t, repository.PriorityInterrupt, entry.Priority)
+
+		var contents []string
+		for _, e := range queue.Drain() {
+			contents = append(contents, e.Content)
+		}
+		require.Equal(t, []string{"question", "task", "review", "notification"}, contents)
+	})
+
+	t.Run("MaxSize", func(t *testing.T) {
+		repo := newRepo(t, 2)
+		queue := repo.GetOrCreate("worker-1")
+		require.NoError(t, queue.Enqueue("first", repository.SenderCoordinator))
+		require.NoError(t, queue.Enqueue("second", repository.SenderCoordinator))
+		require.ErrorIs(t, queue.Enqueue("third", repository.SenderCoordinator), repository.ErrQueueFull)
+		require.Equal(t, 2, repo.Size("worker-1"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		repo := newRepo(t, 0)
+		require.NoError(t, repo.GetOrCreate("worker-1").Enqueue("hello", repository.SenderCoordinator))
+		require.NoError(t, repo.GetOrCreate("worker-2").Enqueue("hello", repository.SenderCoordinator))
+
+		repo.Delete("worker-1")
+		require.Equal(t, 0, repo.Size("worker-1"))
+		require.True(t, repo.GetOrCreate("worker-1").IsEmpty())
+		require.Equal(t, 1, repo.Size("worker-2"))
+	})
+
+	t.Run("ClearAll", func(t *testing.T) {
+		repo := newRepo(t, 0)
+		require.NoError(t, repo.GetOrCreate("worker-1").Enqueue("hello", repository.SenderCoordinator))
+		require.NoError(t, repo.GetOrCreate("worker-2").Enqueue("hello", repository.SenderCoordinator))
+
+		repo.ClearAll()
+		require.Equal(t, 0, repo.Size("worker-1"))
+		require.Equal(t, 0, repo.Size("worker-2"))
+	})
+}
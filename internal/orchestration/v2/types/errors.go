@@ -54,6 +54,13 @@ var ErrTaskNotApproved = errors.New("task has not been approved")
 // ErrNoTaskAssigned is returned when trying to transition a process with no assigned task.
 var ErrNoTaskAssigned = errors.New("process has no task assigned")
 
+// ErrAwaitingApproval is returned when trying to advance a task held by a pending approval.
+var ErrAwaitingApproval = errors.New("task is awaiting human approval")
+
+// ErrApprovalGate is returned when trying to assign an approval gate task to a
+// worker, or to complete one that has not been approved.
+var ErrApprovalGate = errors.New("task is an approval gate")
+
 // ===========================================================================
 // Process State Errors
 // ===========================================================================
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 
@@ -23,6 +24,8 @@ type frontmatter struct {
 	Workers     int                            `yaml:"workers"`
 	TargetMode  string                         `yaml:"target_mode"`
 	AgentRoles  map[string]agentRoleConfigYAML `yaml:"agent_roles"`
+	Health      *config.HealthConfig           `yaml:"health"`
+	Policies    map[string]config.PolicyConfig `yaml:"policies"`
 }
 
 // agentRoleConfigYAML is the YAML representation of AgentRoleConfig.
@@ -79,6 +82,10 @@ func parseWorkflow(content, filename string, source Source) (Workflow, error) {
 		return Workflow{}, fmt.Errorf("parsing frontmatter: %w", err)
 	}
 
+	if err := config.ValidatePolicies("policies", fm.Policies); err != nil {
+		return Workflow{}, err
+	}
+
 	// Derive ID from filename (e.g., "debate.md" -> "debate")
 	id := strings.TrimSuffix(filename, ".md")
 
@@ -99,6 +106,8 @@ func parseWorkflow(content, filename string, source Source) (Workflow, error) {
 		Workers:     fm.Workers,
 		TargetMode:  TargetMode(fm.TargetMode),
 		AgentRoles:  agentRoles,
+		Health:      fm.Health,
+		Policies:    fm.Policies,
 		Content:     content,
 		Source:      source,
 	}, nil
@@ -4,9 +4,12 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
 )
 
 func TestParseFrontmatter(t *testing.T) {
@@ -197,6 +200,65 @@ This is the content of the debate format.
 	assert.Empty(t, wf.FilePath)
 }
 
+func TestParseWorkflowWithHealth(t *testing.T) {
+	content := `---
+name: "Cook"
+health:
+  progress_timeout: 10m
+  escalation:
+    - action: nudge
+      message: "Check on your workers"
+      repeat: 2
+    - action: replace_worker
+---
+
+# Cook
+`
+	wf, err := parseWorkflow(content, "cook.md", SourceBuiltIn)
+	require.NoError(t, err)
+
+	require.NotNil(t, wf.Health)
+	assert.Equal(t, 10*time.Minute, wf.Health.ProgressTimeout)
+	assert.Equal(t, []config.EscalationStepConfig{
+		{Action: "nudge", Message: "Check on your workers", Repeat: 2},
+		{Action: "replace_worker"},
+	}, wf.Health.Escalation)
+
+	wf, err = parseWorkflow("---\nname: \"Debate\"\n---\n", "debate.md", SourceBuiltIn)
+	require.NoError(t, err)
+	assert.Nil(t, wf.Health, "templates without a health block use orchestration.health")
+}
+
+func TestParseWorkflowWithPolicies(t *testing.T) {
+	content := `---
+name: "Prod Deploy"
+policies:
+  worker:
+    allowed_commands: ["go test", "git"]
+    network: deny
+    protected_paths: [".env"]
+  reviewer:
+    read_only: true
+---
+
+# Prod Deploy
+`
+	wf, err := parseWorkflow(content, "prod-deploy.md", SourceUser)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]config.PolicyConfig{
+		"worker": {
+			AllowedCommands: []string{"go test", "git"},
+			Network:         "deny",
+			ProtectedPaths:  []string{".env"},
+		},
+		"reviewer": {ReadOnly: true},
+	}, wf.Policies)
+
+	_, err = parseWorkflow("---\nname: \"Bad\"\npolicies:\n  tester:\n    read_only: true\n---\n", "bad.md", SourceUser)
+	require.ErrorContains(t, err, `policies: unknown role "tester"`)
+}
+
 func TestParseWorkflowFile(t *testing.T) {
 	content := `---
 name: "Custom Workflow"
@@ -2,6 +2,12 @@
 // It supports loading and managing both built-in and user-defined workflow templates.
 package workflow
 
+import (
+	"strings"
+
+	"github.com/zjrosen/perles/internal/config"
+)
+
 // AgentRoleConfig defines per-agent-type customizations for a workflow.
 // This allows workflows to customize prompts and constraints for specific agent types
 // (e.g., implementer, reviewer, researcher).
@@ -80,6 +86,14 @@ type Workflow struct {
 	// If nil or empty, the workflow uses default prompts for all agent types.
 	AgentRoles map[string]AgentRoleConfig
 
+	// Health overrides orchestration.health for workflows run from this template.
+	// Nil when the frontmatter has no health block.
+	Health *config.HealthConfig
+
+	// Policies override orchestration.policies, per role, for workflows run
+	// from this template.
+	Policies map[string]config.PolicyConfig
+
 	// Content is the full markdown content (including frontmatter).
 	Content string
 
@@ -89,3 +103,11 @@ type Workflow struct {
 	// FilePath is the absolute path for user workflows (empty for built-in).
 	FilePath string
 }
+
+// TemplateKey returns the key of the workflow template registration this
+// workflow describes: its ID with underscores replaced by hyphens, e.g.
+// "quick-plan" for quick_plan.md. Control plane workflows record this key as
+// their TemplateID.
+func (w Workflow) TemplateKey() string {
+	return strings.ReplaceAll(w.ID, "_", "-")
+}
@@ -47,6 +47,21 @@ func ResolveBeadsDir(path string) string {
 	return followRedirect(beadsDir)
 }
 
+// BeadsDirFromEnv resolves the .beads directory for commands without a -b flag.
+// Resolution priority:
+//  1. BEADS_DIR environment variable
+//  2. configDir (beads_dir config file setting)
+//  3. workDir
+func BeadsDirFromEnv(configDir, workDir string) string {
+	if envDir := os.Getenv("BEADS_DIR"); envDir != "" {
+		return ResolveBeadsDir(envDir)
+	}
+	if configDir != "" {
+		return ResolveBeadsDir(configDir)
+	}
+	return ResolveBeadsDir(workDir)
+}
+
 // followRedirect checks for a redirect file and follows it if present.
 // Redirect files are used by git worktrees to point to the main worktree's .beads.
 func followRedirect(beadsDir string) string {
@@ -123,3 +123,16 @@ func TestResolveBeadsDir_EmptyRedirect(t *testing.T) {
 	result := ResolveBeadsDir(projectDir)
 	require.Equal(t, beadsDir, result)
 }
+
+func TestBeadsDirFromEnv(t *testing.T) {
+	project := filepath.FromSlash("/path/to/project")
+	configured := filepath.FromSlash("/path/to/configured")
+	env := filepath.FromSlash("/path/to/env")
+
+	t.Setenv("BEADS_DIR", "")
+	require.Equal(t, filepath.Join(project, ".beads"), BeadsDirFromEnv("", project))
+	require.Equal(t, filepath.Join(configured, ".beads"), BeadsDirFromEnv(configured, project))
+
+	t.Setenv("BEADS_DIR", env)
+	require.Equal(t, filepath.Join(env, ".beads"), BeadsDirFromEnv(configured, project))
+}
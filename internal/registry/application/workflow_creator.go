@@ -6,6 +6,7 @@ import (
 	"time"
 
 	beads "github.com/zjrosen/perles/internal/beads/application"
+	beadsdomain "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/registry/domain"
 )
@@ -119,13 +120,18 @@ func (c *WorkflowCreator) CreateWithArgs(feature, workflowKey string, args map[s
 			return nil, fmt.Errorf("render template %s: %w", node.Key(), err)
 		}
 
-		// Create task as child of epic
+		// Create task as child of epic; gate tasks are labeled so they are
+		// approved by the user instead of assigned to a worker
+		labels := []string{"spec:plan"}
+		if node.Gate() {
+			labels = append(labels, beadsdomain.LabelApprovalGate)
+		}
 		taskResult, err := c.executor.CreateTask(
 			node.Name(),
 			content,
 			epicResult.ID,
 			node.Assignee(),
-			[]string{"spec:plan"},
+			labels,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("create task %s: %w", node.Key(), err)
@@ -231,6 +231,50 @@ func TestWorkflowCreator_CreateWithArgs_ConfigCustom(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWorkflowCreator_CreateWithArgs_GateNodeLabeled(t *testing.T) {
+	registrySvc, err := createRegistryServiceWithFS(fstest.MapFS{
+		"workflows/gated/template.yaml": &fstest.MapFile{
+			Data: []byte(`
+registry:
+  - namespace: "workflow"
+    key: "gated"
+    version: "v1"
+    name: "Gated"
+    description: "Gated workflow"
+    system_prompt: "coordinator.md"
+    nodes:
+      - key: "build"
+        name: "Build"
+        template: "build.md"
+        assignee: "worker-1"
+      - key: "approve"
+        name: "Approve Deploy"
+        template: "approve.md"
+        gate: true
+        after: ["build"]
+`),
+		},
+		"workflows/gated/build.md":       &fstest.MapFile{Data: []byte("Build it")},
+		"workflows/gated/approve.md":     &fstest.MapFile{Data: []byte("Approve the deploy")},
+		"workflows/gated/coordinator.md": &fstest.MapFile{Data: []byte("Coordinate")},
+	})
+	require.NoError(t, err)
+
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().CreateEpic(mock.Anything, mock.Anything, mock.Anything).
+		Return(beads.CreateResult{ID: "test-epic", Title: "Gated: Test Feature"}, nil)
+	mockExecutor.EXPECT().CreateTask("Build", mock.Anything, "test-epic", "worker-1", []string{"spec:plan"}).
+		Return(beads.CreateResult{ID: "task-1", Title: "Build"}, nil)
+	mockExecutor.EXPECT().CreateTask("Approve Deploy", mock.Anything, "test-epic", "human", []string{"spec:plan", beads.LabelApprovalGate}).
+		Return(beads.CreateResult{ID: "task-2", Title: "Approve Deploy"}, nil)
+	mockExecutor.EXPECT().AddDependency("task-2", "task-1").Return(nil)
+
+	creator := NewWorkflowCreator(registrySvc, mockExecutor, config.TemplatesConfig{})
+
+	_, err = creator.CreateWithArgs("test-feature", "gated", nil)
+	require.NoError(t, err)
+}
+
 func TestToTitleCase(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -59,6 +59,7 @@ type NodeDef struct {
 	Outputs  []ArtifactDef `yaml:"outputs"`  // Output artifacts (string or {key, file} object)
 	After    []string      `yaml:"after"`    // Node keys this node depends on
 	Assignee string        `yaml:"assignee"` // Worker role to assign this task to
+	Gate     bool          `yaml:"gate"`     // Human approval gate, assigned to "human"
 }
 
 // ArtifactDef defines an input/output artifact in YAML.
@@ -196,6 +197,10 @@ func LoadRegistryFromYAMLWithSource(fsys fs.FS, source registry.Source) ([]*regi
 				if err := validateAssignee(node.Assignee); err != nil {
 					return fmt.Errorf("workflow %s/%s node %d in %s: %w", def.Namespace, def.Key, i, path, err)
 				}
+				if node.Gate && node.Assignee != "" && node.Assignee != "human" {
+					return fmt.Errorf("workflow %s/%s node %d in %s: gate nodes are approved by a human, cannot assign to %q",
+						def.Namespace, def.Key, i, path, node.Assignee)
+				}
 			}
 
 			// Resolve template paths relative to the workflow directory
@@ -391,15 +396,15 @@ func buildArguments(defs []ArgumentDef) ([]*registry.Argument, error) {
 }
 
 // isOrchestrationWorkflow checks if the workflow definition is an orchestration workflow.
-// Orchestration workflows have at least one node with an assignee field, OR are epic-driven.
+// Orchestration workflows have at least one node with an assignee field or a gate, OR are epic-driven.
 func isOrchestrationWorkflow(def *WorkflowDef) bool {
 	// Epic-driven workflows are orchestration workflows
 	if isEpicDrivenWorkflow(def) {
 		return true
 	}
-	// Standard orchestration: at least one node with assignee
+	// Standard orchestration: at least one node with assignee or gate
 	for _, node := range def.Nodes {
-		if node.Assignee != "" {
+		if node.Assignee != "" || node.Gate {
 			return true
 		}
 	}
@@ -420,7 +425,8 @@ func isEpicDrivenWorkflow(def *WorkflowDef) bool {
 	return len(def.Nodes) == 0
 }
 
-// buildNodeOptions converts NodeDef inputs/outputs/after/assignee into NodeOption functions.
+// buildNodeOptions converts NodeDef inputs/outputs/after/assignee/gate into NodeOption functions.
+// Gate nodes without an assignee are assigned to "human".
 // Returns error if artifact validation fails.
 func buildNodeOptions(node NodeDef) ([]registry.NodeOption, error) {
 	var opts []registry.NodeOption
@@ -444,6 +450,11 @@ func buildNodeOptions(node NodeDef) ([]registry.NodeOption, error) {
 	}
 	if node.Assignee != "" {
 		opts = append(opts, registry.Assignee(node.Assignee))
+	} else if node.Gate {
+		opts = append(opts, registry.Assignee("human"))
+	}
+	if node.Gate {
+		opts = append(opts, registry.Gate())
 	}
 
 	return opts, nil
@@ -715,6 +715,15 @@ func TestIsOrchestrationWorkflow_TrueWhenAssigneePresent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "gate node without assignee",
+			def: WorkflowDef{
+				Nodes: []NodeDef{
+					{Key: "step1"},
+					{Key: "approve", Gate: true},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1119,6 +1128,75 @@ registry:
 	require.Contains(t, err.Error(), "invalid assignee")
 }
 
+func TestLoadRegistryFromYAML_GateNode(t *testing.T) {
+	yamlContent := `
+registry:
+  - namespace: "test"
+    key: "gated"
+    version: "v1"
+    name: "Gated"
+    description: ""
+    instructions: "instructions.md"
+    system_prompt: "coordinator.md"
+    nodes:
+      - key: "step1"
+        name: "Step 1"
+        template: "step1.md"
+        assignee: "worker-1"
+      - key: "approve"
+        name: "Approve Deploy"
+        template: "approve.md"
+        gate: true
+        after: ["step1"]
+`
+	fs := fstest.MapFS{
+		"workflows/test/template.yaml":   &fstest.MapFile{Data: []byte(yamlContent)},
+		"workflows/test/step1.md":        &fstest.MapFile{Data: []byte("# Step 1")},
+		"workflows/test/approve.md":      &fstest.MapFile{Data: []byte("# Approve")},
+		"workflows/test/instructions.md": &fstest.MapFile{Data: []byte("# Instructions")},
+		"workflows/test/coordinator.md":  &fstest.MapFile{Data: []byte("# Coordinator")},
+	}
+
+	regs, err := LoadRegistryFromYAML(fs)
+	require.NoError(t, err)
+	require.Len(t, regs, 1)
+
+	nodes := regs[0].DAG().Nodes()
+	require.Len(t, nodes, 2)
+	require.False(t, nodes[0].Gate())
+	require.True(t, nodes[1].Gate())
+	require.Equal(t, "human", nodes[1].Assignee(), "gate nodes default to the human assignee")
+}
+
+func TestLoadRegistryFromYAML_GateNodeAssignedToWorker(t *testing.T) {
+	yamlContent := `
+registry:
+  - namespace: "test"
+    key: "gated"
+    version: "v1"
+    name: "Gated"
+    description: ""
+    instructions: "instructions.md"
+    system_prompt: "coordinator.md"
+    nodes:
+      - key: "approve"
+        name: "Approve Deploy"
+        template: "approve.md"
+        assignee: "worker-1"
+        gate: true
+`
+	fs := fstest.MapFS{
+		"workflows/test/template.yaml":   &fstest.MapFile{Data: []byte(yamlContent)},
+		"workflows/test/approve.md":      &fstest.MapFile{Data: []byte("# Approve")},
+		"workflows/test/instructions.md": &fstest.MapFile{Data: []byte("# Instructions")},
+		"workflows/test/coordinator.md":  &fstest.MapFile{Data: []byte("# Coordinator")},
+	}
+
+	_, err := LoadRegistryFromYAML(fs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "gate nodes are approved by a human")
+}
+
 func TestLoadRegistryFromYAML_MissingTemplate(t *testing.T) {
 	yamlContent := `
 registry:
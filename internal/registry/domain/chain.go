@@ -73,6 +73,14 @@ func Assignee(role string) NodeOption {
 	}
 }
 
+// Gate marks a node as a human approval gate. Gate tasks are never assigned to
+// a worker; the coordinator requests the user's approval for them instead.
+func Gate() NodeOption {
+	return func(n *Node) {
+		n.WithGate()
+	}
+}
+
 // ChainBuilder provides a fluent API for constructing workflow DAGs.
 type ChainBuilder struct {
 	nodes   []*Node
@@ -9,6 +9,7 @@ type Node struct {
 	outputs  []*Artifact // artifacts produced by this node
 	after    []string    // node keys this node must run after (ordering-only deps)
 	assignee string      // worker role to assign this task to (e.g., "research-lead", "architect")
+	gate     bool        // human approval gate: approved by the user instead of worked on
 }
 
 // NewNode creates a new node with the given key, name, and template.
@@ -58,6 +59,11 @@ func (n *Node) Assignee() string {
 	return n.assignee
 }
 
+// Gate reports whether this node is a human approval gate.
+func (n *Node) Gate() bool {
+	return n.gate
+}
+
 // WithInputs adds input artifacts and returns the node for fluent chaining.
 func (n *Node) WithInputs(artifacts ...*Artifact) *Node {
 	n.inputs = append(n.inputs, artifacts...)
@@ -81,3 +87,9 @@ func (n *Node) WithAssignee(assignee string) *Node {
 	n.assignee = assignee
 	return n
 }
+
+// WithGate marks the node as a human approval gate and returns the node for fluent chaining.
+func (n *Node) WithGate() *Node {
+	n.gate = true
+	return n
+}
@@ -162,6 +162,17 @@ func TestNode_Assignee_Empty(t *testing.T) {
 	require.Equal(t, "", node.Assignee())
 }
 
+func TestNode_WithGate(t *testing.T) {
+	node := NewNode("approve", "Approve", "v1-approve.md")
+	require.False(t, node.Gate(), "nodes are not gates by default")
+
+	result := node.WithGate()
+
+	// Returns same node for fluent chaining
+	require.Same(t, node, result)
+	require.True(t, node.Gate())
+}
+
 func TestNode_FluentChaining(t *testing.T) {
 	// Test the full fluent API
 	node := NewNode("propose", "Propose", "v1-proposal.md").
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMacros maps the supported shorthand expressions to their five-field form.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes the allowed range of one cron field.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// CronExpr is a parsed five-field cron expression
+// (minute, hour, day of month, month, day of week).
+type CronExpr struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record an unrestricted day field. When both day
+	// fields are restricted a time matches if either matches, as in cron(8).
+	domStar, dowStar bool
+}
+
+// ParseCron parses a five-field cron expression or one of the macros
+// @yearly, @monthly, @weekly, @daily and @hourly. Fields support "*",
+// single values, ranges ("1-5"), lists ("1,15") and steps ("*/15", "0-30/10").
+// Day of week 7 is accepted as Sunday.
+func ParseCron(spec string) (CronExpr, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return CronExpr{}, fmt.Errorf("cron expression is required")
+	}
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := cronMacros[spec]
+		if !ok {
+			return CronExpr{}, fmt.Errorf("unknown cron macro %q", spec)
+		}
+		spec = expanded
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return CronExpr{}, fmt.Errorf("cron expression %q must have 5 fields, got %d", spec, len(parts))
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		field := cronFields[i]
+		if i == 4 {
+			// Allow 7 for Sunday and fold it onto 0 below
+			field.max = 7
+		}
+		b, err := parseCronField(part, field)
+		if err != nil {
+			return CronExpr{}, fmt.Errorf("cron expression %q: %w", spec, err)
+		}
+		bits[i] = b
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return CronExpr{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}, nil
+}
+
+func parseCronField(part string, field cronField) (uint64, error) {
+	var bits uint64
+	for item := range strings.SplitSeq(part, ",") {
+		rangePart, step := item, 1
+		if before, after, ok := strings.Cut(item, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", after, field.name)
+			}
+			rangePart, step = before, n
+		}
+
+		lo, hi := field.min, field.max
+		if rangePart != "*" {
+			var err error
+			if before, after, ok := strings.Cut(rangePart, "-"); ok {
+				if lo, err = parseCronValue(before, field); err != nil {
+					return 0, err
+				}
+				if hi, err = parseCronValue(after, field); err != nil {
+					return 0, err
+				}
+				if lo > hi {
+					return 0, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+				}
+			} else {
+				if lo, err = parseCronValue(rangePart, field); err != nil {
+					return 0, err
+				}
+				// "5/10" means every 10 starting at 5
+				if strings.Contains(item, "/") {
+					hi = field.max
+				} else {
+					hi = lo
+				}
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, field cronField) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, field.name)
+	}
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", v, field.min, field.max, field.name)
+	}
+	return v, nil
+}
+
+// Next returns the first time strictly after t that matches the expression,
+// in t's location. It returns the zero time if no match exists within five
+// years (e.g. "0 0 30 2 *").
+func (c CronExpr) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c CronExpr) matchesDay(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{"empty", ""},
+		{"too few fields", "0 2 * *"},
+		{"too many fields", "0 2 * * * *"},
+		{"unknown macro", "@sometimes"},
+		{"minute out of range", "60 * * * *"},
+		{"hour out of range", "0 24 * * *"},
+		{"day of month zero", "0 0 0 * *"},
+		{"bad step", "*/0 * * * *"},
+		{"reversed range", "0 5-1 * * *"},
+		{"not a number", "0 two * * *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.spec)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCronExpr_Next(t *testing.T) {
+	// Thursday
+	base := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{"every minute", "* * * * *", base, base.Add(time.Minute)},
+		{"strictly after", "30 10 * * *", base, time.Date(2026, 1, 16, 10, 30, 0, 0, time.UTC)},
+		{"daily at 2am", "0 2 * * *", base, time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)},
+		{"every 15 minutes", "*/15 * * * *", base, time.Date(2026, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{"range and list", "0 9-17 * * 1,3", base, time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)},
+		{"step from value", "5/20 * * * *", base, time.Date(2026, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{"sunday as 7", "0 0 * * 7", base, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)},
+		{"hourly macro", "@hourly", base, time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"monthly macro", "@monthly", base, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"yearly crosses year", "@yearly", base, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", base, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either matches
+		{"day of month or weekday", "0 0 1 * 1", base, time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"ignores seconds", "* * * * *", base.Add(45 * time.Second), base.Add(time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseCron(tt.spec)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, expr.Next(tt.from))
+		})
+	}
+}
+
+func TestCronExpr_Next_Impossible(t *testing.T) {
+	expr, err := ParseCron("0 0 30 2 *")
+	require.NoError(t, err)
+	require.True(t, expr.Next(time.Now()).IsZero())
+}
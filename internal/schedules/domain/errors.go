@@ -0,0 +1,15 @@
+package domain
+
+import "fmt"
+
+// ScheduleNotFoundError indicates that a schedule with the specified identifiers
+// could not be found in the repository.
+type ScheduleNotFoundError struct {
+	GUID    string
+	Project string
+}
+
+// Error implements the error interface.
+func (e *ScheduleNotFoundError) Error() string {
+	return fmt.Sprintf("schedule not found: guid=%q project=%q", e.GUID, e.Project)
+}
@@ -0,0 +1,30 @@
+package domain
+
+// ScheduleRepository defines the persistence interface for Schedule entities.
+type ScheduleRepository interface {
+	// Save persists a schedule to the repository.
+	// For new schedules (ID == 0), this creates a new record and sets the ID.
+	// For existing schedules (ID > 0), this updates the existing record.
+	Save(schedule *Schedule) error
+
+	// FindByGUID retrieves a schedule by its GUID within a specific project.
+	// Returns ScheduleNotFoundError if no matching schedule exists.
+	// Soft-deleted schedules are not returned.
+	FindByGUID(project, guid string) (*Schedule, error)
+
+	// List retrieves all schedules for a project ordered by creation time.
+	// Soft-deleted schedules are not returned.
+	List(project string) ([]*Schedule, error)
+
+	// Delete performs a soft delete on a schedule by setting its DeletedAt timestamp.
+	// Returns ScheduleNotFoundError if no matching schedule exists.
+	Delete(project, guid string) error
+
+	// HasTarget reports whether a workflow was already created for a target issue.
+	HasTarget(scheduleID int64, targetID string) (bool, error)
+
+	// RecordTarget records that a workflow was created for a target issue, so
+	// each issue is targeted at most once per schedule. Recording a target
+	// again is a no-op.
+	RecordTarget(scheduleID int64, targetID, workflowID string) error
+}
@@ -0,0 +1,90 @@
+// Package domain provides the pure domain layer for workflow schedules with no
+// infrastructure dependencies.
+//
+// A Schedule creates workflows from a template whenever its cron expression
+// fires. A schedule without a target query creates one workflow per run. A
+// schedule with a BQL target query creates one workflow for each matching issue
+// it has not created a workflow for before, e.g. every new epic labeled
+// "needs-plan".
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Schedule creates workflows from a template on a cron schedule.
+type Schedule struct {
+	// ID is the database identifier (0 until saved).
+	ID int64
+	// GUID is the globally unique identifier, e.g. "sch-1a2b3c4d".
+	GUID    string
+	Project string
+	Name    string
+
+	// Cron is a five-field cron expression or macro such as "@daily".
+	Cron string
+	// TemplateID is the workflow template to run.
+	TemplateID string
+	// TargetQuery is an optional BQL query. When set, each run creates a
+	// workflow for every matching issue not yet targeted, passing the issue
+	// ID as the epic_id argument.
+	TargetQuery string
+	// Args are template argument values.
+	Args map[string]string
+	// Labels are applied to created workflows.
+	Labels map[string]string
+
+	// Workflow settings
+	WorktreeEnabled    bool
+	WorktreeBaseBranch string
+	Priority           int
+	BudgetUSD          float64
+
+	// Scheduling state
+	Enabled   bool
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+	// PendingWorkflowID is the workflow pre-created for the next run of a
+	// schedule without a target query, so the run is visible before it starts.
+	PendingWorkflowID string
+
+	// Timestamps
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// Validate checks that the schedule has all required fields and a valid cron expression.
+func (s *Schedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if s.TemplateID == "" {
+		return fmt.Errorf("template_id is required")
+	}
+	if _, err := ParseCron(s.Cron); err != nil {
+		return err
+	}
+	if s.BudgetUSD < 0 {
+		return fmt.Errorf("budget_usd must not be negative")
+	}
+	return nil
+}
+
+// IsDue reports whether the schedule is enabled and its next run is at or before now.
+func (s *Schedule) IsDue(now time.Time) bool {
+	return s.Enabled && s.NextRunAt != nil && !s.NextRunAt.After(now)
+}
+
+// ScheduleNext sets NextRunAt to the first cron time after the given time.
+func (s *Schedule) ScheduleNext(after time.Time) error {
+	expr, err := ParseCron(s.Cron)
+	if err != nil {
+		return err
+	}
+	next := expr.Next(after)
+	s.NextRunAt = &next
+	s.UpdatedAt = time.Now()
+	return nil
+}
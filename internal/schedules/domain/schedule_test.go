@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	valid := Schedule{Name: "nightly", Cron: "0 2 * * *", TemplateID: "research_to_tasks"}
+	require.NoError(t, valid.Validate())
+
+	missingName := valid
+	missingName.Name = ""
+	require.ErrorContains(t, missingName.Validate(), "name")
+
+	missingTemplate := valid
+	missingTemplate.TemplateID = ""
+	require.ErrorContains(t, missingTemplate.Validate(), "template_id")
+
+	badCron := valid
+	badCron.Cron = "nightly"
+	require.Error(t, badCron.Validate())
+}
+
+func TestSchedule_IsDueAndScheduleNext(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+	s := Schedule{Cron: "0 2 * * *", Enabled: true}
+	require.False(t, s.IsDue(now), "no next run yet")
+
+	require.NoError(t, s.ScheduleNext(now))
+	require.Equal(t, time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC), *s.NextRunAt)
+	require.False(t, s.IsDue(now))
+	require.True(t, s.IsDue(*s.NextRunAt))
+
+	s.Enabled = false
+	require.False(t, s.IsDue(*s.NextRunAt))
+}
@@ -63,10 +63,20 @@ type Session struct {
 	workDir    string
 	labels     map[string]string
 
+	// Coordinator prompt, kept so a pending session can start after a restart
+	initialPrompt string
+
+	// Dependencies: sessions that must complete before this one starts, the
+	// template arguments, and the outputs handed to dependent sessions
+	after   []string
+	args    map[string]string
+	outputs map[string]string
+
 	// Worktree configuration (requested settings)
 	worktreeEnabled    bool
 	worktreeBaseBranch string
 	worktreeBranchName string
+	workerWorktrees    bool
 
 	// Worktree state (actual created worktree)
 	worktreePath   string
@@ -82,6 +92,11 @@ type Session struct {
 	// Metrics
 	tokensUsed    int64
 	activeWorkers int
+	costUSD       float64
+
+	// Budget (0 = no budget)
+	budgetTokens int64
+	budgetUSD    float64
 
 	// Health tracking
 	lastHeartbeatAt *time.Time
@@ -92,6 +107,7 @@ type Session struct {
 	startedAt   *time.Time
 	pausedAt    *time.Time
 	completedAt *time.Time
+	scheduledAt *time.Time // Requested start time for a pending session
 	updatedAt   time.Time
 	archivedAt  *time.Time
 	deletedAt   *time.Time
@@ -122,12 +138,16 @@ func NewSession(guid, project string, state SessionState) *Session {
 		ownerCurrentPID:    nil,
 		tokensUsed:         0,
 		activeWorkers:      0,
+		costUSD:            0,
+		budgetTokens:       0,
+		budgetUSD:          0,
 		lastHeartbeatAt:    nil,
 		lastProgressAt:     nil,
 		createdAt:          now,
 		startedAt:          nil,
 		pausedAt:           nil,
 		completedAt:        nil,
+		scheduledAt:        nil,
 		updatedAt:          now,
 		archivedAt:         nil,
 		deletedAt:          nil,
@@ -141,17 +161,24 @@ func ReconstituteSession(
 	guid, project, name string,
 	state SessionState,
 	templateID, epicID, workDir string,
+	initialPrompt string,
 	labels map[string]string,
+	after []string,
+	args, outputs map[string]string,
 	worktreeEnabled bool,
+	workerWorktrees bool,
 	worktreeBaseBranch, worktreeBranchName string,
 	worktreePath, worktreeBranch string,
 	sessionDir string,
 	ownerCreatedPID, ownerCurrentPID *int,
 	tokensUsed int64,
 	activeWorkers int,
+	costUSD float64,
+	budgetTokens int64,
+	budgetUSD float64,
 	lastHeartbeatAt, lastProgressAt *time.Time,
 	createdAt time.Time,
-	startedAt, pausedAt, completedAt *time.Time,
+	startedAt, pausedAt, completedAt, scheduledAt *time.Time,
 	updatedAt time.Time,
 	archivedAt, deletedAt *time.Time,
 ) *Session {
@@ -165,7 +192,12 @@ func ReconstituteSession(
 		epicID:             epicID,
 		workDir:            workDir,
 		labels:             labels,
+		initialPrompt:      initialPrompt,
+		after:              after,
+		args:               args,
+		outputs:            outputs,
 		worktreeEnabled:    worktreeEnabled,
+		workerWorktrees:    workerWorktrees,
 		worktreeBaseBranch: worktreeBaseBranch,
 		worktreeBranchName: worktreeBranchName,
 		worktreePath:       worktreePath,
@@ -175,12 +207,16 @@ func ReconstituteSession(
 		ownerCurrentPID:    ownerCurrentPID,
 		tokensUsed:         tokensUsed,
 		activeWorkers:      activeWorkers,
+		costUSD:            costUSD,
+		budgetTokens:       budgetTokens,
+		budgetUSD:          budgetUSD,
 		lastHeartbeatAt:    lastHeartbeatAt,
 		lastProgressAt:     lastProgressAt,
 		createdAt:          createdAt,
 		startedAt:          startedAt,
 		pausedAt:           pausedAt,
 		completedAt:        completedAt,
+		scheduledAt:        scheduledAt,
 		updatedAt:          updatedAt,
 		archivedAt:         archivedAt,
 		deletedAt:          deletedAt,
@@ -233,6 +269,11 @@ func (s *Session) WorktreeEnabled() bool {
 	return s.worktreeEnabled
 }
 
+// WorkerWorktrees returns whether each worker gets its own worktree.
+func (s *Session) WorkerWorktrees() bool {
+	return s.workerWorktrees
+}
+
 // WorktreeBaseBranch returns the branch the worktree was based on.
 func (s *Session) WorktreeBaseBranch() string {
 	return s.worktreeBaseBranch
@@ -248,6 +289,26 @@ func (s *Session) WorkDir() string {
 	return s.workDir
 }
 
+// InitialPrompt returns the coordinator prompt used when this session starts.
+func (s *Session) InitialPrompt() string {
+	return s.initialPrompt
+}
+
+// After returns the GUIDs of the sessions that must complete before this one starts.
+func (s *Session) After() []string {
+	return s.after
+}
+
+// Args returns the template arguments this session was created with.
+func (s *Session) Args() map[string]string {
+	return s.args
+}
+
+// Outputs returns the results this session hands to dependent sessions.
+func (s *Session) Outputs() map[string]string {
+	return s.outputs
+}
+
 // WorktreePath returns the git worktree path for this session, if any.
 func (s *Session) WorktreePath() string {
 	return s.worktreePath
@@ -284,6 +345,21 @@ func (s *Session) ActiveWorkers() int {
 	return s.activeWorkers
 }
 
+// CostUSD returns the total USD spent by this session.
+func (s *Session) CostUSD() float64 {
+	return s.costUSD
+}
+
+// BudgetTokens returns the output token budget for this session (0 = no budget).
+func (s *Session) BudgetTokens() int64 {
+	return s.budgetTokens
+}
+
+// BudgetUSD returns the USD budget for this session (0 = no budget).
+func (s *Session) BudgetUSD() float64 {
+	return s.budgetUSD
+}
+
 // LastHeartbeatAt returns when the last heartbeat was received, or nil if never.
 func (s *Session) LastHeartbeatAt() *time.Time {
 	return s.lastHeartbeatAt
@@ -314,6 +390,11 @@ func (s *Session) CompletedAt() *time.Time {
 	return s.completedAt
 }
 
+// ScheduledAt returns when this pending session is scheduled to start, or nil if not scheduled.
+func (s *Session) ScheduledAt() *time.Time {
+	return s.scheduledAt
+}
+
 // UpdatedAt returns when this session was last updated.
 func (s *Session) UpdatedAt() time.Time {
 	return s.updatedAt
@@ -351,6 +432,21 @@ func (s *Session) SetEpicID(epicID string) {
 	s.updatedAt = time.Now()
 }
 
+// SetInitialPrompt sets the coordinator prompt used when this session starts.
+func (s *Session) SetInitialPrompt(prompt string) {
+	s.initialPrompt = prompt
+	s.updatedAt = time.Now()
+}
+
+// SetDependencies sets the sessions this session waits on, its template
+// arguments, and the outputs it hands to dependent sessions.
+func (s *Session) SetDependencies(after []string, args, outputs map[string]string) {
+	s.after = after
+	s.args = args
+	s.outputs = outputs
+	s.updatedAt = time.Now()
+}
+
 // SetWorkDir sets the working directory for this session.
 func (s *Session) SetWorkDir(workDir string) {
 	s.workDir = workDir
@@ -405,6 +501,12 @@ func (s *Session) SetWorktreeEnabled(enabled bool) {
 	s.updatedAt = time.Now()
 }
 
+// SetWorkerWorktrees sets whether each worker gets its own worktree.
+func (s *Session) SetWorkerWorktrees(enabled bool) {
+	s.workerWorktrees = enabled
+	s.updatedAt = time.Now()
+}
+
 // SetWorktreeBaseBranch sets the branch the worktree was based on.
 func (s *Session) SetWorktreeBaseBranch(branch string) {
 	s.worktreeBaseBranch = branch
@@ -429,6 +531,25 @@ func (s *Session) AddTokens(tokens int64) {
 	s.updatedAt = time.Now()
 }
 
+// SetCostUSD sets the total USD spent by this session.
+func (s *Session) SetCostUSD(cost float64) {
+	s.costUSD = cost
+	s.updatedAt = time.Now()
+}
+
+// SetBudget sets the output token and USD budgets for this session (0 = no budget).
+func (s *Session) SetBudget(tokens int64, usd float64) {
+	s.budgetTokens = tokens
+	s.budgetUSD = usd
+	s.updatedAt = time.Now()
+}
+
+// SetScheduledAt sets when this pending session should start (nil = not scheduled).
+func (s *Session) SetScheduledAt(t *time.Time) {
+	s.scheduledAt = t
+	s.updatedAt = time.Now()
+}
+
 // SetActiveWorkers sets the number of active workers in this session.
 func (s *Session) SetActiveWorkers(count int) {
 	s.activeWorkers = count
@@ -108,8 +108,12 @@ func TestReconstituteSession(t *testing.T) {
 		"template-abc",
 		"epic-123",
 		"/path/to/workdir",
+		"Coordinate the epic",
 		nil,
-		false,
+		[]string{"upstream-guid"},
+		map[string]string{"epic_id": "epic-123"},
+		nil,
+		false, false,
 		"", "",
 		"/path/to/worktree",
 		"feature/branch",
@@ -117,12 +121,13 @@ func TestReconstituteSession(t *testing.T) {
 		&ownerCreatedPID,
 		&ownerCurrentPID,
 		0,
-		0,
+		0, 0, 0, 0,
 		nil, nil,
 		createdAt,
 		&startedAt,
 		&pausedAt,
 		nil, // completedAt
+		nil, // scheduledAt
 		updatedAt,
 		&archivedAt,
 		&deletedAt,
@@ -137,6 +142,10 @@ func TestReconstituteSession(t *testing.T) {
 	require.Equal(t, "template-abc", session.TemplateID())
 	require.Equal(t, "epic-123", session.EpicID())
 	require.Equal(t, "/path/to/workdir", session.WorkDir())
+	require.Equal(t, "Coordinate the epic", session.InitialPrompt())
+	require.Equal(t, []string{"upstream-guid"}, session.After())
+	require.Equal(t, map[string]string{"epic_id": "epic-123"}, session.Args())
+	require.Nil(t, session.Outputs())
 	require.Equal(t, "/path/to/worktree", session.WorktreePath())
 	require.Equal(t, "feature/branch", session.WorktreeBranch())
 	require.NotNil(t, session.OwnerCreatedPID())
@@ -167,19 +176,20 @@ func TestReconstituteSession_NilDeletedAt(t *testing.T) {
 		"active-project",
 		"",
 		SessionStateRunning,
-		"", "", "",
-		nil,
-		false,
+		"", "", "", "",
+		nil, nil, nil, nil,
+		false, false,
 		"", "",
 		"", "",
 		"", // sessionDir
 		nil, nil,
 		0,
-		0,
+		0, 0, 0, 0,
 		nil, nil,
 		createdAt,
 		nil, nil,
 		nil, // completedAt
+		nil, // scheduledAt
 		updatedAt,
 		nil,
 		nil,
@@ -277,11 +287,11 @@ func TestSession_IsDeleted(t *testing.T) {
 	t.Run("deleted when deletedAt is set", func(t *testing.T) {
 		deletedAt := time.Now()
 		session := ReconstituteSession(
-			1, "guid", "project", "", SessionStateCompleted, "", "", "",
-			nil, false, "", "", "", "",
+			1, "guid", "project", "", SessionStateCompleted, "", "", "", "",
+			nil, nil, nil, nil, false, false, "", "", "", "",
 			"", // sessionDir
-			nil, nil, 0, 0, nil, nil,
-			time.Now(), nil, nil, nil, time.Now(), nil, &deletedAt,
+			nil, nil, 0, 0, 0, 0, 0, nil, nil,
+			time.Now(), nil, nil, nil, nil, time.Now(), nil, &deletedAt,
 		)
 		require.True(t, session.IsDeleted())
 	})
@@ -312,19 +322,21 @@ func TestSession_Getters(t *testing.T) {
 		"template-xyz",
 		"epic-456",
 		"/work/dir",
-		nil,
-		false,
+		"",
+		nil, nil, nil, nil,
+		false, false,
 		"", "",
 		"/worktree/path",
 		"main",
 		"", // sessionDir
 		nil, nil,
 		0,
-		0,
+		0, 0, 0, 0,
 		nil, nil,
 		createdAt,
 		nil, nil,
 		nil, // completedAt
+		nil, // scheduledAt
 		updatedAt,
 		nil,
 		nil,
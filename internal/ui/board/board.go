@@ -85,6 +85,8 @@ func NewFromViews(viewConfigs []config.ViewConfig, executor bql.BQLExecutor, clo
 				if cc.Color != "" {
 					col = col.SetColor(lipgloss.Color(cc.Color))
 				}
+				// Column card template overrides the view's card template
+				col = col.SetCard(vc.CardFor(j))
 				// Set clock for timestamp formatting
 				columns[j] = col.SetClock(clock)
 			}
@@ -0,0 +1,201 @@
+package board
+
+import (
+	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
+	"github.com/zjrosen/perles/internal/ui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// cardSeparator joins card metadata fields.
+const cardSeparator = " · "
+
+// cardProgressWidth is the width of the inline descendant progress bar.
+const cardProgressWidth = 5
+
+// minCompactTitleWidth is the narrowest title a compact card keeps before dropping metadata.
+const minCompactTitleWidth = 10
+
+// cardTemplate controls how a column renders its issues.
+// A nil or zero template renders the built-in single badge+title line.
+type cardTemplate struct {
+	fields  []string
+	compact bool
+	clock   shared.Clock
+}
+
+// newCardTemplate builds a card template from config. A nil config yields the built-in layout.
+func newCardTemplate(card *config.CardConfig) cardTemplate {
+	if card == nil {
+		return cardTemplate{}
+	}
+	return cardTemplate{
+		fields:  card.Fields,
+		compact: card.IsCompact(),
+	}
+}
+
+// clockOrReal returns the template clock, falling back to the real clock.
+func (t cardTemplate) clockOrReal() shared.Clock {
+	if t.clock == nil {
+		return shared.RealClock{}
+	}
+	return t.clock
+}
+
+// renderCard renders an issue using the card template.
+// width is the available line width (0 = no limit); it is only used to truncate compact cards.
+func renderCard(issue beads.Issue, tmpl *cardTemplate, isSelected bool, width int) string {
+	if tmpl == nil || (len(tmpl.fields) == 0 && !tmpl.compact) {
+		return renderBuiltinCard(issue, isSelected)
+	}
+
+	meta := renderCardMeta(issue, *tmpl)
+
+	if tmpl.compact {
+		return renderCompactCard(issue, meta, isSelected, width)
+	}
+
+	line := renderIssueLine(issue, isSelected)
+	if meta == "" {
+		return line
+	}
+	return line + "\n  " + meta
+}
+
+// renderBuiltinCard renders the default single-line card. Epics with
+// descendants get their progress rollup appended after the title.
+func renderBuiltinCard(issue beads.Issue, isSelected bool) string {
+	line := renderIssueLine(issue, isSelected)
+	if issue.Type != beads.TypeEpic {
+		return line
+	}
+	if rollup := issuebadge.RenderProgress(issue.Progress); rollup != "" {
+		line += " " + rollup
+	}
+	return line
+}
+
+// renderCompactCard renders the badge, title and metadata on a single truncated line.
+// Metadata is dropped when there isn't room for it next to a readable title.
+func renderCompactCard(issue beads.Issue, meta string, isSelected bool, width int) string {
+	cfg := issuebadge.Config{
+		ShowSelection: true,
+		Selected:      isSelected,
+		MaxWidth:      width,
+	}
+	if width <= 0 {
+		line := issuebadge.Render(issue, cfg)
+		if meta != "" {
+			line += " " + meta
+		}
+		return line
+	}
+
+	// Selection indicator + badge + space before title
+	prefixWidth := 1 + lipgloss.Width(issuebadge.RenderBadge(issue)) + 1
+	metaWidth := lipgloss.Width(meta)
+	if meta == "" || width-prefixWidth-metaWidth-1 < minCompactTitleWidth {
+		return issuebadge.Render(issue, cfg)
+	}
+
+	cfg.MaxWidth = width - metaWidth - 1
+	line := issuebadge.Render(issue, cfg)
+	padding := max(width-lipgloss.Width(line)-metaWidth, 1)
+	return line + strings.Repeat(" ", padding) + meta
+}
+
+// renderCardMeta renders the configured metadata fields, skipping empty ones.
+func renderCardMeta(issue beads.Issue, tmpl cardTemplate) string {
+	metaStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+
+	var parts []string
+	for _, field := range tmpl.fields {
+		if part := renderCardField(issue, field, tmpl, metaStyle); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, metaStyle.Render(cardSeparator))
+}
+
+// renderCardField renders a single card field, or "" when the issue has no value for it.
+func renderCardField(issue beads.Issue, field string, tmpl cardTemplate, metaStyle lipgloss.Style) string {
+	switch field {
+	case config.CardFieldAssignee:
+		if issue.Assignee == "" {
+			return ""
+		}
+		return metaStyle.Render("@" + issue.Assignee)
+
+	case config.CardFieldLabels:
+		if len(issue.Labels) == 0 {
+			return ""
+		}
+		labels := make([]string, len(issue.Labels))
+		for i, label := range issue.Labels {
+			labels[i] = "#" + label
+		}
+		return metaStyle.Render(strings.Join(labels, " "))
+
+	case config.CardFieldAge:
+		if issue.CreatedAt.IsZero() {
+			return ""
+		}
+		return metaStyle.Render(shared.FormatRelativeTimeWithClock(issue.CreatedAt, tmpl.clockOrReal()))
+
+	case config.CardFieldComments:
+		if issue.CommentCount <= 0 {
+			return ""
+		}
+		return metaStyle.Render(styles.FormatCommentIndicator(issue.CommentCount))
+
+	case config.CardFieldProgress:
+		return renderCardProgress(issue.Progress)
+
+	case config.CardFieldBlocked:
+		if !issue.Blocked || issue.Status == beads.StatusClosed {
+			return ""
+		}
+		return lipgloss.NewStyle().Foreground(styles.StatusErrorColor).Render("⊘ blocked")
+
+	case config.CardFieldAgentState:
+		if issue.AgentState == "" {
+			return ""
+		}
+		return metaStyle.Render("● " + issue.AgentState)
+
+	case config.CardFieldLastActivity:
+		if issue.LastActivity.IsZero() {
+			return ""
+		}
+		return metaStyle.Render("active " + shared.FormatRelativeTimeWithClock(issue.LastActivity, tmpl.clockOrReal()))
+
+	case config.CardFieldProject:
+		if issue.Project == "" {
+			return ""
+		}
+		return metaStyle.Render("◆ " + issue.Project)
+	}
+
+	return ""
+}
+
+// renderCardProgress renders a small descendant progress bar such as "███░░ 3/5 ●1 ⊘1".
+func renderCardProgress(p beads.Progress) string {
+	if p.Total == 0 {
+		return ""
+	}
+	filledWidth := cardProgressWidth * p.Closed / p.Total
+
+	filledStyle := lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	emptyStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+
+	return filledStyle.Render(strings.Repeat("█", filledWidth)) +
+		emptyStyle.Render(strings.Repeat("░", cardProgressWidth-filledWidth)) +
+		" " + issuebadge.RenderProgress(p)
+}
@@ -0,0 +1,167 @@
+package board
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func newTestCardTemplate(t *testing.T, card *config.CardConfig) *cardTemplate {
+	t.Helper()
+	fixedTime := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	clock := mocks.NewMockClock(t)
+	clock.EXPECT().Now().Return(fixedTime).Maybe()
+
+	tmpl := newCardTemplate(card)
+	tmpl.clock = clock
+	return &tmpl
+}
+
+func cardTestIssue() beads.Issue {
+	return beads.Issue{
+		ID:           "bd-1",
+		TitleText:    "Plan the rollout",
+		Type:         beads.TypeEpic,
+		Priority:     beads.PriorityHigh,
+		Status:       beads.StatusOpen,
+		Assignee:     "alice",
+		Labels:       []string{"infra", "q3"},
+		CreatedAt:    time.Date(2025, 1, 12, 12, 0, 0, 0, time.UTC),
+		CommentCount: 2,
+		Blocked:      true,
+		Progress:     beads.Progress{Closed: 3, Total: 7},
+	}
+}
+
+func TestRenderCard_NilTemplateMatchesBuiltinLine(t *testing.T) {
+	issue := cardTestIssue()
+	issue.Type = beads.TypeTask
+	require.Equal(t, renderIssueLine(issue, false), renderCard(issue, nil, false, 40))
+	require.Equal(t, renderIssueLine(issue, true), renderCard(issue, &cardTemplate{}, true, 40))
+}
+
+func TestRenderCard_BuiltinEpicShowsRollup(t *testing.T) {
+	issue := cardTestIssue()
+	issue.Progress = beads.Progress{Closed: 3, Total: 7, InProgress: 2, Blocked: 1}
+
+	got := ansi.Strip(renderCard(issue, nil, false, 0))
+	require.True(t, strings.HasSuffix(got, "Plan the rollout 3/7 ●2 ⊘1"), "rollup appended: %q", got)
+
+	issue.Progress = beads.Progress{}
+	require.Equal(t, renderIssueLine(issue, false), renderCard(issue, nil, false, 0))
+}
+
+func TestRenderCard_NormalDensityFieldOrder(t *testing.T) {
+	tmpl := newTestCardTemplate(t, &config.CardConfig{
+		Fields: []string{
+			config.CardFieldProgress,
+			config.CardFieldAssignee,
+			config.CardFieldLabels,
+			config.CardFieldAge,
+			config.CardFieldComments,
+			config.CardFieldBlocked,
+		},
+	})
+
+	lines := strings.Split(ansi.Strip(renderCard(cardTestIssue(), tmpl, false, 0)), "\n")
+	require.Len(t, lines, 2, "normal density renders a title line and a metadata line")
+	require.Contains(t, lines[0], "Plan the rollout")
+	require.Equal(t, "  ██░░░ 3/7 · @alice · #infra #q3 · 3d ago · 2💬 · ⊘ blocked", lines[1])
+}
+
+func TestRenderCard_SkipsEmptyFields(t *testing.T) {
+	tmpl := newTestCardTemplate(t, &config.CardConfig{
+		Fields: []string{config.CardFieldAgentState, config.CardFieldLastActivity, config.CardFieldAssignee, config.CardFieldProject},
+	})
+	issue := beads.Issue{ID: "bd-2", TitleText: "Worker", Type: beads.TypeAgent}
+
+	// No field has a value, so no metadata line is rendered
+	require.Equal(t, renderIssueLine(issue, false), renderCard(issue, tmpl, false, 0))
+
+	issue.AgentState = "running"
+	issue.LastActivity = time.Date(2025, 1, 15, 11, 55, 0, 0, time.UTC)
+	lines := strings.Split(ansi.Strip(renderCard(issue, tmpl, false, 0)), "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "  ● running · active 5m ago", lines[1])
+
+	issue.Project = "api"
+	lines = strings.Split(ansi.Strip(renderCard(issue, tmpl, false, 0)), "\n")
+	require.Equal(t, "  ● running · active 5m ago · ◆ api", lines[1])
+}
+
+func TestRenderCard_BlockedHiddenWhenClosed(t *testing.T) {
+	tmpl := newTestCardTemplate(t, &config.CardConfig{Fields: []string{config.CardFieldBlocked}})
+	issue := cardTestIssue()
+	issue.Status = beads.StatusClosed
+
+	require.NotContains(t, ansi.Strip(renderCard(issue, tmpl, false, 0)), "blocked")
+}
+
+func TestRenderCard_CompactFitsWidth(t *testing.T) {
+	tmpl := newTestCardTemplate(t, &config.CardConfig{
+		Density: config.CardDensityCompact,
+		Fields:  []string{config.CardFieldAssignee},
+	})
+	issue := cardTestIssue()
+	issue.TitleText = "A very long title that cannot possibly fit on one compact line"
+
+	got := renderCard(issue, tmpl, false, 50)
+	require.NotContains(t, got, "\n")
+	require.Equal(t, 50, lipgloss.Width(got))
+	stripped := ansi.Strip(got)
+	require.True(t, strings.HasSuffix(stripped, "@alice"), "metadata right-aligned: %q", stripped)
+	require.Contains(t, stripped, "...")
+}
+
+func TestRenderCard_CompactDropsMetaWhenNarrow(t *testing.T) {
+	tmpl := newTestCardTemplate(t, &config.CardConfig{
+		Density: config.CardDensityCompact,
+		Fields:  []string{config.CardFieldLabels},
+	})
+
+	got := ansi.Strip(renderCard(cardTestIssue(), tmpl, false, 24))
+	require.NotContains(t, got, "#infra")
+	require.LessOrEqual(t, lipgloss.Width(got), 24)
+}
+
+func TestItemRenderedLines_CountsMetadataLine(t *testing.T) {
+	issue := cardTestIssue()
+	require.Equal(t, 1, itemRenderedLines(issue, nil, 100))
+
+	normal := newTestCardTemplate(t, &config.CardConfig{Fields: []string{config.CardFieldAssignee}})
+	require.Equal(t, 2, itemRenderedLines(issue, normal, 100))
+
+	compact := newTestCardTemplate(t, &config.CardConfig{
+		Density: config.CardDensityCompact,
+		Fields:  []string{config.CardFieldAssignee},
+	})
+	require.Equal(t, 1, itemRenderedLines(issue, compact, 20))
+}
+
+func TestNewFromViews_AppliesCardTemplates(t *testing.T) {
+	views := []config.ViewConfig{
+		{
+			Name: "Planning",
+			Card: &config.CardConfig{Fields: []string{config.CardFieldProgress}},
+			Columns: []config.ColumnConfig{
+				{Name: "Epics", Query: "type = epic"},
+				{Name: "Agents", Query: "type = agent", Card: &config.CardConfig{Density: config.CardDensityCompact}},
+			},
+		},
+	}
+
+	m := NewFromViews(views, nil, nil)
+
+	require.Equal(t, []string{config.CardFieldProgress}, m.Column(0).card.fields)
+	require.False(t, m.Column(0).card.compact)
+	require.Empty(t, m.Column(1).card.fields)
+	require.True(t, m.Column(1).card.compact)
+}
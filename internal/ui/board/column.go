@@ -3,11 +3,13 @@ package board
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	zone "github.com/lrstanley/bubblezone"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/mode/shared"
 	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
 	"github.com/zjrosen/perles/internal/ui/styles"
@@ -66,15 +68,17 @@ type BoardColumn interface {
 
 // issueDelegate is a custom delegate for rendering issues with priority colors and type indicators.
 type issueDelegate struct {
-	focused     *bool // pointer to column's focused state
-	columnIndex *int  // pointer to column index for zone ID construction (survives value copies)
+	focused     *bool         // pointer to column's focused state
+	columnIndex *int          // pointer to column index for zone ID construction (survives value copies)
+	card        *cardTemplate // pointer to column's card template (survives value copies)
 }
 
 // newIssueDelegate creates a new issue delegate.
-func newIssueDelegate(focused *bool, columnIndex *int) issueDelegate {
+func newIssueDelegate(focused *bool, columnIndex *int, card *cardTemplate) issueDelegate {
 	return issueDelegate{
 		focused:     focused,
 		columnIndex: columnIndex,
+		card:        card,
 	}
 }
 
@@ -101,15 +105,18 @@ func renderIssueLine(issue beads.Issue, isSelected bool) string {
 	})
 }
 
-// itemRenderedLines returns how many lines an issue takes when rendered at the given width.
-func itemRenderedLines(issue beads.Issue, width int) int {
-	line := renderIssueLine(issue, false)
-	lineWidth := lipgloss.Width(line)
-	if lineWidth <= width || width <= 0 {
-		return 1
+// itemRenderedLines returns how many lines an issue card takes when rendered at the given width.
+func itemRenderedLines(issue beads.Issue, card *cardTemplate, width int) int {
+	total := 0
+	for line := range strings.SplitSeq(renderCard(issue, card, false, width), "\n") {
+		lineWidth := lipgloss.Width(line)
+		if lineWidth <= width || width <= 0 {
+			total++
+			continue
+		}
+		total += (lineWidth + width - 1) / width
 	}
-
-	return (lineWidth + width - 1) / width
+	return total
 }
 
 // Render renders an issue item with priority colors and type indicator.
@@ -121,7 +128,7 @@ func (d issueDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 	issue := *issueItem.Issue
 
 	isSelected := index == m.Index() && d.focused != nil && *d.focused
-	line := renderIssueLine(issue, isSelected)
+	line := renderCard(issue, d.card, isSelected, m.Width())
 
 	// Constrain to list width so lines wrap properly within column bounds
 	if m.Width() > 0 {
@@ -147,8 +154,9 @@ type Column struct {
 	items          []beads.Issue
 	width          int
 	height         int
-	focused        *bool // pointer so it survives value copies
-	showCounts     *bool // pointer so it survives value copies (nil = default true)
+	focused        *bool         // pointer so it survives value copies
+	showCounts     *bool         // pointer so it survives value copies (nil = default true)
+	card           *cardTemplate // pointer so it survives value copies
 
 	// BQL self-loading fields
 	executor  bql.BQLExecutor // BQL executor for loading issues
@@ -161,9 +169,10 @@ func NewColumn(title string) Column {
 	// Allocate state on heap so pointers survive value copies
 	focused := new(bool)
 	columnIndexPtr := new(int)
+	card := new(cardTemplate)
 
 	// Create delegate with pointers to column state
-	delegate := newIssueDelegate(focused, columnIndexPtr)
+	delegate := newIssueDelegate(focused, columnIndexPtr, card)
 
 	l := list.New([]list.Item{}, delegate, 0, 0)
 	l.SetShowTitle(false)
@@ -177,6 +186,7 @@ func NewColumn(title string) Column {
 		list:           l,
 		focused:        focused,
 		columnIndexPtr: columnIndexPtr,
+		card:           card,
 	}
 }
 
@@ -357,7 +367,7 @@ func (c *Column) updatePerPage() {
 	usedLines := 0
 	itemsThatFit := 0
 	for _, issue := range c.items {
-		lines := itemRenderedLines(issue, innerWidth)
+		lines := itemRenderedLines(issue, c.card, innerWidth)
 		if usedLines+lines > availableLines {
 			break
 		}
@@ -479,8 +489,22 @@ func (c Column) Width() int {
 	return c.width
 }
 
-// SetClock sets the clock for timestamp formatting. The board column does not
-// show timestamps, so this is a no-op for now.
-func (c Column) SetClock(_ shared.Clock) BoardColumn {
+// SetClock sets the clock used for age and last-activity card fields.
+func (c Column) SetClock(clock shared.Clock) BoardColumn {
+	if c.card != nil {
+		c.card.clock = clock
+	}
+	return c
+}
+
+// SetCard sets the card template used to render this column's issues.
+// A nil card restores the built-in single-line layout.
+func (c Column) SetCard(card *config.CardConfig) Column {
+	if c.card != nil {
+		clock := c.card.clock
+		*c.card = newCardTemplate(card)
+		c.card.clock = clock
+	}
+	c.updatePerPage()
 	return c
 }
@@ -286,6 +286,7 @@ func (m Model) CurrentConfig() config.ColumnConfig {
 		Name:  m.nameInput.Value(),
 		Type:  m.columnType,
 		Color: m.colorValue,
+		Card:  m.original.Card, // Card templates are edited in config, not the column editor
 	}
 
 	if m.columnType == "tree" {
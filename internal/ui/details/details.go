@@ -3,6 +3,7 @@ package details
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
 	"github.com/zjrosen/perles/internal/ui/shared/markdown"
 	"github.com/zjrosen/perles/internal/ui/styles"
 
@@ -69,6 +71,14 @@ const (
 	FocusMetadata                  // Right column (dependencies)
 )
 
+// DetailTab represents which tab the left column shows.
+type DetailTab int
+
+const (
+	TabOverview DetailTab = iota // Description, design, notes and comments
+	TabHistory                   // Audit events merged with comments
+)
+
 // Model holds the detail view state.
 type Model struct {
 	issue              beads.Issue
@@ -86,6 +96,11 @@ type Model struct {
 	commentLoader      appbeads.CommentReader
 	commentsLoaded     bool
 	commentsError      error
+	eventLoader        appbeads.EventReader // nil when the comment loader can't read events
+	events             []beads.Event
+	eventsLoaded       bool
+	eventsError        error
+	activeTab          DetailTab
 	hideFooter         bool // When true, footer is not rendered (e.g., in dashboard mode)
 
 	// Cached renders to avoid recomputing on every scroll
@@ -98,6 +113,7 @@ type Model struct {
 // The optional loader parameter enables loading full issue data for dependencies.
 // The optional commentLoader enables loading comments for the issue.
 // Pass *beads.SQLiteClient for both (it implements both interfaces); nil disables loading.
+// When the comment loader also implements EventReader, the history tab is available.
 func New(issue beads.Issue, executor bql.BQLExecutor, commentLoader appbeads.CommentReader) Model {
 	m := Model{
 		issue:         issue,
@@ -105,6 +121,9 @@ func New(issue beads.Issue, executor bql.BQLExecutor, commentLoader appbeads.Com
 		commentLoader: commentLoader,
 		markdownStyle: "dark", // Default, will be overridden by SetMarkdownStyle
 	}
+	if eventLoader, ok := commentLoader.(appbeads.EventReader); ok {
+		m.eventLoader = eventLoader
+	}
 	m.loadDependencies()
 	m.loadComments()
 	return m
@@ -285,6 +304,8 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 					IssueType: m.issue.Type,
 				}
 			}
+		case key.Matches(msg, keys.Component.TabToggle):
+			return m.toggleTab(), nil
 		case key.Matches(msg, keys.Component.EditAction):
 			// Open edit menu
 			return m, func() tea.Msg {
@@ -309,6 +330,27 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, cmd
 }
 
+// toggleTab switches the left column between the overview and history tabs.
+// Events are loaded the first time the history tab is opened.
+func (m Model) toggleTab() Model {
+	if m.activeTab == TabHistory {
+		m.activeTab = TabOverview
+	} else {
+		m.activeTab = TabHistory
+		m.loadEvents()
+	}
+	if m.ready {
+		m.viewport.SetContent(m.renderLeftColumn())
+		m.viewport.GotoTop()
+	}
+	return m
+}
+
+// ActiveTab returns the tab currently shown in the left column.
+func (m Model) ActiveTab() DetailTab {
+	return m.activeTab
+}
+
 // UpdateStatus updates the displayed status after a change.
 func (m Model) UpdateStatus(status beads.Status) Model {
 	m.issue.Status = status
@@ -421,7 +463,7 @@ func (m Model) View() string {
 				lipgloss.Top,
 				footerLeftStyle.Render(footer),
 				footerDivider,
-				footerRightStyle.Render(""),
+				footerRightStyle.Render(m.renderTabHint()),
 			)
 			body = lipgloss.JoinVertical(lipgloss.Left, contentStyle.Render(content), contentStyle.Render(footerRow))
 		}
@@ -481,9 +523,14 @@ func (m Model) renderHeader() string {
 	return strings.Join(lines, "\n") + "\n"
 }
 
-// renderLeftColumn renders the left column content (description + comments).
+// renderLeftColumn renders the left column content (description + comments),
+// or the history timeline when the history tab is active.
 // Dependencies are now rendered in the right metadata column.
 func (m Model) renderLeftColumn() string {
+	if m.activeTab == TabHistory {
+		return m.renderHistory()
+	}
+
 	issue := m.issue
 	var sb strings.Builder
 
@@ -549,6 +596,80 @@ func (m Model) renderLeftColumn() string {
 	return sb.String()
 }
 
+// historyEntry is a single row in the history timeline: either an event or a comment.
+type historyEntry struct {
+	at      time.Time
+	actor   string
+	summary string
+	text    string // Comment body, wrapped below the summary
+}
+
+// historyEntries merges events and comments into a single chronological timeline.
+// "commented" events are skipped since comments are read from the comments table.
+func (m Model) historyEntries() []historyEntry {
+	entries := make([]historyEntry, 0, len(m.events)+len(m.comments))
+	for _, e := range m.events {
+		if e.Type == beads.EventCommented {
+			continue
+		}
+		entries = append(entries, historyEntry{at: e.CreatedAt, actor: e.Actor, summary: e.Summary()})
+	}
+	for _, c := range m.comments {
+		entries = append(entries, historyEntry{at: c.CreatedAt, actor: c.Author, summary: "commented", text: c.Text})
+	}
+	slices.SortStableFunc(entries, func(a, b historyEntry) int {
+		return a.at.Compare(b.at)
+	})
+	return entries
+}
+
+// renderHistory renders the history tab: audit events merged with comments.
+func (m Model) renderHistory() string {
+	var sb strings.Builder
+
+	headerStyle := lipgloss.NewStyle().Bold(true)
+	sb.WriteString(headerStyle.Render("History"))
+	sb.WriteString("\n\n")
+
+	if m.eventsError != nil {
+		errorStyle := lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+		sb.WriteString(errorStyle.Render("Failed to load history"))
+		sb.WriteString("\n\n")
+	}
+
+	entries := m.historyEntries()
+	if len(entries) == 0 {
+		emptyStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true)
+		sb.WriteString(emptyStyle.Render("No history recorded"))
+		sb.WriteString("\n")
+		return sb.String()
+	}
+
+	// Calculate wrap width based on content column
+	wrapWidth := contentColWidth - 2 // Leave some margin
+	if m.width > 0 && m.width < contentColWidth {
+		wrapWidth = m.width - 4
+	}
+
+	entryHeaderStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+
+	for _, e := range entries {
+		// [actor] timestamp - same format as comment headers
+		header := fmt.Sprintf("[%s] %s", e.actor, e.at.Format("2006-01-02 15:04:05"))
+		sb.WriteString(entryHeaderStyle.Render(header))
+		sb.WriteString("\n")
+		sb.WriteString(wordwrap.String(e.summary, wrapWidth))
+		sb.WriteString("\n")
+		if e.text != "" {
+			sb.WriteString(wordwrap.String(e.text, wrapWidth))
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
 // renderMetadataColumn renders the right column metadata panel.
 // This will be used as the static right column in the two-column layout.
 func (m Model) renderMetadataColumn() string {
@@ -567,6 +688,14 @@ func (m Model) renderMetadataColumn() string {
 	indent := " "
 	indentedDivider := indent + divider
 
+	// Project (only when several beads databases are mounted)
+	if issue.Project != "" {
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Project"))
+		sb.WriteString(valueStyle.Render(issue.Project))
+		sb.WriteString("\n")
+	}
+
 	// Type (read-only)
 	sb.WriteString(indent)
 	sb.WriteString(labelStyle.Render("Type"))
@@ -586,6 +715,16 @@ func (m Model) renderMetadataColumn() string {
 	sb.WriteString(labelStyle.Render("Status"))
 	sb.WriteString(getStatusStyle(issue.Status).Render(formatStatus(issue.Status)))
 	sb.WriteString("\n")
+
+	// Progress rollup over all descendants (only for issues with children)
+	if rollup := issuebadge.RenderProgress(issue.Progress); rollup != "" {
+		percentStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Progress"))
+		sb.WriteString(rollup)
+		sb.WriteString(percentStyle.Render(fmt.Sprintf(" (%d%%)", issue.Progress.Percent())))
+		sb.WriteString("\n")
+	}
 	sb.WriteString(indentedDivider)
 	sb.WriteString("\n")
 
@@ -926,6 +1065,18 @@ func (m Model) renderFooter() string {
 	return footerStyle.Render("[j/k] Scroll  [ctrl+e] Edit Issue  [ctrl+d] Delete Issue  [Esc] Back" + scrollPercent)
 }
 
+// renderTabHint renders the tab toggle hint shown under the metadata column.
+func (m Model) renderTabHint() string {
+	if m.eventLoader == nil {
+		return ""
+	}
+	hintStyle := lipgloss.NewStyle().Foreground(styles.TextDescriptionColor)
+	if m.activeTab == TabHistory {
+		return hintStyle.Render(" [t] Overview")
+	}
+	return hintStyle.Render(" [t] History")
+}
+
 // getTypeStyle returns the style for an issue type.
 func getTypeStyle(t beads.IssueType) lipgloss.Style {
 	switch t {
@@ -1120,6 +1271,17 @@ func (m *Model) loadComments() {
 	m.commentsLoaded = true
 }
 
+// loadEvents fetches the audit history for the current issue using the event loader.
+func (m *Model) loadEvents() {
+	if m.eventsLoaded || m.eventLoader == nil {
+		return
+	}
+	events, err := m.eventLoader.GetEvents(m.issue.ID)
+	m.events = events
+	m.eventsError = err
+	m.eventsLoaded = true
+}
+
 // formatDuration returns a human-readable duration string.
 // Shows the two largest non-zero units (e.g., "3d 4h", "2h 15m", "45m").
 func formatDuration(d time.Duration) string {
@@ -1328,3 +1328,78 @@ func TestDetails_View_Golden_LongLabels(t *testing.T) {
 	view := m.View()
 	teatest.RequireEqualOutput(t, []byte(view))
 }
+
+func historyTestModel(t *testing.T) Model {
+	client := mocks.NewMockBeadsClient(t)
+	client.EXPECT().GetComments("hist-1").Return([]beads.Comment{
+		{ID: 1, Author: "bob", Text: "Looking into it.", CreatedAt: time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)},
+	}, nil)
+	client.EXPECT().GetEvents("hist-1").Return([]beads.Event{
+		{ID: 1, Type: beads.EventCreated, Actor: "alice", CreatedAt: time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)},
+		{
+			ID: 2, Type: beads.EventStatusChanged, Actor: "coder-1",
+			OldValue:  `{"status":"open","priority":2}`,
+			NewValue:  `{"status":"in_progress","priority":1}`,
+			CreatedAt: time.Date(2024, 4, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{ID: 3, Type: beads.EventCommented, Actor: "bob", Comment: "Looking into it.", CreatedAt: time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)},
+		{ID: 4, Type: beads.EventLabelAdded, Actor: "coder-1", Comment: "Added label: backend", CreatedAt: time.Date(2024, 4, 1, 11, 30, 0, 0, time.UTC)},
+	}, nil).Once()
+
+	issue := beads.Issue{
+		ID:        "hist-1",
+		TitleText: "Issue with history",
+		Type:      beads.TypeTask,
+		Priority:  beads.PriorityHigh,
+		Status:    beads.StatusInProgress,
+		CreatedAt: time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+	return New(issue, nil, client).SetSize(120, 30)
+}
+
+func TestDetails_HistoryTab_MergesEventsAndComments(t *testing.T) {
+	m := historyTestModel(t)
+	require.Equal(t, TabOverview, m.ActiveTab())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, TabHistory, m.ActiveTab())
+
+	view := stripANSI(m.View())
+	created := strings.Index(view, "[alice] 2024-04-01 09:00:00")
+	comment := strings.Index(view, "[bob] 2024-04-01 10:00:00")
+	status := strings.Index(view, "status open → in_progress, priority P2 → P1")
+	label := strings.Index(view, "Added label: backend")
+	require.True(t, created >= 0 && created < comment && comment < status && status < label,
+		"entries should be in chronological order:\n%s", view)
+	require.Equal(t, 1, strings.Count(view, "Looking into it."), "commented events are not duplicated")
+	require.Contains(t, view, "[t] Overview")
+
+	// Toggling back and forth doesn't reload events (GetEvents is expected once)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, TabOverview, m.ActiveTab())
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	require.Equal(t, TabHistory, m.ActiveTab())
+}
+
+func TestDetails_HistoryTab_Empty(t *testing.T) {
+	client := mocks.NewMockBeadsClient(t)
+	client.EXPECT().GetComments("quiet-1").Return(nil, nil)
+	client.EXPECT().GetEvents("quiet-1").Return(nil, errors.New("boom"))
+
+	m := New(beads.Issue{ID: "quiet-1", TitleText: "Quiet"}, nil, client).SetSize(120, 30)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	view := stripANSI(m.View())
+	require.Contains(t, view, "Failed to load history")
+	require.Contains(t, view, "No history recorded")
+}
+
+// TestDetails_View_Golden_History tests rendering of the history tab.
+// Run with -update flag to update golden files: go test -update ./internal/ui/details/...
+func TestDetails_View_Golden_History(t *testing.T) {
+	m := historyTestModel(t)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}
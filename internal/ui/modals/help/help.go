@@ -50,6 +50,7 @@ func BQLFields() []BQLField {
 		{Name: "mol_type", Values: "string"},
 		{Name: "created", Values: "date (today, yesterday, -7d)"},
 		{Name: "updated", Values: "date (today, yesterday, -7d)"},
+		{Name: "progress", Values: "0-100 (% of descendants closed)"},
 	}
 }
 
@@ -245,6 +246,7 @@ func (m Model) renderKanbanContent() string {
 	actionsCol.WriteString(renderBinding(keys.Kanban.Enter))
 	actionsCol.WriteString(renderBinding(keys.Kanban.Refresh))
 	actionsCol.WriteString(renderBinding(keys.Kanban.Yank))
+	actionsCol.WriteString(renderBinding(keys.Kanban.NewIssue))
 	actionsCol.WriteString(renderBinding(keys.Kanban.AddColumn))
 	actionsCol.WriteString(renderBinding(keys.Kanban.EditColumn))
 	actionsCol.WriteString(renderBinding(keys.Kanban.DeleteColumn))
@@ -398,6 +400,8 @@ func (m Model) renderSearchContent() string {
 			values = "string (~ for contains)"
 		case "created", "updated":
 			values = "today, -7d"
+		case "progress":
+			values = "0-100"
 		}
 		fieldsCol.WriteString(bqlLabelStyle.Render(f.Name) + bqlValueStyle.Render(values) + "\n")
 	}
@@ -566,6 +570,8 @@ func (m Model) renderDashboardContent() string {
 	actionsCol.WriteString("\n")
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Start))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Stop))
+	actionsCol.WriteString(renderBinding(keys.Dashboard.Land))
+	actionsCol.WriteString(renderBinding(keys.Dashboard.Approve))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.New))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Help))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Quit))
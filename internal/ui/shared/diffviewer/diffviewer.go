@@ -77,7 +77,12 @@ const (
 )
 
 // ShowDiffViewerMsg requests showing the diff viewer.
-type ShowDiffViewerMsg struct{}
+// With BaseRef set, the working directory pane shows the changes committed in
+// WorkDir since it branched off BaseRef instead of uncommitted changes.
+type ShowDiffViewerMsg struct {
+	WorkDir string // Repository or worktree to open (empty = current)
+	BaseRef string // Branch to diff against (empty = uncommitted changes)
+}
 
 // HideDiffViewerMsg requests hiding the diff viewer.
 type HideDiffViewerMsg struct{}
@@ -204,6 +209,14 @@ type Model struct {
 	currentWorktreeBranch string                          // Branch in the current worktree
 	viewingBranch         string                          // Branch whose commits are displayed (empty = HEAD)
 
+	// baseRef is the branch the working directory pane is diffed against
+	// (empty = uncommitted changes).
+	baseRef string
+
+	// restoreContext holds the git context replaced by ShowBranchDiffAndLoad,
+	// restored by Hide (nil = nothing to restore).
+	restoreContext *gitContext
+
 	// Modal visibility flags
 	showHelpOverlay bool
 
@@ -1161,6 +1174,9 @@ func (m Model) buildBreadcrumb() string {
 
 	if node == nil {
 		if m.focus == focusFileList {
+			if m.baseRef != "" {
+				return "Changes vs " + m.baseRef
+			}
 			return "Working Directory"
 		}
 		return ""
@@ -1650,9 +1666,49 @@ func (m Model) ShowAndLoad() (Model, tea.Cmd) {
 	)
 }
 
-// Hide makes the overlay invisible.
+// gitContext is the git state the diff viewer was switched away from.
+type gitContext struct {
+	executor       appgit.GitExecutor
+	worktreePath   string
+	worktreeBranch string
+	viewingBranch  string
+}
+
+// ShowBranchDiffAndLoad shows the diff viewer for workDir with the working
+// directory pane listing the changes committed since it branched off baseRef.
+// Without a git executor factory the current executor is kept. Hide switches
+// back to the previous executor and worktree.
+func (m Model) ShowBranchDiffAndLoad(workDir, baseRef string) (Model, tea.Cmd) {
+	if m.gitExecutorFactory != nil && workDir != "" {
+		if m.restoreContext == nil {
+			m.restoreContext = &gitContext{
+				executor:       m.gitExecutor,
+				worktreePath:   m.currentWorktreePath,
+				worktreeBranch: m.currentWorktreeBranch,
+				viewingBranch:  m.viewingBranch,
+			}
+		}
+		m.gitExecutor = m.gitExecutorFactory(workDir)
+		m.currentWorktreePath = workDir
+		m.currentWorktreeBranch = ""
+		m.viewingBranch = ""
+	}
+	m.baseRef = baseRef
+	return m.ShowAndLoad()
+}
+
+// Hide makes the overlay invisible and restores the git context replaced by
+// ShowBranchDiffAndLoad.
 func (m Model) Hide() Model {
 	m.visible = false
+	m.baseRef = ""
+	if m.restoreContext != nil {
+		m.gitExecutor = m.restoreContext.executor
+		m.currentWorktreePath = m.restoreContext.worktreePath
+		m.currentWorktreeBranch = m.restoreContext.worktreeBranch
+		m.viewingBranch = m.restoreContext.viewingBranch
+		m.restoreContext = nil
+	}
 	return m
 }
 
@@ -1727,6 +1783,16 @@ func (m Model) LoadWorkingDirDiff() tea.Cmd {
 			return WorkingDirDiffLoadedMsg{Files: nil, Err: nil}
 		}
 
+		// Branch diffs only include committed changes, so untracked files are skipped
+		if m.baseRef != "" {
+			output, err := m.gitExecutor.GetDiff(m.baseRef + "...HEAD")
+			if err != nil {
+				return WorkingDirDiffLoadedMsg{Err: err}
+			}
+			files, err := parseDiff(output)
+			return WorkingDirDiffLoadedMsg{Files: files, Err: err}
+		}
+
 		output, err := m.gitExecutor.GetWorkingDirDiff()
 		if err != nil {
 			return WorkingDirDiffLoadedMsg{Err: err}
@@ -2218,8 +2284,9 @@ func (m Model) handleWorktreeSelected(wtPath string) (Model, tea.Cmd) {
 	m.currentWorktreePath = selectedWorktree.Path
 	m.currentWorktreeBranch = selectedWorktree.Branch
 
-	// Clear viewingBranch (reset to HEAD of the new worktree)
+	// Clear viewingBranch (reset to HEAD of the new worktree) and any branch diff
 	m.viewingBranch = ""
+	m.baseRef = ""
 
 	// Trigger reload - this will reload commits and working dir diff using the new executor
 	return m.ShowAndLoad()
@@ -1672,6 +1672,49 @@ func TestHandleWorktreeSelected_UpdatesStateFields(t *testing.T) {
 	require.NotNil(t, cmd, "Should trigger reload")
 }
 
+// TestShowBranchDiffAndLoad_DiffsAgainstBaseRef tests that a branch diff lists
+// the committed changes of the worktree instead of uncommitted ones.
+func TestShowBranchDiffAndLoad_DiffsAgainstBaseRef(t *testing.T) {
+	mockGit := mocks.NewMockGitExecutor(t)
+	mockGit.EXPECT().GetDiff("main...HEAD").Return(`diff --git a/auth.go b/auth.go
+index 1234567..abcdefg 100644
+--- a/auth.go
++++ b/auth.go
+@@ -1 +1,2 @@
+ package auth
++// Login signs a user in.
+`, nil)
+	originalGit := mocks.NewMockGitExecutor(t)
+	factoryPath := ""
+	m := NewWithGitExecutorFactory(func(path string) appgit.GitExecutor {
+		factoryPath = path
+		if path == "/original" {
+			return originalGit
+		}
+		return mockGit
+	}, "/original").SetSize(100, 50)
+
+	m, cmd := m.ShowBranchDiffAndLoad("/worktrees/perles-auth", "main")
+
+	require.NotNil(t, cmd)
+	require.True(t, m.Visible())
+	require.Equal(t, "/worktrees/perles-auth", factoryPath)
+	require.Equal(t, "/worktrees/perles-auth", m.currentWorktreePath)
+	m.focus = focusFileList
+	require.Equal(t, "Changes vs main", m.buildBreadcrumb())
+
+	msg := m.LoadWorkingDirDiff()().(WorkingDirDiffLoadedMsg)
+	require.NoError(t, msg.Err)
+	require.Len(t, msg.Files, 1)
+	require.Equal(t, "auth.go", msg.Files[0].NewPath)
+	require.Equal(t, 1, msg.Files[0].Additions)
+
+	m = m.Hide()
+	require.Equal(t, "Working Directory", m.buildBreadcrumb(), "hiding ends the branch diff")
+	require.Same(t, originalGit, m.gitExecutor, "hiding restores the original executor")
+	require.Equal(t, "/original", m.currentWorktreePath)
+}
+
 // TestHandleWorktreeSelected_WorktreeNotInCache tests error when worktree not found in cache.
 func TestHandleWorktreeSelected_WorktreeNotInCache(t *testing.T) {
 	// Need factory for handleWorktreeSelected to not no-op
@@ -98,3 +98,25 @@ func Render(issue beads.Issue, cfg Config) string {
 
 	return strings.Join(parts, "")
 }
+
+// RenderProgress returns the descendant rollup for an issue: "3/7 ●2 ⊘1".
+// The in-progress (●) and blocked (⊘) counts are highlighted and only shown when non-zero.
+// Returns "" when the issue has no descendants.
+func RenderProgress(p beads.Progress) string {
+	if p.Total == 0 {
+		return ""
+	}
+
+	countStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+	inProgressStyle := lipgloss.NewStyle().Foreground(styles.StatusInProgressColor)
+	blockedStyle := lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+
+	parts := []string{countStyle.Render(fmt.Sprintf("%d/%d", p.Closed, p.Total))}
+	if p.InProgress > 0 {
+		parts = append(parts, inProgressStyle.Render(fmt.Sprintf("●%d", p.InProgress)))
+	}
+	if p.Blocked > 0 {
+		parts = append(parts, blockedStyle.Render(fmt.Sprintf("⊘%d", p.Blocked)))
+	}
+	return strings.Join(parts, " ")
+}
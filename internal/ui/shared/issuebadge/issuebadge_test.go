@@ -430,3 +430,10 @@ func TestRenderBadge_Pinned_Golden(t *testing.T) {
 	got := RenderBadge(issue)
 	teatest.RequireEqualOutput(t, []byte(got))
 }
+
+func TestRenderProgress(t *testing.T) {
+	require.Empty(t, RenderProgress(beads.Progress{}), "no descendants renders nothing")
+	require.Equal(t, "3/7", stripANSI(RenderProgress(beads.Progress{Closed: 3, Total: 7})))
+	require.Equal(t, "3/7 ●2 ⊘1", stripANSI(RenderProgress(beads.Progress{Closed: 3, Total: 7, InProgress: 2, Blocked: 1})))
+	require.Equal(t, "0/2 ⊘2", stripANSI(RenderProgress(beads.Progress{Total: 2, Blocked: 2})))
+}
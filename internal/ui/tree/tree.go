@@ -392,7 +392,7 @@ func (m *Model) renderNode(node *TreeNode, isLast bool, isSelected bool) string
 	statusText := m.renderStatus(node.Issue.Status)
 	statusWidth := lipgloss.Width(statusText)
 
-	// Build right metadata: comment indicator + timestamp
+	// Build right metadata: progress rollup + comment indicator + timestamp
 	metaStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
 	timestamp := shared.FormatRelativeTimeWithClock(node.Issue.CreatedAt, m.clock)
 	commentInd := styles.FormatCommentIndicator(node.Issue.CommentCount)
@@ -402,6 +402,11 @@ func (m *Model) renderNode(node *TreeNode, isLast bool, isSelected bool) string
 		rightMeta = commentInd + " " + timestamp
 	}
 	rightRendered := metaStyle.Render(rightMeta)
+
+	// Prepend the descendant rollup for nodes with children (e.g. "3/7 ●2 ⊘1")
+	if rollup := issuebadge.RenderProgress(node.Issue.Progress); rollup != "" {
+		rightRendered = rollup + metaStyle.Render(" · ") + rightRendered
+	}
 	rightWidth := lipgloss.Width(rightRendered)
 
 	// Calculate available width for title
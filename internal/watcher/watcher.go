@@ -31,7 +31,7 @@ type WatcherEvent struct {
 // Watcher monitors the beads database for changes and publishes events via broker.
 type Watcher struct {
 	fsWatcher *fsnotify.Watcher
-	dbPath    string
+	dbPaths   []string
 	debounce  time.Duration
 	done      chan struct{}
 	broker    *pubsub.Broker[WatcherEvent]
@@ -41,6 +41,10 @@ type Watcher struct {
 type Config struct {
 	DBPath      string
 	DebounceDur time.Duration
+
+	// AdditionalDBPaths are further databases (other mounted projects) to watch.
+	// A change in any database publishes a single debounced DBChanged event.
+	AdditionalDBPaths []string
 }
 
 // DefaultConfig returns sensible defaults for the watcher.
@@ -53,7 +57,7 @@ func DefaultConfig(dbPath string) Config {
 
 // New creates a new database watcher.
 func New(cfg Config) (*Watcher, error) {
-	log.Debug(log.CatWatcher, "Creating watcher", "dbPath", cfg.DBPath, "debounce", cfg.DebounceDur)
+	log.Debug(log.CatWatcher, "Creating watcher", "dbPath", cfg.DBPath, "additional", len(cfg.AdditionalDBPaths), "debounce", cfg.DebounceDur)
 	fsw, err := fsnotify.NewWatcher()
 	if err != nil {
 		log.ErrorErr(log.CatWatcher, "Failed to create fsnotify watcher", err)
@@ -62,24 +66,26 @@ func New(cfg Config) (*Watcher, error) {
 
 	return &Watcher{
 		fsWatcher: fsw,
-		dbPath:    cfg.DBPath,
+		dbPaths:   append([]string{cfg.DBPath}, cfg.AdditionalDBPaths...),
 		debounce:  cfg.DebounceDur,
 		done:      make(chan struct{}),
 		broker:    pubsub.NewBroker[WatcherEvent](),
 	}, nil
 }
 
-// Start begins watching the database directory.
+// Start begins watching the database directories.
 // Subscribe to watcher events using Broker().Subscribe(ctx) instead of the old channel return.
 func (w *Watcher) Start() error {
-	// Watch the directory containing the database
-	dir := filepath.Dir(w.dbPath)
-	if err := w.fsWatcher.Add(dir); err != nil {
-		log.ErrorErr(log.CatWatcher, "Failed to watch directory", err, "dir", dir)
-		return fmt.Errorf("watching directory %s: %w", dir, err)
+	// Watch the directory containing each database
+	for _, dbPath := range w.dbPaths {
+		dir := filepath.Dir(dbPath)
+		if err := w.fsWatcher.Add(dir); err != nil {
+			log.ErrorErr(log.CatWatcher, "Failed to watch directory", err, "dir", dir)
+			return fmt.Errorf("watching directory %s: %w", dir, err)
+		}
+		log.Info(log.CatWatcher, "Started watching", "dir", dir)
 	}
 
-	log.Info(log.CatWatcher, "Started watching", "dir", dir)
 	go w.loop()
 
 	return nil
@@ -478,3 +478,33 @@ func TestWatcher_MultipleSubscribers(t *testing.T) {
 
 	require.Equal(t, 3, receivedCount, "all three subscribers should receive the event")
 }
+
+func TestWatcher_AdditionalDBPaths(t *testing.T) {
+	primary := filepath.Join(t.TempDir(), "beads.db")
+	secondary := filepath.Join(t.TempDir(), "beads.db")
+	require.NoError(t, os.WriteFile(primary, []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(secondary, []byte("b"), 0644))
+
+	w, err := watcher.New(watcher.Config{
+		DBPath:            primary,
+		AdditionalDBPaths: []string{secondary},
+		DebounceDur:       50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer func() { _ = w.Stop() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sub := w.Broker().Subscribe(ctx)
+	require.NoError(t, w.Start())
+
+	// A write to the additional database triggers a refresh
+	require.NoError(t, os.WriteFile(secondary, []byte("changed"), 0644))
+
+	select {
+	case evt := <-sub:
+		require.Equal(t, watcher.DBChanged, evt.Payload.Type)
+	case <-time.After(time.Second):
+		require.Fail(t, "expected notification for additional database")
+	}
+}